@@ -5,13 +5,19 @@ import (
 	"errors"
 	"fmt"
 	"inventory-service/config"
+	"inventory-service/internal/adapter/consumer"
 	"inventory-service/internal/adapter/grpcserver"
+	"inventory-service/internal/adapter/listener"
 	"inventory-service/internal/adapter/repository"
 	rest "inventory-service/internal/adapter/restapi"
+	"inventory-service/internal/adapter/scheduler"
 	"inventory-service/internal/domain/service"
+	"inventory-service/internal/shared/ratelimit"
 	"inventory-service/pkg/apmtracer"
 	"inventory-service/pkg/bundb"
 	"inventory-service/pkg/logger"
+	"inventory-service/pkg/pubsub"
+	"inventory-service/pkg/tokenbucket"
 	"os"
 	"os/signal"
 	"syscall"
@@ -21,11 +27,19 @@ import (
 )
 
 type App struct {
-	config     *config.Config
-	restServer rest.Server
-	grpcServer *grpc.Server
-	logger     logger.Logger
-	tracer     apmtracer.Tracer
+	config             *config.Config
+	restServer         rest.Server
+	grpcServer         *grpc.Server
+	priceScheduler     *scheduler.PriceScheduler
+	reservationExpirer *scheduler.ReservationExpirer
+	stockListener      *listener.StockListener
+	outboxRelay        *scheduler.OutboxRelay
+	publisher          pubsub.Publisher
+	webhookDispatcher  *scheduler.WebhookDispatcher
+	subscriber         pubsub.Subscriber
+	orderConsumer      *consumer.OrderConsumer
+	logger             logger.Logger
+	tracer             apmtracer.Tracer
 }
 
 func NewApp(config *config.Config, logger logger.Logger) (*App, error) {
@@ -69,20 +83,30 @@ func (a *App) Run() error {
 		return fmt.Errorf("failed to setup repository: %w", err)
 	}
 
+	if a.config.Audit == nil || !a.config.Audit.Enabled {
+		a.logger.Warn().Msg("Auditing is disabled, changes to products and reservations are not recorded")
+	}
+
 	// Initialize service
 	service, err := service.NewService(a.config, repo, a.logger, nil)
 	if err != nil {
 		return fmt.Errorf("failed to setup service: %w", err)
 	}
 
+	// Initialize the rate limiter shared by the REST and gRPC servers
+	limiter, err := a.newLimiter()
+	if err != nil {
+		return fmt.Errorf("failed to setup rate limiter: %w", err)
+	}
+
 	// Initialize and start REST server
-	a.restServer, err = rest.NewEchoServer(a.config, a.logger, service, repo)
+	a.restServer, err = rest.NewEchoServer(a.config, a.logger, service, repo, limiter)
 	if err != nil {
 		return fmt.Errorf("failed to setup server: %w", err)
 	}
 
 	// Initialize gRPC server
-	a.grpcServer, err = grpcserver.NewGRPCServer(a.config, repo, a.logger)
+	a.grpcServer, err = grpcserver.NewGRPCServer(a.config, repo, a.logger, service.StockWatch(), limiter)
 	if err != nil {
 		return fmt.Errorf("failed to setup gRPC server: %w", err)
 	}
@@ -95,6 +119,50 @@ func (a *App) Run() error {
 
 	a.logger.Info().Msgf("Server started at %s:%d", a.config.HTTP.Host, a.config.HTTP.Port)
 
+	// Start price scheduler
+	a.priceScheduler = scheduler.NewPriceScheduler(a.config, service, a.logger)
+	a.priceScheduler.Start(ctx)
+
+	// Start reservation expirer
+	a.reservationExpirer = scheduler.NewReservationExpirer(a.config, service, a.logger)
+	a.reservationExpirer.Start(ctx)
+
+	// Start stock event listener
+	a.stockListener = listener.NewStockListener(a.config, service, a.logger)
+	a.stockListener.Start(ctx)
+
+	// Start outbox relay, which also fans events out to webhooks
+	webhooksEnabled := a.config.Webhook != nil && a.config.Webhook.Enabled
+
+	if a.config.App.UsePubsub {
+		a.publisher, err = a.newPublisher()
+		if err != nil {
+			return fmt.Errorf("failed to setup publisher: %w", err)
+		}
+	}
+
+	if a.config.App.UsePubsub || webhooksEnabled {
+		a.outboxRelay = scheduler.NewOutboxRelay(a.config, service, a.publisher, a.logger)
+		a.outboxRelay.Start(ctx)
+	}
+
+	// Start webhook dispatcher
+	if webhooksEnabled {
+		a.webhookDispatcher = scheduler.NewWebhookDispatcher(a.config, service, a.logger)
+		a.webhookDispatcher.Start(ctx)
+	}
+
+	// Start order event consumer
+	if a.config.Consumer != nil && a.config.Consumer.Enabled {
+		a.subscriber, err = a.newSubscriber()
+		if err != nil {
+			return fmt.Errorf("failed to setup subscriber: %w", err)
+		}
+
+		a.orderConsumer = consumer.NewOrderConsumer(a.subscriber, service, a.logger.NewInstance().Field("component", "order_consumer").Logger())
+		a.orderConsumer.Start(ctx)
+	}
+
 	// Wait for shutdown signal
 	<-ctx.Done()
 	a.logger.Info().Msg("Shutdown signal received, starting graceful shutdown...")
@@ -102,6 +170,9 @@ func (a *App) Run() error {
 	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 15*time.Second)
 	defer shutdownCancel()
 
+	// End stock watches, which would otherwise hold the servers open
+	service.StockWatch().Close()
+
 	// Shutdown REST server
 	if err := a.restServer.Shutdown(shutdownCtx); err != nil {
 		a.logger.Error().Err(err).Msg("Failed to gracefully shutdown REST server")
@@ -115,6 +186,46 @@ func (a *App) Run() error {
 		a.logger.Info().Msg("gRPC server shut down gracefully")
 	}
 
+	// Wait for price scheduler
+	a.priceScheduler.Wait()
+	a.logger.Info().Msg("Price scheduler stopped")
+
+	// Wait for reservation expirer
+	a.reservationExpirer.Wait()
+	a.logger.Info().Msg("Reservation expirer stopped")
+
+	// Wait for stock event listener
+	a.stockListener.Wait()
+	a.logger.Info().Msg("Stock event listener stopped")
+
+	// Wait for outbox relay
+	if a.outboxRelay != nil {
+		a.outboxRelay.Wait()
+		a.logger.Info().Msg("Outbox relay stopped")
+	}
+
+	if a.publisher != nil {
+		if err := a.publisher.Close(); err != nil {
+			a.logger.Error().Err(err).Msg("Failed to close publisher")
+		}
+	}
+
+	// Wait for webhook dispatcher
+	if a.webhookDispatcher != nil {
+		a.webhookDispatcher.Wait()
+		a.logger.Info().Msg("Webhook dispatcher stopped")
+	}
+
+	// Wait for order event consumer
+	if a.orderConsumer != nil {
+		a.orderConsumer.Wait()
+		a.logger.Info().Msg("Order event consumer stopped")
+
+		if err := a.subscriber.Close(); err != nil {
+			a.logger.Error().Err(err).Msg("Failed to close subscriber")
+		}
+	}
+
 	// Close repository
 	if err := repo.Close(); err != nil {
 		a.logger.Error().Err(err).Msg("Failed to gracefully close repository")
@@ -127,6 +238,45 @@ func (a *App) Run() error {
 	return nil
 }
 
+// newLimiter returns nil when rate limiting is disabled. Buckets are kept in
+// memory, so every instance limits its callers on its own.
+func (a *App) newLimiter() (*ratelimit.Limiter, error) {
+	if a.config.RateLimit == nil || !a.config.RateLimit.Enabled {
+		return nil, nil
+	}
+
+	return ratelimit.NewLimiter(a.config, tokenbucket.NewMemoryStore(), a.logger)
+}
+
+// newPublisher returns the publisher selected by the outbox configuration.
+func (a *App) newPublisher() (pubsub.Publisher, error) {
+	outbox := a.config.Outbox
+	if outbox == nil {
+		outbox = &config.OutboxConfig{}
+	}
+
+	switch outbox.Publisher {
+	case "", "log":
+		return pubsub.NewLogPublisher(a.logger.NewInstance().Field("component", "publisher").Logger()), nil
+	case "file":
+		return pubsub.NewFilePublisher(outbox.FilePath)
+	default:
+		return nil, fmt.Errorf("unknown publisher %q", outbox.Publisher)
+	}
+}
+
+// newSubscriber returns the subscriber selected by the consumer configuration.
+func (a *App) newSubscriber() (pubsub.Subscriber, error) {
+	consumer := a.config.Consumer
+
+	switch consumer.Subscriber {
+	case "", "file":
+		return pubsub.NewFileSubscriber(consumer.FilePath, time.Duration(consumer.PollInterval)*time.Second)
+	default:
+		return nil, fmt.Errorf("unknown subscriber %q", consumer.Subscriber)
+	}
+}
+
 func (a *App) Migrate(reset bool) error {
 	db, err := bundb.NewBunDB(a.config, a.logger)
 	if err != nil {
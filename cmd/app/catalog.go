@@ -0,0 +1,69 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"inventory-service/internal/adapter/catalog"
+	"inventory-service/internal/adapter/repository"
+	postgresrepository "inventory-service/internal/adapter/repository/postgres"
+	"inventory-service/internal/domain/service"
+	"io"
+	"os"
+)
+
+// ImportProducts upserts the products of a catalog CSV file, reporting each
+// row to out. A zero batch size falls back to the configured bulk limit.
+func (a *App) ImportProducts(ctx context.Context, path string, out io.Writer, opts catalog.ImportOptions) (*catalog.ImportReport, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	defer file.Close()
+
+	if opts.BatchSize <= 0 && a.config.App != nil {
+		opts.BatchSize = a.config.App.BatchMaxItems
+	}
+
+	var report *catalog.ImportReport
+
+	err = a.withProductService(func(products service.ProductService) error {
+		report, err = catalog.NewImporter(products, out).Import(ctx, file, opts)
+		return err
+	})
+
+	return report, err
+}
+
+// ExportProducts writes every product with its current stock to w as CSV or
+// JSON Lines and returns how many were written.
+func (a *App) ExportProducts(ctx context.Context, w io.Writer, format string) (int, error) {
+	var count int
+
+	err := a.withProductService(func(products service.ProductService) error {
+		var err error
+		count, err = catalog.NewExporter(products).Export(ctx, w, format, &postgresrepository.FilterProductPayload{})
+		return err
+	})
+
+	return count, err
+}
+
+func (a *App) withProductService(fn func(service.ProductService) error) error {
+	repo, err := repository.NewRepository(a.config, a.logger)
+	if err != nil {
+		return fmt.Errorf("failed to setup repository: %w", err)
+	}
+
+	defer func() {
+		if err := repo.Close(); err != nil {
+			a.logger.Error().Err(err).Msg("Failed to close repository")
+		}
+	}()
+
+	service, err := service.NewService(a.config, repo, a.logger, nil)
+	if err != nil {
+		return fmt.Errorf("failed to setup service: %w", err)
+	}
+
+	return fn(service.Product())
+}
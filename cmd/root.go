@@ -1,13 +1,17 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"inventory-service/cmd/app"
 	"inventory-service/config"
+	"inventory-service/internal/adapter/catalog"
 	"inventory-service/pkg/logger"
 	"os"
+	"os/signal"
 	"slices"
 	"strings"
+	"syscall"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -109,6 +113,150 @@ var migrateCmd = &cobra.Command{
 	},
 }
 
+var productsCmd = &cobra.Command{
+	Use:   "products",
+	Short: "Import and export the product catalog",
+}
+
+var productsImportCmd = &cobra.Command{
+	Use:   "import <file.csv>",
+	Short: "Create or update products from a CSV file",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		configFile, err := cmd.Flags().GetString("config")
+		if err != nil {
+			fmt.Println("Failed to get config flag:", err)
+			os.Exit(1)
+		}
+
+		dryRun, err := cmd.Flags().GetBool("dry-run")
+		if err != nil {
+			fmt.Println("Failed to get dry-run flag:", err)
+			os.Exit(1)
+		}
+
+		batchSize, err := cmd.Flags().GetInt("batch-size")
+		if err != nil {
+			fmt.Println("Failed to get batch-size flag:", err)
+			os.Exit(1)
+		}
+
+		logger := logger.NewZerologLogger(false)
+
+		config, err := config.LoadConfig(configFile)
+		if err != nil {
+			fmt.Println("Failed to load config:", err)
+			os.Exit(1)
+		}
+
+		app, err := app.NewApp(config, logger)
+		if err != nil {
+			fmt.Println("Failed to create app:", err)
+			os.Exit(1)
+		}
+
+		ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer cancel()
+
+		report, err := app.ImportProducts(ctx, args[0], os.Stdout, catalog.ImportOptions{
+			BatchSize: batchSize,
+			DryRun:    dryRun,
+		})
+		if report != nil {
+			verb := "Imported"
+			if dryRun {
+				verb = "Dry run"
+			}
+
+			fmt.Printf("%s: %d created, %d updated, %d unchanged, %d failed\n", verb, report.Created, report.Updated, report.Unchanged, report.Failed)
+		}
+
+		if err != nil {
+			fmt.Println("Failed to import products:", err)
+			os.Exit(1)
+		}
+
+		if report.Failed > 0 {
+			os.Exit(1)
+		}
+	},
+}
+
+var productsExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Write all products with current stock as CSV or JSON Lines",
+	Run: func(cmd *cobra.Command, _ []string) {
+		configFile, err := cmd.Flags().GetString("config")
+		if err != nil {
+			fmt.Println("Failed to get config flag:", err)
+			os.Exit(1)
+		}
+
+		format, err := cmd.Flags().GetString("format")
+		if err != nil {
+			fmt.Println("Failed to get format flag:", err)
+			os.Exit(1)
+		}
+
+		output, err := cmd.Flags().GetString("output")
+		if err != nil {
+			fmt.Println("Failed to get output flag:", err)
+			os.Exit(1)
+		}
+
+		logger := logger.NewZerologLogger(false)
+
+		config, err := config.LoadConfig(configFile)
+		if err != nil {
+			fmt.Println("Failed to load config:", err)
+			os.Exit(1)
+		}
+
+		app, err := app.NewApp(config, logger)
+		if err != nil {
+			fmt.Println("Failed to create app:", err)
+			os.Exit(1)
+		}
+
+		w := os.Stdout
+		if output != "-" {
+			w, err = os.Create(output)
+			if err != nil {
+				fmt.Println("Failed to create output file:", err)
+				os.Exit(1)
+			}
+			defer w.Close()
+		}
+
+		ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer cancel()
+
+		count, err := app.ExportProducts(ctx, w, format)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "Failed to export products:", err)
+			os.Exit(1)
+		}
+
+		if output != "-" {
+			fmt.Printf("Exported %d products to %s\n", count, output)
+		}
+	},
+}
+
+func productsExportCmdPreRunE(cmd *cobra.Command, _ []string) error {
+	format, err := cmd.Flags().GetString("format")
+	if err != nil {
+		return fmt.Errorf("failed to get format flag: %w", err)
+	}
+
+	validFormats := []string{catalog.FormatCSV, catalog.FormatJSONL}
+	if !slices.Contains(validFormats, format) {
+		return fmt.Errorf("invalid format %s. valid formats are: %v", format, strings.Join(validFormats, ", "))
+	}
+
+	return nil
+}
+
 func runCmdPreRunE(cmd *cobra.Command, _ []string) error {
 	env, err := cmd.Flags().GetString("env")
 	if err != nil {
@@ -159,11 +307,28 @@ func init() {
 		os.Exit(1)
 	}
 
+	productsCmd.PersistentFlags().StringP("config", "c", ".env", "Specify the config file (optional)")
+
+	if err := viper.BindPFlag("config", productsCmd.PersistentFlags().Lookup("config")); err != nil {
+		fmt.Println("Failed to bind config flag:", err)
+		os.Exit(1)
+	}
+
+	productsImportCmd.Flags().Bool("dry-run", false, "Only report what would change (optional)")
+	productsImportCmd.Flags().IntP("batch-size", "b", 0, "Rows committed per batch, defaults to the bulk limit (optional)")
+	productsExportCmd.Flags().StringP("format", "f", catalog.FormatCSV, "Output format, csv or jsonl (optional)")
+	productsExportCmd.Flags().StringP("output", "o", "-", "Output file, - for stdout (optional)")
+
+	productsCmd.AddCommand(productsImportCmd)
+	productsCmd.AddCommand(productsExportCmd)
+
 	rootCmd.AddCommand(versionCmd)
 	rootCmd.AddCommand(runCmd)
 	rootCmd.AddCommand(migrateCmd)
+	rootCmd.AddCommand(productsCmd)
 
 	runCmd.PreRunE = runCmdPreRunE
+	productsExportCmd.PreRunE = productsExportCmdPreRunE
 }
 
 func Execute() {
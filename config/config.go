@@ -1,6 +1,8 @@
 package config
 
 import (
+	"net"
+	"strconv"
 	"strings"
 
 	"github.com/cockroachdb/errors"
@@ -8,11 +10,19 @@ import (
 )
 
 type Config struct {
-	App      *AppConfig
-	Tracer   *TracerConfig
-	Postgres *DatabaseConfig
-	Grpc     *GRPCConfig
-	HTTP     *HTTPConfig
+	App        *AppConfig
+	Tracer     *TracerConfig
+	Postgres   *DatabaseConfig
+	Grpc       *GRPCConfig
+	HTTP       *HTTPConfig
+	Scheduler  *SchedulerConfig
+	StockWatch *StockWatchConfig
+	Outbox     *OutboxConfig
+	Webhook    *WebhookConfig
+	Consumer   *ConsumerConfig
+	Auth       *AuthConfig
+	Audit      *AuditConfig
+	RateLimit  *RateLimitConfig
 }
 
 type AppConfig struct {
@@ -22,6 +32,13 @@ type AppConfig struct {
 	Debug       bool
 	UsePubsub   bool
 	FrontendURL string
+	// BatchMaxItems caps the number of items a bulk call may carry.
+	BatchMaxItems int
+	// MaxReservationQuantity caps the base units a single reservation may hold.
+	MaxReservationQuantity int
+	// ReservationTTL is how long, in seconds, a pending reservation holds its
+	// stock before it expires. Zero keeps pending reservations indefinitely.
+	ReservationTTL int
 }
 
 type TracerConfig struct {
@@ -50,11 +67,129 @@ type HTTPConfig struct {
 	BasePath           string
 	DomainName         string
 	EnableMigrationAPI bool
+	// TrustedProxies are the address ranges of the proxies whose
+	// X-Forwarded-For header names the client, given as CIDRs separated by
+	// commas. Without any, the client is the address the request came from.
+	TrustedProxies []*net.IPNet
 }
 
 type GRPCConfig struct {
 	Host string
 	Port int
+	// TLSCertFile and TLSKeyFile enable TLS; both are re-read when they
+	// change on disk, checked at most every CertReloadInterval seconds.
+	TLSCertFile        string
+	TLSKeyFile         string
+	CertReloadInterval int
+	// ClientCAFile verifies client certificates. Unless RequireClientCert is
+	// set, clients may instead present one of APIKeys.
+	ClientCAFile      string
+	RequireClientCert bool
+	// APIKeys maps API keys to the name of the client using them, given as
+	// "name=key" pairs separated by commas.
+	APIKeys map[string]string
+}
+
+type SchedulerConfig struct {
+	// PriceChangeInterval is how often, in seconds, due price changes are applied.
+	PriceChangeInterval  int
+	PriceChangeBatchSize int
+	// ReservationExpiryInterval is how often, in seconds, overdue pending
+	// reservations are expired.
+	ReservationExpiryInterval  int
+	ReservationExpiryBatchSize int
+}
+
+type StockWatchConfig struct {
+	// BufferSize is the number of events queued for a watcher before it is
+	// disconnected as too slow.
+	BufferSize  int
+	MaxProducts int
+	// Retention is how long, in hours, stock events are kept for resuming.
+	Retention int
+	// HeartbeatInterval is how often, in seconds, idle SSE streams send a comment.
+	HeartbeatInterval int
+}
+
+type OutboxConfig struct {
+	// Publisher selects where events are published: "log" (default) or "file".
+	Publisher string
+	FilePath  string
+	// PollInterval is how often, in seconds, the relay looks for events.
+	PollInterval int
+	BatchSize    int
+	// MaxAttempts is the number of failed deliveries after which an event is
+	// dead-lettered.
+	MaxAttempts int
+}
+
+type WebhookConfig struct {
+	// Enabled records domain events for webhooks and runs their delivery.
+	Enabled bool
+	// Timeout is how long, in seconds, a receiver has to answer.
+	Timeout      int
+	PollInterval int
+	BatchSize    int
+	MaxAttempts  int
+	// DisableAfter is the number of consecutive failed attempts after which
+	// a webhook is disabled.
+	DisableAfter int
+	// AllowPrivateNetworks lets webhooks be sent to loopback and private
+	// addresses, for receivers running next to the service.
+	AllowPrivateNetworks bool
+}
+
+type ConsumerConfig struct {
+	// Enabled consumes the order service's lifecycle events.
+	Enabled bool
+	// Subscriber selects where events are read from: "file" (default).
+	Subscriber string
+	FilePath   string
+	// PollInterval is how often, in seconds, the file is checked for new events.
+	PollInterval int
+}
+
+type AuthConfig struct {
+	// Enabled requires a bearer JWT on every route and RPC that is not public.
+	Enabled bool
+	// Algorithm is "HS256", verified with Secret, or "RS256", verified with
+	// the keys of the JWKS file at JWKSFile.
+	Algorithm string
+	Secret    string
+	JWKSFile  string
+	Issuer    string
+	Audience  string
+	// PolicyFile maps roles to permissions and endpoints to the permission
+	// they require.
+	PolicyFile string
+}
+
+type AuditConfig struct {
+	// Enabled records who changed products and reservations, and how. It is
+	// on unless AUDIT_ENABLED is set to false.
+	Enabled bool
+}
+
+type RateLimitConfig struct {
+	// Enabled limits how often each caller, identified by its JWT subject,
+	// client name or IP, may call the service.
+	Enabled bool
+	// Rate is the sustained calls per second and Burst the calls allowed at
+	// once, for endpoints whose group has no limit of its own.
+	Rate  float64
+	Burst int
+	// IPRate and IPBurst limit all calls from an IP address before they are
+	// authenticated, so unauthenticated callers are limited too.
+	IPRate  float64
+	IPBurst int
+	// Groups limits the endpoints of a permission of the policy file, read
+	// from "permission=rate:burst" pairs separated by commas.
+	Groups map[string]RateLimit
+}
+
+type RateLimit struct {
+	Rate  float64
+	Burst int
 }
 
 func LoadConfig(envPath string) (*Config, error) {
@@ -66,6 +201,9 @@ func LoadConfig(envPath string) (*Config, error) {
 	viper.AutomaticEnv()
 	viper.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
 
+	// Changes are audited unless auditing is explicitly turned off.
+	viper.SetDefault("AUDIT_ENABLED", true)
+
 	if err := viper.ReadInConfig(); err != nil {
 		var cfgErr viper.ConfigFileNotFoundError
 		if !errors.As(err, &cfgErr) {
@@ -73,14 +211,27 @@ func LoadConfig(envPath string) (*Config, error) {
 		}
 	}
 
+	trustedProxies, err := parseTrustedProxies(viper.GetString("HTTP_TRUSTED_PROXIES"))
+	if err != nil {
+		return nil, err
+	}
+
+	rateLimitGroups, err := parseRateLimits(viper.GetString("RATE_LIMIT_GROUPS"))
+	if err != nil {
+		return nil, err
+	}
+
 	config := &Config{
 		App: &AppConfig{
-			Name:        viper.GetString("APP_NAME"),
-			Version:     viper.GetString("APP_VERSION"),
-			Environment: viper.GetString("APP_ENVIRONMENT"),
-			Debug:       viper.GetBool("APP_DEBUG"),
-			UsePubsub:   viper.GetBool("APP_USE_PUBSUB"),
-			FrontendURL: viper.GetString("FRONTEND_URL"),
+			Name:                   viper.GetString("APP_NAME"),
+			Version:                viper.GetString("APP_VERSION"),
+			Environment:            viper.GetString("APP_ENVIRONMENT"),
+			Debug:                  viper.GetBool("APP_DEBUG"),
+			UsePubsub:              viper.GetBool("APP_USE_PUBSUB"),
+			FrontendURL:            viper.GetString("FRONTEND_URL"),
+			BatchMaxItems:          viper.GetInt("APP_BATCH_MAX_ITEMS"),
+			MaxReservationQuantity: viper.GetInt("APP_MAX_RESERVATION_QUANTITY"),
+			ReservationTTL:         viper.GetInt("APP_RESERVATION_TTL"),
 		},
 		Tracer: &TracerConfig{
 			ServerURL:      viper.GetString("ELASTIC_APM_SERVER_URL"),
@@ -101,8 +252,14 @@ func LoadConfig(envPath string) (*Config, error) {
 			Debug:              viper.GetBool("POSTGRES_DEBUG"),
 		},
 		Grpc: &GRPCConfig{
-			Host: viper.GetString("GRPC_HOST"),
-			Port: viper.GetInt("GRPC_PORT"),
+			Host:               viper.GetString("GRPC_HOST"),
+			Port:               viper.GetInt("GRPC_PORT"),
+			TLSCertFile:        viper.GetString("GRPC_TLS_CERT_FILE"),
+			TLSKeyFile:         viper.GetString("GRPC_TLS_KEY_FILE"),
+			CertReloadInterval: viper.GetInt("GRPC_CERT_RELOAD_INTERVAL"),
+			ClientCAFile:       viper.GetString("GRPC_CLIENT_CA_FILE"),
+			RequireClientCert:  viper.GetBool("GRPC_REQUIRE_CLIENT_CERT"),
+			APIKeys:            parseAPIKeys(viper.GetString("GRPC_API_KEYS")),
 		},
 		HTTP: &HTTPConfig{
 			Host:               viper.GetString("HTTP_HOST"),
@@ -110,8 +267,139 @@ func LoadConfig(envPath string) (*Config, error) {
 			BasePath:           viper.GetString("HTTP_BASE_PATH"),
 			DomainName:         viper.GetString("HTTP_DOMAIN_NAME"),
 			EnableMigrationAPI: viper.GetBool("HTTP_ENABLE_MIGRATION_API"),
+			TrustedProxies:     trustedProxies,
+		},
+		Scheduler: &SchedulerConfig{
+			PriceChangeInterval:        viper.GetInt("SCHEDULER_PRICE_CHANGE_INTERVAL"),
+			PriceChangeBatchSize:       viper.GetInt("SCHEDULER_PRICE_CHANGE_BATCH_SIZE"),
+			ReservationExpiryInterval:  viper.GetInt("SCHEDULER_RESERVATION_EXPIRY_INTERVAL"),
+			ReservationExpiryBatchSize: viper.GetInt("SCHEDULER_RESERVATION_EXPIRY_BATCH_SIZE"),
+		},
+		StockWatch: &StockWatchConfig{
+			BufferSize:        viper.GetInt("STOCK_WATCH_BUFFER_SIZE"),
+			MaxProducts:       viper.GetInt("STOCK_WATCH_MAX_PRODUCTS"),
+			Retention:         viper.GetInt("STOCK_WATCH_RETENTION"),
+			HeartbeatInterval: viper.GetInt("STOCK_WATCH_HEARTBEAT_INTERVAL"),
+		},
+		Outbox: &OutboxConfig{
+			Publisher:    viper.GetString("OUTBOX_PUBLISHER"),
+			FilePath:     viper.GetString("OUTBOX_FILE_PATH"),
+			PollInterval: viper.GetInt("OUTBOX_POLL_INTERVAL"),
+			BatchSize:    viper.GetInt("OUTBOX_BATCH_SIZE"),
+			MaxAttempts:  viper.GetInt("OUTBOX_MAX_ATTEMPTS"),
+		},
+		Webhook: &WebhookConfig{
+			Enabled:              viper.GetBool("WEBHOOK_ENABLED"),
+			Timeout:              viper.GetInt("WEBHOOK_TIMEOUT"),
+			PollInterval:         viper.GetInt("WEBHOOK_POLL_INTERVAL"),
+			BatchSize:            viper.GetInt("WEBHOOK_BATCH_SIZE"),
+			MaxAttempts:          viper.GetInt("WEBHOOK_MAX_ATTEMPTS"),
+			DisableAfter:         viper.GetInt("WEBHOOK_DISABLE_AFTER"),
+			AllowPrivateNetworks: viper.GetBool("WEBHOOK_ALLOW_PRIVATE_NETWORKS"),
+		},
+		Consumer: &ConsumerConfig{
+			Enabled:      viper.GetBool("CONSUMER_ENABLED"),
+			Subscriber:   viper.GetString("CONSUMER_SUBSCRIBER"),
+			FilePath:     viper.GetString("CONSUMER_FILE_PATH"),
+			PollInterval: viper.GetInt("CONSUMER_POLL_INTERVAL"),
+		},
+		Auth: &AuthConfig{
+			Enabled:    viper.GetBool("AUTH_ENABLED"),
+			Algorithm:  viper.GetString("AUTH_ALGORITHM"),
+			Secret:     viper.GetString("AUTH_SECRET"),
+			JWKSFile:   viper.GetString("AUTH_JWKS_FILE"),
+			Issuer:     viper.GetString("AUTH_ISSUER"),
+			Audience:   viper.GetString("AUTH_AUDIENCE"),
+			PolicyFile: viper.GetString("AUTH_POLICY_FILE"),
+		},
+		Audit: &AuditConfig{
+			Enabled: viper.GetBool("AUDIT_ENABLED"),
+		},
+		RateLimit: &RateLimitConfig{
+			Enabled: viper.GetBool("RATE_LIMIT_ENABLED"),
+			Rate:    viper.GetFloat64("RATE_LIMIT_RATE"),
+			Burst:   viper.GetInt("RATE_LIMIT_BURST"),
+			IPRate:  viper.GetFloat64("RATE_LIMIT_IP_RATE"),
+			IPBurst: viper.GetInt("RATE_LIMIT_IP_BURST"),
+			Groups:  rateLimitGroups,
 		},
 	}
 
 	return config, nil
 }
+
+// parseAPIKeys reads "name=key" pairs separated by commas into a map keyed by
+// the API key.
+func parseAPIKeys(value string) map[string]string {
+	keys := make(map[string]string)
+
+	for _, pair := range strings.Split(value, ",") {
+		name, key, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if ok && name != "" && key != "" {
+			keys[key] = name
+		}
+	}
+
+	return keys
+}
+
+// parseTrustedProxies reads CIDRs separated by commas.
+func parseTrustedProxies(value string) ([]*net.IPNet, error) {
+	var proxies []*net.IPNet
+
+	for _, cidr := range strings.Split(value, ",") {
+		cidr = strings.TrimSpace(cidr)
+		if cidr == "" {
+			continue
+		}
+
+		_, proxy, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid HTTP_TRUSTED_PROXIES entry %q", cidr)
+		}
+
+		proxies = append(proxies, proxy)
+	}
+
+	return proxies, nil
+}
+
+// parseRateLimits reads "permission=rate:burst" pairs separated by commas.
+func parseRateLimits(value string) (map[string]RateLimit, error) {
+	limits := make(map[string]RateLimit)
+
+	for _, pair := range strings.Split(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		group, limit, ok := strings.Cut(pair, "=")
+		if !ok || group == "" {
+			return nil, errors.Newf("invalid RATE_LIMIT_GROUPS entry %q, want permission=rate:burst", pair)
+		}
+
+		rate, burst, ok := strings.Cut(limit, ":")
+		if !ok {
+			return nil, errors.Newf("invalid RATE_LIMIT_GROUPS entry %q, want permission=rate:burst", pair)
+		}
+
+		r, err := strconv.ParseFloat(rate, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid rate in RATE_LIMIT_GROUPS entry %q", pair)
+		}
+
+		b, err := strconv.Atoi(burst)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid burst in RATE_LIMIT_GROUPS entry %q", pair)
+		}
+
+		if _, ok := limits[group]; ok {
+			return nil, errors.Newf("rate limit group %q is given more than once in RATE_LIMIT_GROUPS", group)
+		}
+
+		limits[group] = RateLimit{Rate: r, Burst: b}
+	}
+
+	return limits, nil
+}
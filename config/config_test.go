@@ -0,0 +1,48 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRateLimits(t *testing.T) {
+	limits, err := parseRateLimits(" stock.adjust=1.5:3, products.write=10:20 ,")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]RateLimit{
+		"stock.adjust":   {Rate: 1.5, Burst: 3},
+		"products.write": {Rate: 10, Burst: 20},
+	}, limits)
+
+	limits, err = parseRateLimits("")
+	assert.NoError(t, err)
+	assert.Empty(t, limits)
+}
+
+func TestParseRateLimitsRejectsMalformedEntries(t *testing.T) {
+	for _, value := range []string{
+		"stock.adjust",
+		"=1:2",
+		"stock.adjust=1",
+		"stock.adjust=fast:2",
+		"stock.adjust=1:lots",
+		"stock.adjust=1:2,stock.adjust=3:4",
+	} {
+		t.Run(value, func(t *testing.T) {
+			_, err := parseRateLimits(value)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestParseTrustedProxies(t *testing.T) {
+	proxies, err := parseTrustedProxies("10.0.0.0/8, fd00::/8")
+	assert.NoError(t, err)
+	if assert.Len(t, proxies, 2) {
+		assert.Equal(t, "10.0.0.0/8", proxies[0].String())
+		assert.Equal(t, "fd00::/8", proxies[1].String())
+	}
+
+	_, err = parseTrustedProxies("10.0.0.1")
+	assert.Error(t, err)
+}
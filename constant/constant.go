@@ -1,5 +1,7 @@
 package constant
 
+import "time"
+
 const (
 	ReservationStatusPending     = "PENDING"
 	ReservationStatusConfirmed   = "CONFIRMED"
@@ -7,7 +9,149 @@ const (
 	ReservationStatusUnspecified = "UNSPECIFIED"
 )
 
+const (
+	SerialStatusAvailable = "AVAILABLE"
+	SerialStatusReserved  = "RESERVED"
+	SerialStatusAssigned  = "ASSIGNED"
+)
+
+// Product lifecycle statuses. Only active products can be reserved.
+const (
+	ProductStatusDraft        = "DRAFT"
+	ProductStatusActive       = "ACTIVE"
+	ProductStatusDiscontinued = "DISCONTINUED"
+)
+
+// Outcomes of one item of a bulk call. Aborted items were valid but not written
+// because another item of an all-or-nothing batch failed.
+const (
+	BatchItemStatusSucceeded = "SUCCEEDED"
+	BatchItemStatusFailed    = "FAILED"
+	BatchItemStatusAborted   = "ABORTED"
+)
+
+// Well-known product attributes read by the shipping calculator. Weight is a
+// number and dimensions an object with numeric length, width and height.
+const (
+	AttributeWeight     = "weight"
+	AttributeDimensions = "dimensions"
+)
+
+// DefaultBatchMaxItems is the bulk call size limit when none is configured.
+const DefaultBatchMaxItems = 1000
+
+// DefaultMaxReservationQuantity caps the base units of a reservation when no
+// limit is configured.
+const DefaultMaxReservationQuantity = 10000
+
+// DefaultCurrency is used for product prices given without a currency.
+const DefaultCurrency = "USD"
+
+// Units of measure. UnitEach is the base unit every stock quantity is kept in.
+const (
+	UnitEach   = "each"
+	UnitPack   = "pack"
+	UnitCase   = "case"
+	UnitPallet = "pallet"
+)
+
 const (
 	CtxKeyRequestID = "request_id"
 	CtxKeySubLogger = "sub_logger"
 )
+
+// Stock watch defaults used when none are configured.
+const (
+	DefaultStockWatchBufferSize  = 64
+	DefaultStockWatchMaxProducts = 100
+	DefaultStockWatchRetention   = 24 * time.Hour
+)
+
+// Domain events published through the outbox. Every event is keyed by the
+// product it concerns, so the events of one product are delivered in order.
+const (
+	EventProductCreated       = "inventory.product.created"
+	EventStockChanged         = "inventory.stock.changed"
+	EventReservationCreated   = "inventory.reservation.created"
+	EventReservationConfirmed = "inventory.reservation.confirmed"
+	EventReservationCancelled = "inventory.reservation.cancelled"
+	EventReservationExpired   = "inventory.reservation.expired"
+)
+
+// Audited entity types and the actions recorded for them.
+const (
+	AuditEntityProduct     = "product"
+	AuditEntityReservation = "reservation"
+
+	AuditActionCreated       = "created"
+	AuditActionUpdated       = "updated"
+	AuditActionDeleted       = "deleted"
+	AuditActionStatusChanged = "status_changed"
+	AuditActionStockAdjusted = "stock_adjusted"
+)
+
+// Order events consumed from the order service. Paid orders confirm their
+// reservations and cancelled orders release them.
+const (
+	EventOrderPaid      = "order.paid"
+	EventOrderCancelled = "order.cancelled"
+)
+
+// Outbox event delivery states. Dead events ran out of attempts and are no
+// longer retried.
+const (
+	OutboxStatusPending   = "PENDING"
+	OutboxStatusDelivered = "DELIVERED"
+	OutboxStatusDead      = "DEAD"
+)
+
+// Outbox relay defaults used when none are configured.
+const (
+	DefaultOutboxBatchSize   = 100
+	DefaultOutboxMaxAttempts = 10
+)
+
+// Webhook delivery states. Dead deliveries ran out of attempts.
+const (
+	WebhookDeliveryStatusPending   = "PENDING"
+	WebhookDeliveryStatusSucceeded = "SUCCEEDED"
+	WebhookDeliveryStatusDead      = "DEAD"
+)
+
+// Webhook defaults used when none are configured. A webhook is disabled after
+// DefaultWebhookDisableAfter consecutive failed attempts.
+const (
+	DefaultWebhookTimeout      = 10 * time.Second
+	DefaultWebhookBatchSize    = 50
+	DefaultWebhookMaxAttempts  = 8
+	DefaultWebhookDisableAfter = 20
+)
+
+// DefaultCertReloadInterval is how often TLS files are checked for changes
+// when no interval is configured.
+const DefaultCertReloadInterval = 30 * time.Second
+
+// DefaultPolicyFile is the authorization policy read when none is configured.
+const DefaultPolicyFile = "config/policy.yaml"
+
+// Rate limits used when none are configured: calls per second and the calls
+// allowed at once. The IP limit applies to every call from an address before
+// it is authenticated, so it leaves room for callers sharing one.
+const (
+	DefaultRateLimitRate    = 50
+	DefaultRateLimitBurst   = 100
+	DefaultRateLimitIPRate  = 200
+	DefaultRateLimitIPBurst = 400
+)
+
+// Request limits enforced alike by the REST and gRPC APIs. String lengths
+// match the database columns.
+const (
+	MaxPerPage             = 100
+	MaxNameLength          = 255
+	MaxSKULength           = 64
+	MaxBarcodeLength       = 14
+	MaxLotNumberLength     = 64
+	MaxSerialNumberLength  = 128
+	MaxWebhookSecretLength = 255
+)
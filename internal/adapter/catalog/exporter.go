@@ -0,0 +1,79 @@
+package catalog
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	postgresrepository "inventory-service/internal/adapter/repository/postgres"
+	"inventory-service/internal/domain/entity"
+	"inventory-service/internal/domain/service"
+	"io"
+)
+
+// Export formats.
+const (
+	FormatCSV   = "csv"
+	FormatJSONL = "jsonl"
+)
+
+// Exporter writes the product catalog with current stock as CSV or JSON Lines.
+type Exporter struct {
+	products service.ProductService
+}
+
+func NewExporter(products service.ProductService) *Exporter {
+	return &Exporter{products: products}
+}
+
+// Export streams every product matching the filter to w and returns how many
+// were written.
+func (e *Exporter) Export(ctx context.Context, w io.Writer, format string, filter *postgresrepository.FilterProductPayload) (int, error) {
+	var (
+		write func(*Record) error
+		flush func() error
+	)
+
+	switch format {
+	case FormatCSV:
+		writer := csv.NewWriter(w)
+		if err := writer.Write(Columns); err != nil {
+			return 0, err
+		}
+
+		write = func(record *Record) error {
+			row, err := record.CSV()
+			if err != nil {
+				return err
+			}
+
+			return writer.Write(row)
+		}
+		flush = func() error {
+			writer.Flush()
+			return writer.Error()
+		}
+	case FormatJSONL:
+		buffered := bufio.NewWriter(w)
+		encoder := json.NewEncoder(buffered)
+		write = func(record *Record) error {
+			return encoder.Encode(record)
+		}
+		flush = buffered.Flush
+	default:
+		return 0, fmt.Errorf("unsupported export format %q", format)
+	}
+
+	count := 0
+
+	err := e.products.Export(ctx, filter, func(product *entity.Product) error {
+		count++
+		return write(NewRecord(product))
+	})
+	if err != nil {
+		return count, err
+	}
+
+	return count, flush()
+}
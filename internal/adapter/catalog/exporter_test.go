@@ -0,0 +1,73 @@
+package catalog_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"inventory-service/constant"
+	"inventory-service/internal/adapter/catalog"
+	postgresrepository "inventory-service/internal/adapter/repository/postgres"
+	"inventory-service/internal/domain/entity"
+	"inventory-service/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestExporterExport(t *testing.T) {
+	product := &entity.Product{
+		Base:           entity.Base{ID: 7},
+		SKU:            "TSHIRT-RED-M",
+		Name:           "T-Shirt",
+		ParentID:       3,
+		Options:        map[string]string{"size": "M"},
+		Stock:          10,
+		AvailableStock: 8,
+		Price:          entity.Money{Currency: "USD", Units: 19, Nanos: 990000000},
+		Status:         constant.ProductStatusActive,
+	}
+
+	tests := []struct {
+		format string
+		output string
+	}{
+		{
+			catalog.FormatCSV,
+			"id,sku,barcode,name,category_id,parent_id,options,stock,available_stock,price,currency,status,track_lots,track_serials,attributes\n" +
+				`7,TSHIRT-RED-M,,T-Shirt,,3,"{""size"":""M""}",10,8,19.99,USD,ACTIVE,false,false,` + "\n",
+		},
+		{
+			catalog.FormatJSONL,
+			`{"id":7,"sku":"TSHIRT-RED-M","name":"T-Shirt","parent_id":3,"options":{"size":"M"},"stock":10,"available_stock":8,` +
+				`"price":"19.99","currency":"USD","status":"ACTIVE","track_lots":false,"track_serials":false}` + "\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			products := mocks.NewMockProductService(t)
+			filter := &postgresrepository.FilterProductPayload{}
+
+			products.EXPECT().Export(mock.Anything, filter, mock.Anything).
+				RunAndReturn(func(_ context.Context, _ *postgresrepository.FilterProductPayload, fn func(*entity.Product) error) error {
+					return fn(product)
+				})
+
+			var out bytes.Buffer
+			count, err := catalog.NewExporter(products).Export(context.Background(), &out, tt.format, filter)
+
+			assert.NoError(t, err)
+			assert.Equal(t, 1, count)
+			assert.Equal(t, tt.output, out.String())
+		})
+	}
+}
+
+func TestExporterUnsupportedFormat(t *testing.T) {
+	products := mocks.NewMockProductService(t)
+
+	_, err := catalog.NewExporter(products).Export(context.Background(), &bytes.Buffer{}, "xml", &postgresrepository.FilterProductPayload{})
+
+	assert.Error(t, err)
+}
@@ -0,0 +1,557 @@
+package catalog
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"inventory-service/constant"
+	postgresrepository "inventory-service/internal/adapter/repository/postgres"
+	"inventory-service/internal/domain/entity"
+	"inventory-service/internal/domain/service"
+	"inventory-service/internal/shared/exception"
+	"io"
+	"maps"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+// ImportOptions controls an import. BatchSize rows are validated and committed
+// together; DryRun validates and reports without writing.
+type ImportOptions struct {
+	BatchSize int
+	DryRun    bool
+}
+
+// ImportReport counts the outcome of the rows of an import.
+type ImportReport struct {
+	Created   int
+	Updated   int
+	Unchanged int
+	Failed    int
+}
+
+// Importer upserts products from a CSV file with the Columns layout. Rows with
+// an id update that product, rows without one update the product with the
+// same SKU or create a new one. Columns missing from the header keep their
+// current value on update, while empty cells clear it, except for the SKU and
+// status, which are kept. Every row outcome is reported to out with its line
+// number.
+type Importer struct {
+	products service.ProductService
+	out      io.Writer
+	// entries buffers the report of the current batch so it can be written in
+	// line order.
+	entries []reportEntry
+}
+
+type reportEntry struct {
+	line int
+	text string
+}
+
+func NewImporter(products service.ProductService, out io.Writer) *Importer {
+	return &Importer{products: products, out: out}
+}
+
+// importRow is one data row of the file, keyed by column. err is set for
+// rows that could not be read.
+type importRow struct {
+	line   int
+	values map[string]string
+	err    string
+}
+
+// importItem is a row resolved against the catalog.
+type importItem struct {
+	line    int
+	product *entity.Product
+	changes []string
+}
+
+func (i *Importer) Import(ctx context.Context, r io.Reader, opts ImportOptions) (*ImportReport, error) {
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = constant.DefaultBatchMaxItems
+	}
+
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, errors.Wrap(err, "read header")
+	}
+
+	header, err = checkHeader(header)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &ImportReport{}
+	batch := make([]*importRow, 0, opts.BatchSize)
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+
+		var row *importRow
+
+		var parseErr *csv.ParseError
+		switch {
+		case errors.As(err, &parseErr) && errors.Is(parseErr.Err, csv.ErrFieldCount):
+			row = &importRow{line: parseErr.StartLine, err: fmt.Sprintf("expected %d fields, got %d", len(header), len(record))}
+		case err != nil:
+			return report, errors.Wrap(err, "read row")
+		default:
+			line, _ := reader.FieldPos(0)
+			row = &importRow{line: line, values: make(map[string]string, len(header))}
+			for k, column := range header {
+				row.values[column] = strings.TrimSpace(record[k])
+			}
+		}
+
+		batch = append(batch, row)
+		if len(batch) < opts.BatchSize {
+			continue
+		}
+
+		if err := i.importBatch(ctx, batch, opts, report); err != nil {
+			return report, err
+		}
+
+		batch = batch[:0]
+	}
+
+	if len(batch) > 0 {
+		if err := i.importBatch(ctx, batch, opts, report); err != nil {
+			return report, err
+		}
+	}
+
+	return report, nil
+}
+
+func checkHeader(header []string) ([]string, error) {
+	seen := make(map[string]bool, len(header))
+
+	for k := range header {
+		column := strings.ToLower(strings.TrimSpace(strings.TrimPrefix(header[k], "\ufeff")))
+		if !slices.Contains(Columns, column) {
+			return nil, errors.Newf("unknown column %q", header[k])
+		}
+
+		if seen[column] {
+			return nil, errors.Newf("duplicate column %q", header[k])
+		}
+
+		seen[column] = true
+		header[k] = column
+	}
+
+	if !seen["id"] && !seen["sku"] {
+		return nil, errors.New("header needs an id or a sku column")
+	}
+
+	return header, nil
+}
+
+// importBatch resolves the rows against the current catalog, then validates
+// or writes the creates and updates of the batch.
+func (i *Importer) importBatch(ctx context.Context, rows []*importRow, opts ImportOptions, report *ImportReport) error {
+	defer i.flush()
+
+	byID, bySKU, err := i.findExisting(ctx, rows)
+	if err != nil {
+		return err
+	}
+
+	var creates, updates []*importItem
+
+	for _, row := range rows {
+		if row.err != "" {
+			i.fail(report, row.line, row.err)
+			continue
+		}
+
+		var current *entity.Product
+
+		if raw := row.values["id"]; raw != "" {
+			id, err := strconv.ParseUint(raw, 10, 32)
+			if err != nil || id == 0 {
+				i.fail(report, row.line, "id must be a positive integer")
+				continue
+			}
+
+			if current = byID[uint32(id)]; current == nil {
+				i.fail(report, row.line, fmt.Sprintf("product %d not found", id))
+				continue
+			}
+		} else {
+			current = bySKU[row.values["sku"]]
+		}
+
+		product, err := row.product(current)
+		if err != nil {
+			i.fail(report, row.line, describe(err))
+			continue
+		}
+
+		item := &importItem{line: row.line, product: product}
+
+		if current == nil {
+			creates = append(creates, item)
+			continue
+		}
+
+		item.changes = changedFields(current, product)
+		if product.Status != current.Status {
+			item.changes = append(item.changes, "status")
+		}
+
+		if len(item.changes) == 0 {
+			report.Unchanged++
+			continue
+		}
+
+		updates = append(updates, item)
+	}
+
+	if len(creates) == 0 && len(updates) == 0 {
+		return nil
+	}
+
+	if opts.DryRun {
+		return i.checkItems(ctx, creates, updates, report)
+	}
+
+	return i.writeItems(ctx, creates, updates, report)
+}
+
+// findExisting loads the products the rows refer to by id or SKU.
+func (i *Importer) findExisting(ctx context.Context, rows []*importRow) (map[uint32]*entity.Product, map[string]*entity.Product, error) {
+	var (
+		ids  []uint32
+		skus []string
+	)
+
+	for _, row := range rows {
+		if id, err := strconv.ParseUint(row.values["id"], 10, 32); err == nil && id > 0 {
+			ids = append(ids, uint32(id))
+		} else if sku := row.values["sku"]; sku != "" {
+			skus = append(skus, sku)
+		}
+	}
+
+	byID := make(map[uint32]*entity.Product, len(ids))
+	bySKU := make(map[string]*entity.Product, len(skus))
+
+	if len(ids) > 0 {
+		products, _, err := i.products.Find(ctx, &postgresrepository.FilterProductPayload{IDs: ids})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, product := range products {
+			byID[product.ID] = product
+		}
+	}
+
+	if len(skus) > 0 {
+		products, _, err := i.products.Find(ctx, &postgresrepository.FilterProductPayload{SKUs: skus})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, product := range products {
+			bySKU[product.SKU] = product
+		}
+	}
+
+	return byID, bySKU, nil
+}
+
+// checkItems reports what writing the batch would do.
+func (i *Importer) checkItems(ctx context.Context, creates, updates []*importItem, report *ImportReport) error {
+	createResults, updateResults, err := i.products.ValidateBatchUpsert(ctx, itemProducts(creates), itemProducts(updates))
+	if err != nil {
+		return err
+	}
+
+	for k, result := range createResults {
+		if result.Err != nil {
+			i.fail(report, creates[k].line, describe(result.Err))
+			continue
+		}
+
+		report.Created++
+		i.report(creates[k].line, "would create %s", creates[k].product.SKU)
+	}
+
+	for k, result := range updateResults {
+		if result.Err != nil {
+			i.fail(report, updates[k].line, describe(result.Err))
+			continue
+		}
+
+		report.Updated++
+		i.report(updates[k].line, "would update product %d: %s", updates[k].product.ID, strings.Join(updates[k].changes, ", "))
+	}
+
+	return nil
+}
+
+// writeItems commits the creates and updates of the batch, status changes
+// included, in one transaction.
+func (i *Importer) writeItems(ctx context.Context, creates, updates []*importItem, report *ImportReport) error {
+	createResults, updateResults, err := i.products.BatchUpsert(ctx, itemProducts(creates), itemProducts(updates))
+	if err != nil {
+		return err
+	}
+
+	for k, result := range createResults {
+		if result.Err != nil {
+			i.fail(report, creates[k].line, describe(result.Err))
+			continue
+		}
+
+		report.Created++
+		i.report(creates[k].line, "created product %d (%s)", result.Product.ID, result.Product.SKU)
+	}
+
+	for k, result := range updateResults {
+		if result.Err != nil {
+			i.fail(report, updates[k].line, describe(result.Err))
+			continue
+		}
+
+		report.Updated++
+		i.report(updates[k].line, "updated product %d: %s", result.Product.ID, strings.Join(updates[k].changes, ", "))
+	}
+
+	return nil
+}
+
+func (i *Importer) fail(report *ImportReport, line int, message string) {
+	report.Failed++
+	i.report(line, "error: %s", message)
+}
+
+func (i *Importer) report(line int, format string, args ...any) {
+	i.entries = append(i.entries, reportEntry{line: line, text: fmt.Sprintf(format, args...)})
+}
+
+// flush writes the buffered report of a batch in line order.
+func (i *Importer) flush() {
+	slices.SortStableFunc(i.entries, func(a, b reportEntry) int {
+		return a.line - b.line
+	})
+
+	for _, entry := range i.entries {
+		fmt.Fprintf(i.out, "line %d: %s\n", entry.line, entry.text)
+	}
+
+	i.entries = i.entries[:0]
+}
+
+func itemProducts(items []*importItem) []*entity.Product {
+	products := make([]*entity.Product, len(items))
+	for k, item := range items {
+		products[k] = item.product
+	}
+
+	return products
+}
+
+// product applies the row on top of the current product, or builds a new one
+// when there is none.
+func (r *importRow) product(current *entity.Product) (*entity.Product, error) {
+	product := &entity.Product{}
+	if current != nil {
+		*product = *current
+		// Leave kit components, pack sizes and variants untouched.
+		product.Components, product.Units, product.Variants = nil, nil, nil
+	}
+
+	errs := exception.FieldErrors{}
+	value := func(column string) (string, bool) {
+		raw, ok := r.values[column]
+		return raw, ok
+	}
+
+	// An empty SKU cell keeps the current SKU; a new product needs one.
+	if raw, ok := value("sku"); ok && raw != "" {
+		product.SKU = raw
+	}
+
+	if product.SKU == "" {
+		errs["sku"] = append(errs["sku"], "SKU is required")
+	}
+
+	if raw, ok := value("barcode"); ok {
+		product.Barcode = raw
+	}
+
+	if raw, ok := value("name"); ok {
+		product.Name = raw
+	}
+
+	for column, target := range map[string]*uint32{"category_id": &product.CategoryID, "parent_id": &product.ParentID} {
+		if raw, ok := value(column); ok {
+			id, err := parseUint32(raw)
+			if err != nil {
+				errs[column] = append(errs[column], "Must be a positive integer")
+			}
+
+			*target = id
+		}
+	}
+
+	if raw, ok := value("options"); ok {
+		product.Options = nil
+		if raw != "" && json.Unmarshal([]byte(raw), &product.Options) != nil {
+			errs["options"] = append(errs["options"], "Options must be a JSON object of strings")
+		}
+	}
+
+	if raw, ok := value("stock"); ok {
+		stock, err := strconv.Atoi(raw)
+		if raw != "" && err != nil {
+			errs["stock"] = append(errs["stock"], "Stock must be an integer")
+		}
+
+		product.Stock = stock
+	}
+
+	price, hasPrice := value("price")
+	currency, hasCurrency := value("currency")
+	if hasPrice || hasCurrency {
+		if !hasCurrency {
+			currency = product.Price.Currency
+		}
+
+		if !hasPrice {
+			price = product.Price.String()
+		}
+
+		// An empty price makes a variant inherit its parent's; an inherited
+		// price given back unchanged stays inherited.
+		inherited := current != nil && current.InheritPrice
+
+		product.Price = entity.Money{Currency: strings.ToUpper(currency)}
+		product.InheritPrice = price == "" && product.ParentID != 0
+		if price != "" {
+			money, err := entity.ParseMoney(price, product.Price.Currency)
+			if err != nil {
+				errs["price"] = append(errs["price"], "Price must be a decimal number such as 19.99")
+			}
+
+			product.Price = money
+			product.InheritPrice = inherited && money == current.Price
+		}
+	}
+
+	if raw, ok := value("status"); ok {
+		product.Status = strings.ToUpper(raw)
+		if current != nil && raw == "" {
+			product.Status = current.Status
+		}
+	}
+
+	for column, target := range map[string]*bool{"track_lots": &product.TrackLots, "track_serials": &product.TrackSerials} {
+		if raw, ok := value(column); ok {
+			flag, err := strconv.ParseBool(raw)
+			if raw != "" && err != nil {
+				errs[column] = append(errs[column], "Must be true or false")
+			}
+
+			*target = flag
+		}
+	}
+
+	if raw, ok := value("attributes"); ok {
+		product.Attributes = nil
+		if raw != "" && json.Unmarshal([]byte(raw), &product.Attributes) != nil {
+			errs["attributes"] = append(errs["attributes"], "Attributes must be a JSON object")
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, exception.NewWithErrors(exception.TypeValidationError, exception.CodeValidationFailed, "Invalid row", errs)
+	}
+
+	return product, nil
+}
+
+func parseUint32(raw string) (uint32, error) {
+	if raw == "" {
+		return 0, nil
+	}
+
+	id, err := strconv.ParseUint(raw, 10, 32)
+
+	return uint32(id), err
+}
+
+// changedFields lists the columns whose value differs between the current and
+// the imported product. Status is compared separately since it changes through
+// its own transition rules.
+func changedFields(current, product *entity.Product) []string {
+	var changes []string
+
+	for _, field := range []struct {
+		column  string
+		changed bool
+	}{
+		{"sku", current.SKU != product.SKU},
+		{"barcode", current.Barcode != product.Barcode},
+		{"name", current.Name != product.Name},
+		{"category_id", current.CategoryID != product.CategoryID},
+		{"parent_id", current.ParentID != product.ParentID},
+		{"options", !maps.Equal(current.Options, product.Options)},
+		{"stock", current.Stock != product.Stock},
+		{"price", current.InheritPrice != product.InheritPrice || !product.InheritPrice && current.Price != product.Price},
+		{"track_lots", current.TrackLots != product.TrackLots},
+		{"track_serials", current.TrackSerials != product.TrackSerials},
+		{"attributes", !equalAttributes(current.Attributes, product.Attributes)},
+	} {
+		if field.changed {
+			changes = append(changes, field.column)
+		}
+	}
+
+	return changes
+}
+
+func equalAttributes(a, b map[string]any) bool {
+	if len(a) == 0 && len(b) == 0 {
+		return true
+	}
+
+	return reflect.DeepEqual(a, b)
+}
+
+// describe renders an error with its field errors for the row report.
+func describe(err error) string {
+	ex, ok := exception.GetException(err)
+	if !ok {
+		return err.Error()
+	}
+
+	if len(ex.Errors) == 0 {
+		return ex.Message
+	}
+
+	fields := slices.Sorted(maps.Keys(ex.Errors))
+	details := make([]string, 0, len(fields))
+	for _, field := range fields {
+		details = append(details, field+": "+strings.Join(ex.Errors[field], ", "))
+	}
+
+	return ex.Message + " (" + strings.Join(details, "; ") + ")"
+}
@@ -0,0 +1,252 @@
+package catalog_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"inventory-service/constant"
+	"inventory-service/internal/adapter/catalog"
+	postgresrepository "inventory-service/internal/adapter/repository/postgres"
+	"inventory-service/internal/domain/entity"
+	"inventory-service/internal/shared/exception"
+	"inventory-service/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// upsertCSV creates NEW-1, renames OLD-1 and leaves OLD-2 as stored.
+const upsertCSV = `sku,name,price,currency,stock
+NEW-1,New,9.99,USD,5
+OLD-1,Renamed,19.99,USD,3
+OLD-2,Same,5.00,USD,1
+`
+
+func storedProducts() []*entity.Product {
+	return []*entity.Product{
+		{
+			Base:   entity.Base{ID: 7},
+			SKU:    "OLD-1",
+			Name:   "Old",
+			Stock:  3,
+			Price:  entity.Money{Currency: "USD", Units: 19, Nanos: 990000000},
+			Status: constant.ProductStatusActive,
+		},
+		{
+			Base:   entity.Base{ID: 8},
+			SKU:    "OLD-2",
+			Name:   "Same",
+			Stock:  1,
+			Price:  entity.Money{Currency: "USD", Units: 5},
+			Status: constant.ProductStatusActive,
+		},
+	}
+}
+
+// isUpsert matches the creates and updates of one batch by SKU and ID.
+func isUpsert(skus []string, ids []uint32) (any, any) {
+	creates := mock.MatchedBy(func(products []*entity.Product) bool {
+		got := make([]string, len(products))
+		for i, product := range products {
+			got[i] = product.SKU
+		}
+
+		return assert.ObjectsAreEqual(skus, got)
+	})
+	updates := mock.MatchedBy(func(products []*entity.Product) bool {
+		got := make([]uint32, len(products))
+		for i, product := range products {
+			got[i] = product.ID
+		}
+
+		return assert.ObjectsAreEqual(ids, got)
+	})
+
+	return creates, updates
+}
+
+func results(products ...*entity.Product) []*entity.BatchItemResult {
+	results := make([]*entity.BatchItemResult, len(products))
+	for i, product := range products {
+		results[i] = &entity.BatchItemResult{Index: i, Product: product}
+	}
+
+	return results
+}
+
+func TestImporterImport(t *testing.T) {
+	tests := []struct {
+		name   string
+		csv    string
+		opts   catalog.ImportOptions
+		setup  func(m *mocks.MockProductService)
+		report catalog.ImportReport
+		output []string
+	}{
+		{
+			name: "creates and updates a batch together",
+			csv:  upsertCSV,
+			setup: func(m *mocks.MockProductService) {
+				m.EXPECT().Find(mock.Anything, &postgresrepository.FilterProductPayload{SKUs: []string{"NEW-1", "OLD-1", "OLD-2"}}).
+					Return(storedProducts(), 2, nil)
+
+				creates, updates := isUpsert([]string{"NEW-1"}, []uint32{7})
+				m.EXPECT().BatchUpsert(mock.Anything, creates, updates).
+					Return(
+						results(&entity.Product{Base: entity.Base{ID: 12}, SKU: "NEW-1"}),
+						results(&entity.Product{Base: entity.Base{ID: 7}, SKU: "OLD-1"}),
+						nil,
+					)
+			},
+			report: catalog.ImportReport{Created: 1, Updated: 1, Unchanged: 1},
+			output: []string{
+				"line 2: created product 12 (NEW-1)",
+				"line 3: updated product 7: name",
+			},
+		},
+		{
+			name: "dry run only reports what would change",
+			csv:  upsertCSV,
+			opts: catalog.ImportOptions{DryRun: true},
+			setup: func(m *mocks.MockProductService) {
+				m.EXPECT().Find(mock.Anything, &postgresrepository.FilterProductPayload{SKUs: []string{"NEW-1", "OLD-1", "OLD-2"}}).
+					Return(storedProducts(), 2, nil)
+
+				creates, updates := isUpsert([]string{"NEW-1"}, []uint32{7})
+				m.EXPECT().ValidateBatchUpsert(mock.Anything, creates, updates).
+					Return(results(nil), results(nil), nil)
+			},
+			report: catalog.ImportReport{Created: 1, Updated: 1, Unchanged: 1},
+			output: []string{
+				"line 2: would create NEW-1",
+				"line 3: would update product 7: name",
+			},
+		},
+		{
+			name: "reports unreadable rows by line",
+			csv: `id,sku,name,stock
+1,A,Alpha
+x,B,Beta,2
+99,C,Gamma,3
+7,OLD-1,Old,lots
+`,
+			setup: func(m *mocks.MockProductService) {
+				m.EXPECT().Find(mock.Anything, &postgresrepository.FilterProductPayload{IDs: []uint32{99, 7}}).
+					Return(storedProducts()[:1], 1, nil)
+				m.EXPECT().Find(mock.Anything, &postgresrepository.FilterProductPayload{SKUs: []string{"B"}}).
+					Return([]*entity.Product{}, 0, nil)
+			},
+			report: catalog.ImportReport{Failed: 4},
+			output: []string{
+				"line 2: error: expected 4 fields, got 3",
+				"line 3: error: id must be a positive integer",
+				"line 4: error: product 99 not found",
+				"line 5: error: Invalid row (stock: Stock must be an integer)",
+			},
+		},
+		{
+			name: "keeps the SKU of an empty cell and requires one for new products",
+			csv: `id,sku,name
+,,Nameless
+7,,Renamed
+`,
+			setup: func(m *mocks.MockProductService) {
+				m.EXPECT().Find(mock.Anything, &postgresrepository.FilterProductPayload{IDs: []uint32{7}}).
+					Return(storedProducts()[:1], 1, nil)
+
+				creates, _ := isUpsert([]string{}, nil)
+				updates := mock.MatchedBy(func(products []*entity.Product) bool {
+					return len(products) == 1 && products[0].ID == 7 && products[0].SKU == "OLD-1"
+				})
+				m.EXPECT().BatchUpsert(mock.Anything, creates, updates).
+					Return([]*entity.BatchItemResult{}, results(&entity.Product{Base: entity.Base{ID: 7}, SKU: "OLD-1"}), nil)
+			},
+			report: catalog.ImportReport{Updated: 1, Failed: 1},
+			output: []string{
+				"line 2: error: Invalid row (sku: SKU is required)",
+				"line 3: updated product 7: name",
+			},
+		},
+		{
+			name: "reports items the service rejects",
+			csv: `sku,name,barcode
+NEW-1,New,12AB
+NEW-2,Other,
+`,
+			setup: func(m *mocks.MockProductService) {
+				m.EXPECT().Find(mock.Anything, &postgresrepository.FilterProductPayload{SKUs: []string{"NEW-1", "NEW-2"}}).
+					Return([]*entity.Product{}, 0, nil)
+
+				creates, updates := isUpsert([]string{"NEW-1", "NEW-2"}, []uint32{})
+				m.EXPECT().BatchUpsert(mock.Anything, creates, updates).
+					Return([]*entity.BatchItemResult{
+						{Index: 0, Err: exception.NewWithErrors(exception.TypeValidationError, exception.CodeValidationFailed, "Invalid barcode", exception.FieldErrors{
+							"barcode": {"Barcode must be numeric"},
+						})},
+						{Index: 1, Product: &entity.Product{Base: entity.Base{ID: 13}, SKU: "NEW-2"}},
+					}, []*entity.BatchItemResult{}, nil)
+			},
+			report: catalog.ImportReport{Created: 1, Failed: 1},
+			output: []string{
+				"line 2: error: Invalid barcode (barcode: Barcode must be numeric)",
+				"line 3: created product 13 (NEW-2)",
+			},
+		},
+		{
+			name: "commits every batch on its own",
+			csv: `sku,name
+NEW-1,First
+NEW-2,Second
+`,
+			opts: catalog.ImportOptions{BatchSize: 1},
+			setup: func(m *mocks.MockProductService) {
+				for id, sku := range map[uint32]string{12: "NEW-1", 13: "NEW-2"} {
+					m.EXPECT().Find(mock.Anything, &postgresrepository.FilterProductPayload{SKUs: []string{sku}}).
+						Return([]*entity.Product{}, 0, nil)
+
+					creates, updates := isUpsert([]string{sku}, []uint32{})
+					m.EXPECT().BatchUpsert(mock.Anything, creates, updates).
+						Return(results(&entity.Product{Base: entity.Base{ID: id}, SKU: sku}), []*entity.BatchItemResult{}, nil).
+						Once()
+				}
+			},
+			report: catalog.ImportReport{Created: 2},
+			output: []string{
+				"line 2: created product 12 (NEW-1)",
+				"line 3: created product 13 (NEW-2)",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			products := mocks.NewMockProductService(t)
+			tt.setup(products)
+
+			var out bytes.Buffer
+			report, err := catalog.NewImporter(products, &out).Import(context.Background(), strings.NewReader(tt.csv), tt.opts)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.report, *report)
+			assert.Equal(t, tt.output, strings.Split(strings.TrimSpace(out.String()), "\n"))
+		})
+	}
+}
+
+func TestImporterRejectsBadHeaders(t *testing.T) {
+	for name, csv := range map[string]string{
+		"unknown column":   "sku,colour\n",
+		"duplicate column": "sku,name,SKU\n",
+		"no key column":    "name,stock\n",
+	} {
+		t.Run(name, func(t *testing.T) {
+			products := mocks.NewMockProductService(t)
+
+			_, err := catalog.NewImporter(products, &bytes.Buffer{}).Import(context.Background(), strings.NewReader(csv), catalog.ImportOptions{})
+
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package catalog
+
+import (
+	"encoding/json"
+	"inventory-service/internal/domain/entity"
+	"strconv"
+)
+
+// Columns is the CSV layout of the catalog, shared by import and export.
+// available_stock is only written by export; import ignores it.
+var Columns = []string{
+	"id",
+	"sku",
+	"barcode",
+	"name",
+	"category_id",
+	"parent_id",
+	"options",
+	"stock",
+	"available_stock",
+	"price",
+	"currency",
+	"status",
+	"track_lots",
+	"track_serials",
+	"attributes",
+}
+
+// Record is one product of the catalog as exported.
+type Record struct {
+	ID             uint32            `json:"id"`
+	SKU            string            `json:"sku"`
+	Barcode        string            `json:"barcode,omitempty"`
+	Name           string            `json:"name"`
+	CategoryID     uint32            `json:"category_id,omitempty"`
+	ParentID       uint32            `json:"parent_id,omitempty"`
+	Options        map[string]string `json:"options,omitempty"`
+	Stock          int               `json:"stock"`
+	AvailableStock int               `json:"available_stock"`
+	Price          string            `json:"price"`
+	Currency       string            `json:"currency"`
+	Status         string            `json:"status"`
+	TrackLots      bool              `json:"track_lots"`
+	TrackSerials   bool              `json:"track_serials"`
+	Attributes     map[string]any    `json:"attributes,omitempty"`
+}
+
+func NewRecord(product *entity.Product) *Record {
+	return &Record{
+		ID:             product.ID,
+		SKU:            product.SKU,
+		Barcode:        product.Barcode,
+		Name:           product.Name,
+		CategoryID:     product.CategoryID,
+		ParentID:       product.ParentID,
+		Options:        product.Options,
+		Stock:          product.Stock,
+		AvailableStock: product.AvailableStock,
+		Price:          product.Price.String(),
+		Currency:       product.Price.Currency,
+		Status:         product.Status,
+		TrackLots:      product.TrackLots,
+		TrackSerials:   product.TrackSerials,
+		Attributes:     product.Attributes,
+	}
+}
+
+// CSV returns the record's cells in the order of Columns.
+func (r *Record) CSV() ([]string, error) {
+	options, err := jsonCell(r.Options)
+	if err != nil {
+		return nil, err
+	}
+
+	attributes, err := jsonCell(r.Attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	return []string{
+		strconv.FormatUint(uint64(r.ID), 10),
+		r.SKU,
+		r.Barcode,
+		r.Name,
+		idCell(r.CategoryID),
+		idCell(r.ParentID),
+		options,
+		strconv.Itoa(r.Stock),
+		strconv.Itoa(r.AvailableStock),
+		r.Price,
+		r.Currency,
+		r.Status,
+		strconv.FormatBool(r.TrackLots),
+		strconv.FormatBool(r.TrackSerials),
+		attributes,
+	}, nil
+}
+
+func idCell(id uint32) string {
+	if id == 0 {
+		return ""
+	}
+
+	return strconv.FormatUint(uint64(id), 10)
+}
+
+// jsonCell encodes maps as a JSON object, leaving empty ones blank.
+func jsonCell[V any](value map[string]V) (string, error) {
+	if len(value) == 0 {
+		return "", nil
+	}
+
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+
+	return string(raw), nil
+}
@@ -0,0 +1,120 @@
+package consumer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"inventory-service/constant"
+	"inventory-service/internal/domain/entity"
+	"inventory-service/internal/domain/service"
+	"inventory-service/internal/shared/auth"
+	"inventory-service/internal/shared/exception"
+	"inventory-service/pkg/logger"
+	"inventory-service/pkg/pubsub"
+	"time"
+)
+
+const resubscribeDelay = 5 * time.Second
+
+// orderTopics are the order service topics the consumer handles.
+var orderTopics = []string{constant.EventOrderPaid, constant.EventOrderCancelled}
+
+// orderEventPayload is the payload of the order service's lifecycle events.
+type orderEventPayload struct {
+	OrderID uint32 `json:"order_id"`
+}
+
+// OrderConsumer applies order lifecycle events to reservations, so an order
+// cancelled or paid while the order service could not call us still releases
+// or confirms its stock.
+type OrderConsumer struct {
+	subscriber pubsub.Subscriber
+	service    service.Service
+	logger     logger.Logger
+	done       chan struct{}
+}
+
+func NewOrderConsumer(subscriber pubsub.Subscriber, service service.Service, logger logger.Logger) *OrderConsumer {
+	return &OrderConsumer{
+		subscriber: subscriber,
+		service:    service,
+		logger:     logger,
+		done:       make(chan struct{}),
+	}
+}
+
+// Start consumes in the background until ctx is cancelled, subscribing
+// again when the subscription fails.
+func (c *OrderConsumer) Start(ctx context.Context) {
+	go func() {
+		defer close(c.done)
+
+		for {
+			err := c.subscriber.Subscribe(ctx, orderTopics, c.handle)
+			if ctx.Err() != nil || errors.Is(err, pubsub.ErrClosed) {
+				return
+			}
+
+			c.logger.Error().Err(err).Msgf("Order event subscription failed, subscribing again in %s", resubscribeDelay)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(resubscribeDelay):
+			}
+		}
+	}()
+}
+
+// Wait blocks until the consumer has stopped.
+func (c *OrderConsumer) Wait() {
+	<-c.done
+}
+
+// handle applies one message. Messages that can never be applied are logged
+// and acknowledged; other failures are retried.
+func (c *OrderConsumer) handle(ctx context.Context, msg *pubsub.Message) error {
+	var payload orderEventPayload
+	if err := json.Unmarshal(msg.Payload, &payload); err != nil {
+		c.logger.Error().Err(err).Msgf("Dropping order event %s (%s): invalid payload", msg.ID, msg.Topic)
+		return nil
+	}
+
+	ctx = auth.WithOrigin(ctx, &auth.Origin{Source: auth.SourceConsumer, RequestID: msg.ID})
+
+	applied, err := c.service.OrderEvent().Handle(ctx, &entity.OrderEvent{
+		MessageID: msg.ID,
+		Type:      msg.Topic,
+		OrderID:   payload.OrderID,
+	})
+	if err != nil {
+		if ex, ok := exception.GetException(err); ok && isPermanent(ex.Type) {
+			c.logger.Error().Err(err).Msgf("Dropping order event %s (%s)", msg.ID, msg.Topic)
+			return nil
+		}
+
+		c.logger.Error().Err(err).Msgf("Failed to handle order event %s (%s)", msg.ID, msg.Topic)
+		return err
+	}
+
+	if !applied {
+		c.logger.Debug().Msgf("Skipped duplicate order event %s (%s)", msg.ID, msg.Topic)
+		return nil
+	}
+
+	c.logger.Info().Msgf("Applied %s to reservations of order %d", msg.Topic, payload.OrderID)
+
+	return nil
+}
+
+// isPermanent reports whether an error of this type would recur on every
+// delivery of the message. Conflicts, such as a reservation changed by a
+// concurrent call, may clear up and are retried.
+func isPermanent(errorType exception.ErrorType) bool {
+	switch errorType {
+	case exception.TypeBadRequest, exception.TypeValidationError, exception.TypeNotFound:
+		return true
+	default:
+		return false
+	}
+}
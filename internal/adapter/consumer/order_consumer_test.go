@@ -0,0 +1,134 @@
+package consumer_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"inventory-service/config"
+	"inventory-service/constant"
+	"inventory-service/internal/adapter/consumer"
+	postgresrepository "inventory-service/internal/adapter/repository/postgres"
+	"inventory-service/internal/domain/entity"
+	"inventory-service/internal/domain/service"
+	"inventory-service/internal/shared/exception"
+	"inventory-service/mocks"
+	"inventory-service/pkg/logger"
+	"inventory-service/pkg/pubsub"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestOrderConsumerCancelsReservationsOnce(t *testing.T) {
+	mRepo := mocks.NewMockRepository(t)
+	mPostgres := mocks.NewMockPostgresRepository(t)
+	mReservation := mocks.NewMockReservationRepository(t)
+	mProcessed := mocks.NewMockProcessedMessageRepository(t)
+
+	mRepo.EXPECT().Postgres().Return(mPostgres).Maybe()
+	mPostgres.EXPECT().Reservation().Return(mReservation).Maybe()
+	mPostgres.EXPECT().ProcessedMessage().Return(mProcessed).Maybe()
+	mPostgres.EXPECT().
+		Atomic(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, cfg *config.Config, fn postgresrepository.RepositoryAtomicCallback) error {
+			return fn(mPostgres)
+		})
+
+	handled := make(chan string, 3)
+
+	// The first delivery of msg-1 is applied; its redelivery is not.
+	mProcessed.EXPECT().Claim(mock.Anything, "msg-1", constant.EventOrderCancelled).Return(true, nil).Once()
+	mProcessed.EXPECT().Claim(mock.Anything, "msg-1", constant.EventOrderCancelled).Return(false, nil).Once()
+	mProcessed.EXPECT().
+		Claim(mock.Anything, "msg-2", constant.EventOrderPaid).
+		Run(func(context.Context, string, string) { handled <- "msg-2" }).
+		Return(true, nil).
+		Once()
+	mReservation.EXPECT().
+		Find(mock.Anything, &postgresrepository.FilterReservationPayload{OrderIDs: []uint32{42}}).
+		Return([]*entity.Reservation{}, 0, nil)
+	mReservation.EXPECT().
+		Find(mock.Anything, &postgresrepository.FilterReservationPayload{OrderIDs: []uint32{43}}).
+		Return([]*entity.Reservation{}, 0, nil)
+
+	svc, err := service.NewService(&config.Config{}, mRepo, logger.NewZerologLogger(false), nil)
+	assert.NoError(t, err)
+
+	broker := pubsub.NewMemoryBroker()
+	publish := func(id, topic string, orderID uint32) {
+		payload, _ := json.Marshal(map[string]any{"order_id": orderID})
+		assert.NoError(t, broker.Publish(context.Background(), &pubsub.Message{ID: id, Topic: topic, Payload: payload}))
+	}
+
+	publish("msg-1", constant.EventOrderCancelled, 42)
+	publish("msg-1", constant.EventOrderCancelled, 42)
+	publish("other", "order.shipped", 42)
+	publish("msg-2", constant.EventOrderPaid, 43)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	orderConsumer := consumer.NewOrderConsumer(broker, svc, logger.NewZerologLogger(false))
+	orderConsumer.Start(ctx)
+
+	select {
+	case <-handled:
+	case <-time.After(5 * time.Second):
+		t.Fatal("order events were not consumed")
+	}
+
+	cancel()
+	orderConsumer.Wait()
+}
+
+func TestOrderConsumerRetriesConflicts(t *testing.T) {
+	mRepo := mocks.NewMockRepository(t)
+	mPostgres := mocks.NewMockPostgresRepository(t)
+	mReservation := mocks.NewMockReservationRepository(t)
+	mProcessed := mocks.NewMockProcessedMessageRepository(t)
+
+	mRepo.EXPECT().Postgres().Return(mPostgres).Maybe()
+	mPostgres.EXPECT().Reservation().Return(mReservation).Maybe()
+	mPostgres.EXPECT().ProcessedMessage().Return(mProcessed).Maybe()
+	mPostgres.EXPECT().
+		Atomic(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, cfg *config.Config, fn postgresrepository.RepositoryAtomicCallback) error {
+			return fn(mPostgres)
+		})
+
+	handled := make(chan string, 1)
+
+	// The first delivery hits a conflict; the redelivery is applied.
+	mProcessed.EXPECT().
+		Claim(mock.Anything, "msg-1", constant.EventOrderPaid).
+		Return(false, exception.New(exception.TypeConflict, exception.CodeConflict, "concurrent update")).
+		Once()
+	mProcessed.EXPECT().
+		Claim(mock.Anything, "msg-1", constant.EventOrderPaid).
+		Run(func(context.Context, string, string) { handled <- "msg-1" }).
+		Return(true, nil).
+		Once()
+	mReservation.EXPECT().
+		Find(mock.Anything, &postgresrepository.FilterReservationPayload{OrderIDs: []uint32{42}}).
+		Return([]*entity.Reservation{}, 0, nil)
+
+	svc, err := service.NewService(&config.Config{}, mRepo, logger.NewZerologLogger(false), nil)
+	assert.NoError(t, err)
+
+	broker := pubsub.NewMemoryBroker()
+	payload, _ := json.Marshal(map[string]any{"order_id": 42})
+	assert.NoError(t, broker.Publish(context.Background(), &pubsub.Message{ID: "msg-1", Topic: constant.EventOrderPaid, Payload: payload}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	orderConsumer := consumer.NewOrderConsumer(broker, svc, logger.NewZerologLogger(false))
+	orderConsumer.Start(ctx)
+
+	select {
+	case <-handled:
+	case <-time.After(5 * time.Second):
+		t.Fatal("conflicting order event was not retried")
+	}
+
+	cancel()
+	orderConsumer.Wait()
+}
@@ -0,0 +1,183 @@
+package grpcserver
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"inventory-service/config"
+	"inventory-service/constant"
+	"inventory-service/internal/shared/auth"
+	"inventory-service/internal/shared/exception"
+	"inventory-service/pkg/certreload"
+	"inventory-service/pkg/logger"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// apiKeyHeader is the metadata key clients without a certificate send their
+// API key in.
+const apiKeyHeader = "x-api-key"
+
+// ServerCredentials returns TLS credentials when a certificate is configured,
+// verifying client certificates against the client CA when one is given.
+// It returns nil for plaintext, which API keys are never sent over.
+func ServerCredentials(cfg *config.GRPCConfig, appLogger logger.Logger) (credentials.TransportCredentials, error) {
+	if cfg.RequireClientCert && cfg.ClientCAFile == "" {
+		return nil, errors.New("requiring client certificates needs a client ca file")
+	}
+
+	if cfg.TLSCertFile == "" && cfg.TLSKeyFile == "" {
+		if cfg.ClientCAFile != "" {
+			return nil, errors.New("client certificate verification requires a server certificate")
+		}
+
+		if len(cfg.APIKeys) > 0 {
+			return nil, errors.New("api keys require a server certificate")
+		}
+
+		return nil, nil
+	}
+
+	interval := time.Duration(cfg.CertReloadInterval) * time.Second
+	if interval <= 0 {
+		interval = constant.DefaultCertReloadInterval
+	}
+
+	reloader, err := certreload.New(cfg.TLSCertFile, cfg.TLSKeyFile, cfg.ClientCAFile, interval, func(err error) {
+		appLogger.Error().Err(err).Msg("Failed to reload gRPC TLS files, keeping the previous ones")
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	clientAuth := tls.NoClientCert
+
+	switch {
+	case cfg.RequireClientCert:
+		clientAuth = tls.RequireAndVerifyClientCert
+	case cfg.ClientCAFile != "":
+		clientAuth = tls.VerifyClientCertIfGiven
+	}
+
+	return credentials.NewTLS(reloader.Config(&tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ClientAuth: clientAuth,
+		NextProtos: []string{"h2"},
+	})), nil
+}
+
+// clientAuthEnabled reports whether callers must identify themselves with a
+// client certificate or an API key.
+func clientAuthEnabled(cfg *config.GRPCConfig) bool {
+	return cfg.ClientCAFile != "" || len(cfg.APIKeys) > 0
+}
+
+// ClientAuthInterceptor puts the calling service into the context, rejecting
+// non-public calls that present neither a verified certificate nor a known
+// API key.
+func ClientAuthInterceptor(cfg *config.GRPCConfig, appLogger logger.Logger) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		if publicMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+
+		client, err := identifyClient(ctx, cfg.APIKeys)
+		if err != nil {
+			appLogger.Warn().Field("method", info.FullMethod).Err(err).Msg("Rejected gRPC client")
+			return nil, MapErrorToGRPCStatus(err)
+		}
+		return handler(auth.WithClient(ctx, client), req)
+	}
+}
+
+func ClientAuthStreamInterceptor(cfg *config.GRPCConfig, appLogger logger.Logger) grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if publicMethods[info.FullMethod] {
+			return handler(srv, stream)
+		}
+
+		client, err := identifyClient(stream.Context(), cfg.APIKeys)
+		if err != nil {
+			appLogger.Warn().Field("method", info.FullMethod).Err(err).Msg("Rejected gRPC client")
+			return MapErrorToGRPCStatus(err)
+		}
+		return handler(srv, &contextStream{ServerStream: stream, ctx: auth.WithClient(stream.Context(), client)})
+	}
+}
+
+// identifyClient prefers a verified client certificate and falls back to the
+// API key metadata, which is only accepted over TLS.
+func identifyClient(ctx context.Context, apiKeys map[string]string) (*auth.Client, error) {
+	var (
+		tlsInfo credentials.TLSInfo
+		overTLS bool
+	)
+
+	if p, ok := peer.FromContext(ctx); ok {
+		tlsInfo, overTLS = p.AuthInfo.(credentials.TLSInfo)
+	}
+
+	if overTLS && len(tlsInfo.State.VerifiedChains) > 0 {
+		if name := certificateName(tlsInfo.State); name != "" {
+			return &auth.Client{Name: name, Method: auth.ClientAuthMTLS}, nil
+		}
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	values := md.Get(apiKeyHeader)
+	if len(values) == 0 {
+		return nil, exception.ErrClientUnidentified
+	}
+
+	if !overTLS {
+		return nil, exception.ErrAPIKeyInsecure
+	}
+
+	// Every key is compared, in constant time, so timing does not reveal
+	// how much of a key matched.
+	var name string
+	for key, client := range apiKeys {
+		if subtle.ConstantTimeCompare([]byte(values[0]), []byte(key)) == 1 {
+			name = client
+		}
+	}
+
+	if name == "" {
+		return nil, exception.ErrAPIKeyInvalid
+	}
+
+	return &auth.Client{Name: name, Method: auth.ClientAuthAPIKey}, nil
+}
+
+// certificateName names a client after its certificate's common name, or its
+// first DNS or URI SAN.
+func certificateName(state tls.ConnectionState) string {
+	leaf := state.VerifiedChains[0][0]
+
+	switch {
+	case leaf.Subject.CommonName != "":
+		return leaf.Subject.CommonName
+	case len(leaf.DNSNames) > 0:
+		return leaf.DNSNames[0]
+	case len(leaf.URIs) > 0:
+		return leaf.URIs[0].String()
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,256 @@
+package grpcserver_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"inventory-service/config"
+	"inventory-service/internal/adapter/grpcserver"
+	"inventory-service/internal/shared/auth"
+	"inventory-service/pkg/logger"
+	"inventory-service/proto/pb"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pool *x509.CertPool
+	pem  []byte
+}
+
+func newTestCA(t *testing.T) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	assert.NoError(t, err)
+
+	pool := x509.NewCertPool()
+	pool.AddCert(cert)
+
+	return &testCA{cert: cert, key: key, pool: pool, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// issue returns a PEM certificate and key signed by the CA.
+func (ca *testCA) issue(t *testing.T, commonName string, usage x509.ExtKeyUsage) ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	assert.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: commonName},
+		DNSNames:     []string{"localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	assert.NoError(t, err)
+
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	assert.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER})
+}
+
+func writeFile(t *testing.T, path string, data []byte) {
+	assert.NoError(t, os.WriteFile(path, data, 0o600))
+}
+
+// clientNameServer answers GetProduct with the name of the calling client.
+type clientNameServer struct {
+	pb.UnimplementedInventoryServiceServer
+}
+
+func (clientNameServer) GetProduct(ctx context.Context, _ *pb.GetProductRequest) (*pb.Product, error) {
+	client, _ := auth.ClientFromContext(ctx)
+	return &pb.Product{Name: client.Name + "/" + client.Method}, nil
+}
+
+func startTLSServer(t *testing.T, cfg *config.GRPCConfig) string {
+	creds, err := grpcserver.ServerCredentials(cfg, logger.NewZerologLogger(false))
+	assert.NoError(t, err)
+
+	server := grpc.NewServer(
+		grpc.Creds(creds),
+		grpc.UnaryInterceptor(grpcserver.ClientAuthInterceptor(cfg, logger.NewZerologLogger(false))),
+	)
+	pb.RegisterInventoryServiceServer(server, clientNameServer{})
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	return listener.Addr().String()
+}
+
+func dial(t *testing.T, address string, tlsConfig *tls.Config) pb.InventoryServiceClient {
+	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	assert.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return pb.NewInventoryServiceClient(conn)
+}
+
+func TestServerCredentialsIdentifyClients(t *testing.T) {
+	ca := newTestCA(t)
+	dir := t.TempDir()
+
+	serverCert, serverKey := ca.issue(t, "inventory", x509.ExtKeyUsageServerAuth)
+	writeFile(t, filepath.Join(dir, "server.crt"), serverCert)
+	writeFile(t, filepath.Join(dir, "server.key"), serverKey)
+	writeFile(t, filepath.Join(dir, "ca.crt"), ca.pem)
+
+	address := startTLSServer(t, &config.GRPCConfig{
+		TLSCertFile:  filepath.Join(dir, "server.crt"),
+		TLSKeyFile:   filepath.Join(dir, "server.key"),
+		ClientCAFile: filepath.Join(dir, "ca.crt"),
+		APIKeys:      map[string]string{"billing-key": "billing-service"},
+	})
+
+	clientCertPEM, clientKeyPEM := ca.issue(t, "order-service", x509.ExtKeyUsageClientAuth)
+	clientCert, err := tls.X509KeyPair(clientCertPEM, clientKeyPEM)
+	assert.NoError(t, err)
+
+	withCert := dial(t, address, &tls.Config{RootCAs: ca.pool, ServerName: "localhost", Certificates: []tls.Certificate{clientCert}})
+	withoutCert := dial(t, address, &tls.Config{RootCAs: ca.pool, ServerName: "localhost"})
+
+	product, err := withCert.GetProduct(context.Background(), &pb.GetProductRequest{})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "order-service/"+auth.ClientAuthMTLS, product.Name)
+	}
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "x-api-key", "billing-key")
+	product, err = withoutCert.GetProduct(ctx, &pb.GetProductRequest{})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "billing-service/"+auth.ClientAuthAPIKey, product.Name)
+	}
+
+	_, err = withoutCert.GetProduct(context.Background(), &pb.GetProductRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	ctx = metadata.AppendToOutgoingContext(context.Background(), "x-api-key", "wrong-key")
+	_, err = withoutCert.GetProduct(ctx, &pb.GetProductRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// A certificate from another CA fails the handshake.
+	otherCertPEM, otherKeyPEM := newTestCA(t).issue(t, "intruder", x509.ExtKeyUsageClientAuth)
+	otherCert, err := tls.X509KeyPair(otherCertPEM, otherKeyPEM)
+	assert.NoError(t, err)
+
+	intruder := dial(t, address, &tls.Config{RootCAs: ca.pool, ServerName: "localhost", Certificates: []tls.Certificate{otherCert}})
+	_, err = intruder.GetProduct(ctx, &pb.GetProductRequest{})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestServerCredentialsReloadCertificate(t *testing.T) {
+	ca := newTestCA(t)
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "server.crt"), filepath.Join(dir, "server.key")
+
+	certPEM, keyPEM := ca.issue(t, "inventory-v1", x509.ExtKeyUsageServerAuth)
+	writeFile(t, certFile, certPEM)
+	writeFile(t, keyFile, keyPEM)
+
+	address := startTLSServer(t, &config.GRPCConfig{
+		TLSCertFile:        certFile,
+		TLSKeyFile:         keyFile,
+		CertReloadInterval: 1,
+		APIKeys:            map[string]string{"order-key": "order-service"},
+	})
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "x-api-key", "order-key")
+	serverName := func() string {
+		var p peer.Peer
+
+		client := dial(t, address, &tls.Config{RootCAs: ca.pool, ServerName: "localhost"})
+		_, err := client.GetProduct(ctx, &pb.GetProductRequest{}, grpc.Peer(&p))
+		assert.NoError(t, err)
+
+		tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+		if !assert.True(t, ok) {
+			return ""
+		}
+
+		return tlsInfo.State.PeerCertificates[0].Subject.CommonName
+	}
+
+	assert.Equal(t, "inventory-v1", serverName())
+
+	certPEM, keyPEM = ca.issue(t, "inventory-v2", x509.ExtKeyUsageServerAuth)
+	writeFile(t, certFile, certPEM)
+	writeFile(t, keyFile, keyPEM)
+
+	assert.Eventually(t, func() bool { return serverName() == "inventory-v2" }, 5*time.Second, 200*time.Millisecond)
+}
+
+func TestServerCredentialsValidation(t *testing.T) {
+	creds, err := grpcserver.ServerCredentials(&config.GRPCConfig{}, logger.NewZerologLogger(false))
+	assert.NoError(t, err)
+	assert.Nil(t, creds)
+
+	_, err = grpcserver.ServerCredentials(&config.GRPCConfig{ClientCAFile: "ca.crt"}, logger.NewZerologLogger(false))
+	assert.Error(t, err)
+
+	_, err = grpcserver.ServerCredentials(&config.GRPCConfig{TLSCertFile: "a", TLSKeyFile: "b", RequireClientCert: true}, logger.NewZerologLogger(false))
+	assert.Error(t, err)
+
+	// API keys are never accepted over plaintext.
+	_, err = grpcserver.ServerCredentials(&config.GRPCConfig{APIKeys: map[string]string{"order-key": "order-service"}}, logger.NewZerologLogger(false))
+	assert.Error(t, err)
+}
+
+func TestClientAuthInterceptorRejectsAPIKeysWithoutTLS(t *testing.T) {
+	cfg := &config.GRPCConfig{APIKeys: map[string]string{"order-key": "order-service"}}
+	info := &grpc.UnaryServerInfo{FullMethod: "/" + pb.InventoryService_ServiceDesc.ServiceName + "/GetProduct"}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-api-key", "order-key"))
+	ctx = peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 5000}})
+
+	_, err := grpcserver.ClientAuthInterceptor(cfg, logger.NewZerologLogger(false))(ctx, nil, info, func(context.Context, any) (any, error) {
+		return nil, nil
+	})
+
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
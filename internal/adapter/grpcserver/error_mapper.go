@@ -0,0 +1,106 @@
+package grpcserver
+
+import (
+	"context"
+	"inventory-service/internal/shared/exception"
+	"inventory-service/internal/shared/ratelimit"
+	"maps"
+	"slices"
+
+	"github.com/cockroachdb/errors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func MapExceptionTypeToCode(errType exception.ErrorType) codes.Code {
+	switch errType {
+	case exception.TypeBadRequest, exception.TypeValidationError, exception.TypeUnsupportedMediaType:
+		return codes.InvalidArgument
+	case exception.TypeUnauthorized, exception.TypeTokenExpired, exception.TypeTokenInvalid, exception.TypeAuthenticationError:
+		return codes.Unauthenticated
+	case exception.TypePermissionDenied, exception.TypeForbidden:
+		return codes.PermissionDenied
+	case exception.TypeNotFound:
+		return codes.NotFound
+	case exception.TypeConflict:
+		return codes.AlreadyExists
+	case exception.TypeFailedPrecondition:
+		return codes.FailedPrecondition
+	case exception.TypeRateLimitExceeded:
+		return codes.ResourceExhausted
+	case exception.TypeMethodNotAllowed:
+		return codes.Unimplemented
+	case exception.TypeTimeout:
+		return codes.DeadlineExceeded
+	case exception.TypeServiceUnavailable, exception.TypeConnectionError, exception.TypeResourceError:
+		return codes.Unavailable
+	default:
+		return codes.Internal
+	}
+}
+
+// MapErrorToGRPCStatus converts an application error into a gRPC status error.
+// Internal errors are reported with a generic message so driver details do not leak.
+// Rate limited calls carry how long to wait as RetryInfo, and invalid requests
+// their field errors as BadRequest field violations.
+func MapErrorToGRPCStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	if errors.Is(err, context.Canceled) {
+		return status.Error(codes.Canceled, "Request canceled by client")
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return status.Error(codes.DeadlineExceeded, "Operation timed out")
+	}
+
+	ex, ok := exception.GetException(err)
+	if !ok {
+		return status.Error(codes.Internal, "An internal server error occurred")
+	}
+
+	code := MapExceptionTypeToCode(ex.Type)
+	if code == codes.Internal {
+		return status.Error(code, "An internal server error occurred")
+	}
+
+	if retryAfter, ok := ratelimit.RetryAfter(err); ok {
+		st, detailErr := status.New(code, ex.Message).WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(retryAfter)})
+		if detailErr == nil {
+			return st.Err()
+		}
+	}
+
+	if len(ex.Errors) > 0 {
+		st, detailErr := status.New(code, ex.Message).WithDetails(fieldViolations(ex.Errors))
+		if detailErr == nil {
+			return st.Err()
+		}
+	}
+
+	return status.Error(code, ex.Message)
+}
+
+// fieldViolations lists field errors ordered by field.
+func fieldViolations(errs exception.FieldErrors) *errdetails.BadRequest {
+	badRequest := &errdetails.BadRequest{}
+
+	for _, field := range slices.Sorted(maps.Keys(errs)) {
+		for _, message := range errs[field] {
+			badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       field,
+				Description: message,
+			})
+		}
+	}
+
+	return badRequest
+}
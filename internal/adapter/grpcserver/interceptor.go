@@ -2,16 +2,70 @@ package grpcserver
 
 import (
 	"context"
+	"crypto/rand"
+	"inventory-service/internal/shared/auth"
+	"inventory-service/internal/shared/ratelimit"
 	"inventory-service/pkg/logger"
+	"net"
+	"path"
 
 	"go.elastic.co/apm/module/apmgrpc/v2"
 	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 )
 
 func TracingInterceptor() grpc.UnaryServerInterceptor {
 	return apmgrpc.NewUnaryServerInterceptor()
 }
 
+func TracingStreamInterceptor() grpc.StreamServerInterceptor {
+	return apmgrpc.NewStreamServerInterceptor()
+}
+
+// requestIDHeader is the metadata key a caller may pass its request ID in.
+const requestIDHeader = "x-request-id"
+
+// OriginInterceptor marks calls as coming in over gRPC, under the caller's
+// request ID or a generated one.
+func OriginInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		_ *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		return handler(withOrigin(ctx), req)
+	}
+}
+
+func OriginStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		stream grpc.ServerStream,
+		_ *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		return handler(srv, &contextStream{ServerStream: stream, ctx: withOrigin(stream.Context())})
+	}
+}
+
+func withOrigin(ctx context.Context) context.Context {
+	var requestID string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(requestIDHeader); len(values) > 0 {
+			requestID = values[0]
+		}
+	}
+
+	if requestID == "" {
+		requestID = rand.Text()
+	}
+
+	return auth.WithOrigin(ctx, &auth.Origin{Source: auth.SourceGRPC, RequestID: requestID})
+}
+
 func LoggingInterceptor(appLogger logger.Logger) grpc.UnaryServerInterceptor {
 	return func(
 		ctx context.Context,
@@ -19,11 +73,233 @@ func LoggingInterceptor(appLogger logger.Logger) grpc.UnaryServerInterceptor {
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (any, error) {
-		appLogger.Info().Field("method", info.FullMethod).Msg("Incoming gRPC request")
+		reqLogger := callLogger(ctx, appLogger, info.FullMethod)
+		reqLogger.Info().Msg("Incoming gRPC request")
 		resp, err := handler(ctx, req)
 		if err != nil {
-			appLogger.Error().Field("method", info.FullMethod).Err(err).Msg("gRPC request failed")
+			reqLogger.Error().Err(err).Msg("gRPC request failed")
 		}
 		return resp, err
 	}
 }
+
+// callLogger tags the logs of a call with its method, its request ID and,
+// once identified, its client.
+func callLogger(ctx context.Context, appLogger logger.Logger, method string) logger.Logger {
+	instance := appLogger.NewInstance().Field("method", method)
+	if origin, ok := auth.OriginFromContext(ctx); ok {
+		instance = instance.Field("request_id", origin.RequestID)
+	}
+	if client, ok := auth.ClientFromContext(ctx); ok {
+		instance = instance.Field("client", client.Name).Field("client_auth", client.Method)
+	}
+	return instance.Logger()
+}
+
+func ErrorInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		_ *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return nil, MapErrorToGRPCStatus(err)
+		}
+		return resp, nil
+	}
+}
+
+func LoggingStreamInterceptor(appLogger logger.Logger) grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		streamLogger := callLogger(stream.Context(), appLogger, info.FullMethod)
+		streamLogger.Info().Msg("Incoming gRPC stream")
+		err := handler(srv, stream)
+		if err != nil {
+			streamLogger.Error().Err(err).Msg("gRPC stream failed")
+		}
+		return err
+	}
+}
+
+func ErrorStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		stream grpc.ServerStream,
+		_ *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		return MapErrorToGRPCStatus(handler(srv, stream))
+	}
+}
+
+// publicMethods are served without authentication.
+var publicMethods = map[string]bool{
+	healthpb.Health_Check_FullMethodName: true,
+	healthpb.Health_Watch_FullMethodName: true,
+	healthpb.Health_List_FullMethodName:  true,
+}
+
+// AuthInterceptor puts the caller of every non-public RPC into the context,
+// rejecting calls without a valid bearer token in the authorization metadata
+// or without the permission the policy requires for the method.
+func AuthInterceptor(authenticator auth.Authenticator, policy *auth.Policy) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		if publicMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+
+		ctx, err := authorize(ctx, authenticator, policy, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func AuthStreamInterceptor(authenticator auth.Authenticator, policy *auth.Policy) grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if publicMethods[info.FullMethod] {
+			return handler(srv, stream)
+		}
+
+		ctx, err := authorize(stream.Context(), authenticator, policy, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &contextStream{ServerStream: stream, ctx: ctx})
+	}
+}
+
+func authorize(ctx context.Context, authenticator auth.Authenticator, policy *auth.Policy, fullMethod string) (context.Context, error) {
+	var header string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("authorization"); len(values) > 0 {
+			header = values[0]
+		}
+	}
+
+	principal, err := authenticator.Authenticate(header)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := policy.Authorize(principal, auth.TransportGRPC, path.Base(fullMethod)); err != nil {
+		return nil, err
+	}
+	return auth.WithPrincipal(ctx, principal), nil
+}
+
+// RateLimitInterceptor counts every non-public call against its caller,
+// rejecting calls beyond the limit of the method's group.
+func RateLimitInterceptor(limiter *ratelimit.Limiter) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		if !publicMethods[info.FullMethod] {
+			if err := allow(ctx, limiter, info.FullMethod); err != nil {
+				return nil, err
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+func RateLimitStreamInterceptor(limiter *ratelimit.Limiter) grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if !publicMethods[info.FullMethod] {
+			if err := allow(stream.Context(), limiter, info.FullMethod); err != nil {
+				return err
+			}
+		}
+		return handler(srv, stream)
+	}
+}
+
+func allow(ctx context.Context, limiter *ratelimit.Limiter, fullMethod string) error {
+	return limiter.Allow(ctx, ratelimit.Caller(ctx, peerIP(ctx)), auth.TransportGRPC, path.Base(fullMethod))
+}
+
+// IPRateLimitInterceptor counts every non-public call against the IP it came
+// from before authentication, so calls without valid credentials are limited
+// too.
+func IPRateLimitInterceptor(limiter *ratelimit.Limiter) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		if !publicMethods[info.FullMethod] {
+			if err := limiter.AllowIP(ctx, peerIP(ctx)); err != nil {
+				return nil, err
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+func IPRateLimitStreamInterceptor(limiter *ratelimit.Limiter) grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if !publicMethods[info.FullMethod] {
+			if err := limiter.AllowIP(stream.Context(), peerIP(stream.Context())); err != nil {
+				return err
+			}
+		}
+		return handler(srv, stream)
+	}
+}
+
+// peerIP is the IP address a call came from.
+func peerIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	ip := p.Addr.String()
+	if host, _, err := net.SplitHostPort(ip); err == nil {
+		ip = host
+	}
+
+	return ip
+}
+
+// contextStream overrides the context of a server stream.
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
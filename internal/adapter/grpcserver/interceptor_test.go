@@ -0,0 +1,262 @@
+package grpcserver_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"inventory-service/config"
+	"inventory-service/internal/adapter/grpcserver"
+	"inventory-service/internal/shared/auth"
+	"inventory-service/internal/shared/exception"
+	"inventory-service/internal/shared/ratelimit"
+	"inventory-service/pkg/logger"
+	"inventory-service/pkg/tokenbucket"
+	"inventory-service/proto/pb"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const testSecret = "test-secret"
+
+// policyFile is the policy the service ships with.
+const policyFile = "../../../config/policy.yaml"
+
+func setupAuth(t *testing.T) (auth.Authenticator, *auth.Policy) {
+	authenticator, err := auth.NewAuthenticator(&config.AuthConfig{Algorithm: "HS256", Secret: testSecret})
+	assert.NoError(t, err)
+
+	policy, err := auth.LoadPolicy(policyFile)
+	assert.NoError(t, err)
+
+	return authenticator, policy
+}
+
+func bearerContext(t *testing.T, roles ...string) context.Context {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"sub":   "user-1",
+		"roles": roles,
+		"exp":   time.Now().Add(time.Hour).Unix(),
+	}).SignedString([]byte(testSecret))
+	assert.NoError(t, err)
+
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+}
+
+// inventoryMethods lists every RPC of InventoryService, unary and streaming.
+func inventoryMethods() []string {
+	var methods []string
+
+	for _, method := range pb.InventoryService_ServiceDesc.Methods {
+		methods = append(methods, method.MethodName)
+	}
+
+	for _, stream := range pb.InventoryService_ServiceDesc.Streams {
+		methods = append(methods, stream.StreamName)
+	}
+
+	return methods
+}
+
+func callUnary(ctx context.Context, interceptor grpc.UnaryServerInterceptor, method string) (*auth.Principal, error) {
+	var principal *auth.Principal
+
+	info := &grpc.UnaryServerInfo{FullMethod: "/" + pb.InventoryService_ServiceDesc.ServiceName + "/" + method}
+	_, err := interceptor(ctx, nil, info, func(ctx context.Context, _ any) (any, error) {
+		principal, _ = auth.PrincipalFromContext(ctx)
+		return nil, nil
+	})
+
+	return principal, err
+}
+
+func assertPermissionDenied(t *testing.T, err error) {
+	ex, ok := exception.GetException(err)
+	if assert.True(t, ok, "expected an exception, got %v", err) {
+		assert.Equal(t, exception.TypePermissionDenied, ex.Type)
+	}
+}
+
+func TestPolicyCoversEveryRPC(t *testing.T) {
+	_, policy := setupAuth(t)
+
+	for _, method := range inventoryMethods() {
+		_, ok := policy.Permission(auth.TransportGRPC, method)
+		assert.True(t, ok, "%s has no permission in %s", method, policyFile)
+	}
+}
+
+func TestAuthInterceptorAuthorizesEveryRPCByRole(t *testing.T) {
+	authenticator, policy := setupAuth(t)
+	interceptor := grpcserver.AuthInterceptor(authenticator, policy)
+
+	for _, method := range inventoryMethods() {
+		t.Run(method, func(t *testing.T) {
+			permission, _ := policy.Permission(auth.TransportGRPC, method)
+
+			principal, err := callUnary(bearerContext(t, "admin"), interceptor, method)
+			if assert.NoError(t, err) {
+				assert.Equal(t, "user-1", principal.Subject)
+			}
+
+			_, err = callUnary(bearerContext(t, "viewer"), interceptor, method)
+			if permission == "inventory.read" {
+				assert.NoError(t, err)
+			} else {
+				assertPermissionDenied(t, err)
+			}
+
+			_, err = callUnary(bearerContext(t), interceptor, method)
+			assertPermissionDenied(t, err)
+		})
+	}
+}
+
+func TestAuthInterceptorRoles(t *testing.T) {
+	authenticator, policy := setupAuth(t)
+	interceptor := grpcserver.AuthInterceptor(authenticator, policy)
+
+	tests := []struct {
+		role    string
+		method  string
+		allowed bool
+	}{
+		{"viewer", "ListProducts", true},
+		{"viewer", "GetReservation", true},
+		{"viewer", "AdjustStock", false},
+		{"viewer", "CreateReservation", false},
+		{"operator", "AdjustStock", true},
+		{"operator", "CreateReservation", true},
+		{"operator", "UpdateReservationStatus", true},
+		{"operator", "CreateProduct", false},
+		{"operator", "DeleteProduct", false},
+		{"admin", "CreateProduct", true},
+		{"admin", "DeleteProduct", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.role+"/"+tt.method, func(t *testing.T) {
+			_, err := callUnary(bearerContext(t, tt.role), interceptor, tt.method)
+			if tt.allowed {
+				assert.NoError(t, err)
+			} else {
+				assertPermissionDenied(t, err)
+			}
+		})
+	}
+}
+
+func TestAuthInterceptorPublicAndUnauthenticated(t *testing.T) {
+	authenticator, policy := setupAuth(t)
+	interceptor := grpcserver.AuthInterceptor(authenticator, policy)
+
+	info := &grpc.UnaryServerInfo{FullMethod: healthpb.Health_Check_FullMethodName}
+	_, err := interceptor(context.Background(), nil, info, func(context.Context, any) (any, error) { return nil, nil })
+	assert.NoError(t, err)
+
+	_, err = callUnary(context.Background(), interceptor, "ListProducts")
+	ex, ok := exception.GetException(err)
+	if assert.True(t, ok) {
+		assert.Equal(t, exception.CodeAuthHeaderMissing, ex.Code)
+	}
+}
+
+type fakeStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *fakeStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestAuthStreamInterceptor(t *testing.T) {
+	authenticator, policy := setupAuth(t)
+	interceptor := grpcserver.AuthStreamInterceptor(authenticator, policy)
+	info := &grpc.StreamServerInfo{FullMethod: "/" + pb.InventoryService_ServiceDesc.ServiceName + "/WatchStock"}
+
+	var principal *auth.Principal
+	err := interceptor(nil, &fakeStream{ctx: bearerContext(t, "viewer")}, info, func(_ any, stream grpc.ServerStream) error {
+		principal, _ = auth.PrincipalFromContext(stream.Context())
+		return nil
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"viewer"}, principal.Roles)
+	}
+
+	err = interceptor(nil, &fakeStream{ctx: bearerContext(t)}, info, func(any, grpc.ServerStream) error { return nil })
+	assertPermissionDenied(t, err)
+}
+
+func TestRateLimitInterceptorExhaustsResource(t *testing.T) {
+	limiter, err := ratelimit.NewLimiter(&config.Config{
+		Auth:      &config.AuthConfig{PolicyFile: policyFile},
+		RateLimit: &config.RateLimitConfig{Enabled: true, Rate: 1, Burst: 1},
+	}, tokenbucket.NewMemoryStore(), logger.NewZerologLogger(false))
+	assert.NoError(t, err)
+
+	interceptor := grpcserver.RateLimitInterceptor(limiter)
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{Subject: "user-1"})
+
+	_, err = callUnary(ctx, interceptor, "ListProducts")
+	assert.NoError(t, err)
+
+	_, err = callUnary(ctx, interceptor, "ListProducts")
+	st := status.Convert(grpcserver.MapErrorToGRPCStatus(err))
+	assert.Equal(t, codes.ResourceExhausted, st.Code())
+
+	if assert.Len(t, st.Details(), 1) {
+		retryInfo, ok := st.Details()[0].(*errdetails.RetryInfo)
+		if assert.True(t, ok) {
+			assert.Greater(t, retryInfo.RetryDelay.AsDuration(), time.Duration(0))
+		}
+	}
+
+	// Another caller still has its tokens.
+	_, err = callUnary(auth.WithPrincipal(context.Background(), &auth.Principal{Subject: "user-2"}), interceptor, "ListProducts")
+	assert.NoError(t, err)
+}
+
+func TestIPRateLimitInterceptorLimitsUnauthenticatedCalls(t *testing.T) {
+	limiter, err := ratelimit.NewLimiter(&config.Config{
+		Auth:      &config.AuthConfig{PolicyFile: policyFile},
+		RateLimit: &config.RateLimitConfig{Enabled: true, IPRate: 1, IPBurst: 1},
+	}, tokenbucket.NewMemoryStore(), logger.NewZerologLogger(false))
+	assert.NoError(t, err)
+
+	authenticator, policy := setupAuth(t)
+	// The IP limit runs before authentication, as in the server's chain.
+	interceptor := func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		return grpcserver.IPRateLimitInterceptor(limiter)(ctx, req, info, func(ctx context.Context, req any) (any, error) {
+			return grpcserver.AuthInterceptor(authenticator, policy)(ctx, req, info, handler)
+		})
+	}
+
+	peerContext := func(ip string) context.Context {
+		return peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 5000}})
+	}
+
+	_, err = callUnary(peerContext("203.0.113.7"), interceptor, "ListProducts")
+	ex, ok := exception.GetException(err)
+	if assert.True(t, ok) {
+		assert.Equal(t, exception.TypeUnauthorized, ex.Type)
+	}
+
+	_, err = callUnary(peerContext("203.0.113.7"), interceptor, "ListProducts")
+	st := status.Convert(grpcserver.MapErrorToGRPCStatus(err))
+	assert.Equal(t, codes.ResourceExhausted, st.Code())
+
+	// Another address still has its tokens.
+	_, err = callUnary(peerContext("203.0.113.8"), interceptor, "ListProducts")
+	_, limited := ratelimit.RetryAfter(err)
+	assert.False(t, limited)
+}
@@ -0,0 +1,437 @@
+package grpcserver
+
+import (
+	"inventory-service/constant"
+	postgresrepository "inventory-service/internal/adapter/repository/postgres"
+	"inventory-service/internal/domain/entity"
+	"inventory-service/internal/shared/exception"
+	"inventory-service/proto/pb"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func MapProductToPB(product *entity.Product) *pb.Product {
+	if product == nil {
+		return nil
+	}
+
+	variants := make([]*pb.Product, 0, len(product.Variants))
+	for _, variant := range product.Variants {
+		variants = append(variants, MapProductToPB(variant))
+	}
+
+	components := make([]*pb.KitComponent, 0, len(product.Components))
+	for _, component := range product.Components {
+		components = append(components, MapKitComponentToPB(component))
+	}
+
+	units := make([]*pb.ProductUnit, 0, len(product.Units))
+	for _, unit := range product.Units {
+		units = append(units, &pb.ProductUnit{Unit: unit.Unit, Factor: int32(unit.Factor)})
+	}
+
+	return &pb.Product{
+		Id:             product.Base.ID,
+		Sku:            product.SKU,
+		Barcode:        product.Barcode,
+		CategoryId:     product.CategoryID,
+		Name:           product.Name,
+		Stock:          int32(product.Stock),
+		Price:          MapMoneyToPB(product.Price),
+		Status:         MapDBProductStatusToPB(product.Status),
+		Attributes:     mapAttributesToPB(product.Attributes),
+		CreatedAt:      timestamppb.New(product.CreatedAt),
+		UpdatedAt:      timestamppb.New(product.UpdatedAt),
+		ParentId:       product.ParentID,
+		Options:        product.Options,
+		Variants:       variants,
+		AvailableStock: int32(product.AvailableStock),
+		Components:     components,
+		TrackLots:      product.TrackLots,
+		TrackSerials:   product.TrackSerials,
+		Units:          units,
+	}
+}
+
+// mapAttributesToPB converts the attributes, or another object decoded from
+// JSON, to a Struct. Every JSON value is representable, so a failure only
+// drops them.
+func mapAttributesToPB(attributes map[string]any) *structpb.Struct {
+	if len(attributes) == 0 {
+		return nil
+	}
+
+	res, err := structpb.NewStruct(attributes)
+	if err != nil {
+		return nil
+	}
+
+	return res
+}
+
+func MapPBToAttributes(attributes *structpb.Struct) map[string]any {
+	if attributes == nil {
+		return nil
+	}
+
+	return attributes.AsMap()
+}
+
+func MapPBToAttributeFilters(filters []*pb.AttributeFilter) []*postgresrepository.AttributeFilter {
+	if len(filters) == 0 {
+		return nil
+	}
+
+	res := make([]*postgresrepository.AttributeFilter, 0, len(filters))
+	for _, filter := range filters {
+		attribute := &postgresrepository.AttributeFilter{
+			Key:    filter.Key,
+			Min:    filter.Min,
+			Max:    filter.Max,
+			Exists: filter.Exists,
+		}
+
+		if filter.Equals != nil {
+			attribute.Equals = filter.Equals.AsInterface()
+		}
+
+		res = append(res, attribute)
+	}
+
+	return res
+}
+
+func MapMoneyToPB(money entity.Money) *pb.Money {
+	return &pb.Money{
+		CurrencyCode: money.Currency,
+		Units:        money.Units,
+		Nanos:        money.Nanos,
+	}
+}
+
+func MapPBToMoney(money *pb.Money) entity.Money {
+	if money == nil {
+		return entity.Money{}
+	}
+
+	return entity.Money{
+		Currency: money.CurrencyCode,
+		Units:    money.Units,
+		Nanos:    money.Nanos,
+	}
+}
+
+func MapKitComponentToPB(component *entity.KitComponent) *pb.KitComponent {
+	if component == nil {
+		return nil
+	}
+
+	return &pb.KitComponent{
+		ComponentId: component.ComponentID,
+		Quantity:    int32(component.Quantity),
+		Component:   MapProductToPB(component.Component),
+	}
+}
+
+func MapPBToKitComponents(components []*pb.KitComponent) []*entity.KitComponent {
+	if len(components) == 0 {
+		return nil
+	}
+
+	res := make([]*entity.KitComponent, 0, len(components))
+	for _, component := range components {
+		res = append(res, &entity.KitComponent{
+			ComponentID: component.ComponentId,
+			Quantity:    int(component.Quantity),
+		})
+	}
+
+	return res
+}
+
+func MapPBToProductUnits(units []*pb.ProductUnit) []*entity.ProductUnit {
+	if len(units) == 0 {
+		return nil
+	}
+
+	res := make([]*entity.ProductUnit, 0, len(units))
+	for _, unit := range units {
+		res = append(res, &entity.ProductUnit{
+			Unit:   unit.Unit,
+			Factor: int(unit.Factor),
+		})
+	}
+
+	return res
+}
+
+func MapStockAdjustmentToPB(adjustment *entity.StockAdjustment) *pb.StockAdjustment {
+	if adjustment == nil {
+		return nil
+	}
+
+	return &pb.StockAdjustment{
+		ProductId:    adjustment.ProductID,
+		Quantity:     int32(adjustment.Quantity),
+		Unit:         adjustment.Unit,
+		BaseQuantity: int32(adjustment.BaseQuantity),
+		Product:      MapProductToPB(adjustment.Product),
+	}
+}
+
+func MapCategoryToPB(category *entity.Category) *pb.Category {
+	if category == nil {
+		return nil
+	}
+
+	children := make([]*pb.Category, 0, len(category.Children))
+	for _, child := range category.Children {
+		children = append(children, MapCategoryToPB(child))
+	}
+
+	return &pb.Category{
+		Id:           category.Base.ID,
+		ParentId:     category.ParentID,
+		Name:         category.Name,
+		Description:  category.Description,
+		ProductCount: int32(category.ProductCount),
+		TotalStock:   int64(category.TotalStock),
+		CreatedAt:    timestamppb.New(category.CreatedAt),
+		UpdatedAt:    timestamppb.New(category.UpdatedAt),
+		Children:     children,
+	}
+}
+
+func MapReservationToPB(reservation *entity.Reservation) *pb.Reservation {
+	if reservation == nil {
+		return nil
+	}
+
+	components := make([]*pb.Reservation, 0, len(reservation.Components))
+	for _, component := range reservation.Components {
+		components = append(components, MapReservationToPB(component))
+	}
+
+	lots := make([]*pb.LotAllocation, 0, len(reservation.Lots))
+	for _, allocation := range reservation.Lots {
+		lots = append(lots, MapLotAllocationToPB(allocation))
+	}
+
+	serials := make([]*pb.Serial, 0, len(reservation.Serials))
+	for _, serial := range reservation.Serials {
+		serials = append(serials, MapSerialToPB(serial))
+	}
+
+	return &pb.Reservation{
+		Id:           reservation.Base.ID,
+		ProductId:    reservation.ProductID,
+		OrderId:      reservation.OrderID,
+		Quantity:     int32(reservation.Quantity),
+		Unit:         reservation.Unit,
+		UnitQuantity: int32(reservation.UnitQuantity),
+		Status:       MapDBStatusToPBStatus(reservation.Status),
+		CreatedAt:    timestamppb.New(reservation.CreatedAt),
+		ParentId:     reservation.ParentID,
+		Components:   components,
+		Lots:         lots,
+		Serials:      serials,
+	}
+}
+
+func MapLotToPB(lot *entity.Lot) *pb.Lot {
+	if lot == nil {
+		return nil
+	}
+
+	return &pb.Lot{
+		Id:        lot.Base.ID,
+		ProductId: lot.ProductID,
+		LotNumber: lot.LotNumber,
+		ExpiresAt: mapOptionalTimestamp(lot.ExpiresAt),
+		Quantity:  int32(lot.Quantity),
+		CreatedAt: timestamppb.New(lot.CreatedAt),
+		UpdatedAt: timestamppb.New(lot.UpdatedAt),
+	}
+}
+
+func MapLotAllocationToPB(allocation *entity.LotAllocation) *pb.LotAllocation {
+	if allocation == nil {
+		return nil
+	}
+
+	res := &pb.LotAllocation{
+		LotId:    allocation.LotID,
+		Quantity: int32(allocation.Quantity),
+	}
+
+	if allocation.Lot != nil {
+		res.LotNumber = allocation.Lot.LotNumber
+		res.ExpiresAt = mapOptionalTimestamp(allocation.Lot.ExpiresAt)
+	}
+
+	return res
+}
+
+func MapPriceChangeToPB(change *entity.PriceChange) *pb.PriceChange {
+	if change == nil {
+		return nil
+	}
+
+	return &pb.PriceChange{
+		Id:            change.Base.ID,
+		ProductId:     change.ProductID,
+		Price:         MapMoneyToPB(change.Price),
+		EffectiveFrom: timestamppb.New(change.EffectiveFrom),
+		AppliedAt:     mapOptionalTimestamp(change.AppliedAt),
+		CreatedAt:     timestamppb.New(change.CreatedAt),
+	}
+}
+
+func MapStockEventToPB(event *entity.StockEvent) *pb.StockEvent {
+	if event == nil {
+		return nil
+	}
+
+	return &pb.StockEvent{
+		Sequence:       event.Sequence,
+		ProductId:      event.ProductID,
+		Stock:          int32(event.Stock),
+		Reserved:       int32(event.Reserved),
+		AvailableStock: int32(event.AvailableStock()),
+		ChangedAt:      timestamppb.New(event.CreatedAt),
+		Snapshot:       event.Snapshot,
+	}
+}
+
+func MapSerialToPB(serial *entity.Serial) *pb.Serial {
+	if serial == nil {
+		return nil
+	}
+
+	return &pb.Serial{
+		Id:            serial.Base.ID,
+		ProductId:     serial.ProductID,
+		SerialNumber:  serial.SerialNumber,
+		Status:        MapDBSerialStatusToPB(serial.Status),
+		ReservationId: serial.ReservationID,
+		OrderId:       serial.OrderID,
+		CreatedAt:     timestamppb.New(serial.CreatedAt),
+		UpdatedAt:     timestamppb.New(serial.UpdatedAt),
+		Product:       MapProductToPB(serial.Product),
+	}
+}
+
+// MapWebhookToPB leaves out the secret, which only CreateWebhook returns.
+func MapWebhookToPB(webhook *entity.Webhook) *pb.Webhook {
+	if webhook == nil {
+		return nil
+	}
+
+	return &pb.Webhook{
+		Id:           webhook.Base.ID,
+		Url:          webhook.URL,
+		EventTypes:   webhook.EventTypes,
+		Active:       webhook.Active,
+		FailureCount: int32(webhook.FailureCount),
+		DisabledAt:   mapOptionalTimestamp(webhook.DisabledAt),
+		CreatedAt:    timestamppb.New(webhook.CreatedAt),
+		UpdatedAt:    timestamppb.New(webhook.UpdatedAt),
+	}
+}
+
+func MapWebhookDeliveryToPB(delivery *entity.WebhookDelivery) *pb.WebhookDelivery {
+	if delivery == nil {
+		return nil
+	}
+
+	res := &pb.WebhookDelivery{
+		Id:             delivery.ID,
+		WebhookId:      delivery.WebhookID,
+		EventId:        delivery.EventID,
+		EventType:      delivery.EventType,
+		Status:         MapDBWebhookDeliveryStatusToPB(delivery.Status),
+		Attempts:       int32(delivery.Attempts),
+		ResponseStatus: int32(delivery.ResponseStatus),
+		ResponseBody:   delivery.ResponseBody,
+		LastError:      delivery.LastError,
+		DeliveredAt:    mapOptionalTimestamp(delivery.DeliveredAt),
+		CreatedAt:      timestamppb.New(delivery.CreatedAt),
+	}
+
+	if delivery.Status == constant.WebhookDeliveryStatusPending {
+		res.NextAttemptAt = timestamppb.New(delivery.NextAttemptAt)
+	}
+
+	return res
+}
+
+func MapAuditEventToPB(event *entity.AuditEvent) *pb.AuditEvent {
+	if event == nil {
+		return nil
+	}
+
+	return &pb.AuditEvent{
+		Id:         event.ID,
+		EntityType: event.EntityType,
+		EntityId:   event.EntityID,
+		Action:     event.Action,
+		Actor:      event.Actor,
+		Source:     event.Source,
+		RequestId:  event.RequestID,
+		Before:     mapAttributesToPB(event.Before),
+		After:      mapAttributesToPB(event.After),
+		CreatedAt:  timestamppb.New(event.CreatedAt),
+	}
+}
+
+func mapOptionalTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+
+	return timestamppb.New(*t)
+}
+
+// MapBatchResultsToPB reports each item of a bulk call. Errors without an
+// application exception are reported generically, as MapErrorToGRPCStatus does.
+func MapBatchResultsToPB(results []*entity.BatchItemResult) *pb.BatchProductsResponse {
+	response := &pb.BatchProductsResponse{
+		Results: make([]*pb.BatchItemResult, len(results)),
+	}
+
+	for i, result := range results {
+		item := &pb.BatchItemResult{Index: int32(result.Index)}
+
+		switch {
+		case result.Err == nil:
+			item.Status = pb.BatchItemStatus_BATCH_ITEM_STATUS_SUCCEEDED
+			item.Product = MapProductToPB(result.Product)
+			response.Succeeded++
+		case errors.Is(result.Err, entity.ErrBatchItemSkipped):
+			item.Status = pb.BatchItemStatus_BATCH_ITEM_STATUS_ABORTED
+			item.Error = result.Err.Error()
+			response.Failed++
+		default:
+			item.Status = pb.BatchItemStatus_BATCH_ITEM_STATUS_FAILED
+			item.Error = "An internal server error occurred"
+			if ex, ok := exception.GetException(result.Err); ok && MapExceptionTypeToCode(ex.Type) != codes.Internal {
+				item.Error = ex.Message
+				item.ErrorCode = ex.Code
+				item.FieldErrors = make(map[string]string, len(ex.Errors))
+				for field, messages := range ex.Errors {
+					item.FieldErrors[field] = strings.Join(messages, "; ")
+				}
+			}
+			response.Failed++
+		}
+
+		response.Results[i] = item
+	}
+
+	return response
+}
@@ -5,6 +5,9 @@ import (
 	"fmt"
 	"inventory-service/config"
 	"inventory-service/internal/adapter/repository"
+	"inventory-service/internal/domain/service"
+	"inventory-service/internal/shared/auth"
+	"inventory-service/internal/shared/ratelimit"
 	"inventory-service/pkg/logger"
 	"inventory-service/proto/pb"
 	"net"
@@ -13,6 +16,8 @@ import (
 	"syscall"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
 // chainUnaryInterceptors chains multiple gRPC interceptors into one
@@ -31,23 +36,109 @@ func chainUnaryInterceptors(interceptors ...grpc.UnaryServerInterceptor) grpc.Un
 	}
 }
 
-func NewGRPCServer(config *config.Config, repo repository.Repository, logger logger.Logger) (*grpc.Server, error) {
-	grpcService, err := NewGRPCService(config, repo, logger)
+// chainStreamInterceptors chains multiple gRPC stream interceptors into one
+func chainStreamInterceptors(interceptors ...grpc.StreamServerInterceptor) grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		chain := handler
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			current := interceptors[i]
+			chain = func(currentHandler grpc.StreamHandler) grpc.StreamHandler {
+				return func(currentSrv any, currentStream grpc.ServerStream) error {
+					return current(currentSrv, currentStream, info, currentHandler)
+				}
+			}(chain)
+		}
+		return chain(srv, stream)
+	}
+}
+
+// NewGRPCServer starts the gRPC server. The stock watch service is shared with
+// the rest of the app, since its watchers are fed by the app's listener.
+func NewGRPCServer(
+	config *config.Config,
+	repo repository.Repository,
+	logger logger.Logger,
+	stockWatchService service.StockWatchService,
+	limiter *ratelimit.Limiter,
+) (*grpc.Server, error) {
+	grpcService, err := NewGRPCService(config, repo, logger, stockWatchService)
 	if err != nil {
 		return nil, fmt.Errorf("failed to setup gRPC service: %w", err)
 	}
 
-	// Chain logging and tracing interceptors
-	interceptor := chainUnaryInterceptors(
+	// Chain logging, tracing and error mapping interceptors. The request ID
+	// and client identification come first, so the logged calls carry them;
+	// user authentication runs inside error mapping so its failures get
+	// their gRPC codes.
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
 		LoggingInterceptor(logger),
 		TracingInterceptor(),
-	)
+		ErrorInterceptor(),
+	}
+
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		LoggingStreamInterceptor(logger),
+		TracingStreamInterceptor(),
+		ErrorStreamInterceptor(),
+	}
+
+	if clientAuthEnabled(config.Grpc) {
+		unaryInterceptors = append([]grpc.UnaryServerInterceptor{ClientAuthInterceptor(config.Grpc, logger)}, unaryInterceptors...)
+		streamInterceptors = append([]grpc.StreamServerInterceptor{ClientAuthStreamInterceptor(config.Grpc, logger)}, streamInterceptors...)
+	}
+
+	unaryInterceptors = append([]grpc.UnaryServerInterceptor{OriginInterceptor()}, unaryInterceptors...)
+	streamInterceptors = append([]grpc.StreamServerInterceptor{OriginStreamInterceptor()}, streamInterceptors...)
+
+	// Calls are limited per IP before authentication, so floods of
+	// unauthenticated calls are limited too.
+	if limiter != nil {
+		unaryInterceptors = append(unaryInterceptors, IPRateLimitInterceptor(limiter))
+		streamInterceptors = append(streamInterceptors, IPRateLimitStreamInterceptor(limiter))
+	}
+
+	if config.Auth != nil && config.Auth.Enabled {
+		authenticator, err := auth.NewAuthenticator(config.Auth)
+		if err != nil {
+			return nil, err
+		}
+
+		policy, err := auth.LoadConfiguredPolicy(config.Auth)
+		if err != nil {
+			return nil, err
+		}
+
+		unaryInterceptors = append(unaryInterceptors, AuthInterceptor(authenticator, policy))
+		streamInterceptors = append(streamInterceptors, AuthStreamInterceptor(authenticator, policy))
+	}
+
+	// Rate limiting counts calls against the caller authentication named.
+	if limiter != nil {
+		unaryInterceptors = append(unaryInterceptors, RateLimitInterceptor(limiter))
+		streamInterceptors = append(streamInterceptors, RateLimitStreamInterceptor(limiter))
+	}
+
+	unaryInterceptors = append(unaryInterceptors, ValidationInterceptor())
+	streamInterceptors = append(streamInterceptors, ValidationStreamInterceptor())
+
+	serverOptions := []grpc.ServerOption{
+		grpc.UnaryInterceptor(chainUnaryInterceptors(unaryInterceptors...)),
+		grpc.StreamInterceptor(chainStreamInterceptors(streamInterceptors...)),
+	}
+
+	creds, err := ServerCredentials(config.Grpc, logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to setup gRPC credentials: %w", err)
+	}
+
+	if creds != nil {
+		serverOptions = append(serverOptions, grpc.Creds(creds))
+	}
 
-	grpcServer := grpc.NewServer(
-		grpc.UnaryInterceptor(interceptor),
-	)
+	grpcServer := grpc.NewServer(serverOptions...)
 
 	pb.RegisterInventoryServiceServer(grpcServer, grpcService)
+	healthpb.RegisterHealthServer(grpcServer, health.NewServer())
 
 	listener, err := net.Listen("tcp", fmt.Sprintf("%s:%d", config.Grpc.Host, config.Grpc.Port))
 	if err != nil {
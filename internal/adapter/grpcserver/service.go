@@ -10,6 +10,9 @@ import (
 	"inventory-service/pkg/logger"
 	"inventory-service/proto/pb"
 
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -19,12 +22,20 @@ type grpcService struct {
 	pb.UnimplementedInventoryServiceServer
 	productService     service.ProductService
 	reservationService service.ReservationService
+	categoryService    service.CategoryService
+	lotService         service.LotService
+	serialService      service.SerialService
+	priceChangeService service.PriceChangeService
+	stockWatchService  service.StockWatchService
+	webhookService     service.WebhookService
+	auditService       service.AuditService
 }
 
 func NewGRPCService(
 	config *config.Config,
 	repo repository.Repository,
 	logger logger.Logger,
+	stockWatchService service.StockWatchService,
 ) (*grpcService, error) {
 	props := service.Properties{
 		Config: config,
@@ -35,19 +46,18 @@ func NewGRPCService(
 	return &grpcService{
 		productService:     service.NewProductService(props),
 		reservationService: service.NewReservationService(props),
+		categoryService:    service.NewCategoryService(props),
+		lotService:         service.NewLotService(props),
+		serialService:      service.NewSerialService(props),
+		priceChangeService: service.NewPriceChangeService(props),
+		stockWatchService:  stockWatchService,
+		webhookService:     service.NewWebhookService(props),
+		auditService:       service.NewAuditService(props),
 	}, nil
 }
 
 func (s *grpcService) ListProducts(ctx context.Context, req *pb.ListProductsRequest) (*pb.ListProductsResponse, error) {
-	filter := &postgresrepository.FilterProductPayload{
-		IDs:     req.Ids,
-		Names:   req.Names,
-		Search:  req.Search,
-		Page:    int(req.Page),
-		PerPage: int(req.PerPage),
-	}
-
-	products, total, err := s.productService.Find(ctx, filter)
+	products, total, err := s.productService.Find(ctx, mapProductFilter(req))
 	if err != nil {
 		return nil, err
 	}
@@ -58,72 +68,212 @@ func (s *grpcService) ListProducts(ctx context.Context, req *pb.ListProductsRequ
 	}
 
 	for i, product := range products {
-		response.Products[i] = &pb.Product{
-			Id:    product.Base.ID,
-			Name:  product.Name,
-			Stock: int32(product.Stock),
-			Price: product.Price,
-		}
+		response.Products[i] = MapProductToPB(product)
 	}
 
 	return response, nil
 }
 
+func (s *grpcService) ExportProducts(req *pb.ListProductsRequest, stream grpc.ServerStreamingServer[pb.Product]) error {
+	err := s.productService.Export(stream.Context(), mapProductFilter(req), func(product *entity.Product) error {
+		return stream.Send(MapProductToPB(product))
+	})
+
+	return streamError(stream.Context(), err)
+}
+
+func mapProductFilter(req *pb.ListProductsRequest) *postgresrepository.FilterProductPayload {
+	filter := &postgresrepository.FilterProductPayload{
+		IDs:                req.Ids,
+		SKUs:               req.Skus,
+		Names:              req.Names,
+		CategoryID:         req.CategoryId,
+		IncludeDescendants: req.IncludeDescendants,
+		ExcludeVariants:    req.ExcludeVariants,
+		Attributes:         MapPBToAttributeFilters(req.Attributes),
+		Search:             req.Search,
+		Page:               int(req.Page),
+		PerPage:            int(req.PerPage),
+	}
+
+	if req.ParentId > 0 {
+		filter.ParentIDs = []uint32{req.ParentId}
+	}
+
+	for _, status := range req.Statuses {
+		filter.Statuses = append(filter.Statuses, MapPBProductStatusToDB(status))
+	}
+
+	return filter
+}
+
 func (s *grpcService) GetProduct(ctx context.Context, req *pb.GetProductRequest) (*pb.Product, error) {
 	product, err := s.productService.FindByID(ctx, req.Id)
 	if err != nil {
 		return nil, err
 	}
 
-	return &pb.Product{
-		Id:    product.Base.ID,
-		Name:  product.Name,
-		Stock: int32(product.Stock),
-		Price: product.Price,
-	}, nil
+	if req.PriceAt != nil {
+		product.Price, err = s.priceChangeService.PriceAt(ctx, product.ID, req.PriceAt.AsTime())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return MapProductToPB(product), nil
 }
 
-func (s *grpcService) CreateProduct(ctx context.Context, req *pb.CreateProductRequest) (*pb.Product, error) {
-	productEntity := &entity.Product{
-		Name:  req.Name,
-		Stock: int(req.Stock),
-		Price: req.Price,
+func (s *grpcService) GetProductBySKU(ctx context.Context, req *pb.GetProductBySKURequest) (*pb.Product, error) {
+	product, err := s.productService.FindBySKU(ctx, req.Sku)
+	if err != nil {
+		return nil, err
 	}
 
-	createdProduct, err := s.productService.Create(ctx, productEntity)
+	return MapProductToPB(product), nil
+}
+
+func (s *grpcService) GetProductByBarcode(ctx context.Context, req *pb.GetProductByBarcodeRequest) (*pb.Product, error) {
+	product, err := s.productService.FindByBarcode(ctx, req.Barcode)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &pb.Product{
-		Id:    createdProduct.Base.ID,
-		Name:  createdProduct.Name,
-		Stock: int32(createdProduct.Stock),
-		Price: createdProduct.Price,
+	return MapProductToPB(product), nil
+}
+
+func (s *grpcService) CreateProduct(ctx context.Context, req *pb.CreateProductRequest) (*pb.Product, error) {
+	createdProduct, err := s.productService.Create(ctx, mapCreateProductRequest(req))
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
+	return MapProductToPB(createdProduct), nil
 }
 
 func (s *grpcService) UpdateProduct(ctx context.Context, req *pb.UpdateProductRequest) (*pb.Product, error) {
-	product := &entity.Product{
-		Base:  entity.Base{ID: req.Id},
-		Name:  req.Name,
-		Stock: int(req.Stock),
-		Price: req.Price,
+	updatedProduct, err := s.productService.UpdateFields(ctx, mapUpdateProductRequest(req), productUpdateFields(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return MapProductToPB(updatedProduct), nil
+}
+
+func (s *grpcService) BatchCreateProducts(ctx context.Context, req *pb.BatchCreateProductsRequest) (*pb.BatchProductsResponse, error) {
+	products := make([]*entity.Product, len(req.Products))
+	for i, product := range req.Products {
+		products[i] = mapCreateProductRequest(product)
 	}
 
-	updatedProduct, err := s.productService.Update(ctx, product)
+	results, err := s.productService.BatchCreate(ctx, products, req.Atomic)
 	if err != nil {
 		return nil, err
 	}
 
-	return &pb.Product{
-		Id:    updatedProduct.Base.ID,
-		Name:  updatedProduct.Name,
-		Stock: int32(updatedProduct.Stock),
-		Price: updatedProduct.Price,
-	}, nil
+	return MapBatchResultsToPB(results), nil
+}
+
+func (s *grpcService) BatchUpdateProducts(ctx context.Context, req *pb.BatchUpdateProductsRequest) (*pb.BatchProductsResponse, error) {
+	products := make([]*entity.Product, len(req.Products))
+	for i, product := range req.Products {
+		products[i] = mapUpdateProductRequest(product)
+	}
+
+	results, err := s.productService.BatchUpdate(ctx, products, req.Atomic)
+	if err != nil {
+		return nil, err
+	}
+
+	return MapBatchResultsToPB(results), nil
+}
+
+func mapCreateProductRequest(req *pb.CreateProductRequest) *entity.Product {
+	if req == nil {
+		return nil
+	}
+
+	return &entity.Product{
+		SKU:          req.Sku,
+		Barcode:      req.Barcode,
+		CategoryID:   req.CategoryId,
+		Name:         req.Name,
+		Stock:        int(req.Stock),
+		Price:        MapPBToMoney(req.Price),
+		InheritPrice: req.Price == nil,
+		ParentID:     req.ParentId,
+		Options:      req.Options,
+		Components:   MapPBToKitComponents(req.Components),
+		TrackLots:    req.TrackLots,
+		TrackSerials: req.TrackSerials,
+		Units:        MapPBToProductUnits(req.Units),
+		Attributes:   MapPBToAttributes(req.Attributes),
+		Status:       MapPBProductStatusToDB(req.Status),
+	}
+}
+
+func mapUpdateProductRequest(req *pb.UpdateProductRequest) *entity.Product {
+	if req == nil {
+		return nil
+	}
+
+	return &entity.Product{
+		Base:         entity.Base{ID: req.Id},
+		SKU:          req.Sku,
+		Barcode:      req.Barcode,
+		CategoryID:   req.CategoryId,
+		Name:         req.Name,
+		Stock:        int(req.Stock),
+		Price:        MapPBToMoney(req.Price),
+		InheritPrice: req.Price == nil,
+		ParentID:     req.ParentId,
+		Options:      req.Options,
+		Components:   MapPBToKitComponents(req.Components),
+		TrackLots:    req.TrackLots,
+		TrackSerials: req.TrackSerials,
+		Units:        MapPBToProductUnits(req.Units),
+		Attributes:   MapPBToAttributes(req.Attributes),
+	}
+}
+
+// productUpdateFields names the fields an UpdateProduct call changes: those in
+// its update mask, or without one those set to a non-default value.
+func productUpdateFields(req *pb.UpdateProductRequest) []string {
+	if paths := req.GetUpdateMask().GetPaths(); len(paths) > 0 {
+		return paths
+	}
+
+	var fields []string
+
+	req.ProtoReflect().Range(func(field protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		if name := string(field.Name()); name != "id" && name != "update_mask" {
+			fields = append(fields, name)
+		}
+		return true
+	})
+
+	return fields
+}
+
+func (s *grpcService) AdjustStock(ctx context.Context, req *pb.AdjustStockRequest) (*pb.StockAdjustment, error) {
+	adjustment, err := s.productService.AdjustStock(ctx, &entity.StockAdjustment{
+		ProductID: req.ProductId,
+		Quantity:  int(req.Quantity),
+		Unit:      req.Unit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return MapStockAdjustmentToPB(adjustment), nil
+}
+
+func (s *grpcService) UpdateProductStatus(ctx context.Context, req *pb.UpdateProductStatusRequest) (*pb.Product, error) {
+	product, err := s.productService.UpdateStatus(ctx, req.Id, MapPBProductStatusToDB(req.Status))
+	if err != nil {
+		return nil, err
+	}
+
+	return MapProductToPB(product), nil
 }
 
 func (s *grpcService) DeleteProduct(ctx context.Context, req *pb.DeleteProductRequest) (*emptypb.Empty, error) {
@@ -133,41 +283,265 @@ func (s *grpcService) DeleteProduct(ctx context.Context, req *pb.DeleteProductRe
 	return &emptypb.Empty{}, nil
 }
 
-func (s *grpcService) CreateReservation(ctx context.Context, req *pb.CreateReservationRequest) (*pb.Reservation, error) {
-	reservation := &entity.Reservation{
+func (s *grpcService) ListCategories(ctx context.Context, req *pb.ListCategoriesRequest) (*pb.ListCategoriesResponse, error) {
+	filter := &postgresrepository.FilterCategoryPayload{
+		RootsOnly: req.RootsOnly,
+		Search:    req.Search,
+		Page:      int(req.Page),
+		PerPage:   int(req.PerPage),
+	}
+
+	if req.ParentId > 0 {
+		filter.ParentIDs = []uint32{req.ParentId}
+	}
+
+	categories, total, err := s.categoryService.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.ListCategoriesResponse{
+		Total:      int32(total),
+		Categories: make([]*pb.Category, len(categories)),
+	}
+
+	for i, category := range categories {
+		response.Categories[i] = MapCategoryToPB(category)
+	}
+
+	return response, nil
+}
+
+func (s *grpcService) GetCategory(ctx context.Context, req *pb.GetCategoryRequest) (*pb.Category, error) {
+	category, err := s.categoryService.FindByID(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return MapCategoryToPB(category), nil
+}
+
+func (s *grpcService) CreateCategory(ctx context.Context, req *pb.CreateCategoryRequest) (*pb.Category, error) {
+	category := &entity.Category{
+		ParentID:    req.ParentId,
+		Name:        req.Name,
+		Description: req.Description,
+	}
+
+	createdCategory, err := s.categoryService.Create(ctx, category)
+	if err != nil {
+		return nil, err
+	}
+
+	return MapCategoryToPB(createdCategory), nil
+}
+
+func (s *grpcService) UpdateCategory(ctx context.Context, req *pb.UpdateCategoryRequest) (*pb.Category, error) {
+	category := &entity.Category{
+		Base:        entity.Base{ID: req.Id},
+		ParentID:    req.ParentId,
+		Name:        req.Name,
+		Description: req.Description,
+	}
+
+	updatedCategory, err := s.categoryService.Update(ctx, category)
+	if err != nil {
+		return nil, err
+	}
+
+	return MapCategoryToPB(updatedCategory), nil
+}
+
+func (s *grpcService) DeleteCategory(ctx context.Context, req *pb.DeleteCategoryRequest) (*emptypb.Empty, error) {
+	if err := s.categoryService.Delete(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *grpcService) CreateLot(ctx context.Context, req *pb.CreateLotRequest) (*pb.Lot, error) {
+	lot := &entity.Lot{
 		ProductID: req.ProductId,
-		OrderID:   req.OrderId,
+		LotNumber: req.LotNumber,
 		Quantity:  int(req.Quantity),
 	}
 
-	createdReservation, err := s.reservationService.Create(ctx, reservation)
+	if req.ExpiresAt != nil {
+		expiresAt := req.ExpiresAt.AsTime()
+		lot.ExpiresAt = &expiresAt
+	}
+
+	createdLot, err := s.lotService.Create(ctx, lot)
 	if err != nil {
 		return nil, err
 	}
 
-	return &pb.Reservation{
-		Id:        createdReservation.Base.ID,
-		ProductId: createdReservation.ProductID,
-		OrderId:   createdReservation.OrderID,
-		Quantity:  int32(createdReservation.Quantity),
-		Status:    MapDBStatusToPBStatus(createdReservation.Status),
-	}, nil
+	return MapLotToPB(createdLot), nil
 }
 
-func (s *grpcService) ListReservations(ctx context.Context, req *pb.ListReservationsRequest) (*pb.ListReservationsResponse, error) {
-	filter := &postgresrepository.FilterReservationPayload{
+func (s *grpcService) ListLots(ctx context.Context, req *pb.ListLotsRequest) (*pb.ListLotsResponse, error) {
+	filter := &postgresrepository.FilterLotPayload{
+		ProductIDs:     req.ProductIds,
+		ExcludeExpired: req.ExcludeExpired,
+		Page:           int(req.Page),
+		PerPage:        int(req.PerPage),
+	}
+
+	lots, total, err := s.lotService.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapLotsResponse(lots, total), nil
+}
+
+func (s *grpcService) ListExpiringLots(ctx context.Context, req *pb.ListExpiringLotsRequest) (*pb.ListLotsResponse, error) {
+	filter := &postgresrepository.FilterLotPayload{
 		ProductIDs: req.ProductIds,
-		OrderIDs:   req.OrderIds,
 		Page:       int(req.Page),
 		PerPage:    int(req.PerPage),
 	}
 
-	filter.Statuses = make([]string, len(req.Statuses))
-	for i, status := range req.Statuses {
-		filter.Statuses[i] = MapPBStatusToDBStatus(status)
+	lots, total, err := s.lotService.FindExpiring(ctx, int(req.WithinDays), filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapLotsResponse(lots, total), nil
+}
+
+func mapLotsResponse(lots []*entity.Lot, total int) *pb.ListLotsResponse {
+	response := &pb.ListLotsResponse{
+		Total: int32(total),
+		Lots:  make([]*pb.Lot, len(lots)),
+	}
+
+	for i, lot := range lots {
+		response.Lots[i] = MapLotToPB(lot)
+	}
+
+	return response
+}
+
+func (s *grpcService) RegisterSerials(ctx context.Context, req *pb.RegisterSerialsRequest) (*pb.ListSerialsResponse, error) {
+	serials, err := s.serialService.Register(ctx, req.ProductId, req.SerialNumbers)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapSerialsResponse(serials, len(serials)), nil
+}
+
+func (s *grpcService) ListSerials(ctx context.Context, req *pb.ListSerialsRequest) (*pb.ListSerialsResponse, error) {
+	filter := &postgresrepository.FilterSerialPayload{
+		ProductIDs: req.ProductIds,
+		Page:       int(req.Page),
+		PerPage:    int(req.PerPage),
+	}
+
+	for _, status := range req.Statuses {
+		filter.Statuses = append(filter.Statuses, MapPBSerialStatusToDB(status))
+	}
+
+	serials, total, err := s.serialService.Find(ctx, filter)
+	if err != nil {
+		return nil, err
 	}
 
-	reservations, total, err := s.reservationService.Find(ctx, filter)
+	return mapSerialsResponse(serials, total), nil
+}
+
+func (s *grpcService) GetSerial(ctx context.Context, req *pb.GetSerialRequest) (*pb.Serial, error) {
+	serial, err := s.serialService.FindBySerialNumber(ctx, req.SerialNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	return MapSerialToPB(serial), nil
+}
+
+func mapSerialsResponse(serials []*entity.Serial, total int) *pb.ListSerialsResponse {
+	response := &pb.ListSerialsResponse{
+		Total:   int32(total),
+		Serials: make([]*pb.Serial, len(serials)),
+	}
+
+	for i, serial := range serials {
+		response.Serials[i] = MapSerialToPB(serial)
+	}
+
+	return response
+}
+
+func (s *grpcService) SchedulePriceChange(ctx context.Context, req *pb.SchedulePriceChangeRequest) (*pb.PriceChange, error) {
+	change := &entity.PriceChange{
+		ProductID:     req.ProductId,
+		Price:         MapPBToMoney(req.Price),
+		EffectiveFrom: req.EffectiveFrom.AsTime(),
+	}
+
+	scheduledChange, err := s.priceChangeService.Schedule(ctx, change)
+	if err != nil {
+		return nil, err
+	}
+
+	return MapPriceChangeToPB(scheduledChange), nil
+}
+
+func (s *grpcService) CancelPriceChange(ctx context.Context, req *pb.CancelPriceChangeRequest) (*emptypb.Empty, error) {
+	if err := s.priceChangeService.Cancel(ctx, req.ProductId, req.Id); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *grpcService) ListPriceHistory(ctx context.Context, req *pb.ListPriceHistoryRequest) (*pb.ListPriceHistoryResponse, error) {
+	filter := &postgresrepository.FilterPriceChangePayload{
+		PendingOnly: req.PendingOnly,
+		Page:        int(req.Page),
+		PerPage:     int(req.PerPage),
+	}
+
+	if req.ProductId > 0 {
+		filter.ProductIDs = []uint32{req.ProductId}
+	}
+
+	changes, total, err := s.priceChangeService.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.ListPriceHistoryResponse{
+		Total:        int32(total),
+		PriceChanges: make([]*pb.PriceChange, len(changes)),
+	}
+
+	for i, change := range changes {
+		response.PriceChanges[i] = MapPriceChangeToPB(change)
+	}
+
+	return response, nil
+}
+
+func (s *grpcService) CreateReservation(ctx context.Context, req *pb.CreateReservationRequest) (*pb.Reservation, error) {
+	reservation := &entity.Reservation{
+		ProductID:    req.ProductId,
+		OrderID:      req.OrderId,
+		Unit:         req.Unit,
+		UnitQuantity: int(req.Quantity),
+	}
+
+	createdReservation, err := s.reservationService.Create(ctx, reservation)
+	if err != nil {
+		return nil, err
+	}
+
+	return MapReservationToPB(createdReservation), nil
+}
+
+func (s *grpcService) ListReservations(ctx context.Context, req *pb.ListReservationsRequest) (*pb.ListReservationsResponse, error) {
+	reservations, total, err := s.reservationService.Find(ctx, mapReservationFilter(req))
 	if err != nil {
 		return nil, err
 	}
@@ -178,31 +552,61 @@ func (s *grpcService) ListReservations(ctx context.Context, req *pb.ListReservat
 	}
 
 	for i, reservation := range reservations {
-		response.Reservations[i] = &pb.Reservation{
-			Id:        reservation.Base.ID,
-			ProductId: reservation.ProductID,
-			OrderId:   reservation.OrderID,
-			Quantity:  int32(reservation.Quantity),
-			Status:    MapDBStatusToPBStatus(reservation.Status),
-		}
+		response.Reservations[i] = MapReservationToPB(reservation)
 	}
 
 	return response, nil
 }
 
+func (s *grpcService) WatchStock(req *pb.WatchStockRequest, stream grpc.ServerStreamingServer[pb.StockEvent]) error {
+	err := s.stockWatchService.Watch(stream.Context(), req.ProductIds, req.AfterSequence, func(event *entity.StockEvent) error {
+		return stream.Send(MapStockEventToPB(event))
+	})
+
+	return streamError(stream.Context(), err)
+}
+
+func (s *grpcService) ExportReservations(req *pb.ListReservationsRequest, stream grpc.ServerStreamingServer[pb.Reservation]) error {
+	err := s.reservationService.Export(stream.Context(), mapReservationFilter(req), func(reservation *entity.Reservation) error {
+		return stream.Send(MapReservationToPB(reservation))
+	})
+
+	return streamError(stream.Context(), err)
+}
+
+func mapReservationFilter(req *pb.ListReservationsRequest) *postgresrepository.FilterReservationPayload {
+	filter := &postgresrepository.FilterReservationPayload{
+		ProductIDs: req.ProductIds,
+		OrderIDs:   req.OrderIds,
+		Page:       int(req.Page),
+		PerPage:    int(req.PerPage),
+	}
+
+	filter.Statuses = make([]string, len(req.Statuses))
+	for i, status := range req.Statuses {
+		filter.Statuses[i] = MapPBStatusToDBStatus(status)
+	}
+
+	return filter
+}
+
+// streamError reports a failed stream by the client's cancellation or deadline
+// when that is what stopped it, rather than by the database error it caused.
+func streamError(ctx context.Context, err error) error {
+	if err != nil && ctx.Err() != nil {
+		return status.FromContextError(ctx.Err()).Err()
+	}
+
+	return err
+}
+
 func (s *grpcService) GetReservation(ctx context.Context, req *pb.GetReservationRequest) (*pb.Reservation, error) {
 	reservation, err := s.reservationService.FindByID(ctx, req.Id)
 	if err != nil {
 		return nil, err
 	}
 
-	return &pb.Reservation{
-		Id:        reservation.Base.ID,
-		ProductId: reservation.ProductID,
-		OrderId:   reservation.OrderID,
-		Quantity:  int32(reservation.Quantity),
-		Status:    MapDBStatusToPBStatus(reservation.Status),
-	}, nil
+	return MapReservationToPB(reservation), nil
 }
 
 func (s *grpcService) UpdateReservationStatus(ctx context.Context, req *pb.UpdateReservationStatusRequest) (*emptypb.Empty, error) {
@@ -213,4 +617,148 @@ func (s *grpcService) UpdateReservationStatus(ctx context.Context, req *pb.Updat
 	return &emptypb.Empty{}, nil
 }
 
+func (s *grpcService) ListWebhooks(ctx context.Context, req *pb.ListWebhooksRequest) (*pb.ListWebhooksResponse, error) {
+	filter := &postgresrepository.FilterWebhookPayload{
+		ActiveOnly: req.ActiveOnly,
+		Page:       int(req.Page),
+		PerPage:    int(req.PerPage),
+	}
+
+	webhooks, total, err := s.webhookService.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.ListWebhooksResponse{
+		Total:    int32(total),
+		Webhooks: make([]*pb.Webhook, len(webhooks)),
+	}
+
+	for i, webhook := range webhooks {
+		response.Webhooks[i] = MapWebhookToPB(webhook)
+	}
+
+	return response, nil
+}
+
+func (s *grpcService) GetWebhook(ctx context.Context, req *pb.GetWebhookRequest) (*pb.Webhook, error) {
+	webhook, err := s.webhookService.FindByID(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return MapWebhookToPB(webhook), nil
+}
+
+func (s *grpcService) CreateWebhook(ctx context.Context, req *pb.CreateWebhookRequest) (*pb.Webhook, error) {
+	webhook := &entity.Webhook{
+		URL:        req.Url,
+		EventTypes: req.EventTypes,
+		Secret:     req.Secret,
+	}
+
+	createdWebhook, err := s.webhookService.Create(ctx, webhook)
+	if err != nil {
+		return nil, err
+	}
+
+	response := MapWebhookToPB(createdWebhook)
+	response.Secret = createdWebhook.Secret
+
+	return response, nil
+}
+
+func (s *grpcService) UpdateWebhook(ctx context.Context, req *pb.UpdateWebhookRequest) (*pb.Webhook, error) {
+	webhook := &entity.Webhook{
+		Base:       entity.Base{ID: req.Id},
+		URL:        req.Url,
+		EventTypes: req.EventTypes,
+		Secret:     req.Secret,
+		Active:     req.Active,
+	}
+
+	updatedWebhook, err := s.webhookService.Update(ctx, webhook)
+	if err != nil {
+		return nil, err
+	}
+
+	return MapWebhookToPB(updatedWebhook), nil
+}
+
+func (s *grpcService) DeleteWebhook(ctx context.Context, req *pb.DeleteWebhookRequest) (*emptypb.Empty, error) {
+	if err := s.webhookService.Delete(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *grpcService) ListWebhookDeliveries(ctx context.Context, req *pb.ListWebhookDeliveriesRequest) (*pb.ListWebhookDeliveriesResponse, error) {
+	filter := &postgresrepository.FilterWebhookDeliveryPayload{
+		Page:    int(req.Page),
+		PerPage: int(req.PerPage),
+	}
+
+	if req.WebhookId > 0 {
+		filter.WebhookIDs = []uint32{req.WebhookId}
+	}
+
+	filter.Statuses = make([]string, len(req.Statuses))
+	for i, status := range req.Statuses {
+		filter.Statuses[i] = MapPBWebhookDeliveryStatusToDB(status)
+	}
+
+	deliveries, total, err := s.webhookService.FindDeliveries(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.ListWebhookDeliveriesResponse{
+		Total:      int32(total),
+		Deliveries: make([]*pb.WebhookDelivery, len(deliveries)),
+	}
+
+	for i, delivery := range deliveries {
+		response.Deliveries[i] = MapWebhookDeliveryToPB(delivery)
+	}
+
+	return response, nil
+}
+
+func (s *grpcService) ListAuditEvents(ctx context.Context, req *pb.ListAuditEventsRequest) (*pb.ListAuditEventsResponse, error) {
+	filter := &postgresrepository.FilterAuditEventPayload{
+		EntityType: req.EntityType,
+		EntityIDs:  req.EntityIds,
+		Actors:     req.Actors,
+		Page:       int(req.Page),
+		PerPage:    int(req.PerPage),
+	}
+
+	if req.From != nil {
+		from := req.From.AsTime()
+		filter.From = &from
+	}
+
+	if req.To != nil {
+		to := req.To.AsTime()
+		filter.To = &to
+	}
+
+	events, total, err := s.auditService.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.ListAuditEventsResponse{
+		Total:  int32(total),
+		Events: make([]*pb.AuditEvent, len(events)),
+	}
+
+	for i, event := range events {
+		response.Events[i] = MapAuditEventToPB(event)
+	}
+
+	return response, nil
+}
+
 func (s *grpcService) mustEmbedUnimplementedInventoryServiceServer() {}
@@ -30,3 +30,81 @@ func MapPBStatusToDBStatus(pbStatus pb.ReservationStatus) string {
 		return constant.ReservationStatusUnspecified
 	}
 }
+
+func MapDBProductStatusToPB(dbStatus string) pb.ProductStatus {
+	switch dbStatus {
+	case constant.ProductStatusDraft:
+		return pb.ProductStatus_PRODUCT_STATUS_DRAFT
+	case constant.ProductStatusActive:
+		return pb.ProductStatus_PRODUCT_STATUS_ACTIVE
+	case constant.ProductStatusDiscontinued:
+		return pb.ProductStatus_PRODUCT_STATUS_DISCONTINUED
+	default:
+		return pb.ProductStatus_PRODUCT_STATUS_UNSPECIFIED
+	}
+}
+
+func MapPBProductStatusToDB(pbStatus pb.ProductStatus) string {
+	switch pbStatus {
+	case pb.ProductStatus_PRODUCT_STATUS_DRAFT:
+		return constant.ProductStatusDraft
+	case pb.ProductStatus_PRODUCT_STATUS_ACTIVE:
+		return constant.ProductStatusActive
+	case pb.ProductStatus_PRODUCT_STATUS_DISCONTINUED:
+		return constant.ProductStatusDiscontinued
+	default:
+		return ""
+	}
+}
+
+func MapDBSerialStatusToPB(dbStatus string) pb.SerialStatus {
+	switch dbStatus {
+	case constant.SerialStatusAvailable:
+		return pb.SerialStatus_SERIAL_STATUS_AVAILABLE
+	case constant.SerialStatusReserved:
+		return pb.SerialStatus_SERIAL_STATUS_RESERVED
+	case constant.SerialStatusAssigned:
+		return pb.SerialStatus_SERIAL_STATUS_ASSIGNED
+	default:
+		return pb.SerialStatus_SERIAL_STATUS_UNSPECIFIED
+	}
+}
+
+func MapPBSerialStatusToDB(pbStatus pb.SerialStatus) string {
+	switch pbStatus {
+	case pb.SerialStatus_SERIAL_STATUS_AVAILABLE:
+		return constant.SerialStatusAvailable
+	case pb.SerialStatus_SERIAL_STATUS_RESERVED:
+		return constant.SerialStatusReserved
+	case pb.SerialStatus_SERIAL_STATUS_ASSIGNED:
+		return constant.SerialStatusAssigned
+	default:
+		return ""
+	}
+}
+
+func MapDBWebhookDeliveryStatusToPB(dbStatus string) pb.WebhookDeliveryStatus {
+	switch dbStatus {
+	case constant.WebhookDeliveryStatusPending:
+		return pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING
+	case constant.WebhookDeliveryStatusSucceeded:
+		return pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_SUCCEEDED
+	case constant.WebhookDeliveryStatusDead:
+		return pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DEAD
+	default:
+		return pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED
+	}
+}
+
+func MapPBWebhookDeliveryStatusToDB(pbStatus pb.WebhookDeliveryStatus) string {
+	switch pbStatus {
+	case pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING:
+		return constant.WebhookDeliveryStatusPending
+	case pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_SUCCEEDED:
+		return constant.WebhookDeliveryStatusSucceeded
+	case pb.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DEAD:
+		return constant.WebhookDeliveryStatusDead
+	default:
+		return ""
+	}
+}
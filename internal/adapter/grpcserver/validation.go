@@ -0,0 +1,327 @@
+package grpcserver
+
+import (
+	"context"
+	"fmt"
+	"inventory-service/constant"
+	"inventory-service/internal/shared/validation"
+	"inventory-service/proto/pb"
+
+	"google.golang.org/grpc"
+)
+
+// ValidationInterceptor rejects requests that break the rules REST requests
+// are held to, before they reach the service.
+func ValidationInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		_ *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		if err := validateRequest(req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// ValidationStreamInterceptor validates the request of a server streaming
+// call as the handler receives it.
+func ValidationStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		stream grpc.ServerStream,
+		_ *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		return handler(srv, &validatingStream{ServerStream: stream})
+	}
+}
+
+type validatingStream struct {
+	grpc.ServerStream
+}
+
+func (s *validatingStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	return validateRequest(m)
+}
+
+// validateRequest checks a request message, returning a validation exception
+// with an error for every field that breaks a rule. Field paths use the
+// proto field names.
+func validateRequest(req any) error {
+	v := validation.New()
+
+	switch req := req.(type) {
+	case *pb.ListProductsRequest:
+		v.Pagination(int(req.Page), int(req.PerPage))
+		for i, status := range req.Statuses {
+			v.Check(definedEnum(pb.ProductStatus_name, int32(status)), validation.Field("statuses", i), "Unknown product status")
+		}
+		for i, attribute := range req.Attributes {
+			v.Required(validation.Field("attributes", i, "key"), attribute.Key)
+		}
+	case *pb.GetProductRequest:
+		v.RequiredID("id", req.Id)
+	case *pb.GetProductBySKURequest:
+		v.Required("sku", req.Sku)
+	case *pb.GetProductByBarcodeRequest:
+		v.Required("barcode", req.Barcode)
+	case *pb.CreateProductRequest:
+		validateCreateProduct(v, "", req)
+	case *pb.UpdateProductRequest:
+		validateProductUpdate(v, req)
+	case *pb.BatchCreateProductsRequest:
+		v.Check(len(req.Products) > 0, "products", "This field is required")
+		for i, product := range req.Products {
+			validateCreateProduct(v, validation.Field("products", i)+".", product)
+		}
+	case *pb.BatchUpdateProductsRequest:
+		v.Check(len(req.Products) > 0, "products", "This field is required")
+		for i, product := range req.Products {
+			validateUpdateProduct(v, validation.Field("products", i)+".", product)
+		}
+	case *pb.AdjustStockRequest:
+		v.RequiredID("product_id", req.ProductId)
+		v.Check(req.Quantity != 0, "quantity", "This field is required")
+		v.Unit("unit", req.Unit)
+	case *pb.UpdateProductStatusRequest:
+		v.RequiredID("id", req.Id)
+		v.Check(req.Status != pb.ProductStatus_PRODUCT_STATUS_UNSPECIFIED && definedEnum(pb.ProductStatus_name, int32(req.Status)), "status", "This field is required")
+	case *pb.DeleteProductRequest:
+		v.RequiredID("id", req.Id)
+	case *pb.ListCategoriesRequest:
+		v.Pagination(int(req.Page), int(req.PerPage))
+	case *pb.GetCategoryRequest:
+		v.RequiredID("id", req.Id)
+	case *pb.CreateCategoryRequest:
+		v.Name("name", req.Name)
+	case *pb.UpdateCategoryRequest:
+		v.RequiredID("id", req.Id)
+		v.Name("name", req.Name)
+	case *pb.DeleteCategoryRequest:
+		v.RequiredID("id", req.Id)
+	case *pb.CreateLotRequest:
+		v.RequiredID("product_id", req.ProductId)
+		v.LotNumber("lot_number", req.LotNumber)
+		v.Min("quantity", int(req.Quantity), 1)
+	case *pb.ListLotsRequest:
+		v.Pagination(int(req.Page), int(req.PerPage))
+	case *pb.ListExpiringLotsRequest:
+		v.Pagination(int(req.Page), int(req.PerPage))
+	case *pb.RegisterSerialsRequest:
+		v.RequiredID("product_id", req.ProductId)
+		v.SerialNumbers("serial_numbers", req.SerialNumbers)
+	case *pb.ListSerialsRequest:
+		v.Pagination(int(req.Page), int(req.PerPage))
+		for i, status := range req.Statuses {
+			v.Check(definedEnum(pb.SerialStatus_name, int32(status)), validation.Field("statuses", i), "Unknown serial status")
+		}
+	case *pb.GetSerialRequest:
+		v.Required("serial_number", req.SerialNumber)
+	case *pb.SchedulePriceChangeRequest:
+		v.RequiredID("product_id", req.ProductId)
+		v.Check(req.Price != nil, "price", "This field is required")
+		validatePrice(v, "price", req.Price)
+		v.Check(req.EffectiveFrom != nil, "effective_from", "This field is required")
+	case *pb.CancelPriceChangeRequest:
+		v.RequiredID("product_id", req.ProductId)
+		v.RequiredID("id", req.Id)
+	case *pb.ListPriceHistoryRequest:
+		v.Pagination(int(req.Page), int(req.PerPage))
+	case *pb.CreateReservationRequest:
+		v.RequiredID("product_id", req.ProductId)
+		v.Min("quantity", int(req.Quantity), 1)
+		v.Unit("unit", req.Unit)
+	case *pb.ListReservationsRequest:
+		v.Pagination(int(req.Page), int(req.PerPage))
+		for i, status := range req.Statuses {
+			v.Check(definedEnum(pb.ReservationStatus_name, int32(status)), validation.Field("statuses", i), "Unknown reservation status")
+		}
+	case *pb.GetReservationRequest:
+		v.RequiredID("id", req.Id)
+	case *pb.UpdateReservationStatusRequest:
+		v.Check(len(req.Ids) > 0, "ids", "This field is required")
+		v.Check(req.Status != pb.ReservationStatus_RESERVATION_STATUS_UNSPECIFIED && definedEnum(pb.ReservationStatus_name, int32(req.Status)), "status", "This field is required")
+	case *pb.ListWebhooksRequest:
+		v.Pagination(int(req.Page), int(req.PerPage))
+	case *pb.GetWebhookRequest:
+		v.RequiredID("id", req.Id)
+	case *pb.CreateWebhookRequest:
+		v.Webhook(req.Url, req.EventTypes, req.Secret)
+	case *pb.UpdateWebhookRequest:
+		v.RequiredID("id", req.Id)
+		v.Webhook(req.Url, req.EventTypes, req.Secret)
+	case *pb.DeleteWebhookRequest:
+		v.RequiredID("id", req.Id)
+	case *pb.ListWebhookDeliveriesRequest:
+		v.Pagination(int(req.Page), int(req.PerPage))
+		for i, status := range req.Statuses {
+			v.Check(definedEnum(pb.WebhookDeliveryStatus_name, int32(status)), validation.Field("statuses", i), "Unknown delivery status")
+		}
+	case *pb.ListAuditEventsRequest:
+		v.Pagination(int(req.Page), int(req.PerPage))
+	}
+
+	return v.Err("Invalid request")
+}
+
+// validateCreateProduct checks a product to create; prefix places its fields
+// within a batch.
+func validateCreateProduct(v *validation.Validator, prefix string, req *pb.CreateProductRequest) {
+	if req == nil {
+		v.Check(false, prefix+"name", "This field is required")
+		return
+	}
+
+	validateProductFields(v, prefix, productFields{
+		name:       req.Name,
+		stock:      req.Stock,
+		sku:        req.Sku,
+		barcode:    req.Barcode,
+		parentID:   req.ParentId,
+		options:    req.Options,
+		components: req.Components,
+		units:      req.Units,
+		price:      req.Price,
+	})
+
+	// Products are created as drafts or active; later changes go through
+	// UpdateProductStatus.
+	v.Check(req.Status == pb.ProductStatus_PRODUCT_STATUS_UNSPECIFIED ||
+		req.Status == pb.ProductStatus_PRODUCT_STATUS_DRAFT ||
+		req.Status == pb.ProductStatus_PRODUCT_STATUS_ACTIVE,
+		prefix+"status", "This field must be one of DRAFT, ACTIVE")
+}
+
+// validateProductUpdate checks the fields an UpdateProduct call changes; the
+// service checks the product they add up to.
+func validateProductUpdate(v *validation.Validator, req *pb.UpdateProductRequest) {
+	v.RequiredID("id", req.Id)
+
+	for _, field := range productUpdateFields(req) {
+		switch field {
+		case "name":
+			v.MaxLength("name", req.Name, constant.MaxNameLength)
+		case "stock":
+			v.Min("stock", int(req.Stock), 0)
+		case "sku":
+			v.SKU("sku", req.Sku)
+		case "barcode":
+			v.Barcode("barcode", req.Barcode)
+		case "price":
+			validatePrice(v, "price", req.Price)
+		case "components":
+			validateComponents(v, "components", req.Components)
+		case "units":
+			validateUnits(v, "units", req.Units)
+		case "category_id", "parent_id", "options", "track_lots", "track_serials", "attributes":
+		default:
+			v.Check(false, "update_mask", fmt.Sprintf("Unknown field %q", field))
+		}
+	}
+}
+
+// validateUpdateProduct checks a batch item, which replaces the whole product.
+func validateUpdateProduct(v *validation.Validator, prefix string, req *pb.UpdateProductRequest) {
+	if req == nil {
+		v.Check(false, prefix+"id", "This field is required")
+		return
+	}
+
+	v.RequiredID(prefix+"id", req.Id)
+	v.Check(req.UpdateMask == nil, prefix+"update_mask", "Update masks are not supported in batches")
+	validateProductFields(v, prefix, productFields{
+		name:       req.Name,
+		stock:      req.Stock,
+		sku:        req.Sku,
+		barcode:    req.Barcode,
+		parentID:   req.ParentId,
+		options:    req.Options,
+		components: req.Components,
+		units:      req.Units,
+		price:      req.Price,
+	})
+}
+
+// productFields are the fields create and update requests share.
+type productFields struct {
+	name       string
+	stock      int32
+	sku        string
+	barcode    string
+	parentID   uint32
+	options    map[string]string
+	components []*pb.KitComponent
+	units      []*pb.ProductUnit
+	price      *pb.Money
+}
+
+func validateProductFields(v *validation.Validator, prefix string, p productFields) {
+	v.Min(prefix+"stock", int(p.stock), 0)
+	v.SKU(prefix+"sku", p.sku)
+	v.Barcode(prefix+"barcode", p.barcode)
+
+	// Variants inherit the name and price of their parent and are told apart
+	// by their options.
+	if p.parentID > 0 {
+		v.MaxLength(prefix+"name", p.name, constant.MaxNameLength)
+		v.Check(len(p.options) > 0, prefix+"options", "This field is required for a variant")
+	} else {
+		v.Name(prefix+"name", p.name)
+		v.Check(p.price != nil, prefix+"price", "This field is required")
+	}
+	validatePrice(v, prefix+"price", p.price)
+
+	validateComponents(v, prefix+"components", p.components)
+	validateUnits(v, prefix+"units", p.units)
+}
+
+func validateComponents(v *validation.Validator, field string, components []*pb.KitComponent) {
+	for i, component := range components {
+		field := validation.Field(field, i)
+		if component == nil {
+			v.Check(false, field, "This field is required")
+			continue
+		}
+
+		v.RequiredID(field+".component_id", component.ComponentId)
+		v.Min(field+".quantity", int(component.Quantity), 1)
+	}
+}
+
+func validateUnits(v *validation.Validator, field string, units []*pb.ProductUnit) {
+	for i, unit := range units {
+		field := validation.Field(field, i)
+		if unit == nil {
+			v.Check(false, field, "This field is required")
+			continue
+		}
+
+		v.PackagingUnit(field+".unit", unit.Unit)
+		v.Min(field+".factor", int(unit.Factor), 1)
+	}
+}
+
+// validatePrice checks a given price is a non-negative amount in a valid
+// currency.
+func validatePrice(v *validation.Validator, field string, price *pb.Money) {
+	if price == nil {
+		return
+	}
+
+	v.Currency(field+".currency_code", price.CurrencyCode)
+	v.Check(price.Units >= 0 && price.Nanos >= 0 && price.Nanos < 1e9, field, "This field must be a non-negative amount")
+}
+
+// definedEnum reports whether value is one of the values of an enum, given
+// its generated name map.
+func definedEnum(names map[int32]string, value int32) bool {
+	_, ok := names[value]
+	return ok
+}
@@ -0,0 +1,278 @@
+package grpcserver_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"inventory-service/internal/adapter/grpcserver"
+	"inventory-service/proto/pb"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func validateUnary(req any) error {
+	info := &grpc.UnaryServerInfo{FullMethod: "/" + pb.InventoryService_ServiceDesc.ServiceName + "/Test"}
+	_, err := grpcserver.ValidationInterceptor()(context.Background(), req, info, func(context.Context, any) (any, error) {
+		return nil, nil
+	})
+
+	return err
+}
+
+// violatedFields lists the fields a status reports as invalid.
+func violatedFields(t *testing.T, st *status.Status) []string {
+	var fields []string
+
+	for _, detail := range st.Details() {
+		badRequest, ok := detail.(*errdetails.BadRequest)
+		if !assert.True(t, ok) {
+			continue
+		}
+
+		for _, violation := range badRequest.FieldViolations {
+			fields = append(fields, violation.Field)
+		}
+	}
+
+	return fields
+}
+
+func validProduct() *pb.CreateProductRequest {
+	return &pb.CreateProductRequest{
+		Name:  "Product",
+		Sku:   "SKU-1",
+		Stock: 10,
+		Price: &pb.Money{CurrencyCode: "USD", Units: 19, Nanos: 990000000},
+	}
+}
+
+func TestValidationInterceptorRejectsInvalidRequests(t *testing.T) {
+	tests := []struct {
+		name   string
+		req    any
+		fields []string
+	}{
+		{
+			"product without name and with negative stock",
+			&pb.CreateProductRequest{Sku: "SKU-1", Stock: -1, Price: &pb.Money{Units: 1}},
+			[]string{"name", "stock"},
+		},
+		{
+			"product with long sku and letters in barcode",
+			func() *pb.CreateProductRequest {
+				req := validProduct()
+				req.Sku = strings.Repeat("S", 65)
+				req.Barcode = "12AB"
+				return req
+			}(),
+			[]string{"barcode", "sku"},
+		},
+		{
+			"product without price",
+			func() *pb.CreateProductRequest {
+				req := validProduct()
+				req.Price = nil
+				return req
+			}(),
+			[]string{"price"},
+		},
+		{
+			"variant without options",
+			func() *pb.CreateProductRequest {
+				req := validProduct()
+				req.ParentId = 1
+				return req
+			}(),
+			[]string{"options"},
+		},
+		{
+			"product with negative price",
+			func() *pb.CreateProductRequest {
+				req := validProduct()
+				req.Price = &pb.Money{CurrencyCode: "usd", Units: -1}
+				return req
+			}(),
+			[]string{"price", "price.currency_code"},
+		},
+		{
+			"product created discontinued",
+			func() *pb.CreateProductRequest {
+				req := validProduct()
+				req.Status = pb.ProductStatus_PRODUCT_STATUS_DISCONTINUED
+				return req
+			}(),
+			[]string{"status"},
+		},
+		{
+			"batch item with zero component quantity",
+			&pb.BatchCreateProductsRequest{Products: []*pb.CreateProductRequest{
+				validProduct(),
+				func() *pb.CreateProductRequest {
+					req := validProduct()
+					req.Components = []*pb.KitComponent{{ComponentId: 2}}
+					req.Units = []*pb.ProductUnit{{Unit: "each", Factor: 1}}
+					return req
+				}(),
+			}},
+			[]string{"products.1.components.0.quantity", "products.1.units.0.unit"},
+		},
+		{
+			"empty batch",
+			&pb.BatchUpdateProductsRequest{},
+			[]string{"products"},
+		},
+		{
+			"update without id",
+			&pb.UpdateProductRequest{Name: "Product", Sku: "SKU-1", Price: &pb.Money{Units: 1}},
+			[]string{"id"},
+		},
+		{
+			"update of an unknown field",
+			&pb.UpdateProductRequest{Id: 1, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"sku", "colour"}}},
+			[]string{"sku", "update_mask"},
+		},
+		{
+			"update to negative stock",
+			&pb.UpdateProductRequest{Id: 1, Stock: -2, Barcode: "12AB"},
+			[]string{"barcode", "stock"},
+		},
+		{
+			"batch item with update mask",
+			&pb.BatchUpdateProductsRequest{Products: []*pb.UpdateProductRequest{{
+				Id:         1,
+				Name:       "Product",
+				Sku:        "SKU-1",
+				Price:      &pb.Money{Units: 1},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name"}},
+			}}},
+			[]string{"products.0.update_mask"},
+		},
+		{
+			"reservation of zero items",
+			&pb.CreateReservationRequest{ProductId: 1, OrderId: 1},
+			[]string{"quantity"},
+		},
+		{
+			"reservation in unknown unit",
+			&pb.CreateReservationRequest{Quantity: 1, Unit: "crate"},
+			[]string{"product_id", "unit"},
+		},
+		{
+			"page of a million products",
+			&pb.ListProductsRequest{PerPage: 1000000},
+			[]string{"per_page"},
+		},
+		{
+			"unknown reservation status",
+			&pb.ListReservationsRequest{Statuses: []pb.ReservationStatus{42}},
+			[]string{"statuses.0"},
+		},
+		{
+			"status update without status",
+			&pb.UpdateReservationStatusRequest{Ids: []uint32{1}},
+			[]string{"status"},
+		},
+		{
+			"adjustment by nothing",
+			&pb.AdjustStockRequest{ProductId: 1},
+			[]string{"quantity"},
+		},
+		{
+			"lot without number",
+			&pb.CreateLotRequest{ProductId: 1, Quantity: 1},
+			[]string{"lot_number"},
+		},
+		{
+			"empty serial number",
+			&pb.RegisterSerialsRequest{ProductId: 1, SerialNumbers: []string{"SN-1", ""}},
+			[]string{"serial_numbers.1"},
+		},
+		{
+			"webhook without events",
+			&pb.CreateWebhookRequest{Url: "https://example.com/hook"},
+			[]string{"event_types"},
+		},
+		{
+			"get without id",
+			&pb.GetProductRequest{},
+			[]string{"id"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := status.Convert(grpcserver.MapErrorToGRPCStatus(validateUnary(tt.req)))
+
+			assert.Equal(t, codes.InvalidArgument, st.Code())
+			assert.ElementsMatch(t, tt.fields, uniqueFields(violatedFields(t, st)))
+		})
+	}
+}
+
+func uniqueFields(fields []string) []string {
+	seen := map[string]bool{}
+	unique := make([]string, 0, len(fields))
+
+	for _, field := range fields {
+		if !seen[field] {
+			seen[field] = true
+			unique = append(unique, field)
+		}
+	}
+
+	return unique
+}
+
+func TestValidationInterceptorPassesValidRequests(t *testing.T) {
+	variant := validProduct()
+	variant.ParentId = 1
+	variant.Price = nil
+	variant.Options = map[string]string{"size": "M"}
+
+	requests := []any{
+		validProduct(),
+		variant,
+		&pb.UpdateProductRequest{Id: 1, Name: "Product", Sku: "SKU-1", Price: &pb.Money{Units: 1}},
+		&pb.UpdateProductRequest{Id: 1, Barcode: "4006381333931"},
+		&pb.UpdateProductRequest{Id: 1, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"barcode", "units"}}},
+		&pb.CreateReservationRequest{ProductId: 1, OrderId: 1, Quantity: 2, Unit: "case"},
+		&pb.AdjustStockRequest{ProductId: 1, Quantity: -3},
+		&pb.ListProductsRequest{Page: 2, PerPage: 100},
+		&pb.WatchStockRequest{},
+	}
+
+	for _, req := range requests {
+		assert.NoError(t, validateUnary(req))
+	}
+}
+
+// recvStream hands out one request message.
+type recvStream struct {
+	fakeStream
+	req proto.Message
+}
+
+func (s *recvStream) RecvMsg(m any) error {
+	proto.Merge(m.(proto.Message), s.req)
+	return nil
+}
+
+func TestValidationStreamInterceptor(t *testing.T) {
+	info := &grpc.StreamServerInfo{FullMethod: "/" + pb.InventoryService_ServiceDesc.ServiceName + "/ExportReservations"}
+	stream := &recvStream{fakeStream: fakeStream{ctx: context.Background()}, req: &pb.ListReservationsRequest{PerPage: 500}}
+
+	err := grpcserver.ValidationStreamInterceptor()(nil, stream, info, func(_ any, stream grpc.ServerStream) error {
+		return stream.RecvMsg(&pb.ListReservationsRequest{})
+	})
+
+	st := status.Convert(grpcserver.MapErrorToGRPCStatus(err))
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	assert.Equal(t, []string{"per_page"}, violatedFields(t, st))
+}
@@ -0,0 +1,160 @@
+package listener
+
+import (
+	"context"
+	"encoding/json"
+	"inventory-service/config"
+	"inventory-service/internal/domain/entity"
+	"inventory-service/internal/domain/service"
+	"inventory-service/pkg/logger"
+	"inventory-service/pkg/pgnotify"
+	"sync"
+	"time"
+)
+
+const (
+	stockEventsChannel = "stock_events"
+	stockPruneInterval = time.Hour
+	minReconnectDelay  = time.Second
+	maxReconnectDelay  = 30 * time.Second
+)
+
+// stockEventPayload is the notification sent by record_stock_event.
+type stockEventPayload struct {
+	Sequence  uint64    `json:"sequence"`
+	ProductID uint32    `json:"product_id"`
+	Stock     int       `json:"stock"`
+	Reserved  int       `json:"reserved"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// StockListener receives stock event notifications from Postgres and
+// publishes them to stock watchers. It reconnects when the connection is
+// lost and catches up on the events stored in the meantime.
+type StockListener struct {
+	dsn     string
+	service service.Service
+	logger  logger.Logger
+	wg      sync.WaitGroup
+}
+
+func NewStockListener(config *config.Config, service service.Service, logger logger.Logger) *StockListener {
+	return &StockListener{
+		dsn:     config.Postgres.DSN,
+		service: service,
+		logger:  logger,
+	}
+}
+
+// Start runs the listener and the event pruning in the background until ctx
+// is cancelled.
+func (l *StockListener) Start(ctx context.Context) {
+	l.wg.Add(2)
+
+	go func() {
+		defer l.wg.Done()
+		l.listen(ctx)
+	}()
+
+	go func() {
+		defer l.wg.Done()
+		l.prune(ctx)
+	}()
+}
+
+// Wait blocks until the listener has stopped.
+func (l *StockListener) Wait() {
+	l.wg.Wait()
+}
+
+func (l *StockListener) listen(ctx context.Context) {
+	delay := minReconnectDelay
+
+	for ctx.Err() == nil {
+		connected, err := l.run(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+
+		if connected {
+			delay = minReconnectDelay
+		}
+
+		l.logger.Error().Err(err).Msgf("Stock event listener disconnected, reconnecting in %s", delay)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+
+		delay = min(delay*2, maxReconnectDelay)
+	}
+}
+
+// run listens on one connection until it fails, and reports whether it got
+// as far as listening.
+func (l *StockListener) run(ctx context.Context) (bool, error) {
+	conn, err := pgnotify.Listen(ctx, l.dsn, stockEventsChannel)
+	if err != nil {
+		return false, err
+	}
+
+	defer func() {
+		closeCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		if err := conn.Close(closeCtx); err != nil {
+			l.logger.Error().Err(err).Msg("Failed to close stock event listener connection")
+		}
+	}()
+
+	// Listen first, then catch up, so events stored in between are seen by
+	// one or both; watchers skip the duplicates.
+	if err := l.service.StockWatch().CatchUp(ctx); err != nil {
+		return true, err
+	}
+
+	for {
+		payload, err := conn.Wait(ctx)
+		if err != nil {
+			return true, err
+		}
+
+		var event stockEventPayload
+		if err := json.Unmarshal([]byte(payload), &event); err != nil {
+			l.logger.Error().Err(err).Msgf("Failed to decode stock event %q", payload)
+			continue
+		}
+
+		l.service.StockWatch().Publish(&entity.StockEvent{
+			Sequence:  event.Sequence,
+			ProductID: event.ProductID,
+			Stock:     event.Stock,
+			Reserved:  event.Reserved,
+			CreatedAt: event.CreatedAt,
+		})
+	}
+}
+
+func (l *StockListener) prune(ctx context.Context) {
+	ticker := time.NewTicker(stockPruneInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			deleted, err := l.service.StockWatch().Prune(ctx)
+			if err != nil {
+				l.logger.Error().Err(err).Msg("Failed to prune stock events")
+				continue
+			}
+
+			if deleted > 0 {
+				l.logger.Info().Msgf("Pruned %d stock events", deleted)
+			}
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package postgresrepository
+
+import (
+	"context"
+	"inventory-service/internal/adapter/repository/postgres/model"
+	"inventory-service/internal/domain/entity"
+	"inventory-service/internal/shared/exception"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+var _ AuditEventRepository = (*auditEventRepository)(nil)
+
+type AuditEventRepository interface {
+	Create(ctx context.Context, events []*entity.AuditEvent) error
+	Find(ctx context.Context, filter *FilterAuditEventPayload) ([]*entity.AuditEvent, int, error)
+}
+
+type auditEventRepository struct {
+	properties
+}
+
+func NewAuditEventRepository(props properties) *auditEventRepository {
+	return &auditEventRepository{properties: props}
+}
+
+func (r *auditEventRepository) GetTableName() string {
+	return "audit_events"
+}
+
+// FilterAuditEventPayload selects events by entity, actor and a time range;
+// From is inclusive and To exclusive.
+type FilterAuditEventPayload struct {
+	EntityType string
+	EntityIDs  []uint32
+	Actors     []string
+	From       *time.Time
+	To         *time.Time
+	Page       int
+	PerPage    int
+}
+
+// Create stores the events with one multi-row INSERT.
+func (r *auditEventRepository) Create(ctx context.Context, events []*entity.AuditEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	dbEvents := make([]*model.AuditEvent, len(events))
+	for i, event := range events {
+		if event == nil {
+			return exception.ErrDataNull
+		}
+
+		dbEvents[i] = model.AsAuditEvent(event)
+	}
+
+	if _, err := r.db.NewInsert().Model(&dbEvents).Exec(ctx); err != nil {
+		return exception.NewDBError(err, r.GetTableName(), "create audit events")
+	}
+
+	return nil
+}
+
+// Find returns events newest first.
+func (r *auditEventRepository) Find(ctx context.Context, filter *FilterAuditEventPayload) ([]*entity.AuditEvent, int, error) {
+	var events []*model.AuditEvent
+
+	query := r.db.NewSelect().Model(&events)
+
+	if filter.EntityType != "" {
+		query = query.Where("entity_type = ?", filter.EntityType)
+	}
+
+	if len(filter.EntityIDs) > 0 {
+		query = query.Where("entity_id IN (?)", bun.In(filter.EntityIDs))
+	}
+
+	if len(filter.Actors) > 0 {
+		query = query.Where("actor IN (?)", bun.In(filter.Actors))
+	}
+
+	if filter.From != nil {
+		query = query.Where("created_at >= ?", *filter.From)
+	}
+
+	if filter.To != nil {
+		query = query.Where("created_at < ?", *filter.To)
+	}
+
+	totalCount, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, exception.NewDBError(err, r.GetTableName(), "count audit event")
+	}
+
+	if totalCount == 0 {
+		return []*entity.AuditEvent{}, 0, nil
+	}
+
+	if filter.PerPage > 0 {
+		query = query.Limit(filter.PerPage)
+	}
+
+	if filter.Page > 0 && filter.PerPage > 0 {
+		offset := (filter.Page - 1) * filter.PerPage
+		query = query.Offset(offset)
+	}
+
+	query = query.Order("created_at DESC", "id DESC")
+	if err := query.Scan(ctx); err != nil {
+		return nil, 0, exception.NewDBError(err, r.GetTableName(), "find audit event")
+	}
+
+	return model.ToAuditEventsDomain(events), totalCount, nil
+}
@@ -0,0 +1,236 @@
+package postgresrepository
+
+import (
+	"context"
+	"database/sql"
+	"inventory-service/internal/adapter/repository/postgres/model"
+	"inventory-service/internal/domain/entity"
+	"inventory-service/internal/shared/exception"
+
+	"github.com/uptrace/bun"
+)
+
+var _ CategoryRepository = (*categoryRepository)(nil)
+
+// descendantCategoryIDsQuery selects the given category and every category below it.
+const descendantCategoryIDsQuery = `
+	WITH RECURSIVE descendants AS (
+		SELECT id FROM categories WHERE id = ? AND deleted_at IS NULL
+		UNION ALL
+		SELECT c.id FROM categories c JOIN descendants d ON c.parent_id = d.id WHERE c.deleted_at IS NULL
+	)
+	SELECT id FROM descendants`
+
+// categoryStatsQuery counts the live products of each category tree and sums
+// their stock as product reads report it, from lots and serials for tracked
+// products (see product_available_stock in the migrations).
+const categoryStatsQuery = `
+	WITH RECURSIVE tree AS (
+		SELECT id AS root_id, id FROM categories WHERE id IN (?) AND deleted_at IS NULL
+		UNION ALL
+		SELECT tree.root_id, c.id FROM categories c JOIN tree ON c.parent_id = tree.id WHERE c.deleted_at IS NULL
+	)
+	SELECT tree.root_id AS category_id, COUNT(p.id) AS product_count, COALESCE(SUM(product_available_stock(p.id)), 0) AS total_stock
+	FROM tree
+	LEFT JOIN products p ON p.category_id = tree.id AND p.deleted_at IS NULL
+	GROUP BY tree.root_id`
+
+type CategoryRepository interface {
+	FindByID(ctx context.Context, id uint32) (*entity.Category, error)
+	Find(ctx context.Context, filter *FilterCategoryPayload) ([]*entity.Category, int, error)
+	FindDescendantIDs(ctx context.Context, id uint32) ([]uint32, error)
+	Create(ctx context.Context, category *entity.Category) (*entity.Category, error)
+	Update(ctx context.Context, category *entity.Category) (*entity.Category, error)
+	Delete(ctx context.Context, id uint32) error
+}
+
+type categoryRepository struct {
+	properties
+}
+
+func NewCategoryRepository(props properties) *categoryRepository {
+	return &categoryRepository{properties: props}
+}
+
+func (r *categoryRepository) GetTableName() string {
+	return "categories"
+}
+
+type FilterCategoryPayload struct {
+	IDs       []uint32
+	ParentIDs []uint32
+	RootsOnly bool
+	Search    string
+	Page      int
+	PerPage   int
+}
+
+func (r *categoryRepository) Find(ctx context.Context, filter *FilterCategoryPayload) ([]*entity.Category, int, error) {
+	var categories []*model.Category
+
+	query := r.db.NewSelect().Model(&categories)
+
+	if len(filter.IDs) > 0 {
+		query = query.Where("id IN (?)", bun.In(filter.IDs))
+	}
+
+	if len(filter.ParentIDs) > 0 {
+		query = query.Where("parent_id IN (?)", bun.In(filter.ParentIDs))
+	}
+
+	if filter.RootsOnly {
+		query = query.Where("parent_id IS NULL")
+	}
+
+	if filter.Search != "" {
+		query = query.Where("LOWER(name) LIKE LOWER(?)", "%"+filter.Search+"%")
+	}
+
+	totalCount, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, exception.NewDBError(err, r.GetTableName(), "count category")
+	}
+
+	if totalCount == 0 {
+		return []*entity.Category{}, 0, nil
+	}
+
+	if filter.PerPage > 0 {
+		query = query.Limit(filter.PerPage)
+	}
+
+	if filter.Page > 0 && filter.PerPage > 0 {
+		offset := (filter.Page - 1) * filter.PerPage
+		query = query.Offset(offset)
+	}
+
+	query = query.Order("name ASC", "id ASC")
+	if err := query.Scan(ctx); err != nil {
+		return nil, 0, exception.NewDBError(err, r.GetTableName(), "find category")
+	}
+
+	result := model.ToCategoriesDomain(categories)
+	if err := r.attachStats(ctx, result); err != nil {
+		return nil, 0, err
+	}
+
+	return result, totalCount, nil
+}
+
+func (r *categoryRepository) FindByID(ctx context.Context, id uint32) (*entity.Category, error) {
+	if id == 0 {
+		return nil, exception.ErrIDNull
+	}
+
+	category := &model.Category{Base: model.Base{ID: id}}
+
+	if err := r.db.NewSelect().Model(category).WherePK().Scan(ctx); err != nil {
+		return nil, exception.NewDBError(err, r.GetTableName(), "find category by id")
+	}
+
+	result := category.ToDomain()
+	if err := r.attachStats(ctx, []*entity.Category{result}); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (r *categoryRepository) FindDescendantIDs(ctx context.Context, id uint32) ([]uint32, error) {
+	if id == 0 {
+		return nil, exception.ErrIDNull
+	}
+
+	var ids []uint32
+
+	if err := r.db.NewRaw(descendantCategoryIDsQuery, id).Scan(ctx, &ids); err != nil {
+		return nil, exception.NewDBError(err, r.GetTableName(), "find category descendants")
+	}
+
+	return ids, nil
+}
+
+func (r *categoryRepository) Create(ctx context.Context, category *entity.Category) (*entity.Category, error) {
+	if category == nil {
+		return nil, exception.ErrDataNull
+	}
+
+	dbCategory := model.AsCategory(category)
+
+	_, err := r.db.NewInsert().Model(dbCategory).Exec(ctx)
+	if err != nil {
+		return nil, exception.NewDBError(err, r.GetTableName(), "create category")
+	}
+
+	return dbCategory.ToDomain(), nil
+}
+
+func (r *categoryRepository) Update(ctx context.Context, category *entity.Category) (*entity.Category, error) {
+	if category == nil || category.Base.ID == 0 {
+		return nil, exception.ErrDataNull
+	}
+
+	dbCategory := model.AsCategory(category)
+
+	res, err := r.db.NewUpdate().Model(dbCategory).
+		Column("parent_id", "name", "description").
+		Set("updated_at = CURRENT_TIMESTAMP").
+		WherePK().
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		return nil, exception.NewDBError(err, r.GetTableName(), "update category")
+	}
+
+	if affected, err := res.RowsAffected(); err == nil && affected == 0 {
+		return nil, exception.NewDBError(sql.ErrNoRows, r.GetTableName(), "update category")
+	}
+
+	return dbCategory.ToDomain(), nil
+}
+
+func (r *categoryRepository) Delete(ctx context.Context, id uint32) error {
+	if id == 0 {
+		return exception.ErrIDNull
+	}
+
+	dbCategory := &model.Category{Base: model.Base{ID: id}}
+
+	_, err := r.db.NewDelete().Model(dbCategory).WherePK().Exec(ctx)
+	if err != nil {
+		return exception.NewDBError(err, r.GetTableName(), "delete category")
+	}
+
+	return nil
+}
+
+func (r *categoryRepository) attachStats(ctx context.Context, categories []*entity.Category) error {
+	if len(categories) == 0 {
+		return nil
+	}
+
+	ids := make([]uint32, 0, len(categories))
+	for _, category := range categories {
+		ids = append(ids, category.ID)
+	}
+
+	var stats []*model.CategoryStats
+
+	if err := r.db.NewRaw(categoryStatsQuery, bun.In(ids)).Scan(ctx, &stats); err != nil {
+		return exception.NewDBError(err, r.GetTableName(), "aggregate category stats")
+	}
+
+	byID := make(map[uint32]*model.CategoryStats, len(stats))
+	for _, stat := range stats {
+		byID[stat.CategoryID] = stat
+	}
+
+	for _, category := range categories {
+		if stat, ok := byID[category.ID]; ok {
+			category.ProductCount = stat.ProductCount
+			category.TotalStock = stat.TotalStock
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package postgresrepository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/uptrace/bun"
+)
+
+// cursorFetchSize is how many rows streamCursor fetches per round trip.
+const cursorFetchSize = 500
+
+// streamCursor declares a server-side cursor for query in a read-only
+// transaction and hands its rows to fn one fetch at a time, so memory is
+// bounded by the fetch size however many rows match. All fetches read the
+// same snapshot. Cancelling ctx aborts the fetch in flight and rolls back.
+func streamCursor[T any](ctx context.Context, db bun.IDB, query *bun.SelectQuery, fn func(rows []T) error) error {
+	return db.RunInTx(ctx, &sql.TxOptions{ReadOnly: true}, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.ExecContext(ctx, "DECLARE stream_cursor NO SCROLL CURSOR FOR "+query.String()); err != nil {
+			return err
+		}
+
+		for {
+			rows := make([]T, 0, cursorFetchSize)
+			if err := tx.NewRaw("FETCH FORWARD ? FROM stream_cursor", cursorFetchSize).Scan(ctx, &rows); err != nil {
+				return err
+			}
+
+			if len(rows) == 0 {
+				return nil
+			}
+
+			if err := fn(rows); err != nil {
+				return err
+			}
+		}
+	})
+}
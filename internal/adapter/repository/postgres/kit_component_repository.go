@@ -0,0 +1,78 @@
+package postgresrepository
+
+import (
+	"context"
+	"inventory-service/internal/adapter/repository/postgres/model"
+	"inventory-service/internal/domain/entity"
+	"inventory-service/internal/shared/exception"
+
+	"github.com/uptrace/bun"
+)
+
+var _ KitComponentRepository = (*kitComponentRepository)(nil)
+
+type KitComponentRepository interface {
+	FindByKitIDs(ctx context.Context, kitIDs []uint32) ([]*entity.KitComponent, error)
+	Replace(ctx context.Context, kitID uint32, components []*entity.KitComponent) error
+}
+
+type kitComponentRepository struct {
+	properties
+}
+
+func NewKitComponentRepository(props properties) *kitComponentRepository {
+	return &kitComponentRepository{properties: props}
+}
+
+func (r *kitComponentRepository) GetTableName() string {
+	return "kit_components"
+}
+
+// FindByKitIDs returns the bill of materials of the given kits with each
+// component product loaded.
+func (r *kitComponentRepository) FindByKitIDs(ctx context.Context, kitIDs []uint32) ([]*entity.KitComponent, error) {
+	if len(kitIDs) == 0 {
+		return []*entity.KitComponent{}, nil
+	}
+
+	var components []*model.KitComponent
+
+	err := r.db.NewSelect().
+		Model(&components).
+		Relation("Component").
+		Where("kit_component.kit_id IN (?)", bun.In(kitIDs)).
+		Order("kit_component.kit_id ASC", "kit_component.component_id ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, exception.NewDBError(err, r.GetTableName(), "find kit components")
+	}
+
+	return model.ToKitComponentsDomain(components), nil
+}
+
+// Replace swaps the kit's bill of materials for the given components.
+func (r *kitComponentRepository) Replace(ctx context.Context, kitID uint32, components []*entity.KitComponent) error {
+	if kitID == 0 {
+		return exception.ErrIDNull
+	}
+
+	_, err := r.db.NewDelete().Model((*model.KitComponent)(nil)).Where("kit_id = ?", kitID).Exec(ctx)
+	if err != nil {
+		return exception.NewDBError(err, r.GetTableName(), "delete kit components")
+	}
+
+	if len(components) == 0 {
+		return nil
+	}
+
+	dbComponents := model.AsKitComponents(components)
+	for _, component := range dbComponents {
+		component.KitID = kitID
+	}
+
+	if _, err := r.db.NewInsert().Model(&dbComponents).Exec(ctx); err != nil {
+		return exception.NewDBError(err, r.GetTableName(), "create kit components")
+	}
+
+	return nil
+}
@@ -0,0 +1,255 @@
+package postgresrepository
+
+import (
+	"context"
+	"inventory-service/internal/adapter/repository/postgres/model"
+	"inventory-service/internal/domain/entity"
+	"inventory-service/internal/shared/exception"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/uptrace/bun"
+)
+
+var _ LotRepository = (*lotRepository)(nil)
+
+type LotRepository interface {
+	Find(ctx context.Context, filter *FilterLotPayload) ([]*entity.Lot, int, error)
+	Create(ctx context.Context, lot *entity.Lot) (*entity.Lot, error)
+	FindAllocatable(ctx context.Context, productID uint32) ([]*entity.Lot, error)
+	SumAvailable(ctx context.Context, productIDs []uint32) (map[uint32]int, error)
+	Allocate(ctx context.Context, allocations []*entity.LotAllocation) error
+	FindAllocations(ctx context.Context, reservationIDs []uint32) ([]*entity.LotAllocation, error)
+	ReleaseAllocations(ctx context.Context, reservationIDs []uint32) error
+}
+
+type lotRepository struct {
+	properties
+}
+
+func NewLotRepository(props properties) *lotRepository {
+	return &lotRepository{properties: props}
+}
+
+func (r *lotRepository) GetTableName() string {
+	return "lots"
+}
+
+type FilterLotPayload struct {
+	IDs        []uint32
+	ProductIDs []uint32
+	// ExcludeExpired drops lots whose expiry date has passed.
+	ExcludeExpired bool
+	// ExpiresBefore keeps only lots expiring before the given time.
+	ExpiresBefore *time.Time
+	InStockOnly   bool
+	Page          int
+	PerPage       int
+}
+
+func (r *lotRepository) Find(ctx context.Context, filter *FilterLotPayload) ([]*entity.Lot, int, error) {
+	var lots []*model.Lot
+
+	query := r.db.NewSelect().Model(&lots)
+
+	if len(filter.IDs) > 0 {
+		query = query.Where("id IN (?)", bun.In(filter.IDs))
+	}
+
+	if len(filter.ProductIDs) > 0 {
+		query = query.Where("product_id IN (?)", bun.In(filter.ProductIDs))
+	}
+
+	if filter.ExcludeExpired {
+		query = query.Where("(expires_at IS NULL OR expires_at > CURRENT_TIMESTAMP)")
+	}
+
+	if filter.ExpiresBefore != nil {
+		query = query.Where("expires_at <= ?", *filter.ExpiresBefore)
+	}
+
+	if filter.InStockOnly {
+		query = query.Where("quantity > 0")
+	}
+
+	totalCount, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, exception.NewDBError(err, r.GetTableName(), "count lot")
+	}
+
+	if totalCount == 0 {
+		return []*entity.Lot{}, 0, nil
+	}
+
+	if filter.PerPage > 0 {
+		query = query.Limit(filter.PerPage)
+	}
+
+	if filter.Page > 0 && filter.PerPage > 0 {
+		offset := (filter.Page - 1) * filter.PerPage
+		query = query.Offset(offset)
+	}
+
+	query = query.Order("expires_at ASC NULLS LAST", "id ASC")
+	if err := query.Scan(ctx); err != nil {
+		return nil, 0, exception.NewDBError(err, r.GetTableName(), "find lot")
+	}
+
+	return model.ToLotsDomain(lots), totalCount, nil
+}
+
+func (r *lotRepository) Create(ctx context.Context, lot *entity.Lot) (*entity.Lot, error) {
+	if lot == nil {
+		return nil, exception.ErrDataNull
+	}
+
+	dbLot := model.AsLot(lot)
+
+	_, err := r.db.NewInsert().Model(dbLot).Exec(ctx)
+	if err != nil {
+		return nil, exception.NewDBError(err, r.GetTableName(), "create lot")
+	}
+
+	return dbLot.ToDomain(), nil
+}
+
+// FindAllocatable locks and returns the product's unexpired lots that still
+// have stock, first-expired first.
+func (r *lotRepository) FindAllocatable(ctx context.Context, productID uint32) ([]*entity.Lot, error) {
+	if productID == 0 {
+		return nil, exception.ErrIDNull
+	}
+
+	var lots []*model.Lot
+
+	err := r.db.NewSelect().
+		Model(&lots).
+		Where("product_id = ?", productID).
+		Where("quantity > 0").
+		Where("(expires_at IS NULL OR expires_at > CURRENT_TIMESTAMP)").
+		Order("expires_at ASC NULLS LAST", "id ASC").
+		For("UPDATE").
+		Scan(ctx)
+	if err != nil {
+		return nil, exception.NewDBError(err, r.GetTableName(), "find allocatable lots")
+	}
+
+	return model.ToLotsDomain(lots), nil
+}
+
+// SumAvailable returns the unexpired lot stock of each product.
+func (r *lotRepository) SumAvailable(ctx context.Context, productIDs []uint32) (map[uint32]int, error) {
+	result := make(map[uint32]int, len(productIDs))
+	if len(productIDs) == 0 {
+		return result, nil
+	}
+
+	var rows []*model.LotAvailability
+
+	err := r.db.NewSelect().
+		Model((*model.Lot)(nil)).
+		Column("product_id").
+		ColumnExpr("COALESCE(SUM(quantity), 0) AS quantity").
+		Where("product_id IN (?)", bun.In(productIDs)).
+		Where("(expires_at IS NULL OR expires_at > CURRENT_TIMESTAMP)").
+		Group("product_id").
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, exception.NewDBError(err, r.GetTableName(), "sum available lots")
+	}
+
+	for _, row := range rows {
+		result[row.ProductID] = row.Quantity
+	}
+
+	return result, nil
+}
+
+// Allocate takes each allocation out of its lot and records it against the reservation.
+func (r *lotRepository) Allocate(ctx context.Context, allocations []*entity.LotAllocation) error {
+	if len(allocations) == 0 {
+		return nil
+	}
+
+	for _, allocation := range allocations {
+		res, err := r.db.NewUpdate().
+			Model((*model.Lot)(nil)).
+			Set("quantity = quantity - ?", allocation.Quantity).
+			Set("updated_at = CURRENT_TIMESTAMP").
+			Where("id = ?", allocation.LotID).
+			Where("quantity >= ?", allocation.Quantity).
+			Exec(ctx)
+		if err != nil {
+			return exception.NewDBError(err, r.GetTableName(), "allocate lot")
+		}
+
+		if affected, err := res.RowsAffected(); err == nil && affected == 0 {
+			return errors.Wrapf(exception.ErrInsufficientStock, "insufficient stock in lot %d", allocation.LotID)
+		}
+	}
+
+	dbAllocations := model.AsLotAllocations(allocations)
+
+	if _, err := r.db.NewInsert().Model(&dbAllocations).Exec(ctx); err != nil {
+		return exception.NewDBError(err, "reservation_lots", "create lot allocations")
+	}
+
+	return nil
+}
+
+func (r *lotRepository) FindAllocations(ctx context.Context, reservationIDs []uint32) ([]*entity.LotAllocation, error) {
+	if len(reservationIDs) == 0 {
+		return []*entity.LotAllocation{}, nil
+	}
+
+	var allocations []*model.LotAllocation
+
+	err := r.db.NewSelect().
+		Model(&allocations).
+		Relation("Lot").
+		Where("reservation_lot.reservation_id IN (?)", bun.In(reservationIDs)).
+		Order("reservation_lot.reservation_id ASC", "lot.expires_at ASC NULLS LAST").
+		Scan(ctx)
+	if err != nil {
+		return nil, exception.NewDBError(err, "reservation_lots", "find lot allocations")
+	}
+
+	return model.ToLotAllocationsDomain(allocations), nil
+}
+
+// ReleaseAllocations returns the lot stock held by the reservations and drops
+// their allocation records.
+func (r *lotRepository) ReleaseAllocations(ctx context.Context, reservationIDs []uint32) error {
+	if len(reservationIDs) == 0 {
+		return nil
+	}
+
+	held := r.db.NewSelect().
+		Model((*model.LotAllocation)(nil)).
+		Column("lot_id").
+		ColumnExpr("SUM(quantity) AS quantity").
+		Where("reservation_id IN (?)", bun.In(reservationIDs)).
+		Group("lot_id")
+
+	_, err := r.db.NewUpdate().
+		With("held", held).
+		Model((*model.Lot)(nil)).
+		TableExpr("held").
+		Set("quantity = lot.quantity + held.quantity").
+		Set("updated_at = CURRENT_TIMESTAMP").
+		Where("lot.id = held.lot_id").
+		Exec(ctx)
+	if err != nil {
+		return exception.NewDBError(err, r.GetTableName(), "release lot allocations")
+	}
+
+	_, err = r.db.NewDelete().
+		Model((*model.LotAllocation)(nil)).
+		Where("reservation_id IN (?)", bun.In(reservationIDs)).
+		Exec(ctx)
+	if err != nil {
+		return exception.NewDBError(err, "reservation_lots", "delete lot allocations")
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package model
+
+import (
+	"inventory-service/internal/domain/entity"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type AuditEvent struct {
+	bun.BaseModel `bun:"table:audit_events,alias:audit_event"`
+	ID            uint64         `bun:"id,pk,autoincrement"`
+	EntityType    string         `bun:"entity_type,notnull"`
+	EntityID      uint32         `bun:"entity_id,notnull"`
+	Action        string         `bun:"action,notnull"`
+	Actor         string         `bun:"actor,notnull"`
+	Source        string         `bun:"source,notnull"`
+	RequestID     string         `bun:"request_id,notnull"`
+	Before        map[string]any `bun:"before,type:jsonb"`
+	After         map[string]any `bun:"after,type:jsonb"`
+	CreatedAt     time.Time      `bun:"created_at,notnull,default:current_timestamp"`
+}
+
+func (m *AuditEvent) ToDomain() *entity.AuditEvent {
+	if m == nil {
+		return nil
+	}
+
+	return &entity.AuditEvent{
+		ID:         m.ID,
+		EntityType: m.EntityType,
+		EntityID:   m.EntityID,
+		Action:     m.Action,
+		Actor:      m.Actor,
+		Source:     m.Source,
+		RequestID:  m.RequestID,
+		Before:     m.Before,
+		After:      m.After,
+		CreatedAt:  m.CreatedAt,
+	}
+}
+
+func ToAuditEventsDomain(arg []*AuditEvent) []*entity.AuditEvent {
+	if len(arg) == 0 {
+		return nil
+	}
+
+	res := make([]*entity.AuditEvent, 0, len(arg))
+
+	for i := range arg {
+		if arg[i] == nil {
+			continue
+		}
+
+		res = append(res, arg[i].ToDomain())
+	}
+
+	return res
+}
+
+func AsAuditEvent(arg *entity.AuditEvent) *AuditEvent {
+	if arg == nil {
+		return nil
+	}
+
+	return &AuditEvent{
+		ID:         arg.ID,
+		EntityType: arg.EntityType,
+		EntityID:   arg.EntityID,
+		Action:     arg.Action,
+		Actor:      arg.Actor,
+		Source:     arg.Source,
+		RequestID:  arg.RequestID,
+		Before:     arg.Before,
+		After:      arg.After,
+		CreatedAt:  arg.CreatedAt,
+	}
+}
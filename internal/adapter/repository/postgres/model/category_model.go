@@ -0,0 +1,76 @@
+package model
+
+import (
+	"inventory-service/internal/domain/entity"
+
+	"github.com/uptrace/bun"
+)
+
+type Category struct {
+	bun.BaseModel `bun:"table:categories,alias:category"`
+	Base
+	ParentID    uint32 `bun:"parent_id,nullzero"`
+	Name        string `bun:"name,notnull"`
+	Description string `bun:"description,notnull"`
+}
+
+func (m *Category) ToDomain() *entity.Category {
+	if m == nil {
+		return nil
+	}
+
+	return &entity.Category{
+		Base: entity.Base{
+			ID:        m.ID,
+			CreatedAt: m.CreatedAt,
+			UpdatedAt: m.UpdatedAt,
+			DeletedAt: m.DeletedAt,
+		},
+		ParentID:    m.ParentID,
+		Name:        m.Name,
+		Description: m.Description,
+	}
+}
+
+func ToCategoriesDomain(arg []*Category) []*entity.Category {
+	if len(arg) == 0 {
+		return nil
+	}
+
+	res := make([]*entity.Category, 0, len(arg))
+
+	for i := range arg {
+		if arg[i] == nil {
+			continue
+		}
+
+		res = append(res, arg[i].ToDomain())
+	}
+
+	return res
+}
+
+func AsCategory(arg *entity.Category) *Category {
+	if arg == nil {
+		return nil
+	}
+
+	return &Category{
+		Base: Base{
+			ID:        arg.ID,
+			CreatedAt: arg.CreatedAt,
+			UpdatedAt: arg.UpdatedAt,
+			DeletedAt: arg.DeletedAt,
+		},
+		ParentID:    arg.ParentID,
+		Name:        arg.Name,
+		Description: arg.Description,
+	}
+}
+
+// CategoryStats is the scan target for per-category product aggregates.
+type CategoryStats struct {
+	CategoryID   uint32 `bun:"category_id"`
+	ProductCount int    `bun:"product_count"`
+	TotalStock   int    `bun:"total_stock"`
+}
@@ -0,0 +1,77 @@
+package model
+
+import (
+	"inventory-service/internal/domain/entity"
+
+	"github.com/uptrace/bun"
+)
+
+type KitComponent struct {
+	bun.BaseModel `bun:"table:kit_components,alias:kit_component"`
+	KitID         uint32 `bun:"kit_id,pk"`
+	ComponentID   uint32 `bun:"component_id,pk"`
+	Quantity      int    `bun:"quantity,notnull"`
+
+	Component *Product `bun:"rel:belongs-to,join:component_id=id"`
+}
+
+func (m *KitComponent) ToDomain() *entity.KitComponent {
+	if m == nil {
+		return nil
+	}
+
+	return &entity.KitComponent{
+		KitID:       m.KitID,
+		ComponentID: m.ComponentID,
+		Quantity:    m.Quantity,
+		Component:   m.Component.ToDomain(),
+	}
+}
+
+func ToKitComponentsDomain(arg []*KitComponent) []*entity.KitComponent {
+	if len(arg) == 0 {
+		return nil
+	}
+
+	res := make([]*entity.KitComponent, 0, len(arg))
+
+	for i := range arg {
+		if arg[i] == nil {
+			continue
+		}
+
+		res = append(res, arg[i].ToDomain())
+	}
+
+	return res
+}
+
+func AsKitComponent(arg *entity.KitComponent) *KitComponent {
+	if arg == nil {
+		return nil
+	}
+
+	return &KitComponent{
+		KitID:       arg.KitID,
+		ComponentID: arg.ComponentID,
+		Quantity:    arg.Quantity,
+	}
+}
+
+func AsKitComponents(arg []*entity.KitComponent) []*KitComponent {
+	if len(arg) == 0 {
+		return nil
+	}
+
+	res := make([]*KitComponent, 0, len(arg))
+
+	for i := range arg {
+		if arg[i] == nil {
+			continue
+		}
+
+		res = append(res, AsKitComponent(arg[i]))
+	}
+
+	return res
+}
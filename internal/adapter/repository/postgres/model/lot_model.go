@@ -0,0 +1,141 @@
+package model
+
+import (
+	"inventory-service/internal/domain/entity"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type Lot struct {
+	bun.BaseModel `bun:"table:lots,alias:lot"`
+	Base
+	ProductID uint32     `bun:"product_id,notnull"`
+	LotNumber string     `bun:"lot_number,notnull"`
+	ExpiresAt *time.Time `bun:"expires_at"`
+	Quantity  int        `bun:"quantity,notnull"`
+}
+
+func (m *Lot) ToDomain() *entity.Lot {
+	if m == nil {
+		return nil
+	}
+
+	return &entity.Lot{
+		Base: entity.Base{
+			ID:        m.ID,
+			CreatedAt: m.CreatedAt,
+			UpdatedAt: m.UpdatedAt,
+			DeletedAt: m.DeletedAt,
+		},
+		ProductID: m.ProductID,
+		LotNumber: m.LotNumber,
+		ExpiresAt: m.ExpiresAt,
+		Quantity:  m.Quantity,
+	}
+}
+
+func ToLotsDomain(arg []*Lot) []*entity.Lot {
+	if len(arg) == 0 {
+		return nil
+	}
+
+	res := make([]*entity.Lot, 0, len(arg))
+
+	for i := range arg {
+		if arg[i] == nil {
+			continue
+		}
+
+		res = append(res, arg[i].ToDomain())
+	}
+
+	return res
+}
+
+func AsLot(arg *entity.Lot) *Lot {
+	if arg == nil {
+		return nil
+	}
+
+	return &Lot{
+		Base: Base{
+			ID:        arg.ID,
+			CreatedAt: arg.CreatedAt,
+			UpdatedAt: arg.UpdatedAt,
+			DeletedAt: arg.DeletedAt,
+		},
+		ProductID: arg.ProductID,
+		LotNumber: arg.LotNumber,
+		ExpiresAt: arg.ExpiresAt,
+		Quantity:  arg.Quantity,
+	}
+}
+
+type LotAllocation struct {
+	bun.BaseModel `bun:"table:reservation_lots,alias:reservation_lot"`
+	ReservationID uint32 `bun:"reservation_id,pk"`
+	LotID         uint32 `bun:"lot_id,pk"`
+	Quantity      int    `bun:"quantity,notnull"`
+
+	Lot *Lot `bun:"rel:belongs-to,join:lot_id=id"`
+}
+
+func (m *LotAllocation) ToDomain() *entity.LotAllocation {
+	if m == nil {
+		return nil
+	}
+
+	return &entity.LotAllocation{
+		ReservationID: m.ReservationID,
+		LotID:         m.LotID,
+		Quantity:      m.Quantity,
+		Lot:           m.Lot.ToDomain(),
+	}
+}
+
+func ToLotAllocationsDomain(arg []*LotAllocation) []*entity.LotAllocation {
+	if len(arg) == 0 {
+		return nil
+	}
+
+	res := make([]*entity.LotAllocation, 0, len(arg))
+
+	for i := range arg {
+		if arg[i] == nil {
+			continue
+		}
+
+		res = append(res, arg[i].ToDomain())
+	}
+
+	return res
+}
+
+func AsLotAllocations(arg []*entity.LotAllocation) []*LotAllocation {
+	if len(arg) == 0 {
+		return nil
+	}
+
+	res := make([]*LotAllocation, 0, len(arg))
+
+	for i := range arg {
+		if arg[i] == nil {
+			continue
+		}
+
+		res = append(res, &LotAllocation{
+			ReservationID: arg[i].ReservationID,
+			LotID:         arg[i].LotID,
+			Quantity:      arg[i].Quantity,
+		})
+	}
+
+	return res
+}
+
+// LotAvailability is the scan target for unexpired lot stock per product.
+type LotAvailability struct {
+	ProductID uint32 `bun:"product_id"`
+	Quantity  int    `bun:"quantity"`
+}
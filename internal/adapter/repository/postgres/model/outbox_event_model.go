@@ -0,0 +1,84 @@
+package model
+
+import (
+	"inventory-service/internal/domain/entity"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type OutboxEvent struct {
+	bun.BaseModel `bun:"table:outbox_events,alias:outbox_event"`
+	ID            uint64         `bun:"id,pk,autoincrement"`
+	EventType     string         `bun:"event_type,notnull"`
+	PartitionKey  string         `bun:"partition_key,notnull"`
+	Payload       map[string]any `bun:"payload,type:jsonb,notnull"`
+	Status        string         `bun:"status,notnull"`
+	Attempts      int            `bun:"attempts,notnull"`
+	NextAttemptAt time.Time      `bun:"next_attempt_at,notnull,default:current_timestamp"`
+	LastError     string         `bun:"last_error,notnull"`
+	DeliveredAt   *time.Time     `bun:"delivered_at"`
+	CreatedAt     time.Time      `bun:"created_at,notnull,default:current_timestamp"`
+	UpdatedAt     time.Time      `bun:"updated_at,notnull,default:current_timestamp"`
+}
+
+func (m *OutboxEvent) ToDomain() *entity.OutboxEvent {
+	if m == nil {
+		return nil
+	}
+
+	return &entity.OutboxEvent{
+		ID:            m.ID,
+		Type:          m.EventType,
+		PartitionKey:  m.PartitionKey,
+		Payload:       m.Payload,
+		Status:        m.Status,
+		Attempts:      m.Attempts,
+		NextAttemptAt: m.NextAttemptAt,
+		LastError:     m.LastError,
+		DeliveredAt:   m.DeliveredAt,
+		CreatedAt:     m.CreatedAt,
+	}
+}
+
+func ToOutboxEventsDomain(arg []*OutboxEvent) []*entity.OutboxEvent {
+	if len(arg) == 0 {
+		return nil
+	}
+
+	res := make([]*entity.OutboxEvent, 0, len(arg))
+
+	for i := range arg {
+		if arg[i] == nil {
+			continue
+		}
+
+		res = append(res, arg[i].ToDomain())
+	}
+
+	return res
+}
+
+func AsOutboxEvent(arg *entity.OutboxEvent) *OutboxEvent {
+	if arg == nil {
+		return nil
+	}
+
+	payload := arg.Payload
+	if payload == nil {
+		payload = map[string]any{}
+	}
+
+	return &OutboxEvent{
+		ID:            arg.ID,
+		EventType:     arg.Type,
+		PartitionKey:  arg.PartitionKey,
+		Payload:       payload,
+		Status:        arg.Status,
+		Attempts:      arg.Attempts,
+		NextAttemptAt: arg.NextAttemptAt,
+		LastError:     arg.LastError,
+		DeliveredAt:   arg.DeliveredAt,
+		CreatedAt:     arg.CreatedAt,
+	}
+}
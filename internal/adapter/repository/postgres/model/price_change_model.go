@@ -0,0 +1,77 @@
+package model
+
+import (
+	"inventory-service/internal/domain/entity"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type PriceChange struct {
+	bun.BaseModel `bun:"table:price_changes,alias:price_change"`
+	Base
+	ProductID     uint32     `bun:"product_id,notnull"`
+	Price         string     `bun:"price,type:decimal(10,2),notnull"`
+	Currency      string     `bun:"currency,notnull"`
+	EffectiveFrom time.Time  `bun:"effective_from,notnull"`
+	AppliedAt     *time.Time `bun:"applied_at"`
+}
+
+func (m *PriceChange) ToDomain() *entity.PriceChange {
+	if m == nil {
+		return nil
+	}
+
+	price, _ := entity.ParseMoney(m.Price, m.Currency)
+
+	return &entity.PriceChange{
+		Base: entity.Base{
+			ID:        m.ID,
+			CreatedAt: m.CreatedAt,
+			UpdatedAt: m.UpdatedAt,
+			DeletedAt: m.DeletedAt,
+		},
+		ProductID:     m.ProductID,
+		Price:         price,
+		EffectiveFrom: m.EffectiveFrom,
+		AppliedAt:     m.AppliedAt,
+	}
+}
+
+func ToPriceChangesDomain(arg []*PriceChange) []*entity.PriceChange {
+	if len(arg) == 0 {
+		return nil
+	}
+
+	res := make([]*entity.PriceChange, 0, len(arg))
+
+	for i := range arg {
+		if arg[i] == nil {
+			continue
+		}
+
+		res = append(res, arg[i].ToDomain())
+	}
+
+	return res
+}
+
+func AsPriceChange(arg *entity.PriceChange) *PriceChange {
+	if arg == nil {
+		return nil
+	}
+
+	return &PriceChange{
+		Base: Base{
+			ID:        arg.ID,
+			CreatedAt: arg.CreatedAt,
+			UpdatedAt: arg.UpdatedAt,
+			DeletedAt: arg.DeletedAt,
+		},
+		ProductID:     arg.ProductID,
+		Price:         arg.Price.String(),
+		Currency:      arg.Price.Currency,
+		EffectiveFrom: arg.EffectiveFrom,
+		AppliedAt:     arg.AppliedAt,
+	}
+}
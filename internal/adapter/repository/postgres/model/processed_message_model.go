@@ -0,0 +1,14 @@
+package model
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type ProcessedMessage struct {
+	bun.BaseModel `bun:"table:processed_messages,alias:processed_message"`
+	MessageID     string    `bun:"message_id,pk"`
+	Topic         string    `bun:"topic,notnull"`
+	ProcessedAt   time.Time `bun:"processed_at,notnull,default:current_timestamp"`
+}
@@ -9,9 +9,20 @@ import (
 type Product struct {
 	bun.BaseModel `bun:"table:products,alias:product"`
 	Base
-	Name  string  `bun:"name,notnull"`
-	Stock int     `bun:"stock,notnull"`
-	Price float64 `bun:"price,notnull"`
+	SKU          string            `bun:"sku,notnull"`
+	Barcode      string            `bun:"barcode,nullzero"`
+	CategoryID   uint32            `bun:"category_id,nullzero"`
+	Name         string            `bun:"name,notnull"`
+	Stock        int               `bun:"stock,notnull"`
+	Price        string            `bun:"price,type:decimal(10,2),notnull"`
+	Currency     string            `bun:"currency,notnull"`
+	InheritPrice bool              `bun:"inherit_price,notnull"`
+	Status       string            `bun:"status,nullzero,notnull,default:'ACTIVE'"`
+	Attributes   map[string]any    `bun:"attributes,type:jsonb,notnull"`
+	ParentID     uint32            `bun:"parent_id,nullzero"`
+	Options      map[string]string `bun:"options,type:jsonb,notnull"`
+	TrackLots    bool              `bun:"track_lots,notnull"`
+	TrackSerials bool              `bun:"track_serials,notnull"`
 }
 
 func (m *Product) ToDomain() *entity.Product {
@@ -26,12 +37,31 @@ func (m *Product) ToDomain() *entity.Product {
 			UpdatedAt: m.UpdatedAt,
 			DeletedAt: m.DeletedAt,
 		},
-		Name:  m.Name,
-		Stock: m.Stock,
-		Price: m.Price,
+		SKU:          m.SKU,
+		Barcode:      m.Barcode,
+		CategoryID:   m.CategoryID,
+		Name:         m.Name,
+		Stock:        m.Stock,
+		Price:        m.price(),
+		InheritPrice: m.InheritPrice,
+		Status:       m.Status,
+		Attributes:   m.Attributes,
+		ParentID:     m.ParentID,
+		Options:      m.Options,
+		TrackLots:    m.TrackLots,
+		TrackSerials: m.TrackSerials,
+
+		AvailableStock: m.Stock,
 	}
 }
 
+// price reads the DECIMAL column, which the database guarantees to be a valid
+// decimal, into an exact money amount.
+func (m *Product) price() entity.Money {
+	price, _ := entity.ParseMoney(m.Price, m.Currency)
+	return price
+}
+
 func ToProductsDomain(arg []*Product) []*entity.Product {
 	if len(arg) == 0 {
 		return nil
@@ -55,6 +85,16 @@ func AsProduct(arg *entity.Product) *Product {
 		return nil
 	}
 
+	options := arg.Options
+	if options == nil {
+		options = map[string]string{}
+	}
+
+	attributes := arg.Attributes
+	if attributes == nil {
+		attributes = map[string]any{}
+	}
+
 	return &Product{
 		Base: Base{
 			ID:        arg.ID,
@@ -62,9 +102,20 @@ func AsProduct(arg *entity.Product) *Product {
 			UpdatedAt: arg.UpdatedAt,
 			DeletedAt: arg.DeletedAt,
 		},
-		Name:  arg.Name,
-		Stock: arg.Stock,
-		Price: arg.Price,
+		SKU:          arg.SKU,
+		Barcode:      arg.Barcode,
+		CategoryID:   arg.CategoryID,
+		Name:         arg.Name,
+		Stock:        arg.Stock,
+		Price:        arg.Price.String(),
+		Currency:     arg.Price.Currency,
+		InheritPrice: arg.InheritPrice,
+		Status:       arg.Status,
+		Attributes:   attributes,
+		ParentID:     arg.ParentID,
+		Options:      options,
+		TrackLots:    arg.TrackLots,
+		TrackSerials: arg.TrackSerials,
 	}
 }
 
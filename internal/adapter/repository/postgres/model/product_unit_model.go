@@ -0,0 +1,74 @@
+package model
+
+import (
+	"inventory-service/internal/domain/entity"
+
+	"github.com/uptrace/bun"
+)
+
+type ProductUnit struct {
+	bun.BaseModel `bun:"table:product_units,alias:product_unit"`
+	ProductID     uint32 `bun:"product_id,pk"`
+	Unit          string `bun:"unit,pk"`
+	Factor        int    `bun:"factor,notnull"`
+}
+
+func (m *ProductUnit) ToDomain() *entity.ProductUnit {
+	if m == nil {
+		return nil
+	}
+
+	return &entity.ProductUnit{
+		ProductID: m.ProductID,
+		Unit:      m.Unit,
+		Factor:    m.Factor,
+	}
+}
+
+func ToProductUnitsDomain(arg []*ProductUnit) []*entity.ProductUnit {
+	if len(arg) == 0 {
+		return nil
+	}
+
+	res := make([]*entity.ProductUnit, 0, len(arg))
+
+	for i := range arg {
+		if arg[i] == nil {
+			continue
+		}
+
+		res = append(res, arg[i].ToDomain())
+	}
+
+	return res
+}
+
+func AsProductUnit(arg *entity.ProductUnit) *ProductUnit {
+	if arg == nil {
+		return nil
+	}
+
+	return &ProductUnit{
+		ProductID: arg.ProductID,
+		Unit:      arg.Unit,
+		Factor:    arg.Factor,
+	}
+}
+
+func AsProductUnits(arg []*entity.ProductUnit) []*ProductUnit {
+	if len(arg) == 0 {
+		return nil
+	}
+
+	res := make([]*ProductUnit, 0, len(arg))
+
+	for i := range arg {
+		if arg[i] == nil {
+			continue
+		}
+
+		res = append(res, AsProductUnit(arg[i]))
+	}
+
+	return res
+}
@@ -9,10 +9,13 @@ import (
 type Reservation struct {
 	bun.BaseModel `bun:"table:reservations,alias:reservation"`
 	Base
-	ProductID uint32 `bun:"product_id,notnull"`
-	OrderID   uint32 `bun:"order_id,notnull"`
-	Quantity  int    `bun:"quantity,notnull"`
-	Status    string `bun:"status,notnull"`
+	ProductID    uint32 `bun:"product_id,notnull"`
+	OrderID      uint32 `bun:"order_id,notnull"`
+	Quantity     int    `bun:"quantity,notnull"`
+	Unit         string `bun:"unit,notnull"`
+	UnitQuantity int    `bun:"unit_quantity,notnull"`
+	Status       string `bun:"status,notnull"`
+	ParentID     uint32 `bun:"parent_id,nullzero"`
 
 	Product *Product `bun:"rel:belongs-to,join:product_id=id"`
 }
@@ -29,11 +32,14 @@ func (m *Reservation) ToDomain() *entity.Reservation {
 			UpdatedAt: m.UpdatedAt,
 			DeletedAt: m.DeletedAt,
 		},
-		ProductID: m.ProductID,
-		OrderID:   m.OrderID,
-		Quantity:  m.Quantity,
-		Status:    m.Status,
-		Product:   m.Product.ToDomain(),
+		ProductID:    m.ProductID,
+		OrderID:      m.OrderID,
+		Quantity:     m.Quantity,
+		Unit:         m.Unit,
+		UnitQuantity: m.UnitQuantity,
+		Status:       m.Status,
+		ParentID:     m.ParentID,
+		Product:      m.Product.ToDomain(),
 	}
 }
 
@@ -67,11 +73,14 @@ func AsReservation(arg *entity.Reservation) *Reservation {
 			UpdatedAt: arg.UpdatedAt,
 			DeletedAt: arg.DeletedAt,
 		},
-		ProductID: arg.ProductID,
-		OrderID:   arg.OrderID,
-		Quantity:  arg.Quantity,
-		Status:    arg.Status,
-		Product:   AsProduct(arg.Product),
+		ProductID:    arg.ProductID,
+		OrderID:      arg.OrderID,
+		Quantity:     arg.Quantity,
+		Unit:         arg.Unit,
+		UnitQuantity: arg.UnitQuantity,
+		Status:       arg.Status,
+		ParentID:     arg.ParentID,
+		Product:      AsProduct(arg.Product),
 	}
 }
 
@@ -0,0 +1,102 @@
+package model
+
+import (
+	"inventory-service/internal/domain/entity"
+
+	"github.com/uptrace/bun"
+)
+
+type Serial struct {
+	bun.BaseModel `bun:"table:serials,alias:serial"`
+	Base
+	ProductID     uint32 `bun:"product_id,notnull"`
+	SerialNumber  string `bun:"serial_number,notnull"`
+	Status        string `bun:"status,notnull"`
+	ReservationID uint32 `bun:"reservation_id,nullzero"`
+	OrderID       uint32 `bun:"order_id,nullzero"`
+
+	Product *Product `bun:"rel:belongs-to,join:product_id=id"`
+}
+
+func (m *Serial) ToDomain() *entity.Serial {
+	if m == nil {
+		return nil
+	}
+
+	return &entity.Serial{
+		Base: entity.Base{
+			ID:        m.ID,
+			CreatedAt: m.CreatedAt,
+			UpdatedAt: m.UpdatedAt,
+			DeletedAt: m.DeletedAt,
+		},
+		ProductID:     m.ProductID,
+		SerialNumber:  m.SerialNumber,
+		Status:        m.Status,
+		ReservationID: m.ReservationID,
+		OrderID:       m.OrderID,
+		Product:       m.Product.ToDomain(),
+	}
+}
+
+func ToSerialsDomain(arg []*Serial) []*entity.Serial {
+	if len(arg) == 0 {
+		return nil
+	}
+
+	res := make([]*entity.Serial, 0, len(arg))
+
+	for i := range arg {
+		if arg[i] == nil {
+			continue
+		}
+
+		res = append(res, arg[i].ToDomain())
+	}
+
+	return res
+}
+
+func AsSerial(arg *entity.Serial) *Serial {
+	if arg == nil {
+		return nil
+	}
+
+	return &Serial{
+		Base: Base{
+			ID:        arg.ID,
+			CreatedAt: arg.CreatedAt,
+			UpdatedAt: arg.UpdatedAt,
+			DeletedAt: arg.DeletedAt,
+		},
+		ProductID:     arg.ProductID,
+		SerialNumber:  arg.SerialNumber,
+		Status:        arg.Status,
+		ReservationID: arg.ReservationID,
+		OrderID:       arg.OrderID,
+	}
+}
+
+func AsSerials(arg []*entity.Serial) []*Serial {
+	if len(arg) == 0 {
+		return nil
+	}
+
+	res := make([]*Serial, 0, len(arg))
+
+	for i := range arg {
+		if arg[i] == nil {
+			continue
+		}
+
+		res = append(res, AsSerial(arg[i]))
+	}
+
+	return res
+}
+
+// SerialCount is the scan target for available serials per product.
+type SerialCount struct {
+	ProductID uint32 `bun:"product_id"`
+	Count     int    `bun:"count"`
+}
@@ -0,0 +1,49 @@
+package model
+
+import (
+	"inventory-service/internal/domain/entity"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type StockEvent struct {
+	bun.BaseModel `bun:"table:stock_events,alias:stock_event"`
+	ID            uint64    `bun:"id,pk,autoincrement"`
+	ProductID     uint32    `bun:"product_id,notnull"`
+	Stock         int       `bun:"stock,notnull"`
+	Reserved      int       `bun:"reserved,notnull"`
+	CreatedAt     time.Time `bun:"created_at,notnull,default:current_timestamp"`
+}
+
+func (m *StockEvent) ToDomain() *entity.StockEvent {
+	if m == nil {
+		return nil
+	}
+
+	return &entity.StockEvent{
+		Sequence:  m.ID,
+		ProductID: m.ProductID,
+		Stock:     m.Stock,
+		Reserved:  m.Reserved,
+		CreatedAt: m.CreatedAt,
+	}
+}
+
+func ToStockEventsDomain(arg []*StockEvent) []*entity.StockEvent {
+	if len(arg) == 0 {
+		return nil
+	}
+
+	res := make([]*entity.StockEvent, 0, len(arg))
+
+	for i := range arg {
+		if arg[i] == nil {
+			continue
+		}
+
+		res = append(res, arg[i].ToDomain())
+	}
+
+	return res
+}
@@ -0,0 +1,164 @@
+package model
+
+import (
+	"inventory-service/internal/domain/entity"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type Webhook struct {
+	bun.BaseModel `bun:"table:webhooks,alias:webhook"`
+	Base
+	URL          string     `bun:"url,notnull"`
+	EventTypes   []string   `bun:"event_types,array,notnull"`
+	Secret       string     `bun:"secret,notnull"`
+	Active       bool       `bun:"active,notnull"`
+	FailureCount int        `bun:"failure_count,notnull"`
+	DisabledAt   *time.Time `bun:"disabled_at"`
+}
+
+func (m *Webhook) ToDomain() *entity.Webhook {
+	if m == nil {
+		return nil
+	}
+
+	return &entity.Webhook{
+		Base: entity.Base{
+			ID:        m.ID,
+			CreatedAt: m.CreatedAt,
+			UpdatedAt: m.UpdatedAt,
+			DeletedAt: m.DeletedAt,
+		},
+		URL:          m.URL,
+		EventTypes:   m.EventTypes,
+		Secret:       m.Secret,
+		Active:       m.Active,
+		FailureCount: m.FailureCount,
+		DisabledAt:   m.DisabledAt,
+	}
+}
+
+func ToWebhooksDomain(arg []*Webhook) []*entity.Webhook {
+	if len(arg) == 0 {
+		return nil
+	}
+
+	res := make([]*entity.Webhook, 0, len(arg))
+
+	for i := range arg {
+		if arg[i] == nil {
+			continue
+		}
+
+		res = append(res, arg[i].ToDomain())
+	}
+
+	return res
+}
+
+func AsWebhook(arg *entity.Webhook) *Webhook {
+	if arg == nil {
+		return nil
+	}
+
+	return &Webhook{
+		Base: Base{
+			ID:        arg.ID,
+			CreatedAt: arg.CreatedAt,
+			UpdatedAt: arg.UpdatedAt,
+			DeletedAt: arg.DeletedAt,
+		},
+		URL:          arg.URL,
+		EventTypes:   arg.EventTypes,
+		Secret:       arg.Secret,
+		Active:       arg.Active,
+		FailureCount: arg.FailureCount,
+		DisabledAt:   arg.DisabledAt,
+	}
+}
+
+type WebhookDelivery struct {
+	bun.BaseModel  `bun:"table:webhook_deliveries,alias:webhook_delivery"`
+	ID             uint64         `bun:"id,pk,autoincrement"`
+	WebhookID      uint32         `bun:"webhook_id,notnull"`
+	EventID        uint64         `bun:"event_id,notnull"`
+	EventType      string         `bun:"event_type,notnull"`
+	Payload        map[string]any `bun:"payload,type:jsonb,notnull"`
+	Status         string         `bun:"status,notnull"`
+	Attempts       int            `bun:"attempts,notnull"`
+	NextAttemptAt  time.Time      `bun:"next_attempt_at,notnull,default:current_timestamp"`
+	ResponseStatus int            `bun:"response_status,notnull"`
+	ResponseBody   string         `bun:"response_body,notnull"`
+	LastError      string         `bun:"last_error,notnull"`
+	DeliveredAt    *time.Time     `bun:"delivered_at"`
+	CreatedAt      time.Time      `bun:"created_at,notnull,default:current_timestamp"`
+	UpdatedAt      time.Time      `bun:"updated_at,notnull,default:current_timestamp"`
+}
+
+func (m *WebhookDelivery) ToDomain() *entity.WebhookDelivery {
+	if m == nil {
+		return nil
+	}
+
+	return &entity.WebhookDelivery{
+		ID:             m.ID,
+		WebhookID:      m.WebhookID,
+		EventID:        m.EventID,
+		EventType:      m.EventType,
+		Payload:        m.Payload,
+		Status:         m.Status,
+		Attempts:       m.Attempts,
+		NextAttemptAt:  m.NextAttemptAt,
+		ResponseStatus: m.ResponseStatus,
+		ResponseBody:   m.ResponseBody,
+		LastError:      m.LastError,
+		DeliveredAt:    m.DeliveredAt,
+		CreatedAt:      m.CreatedAt,
+	}
+}
+
+func ToWebhookDeliveriesDomain(arg []*WebhookDelivery) []*entity.WebhookDelivery {
+	if len(arg) == 0 {
+		return nil
+	}
+
+	res := make([]*entity.WebhookDelivery, 0, len(arg))
+
+	for i := range arg {
+		if arg[i] == nil {
+			continue
+		}
+
+		res = append(res, arg[i].ToDomain())
+	}
+
+	return res
+}
+
+func AsWebhookDelivery(arg *entity.WebhookDelivery) *WebhookDelivery {
+	if arg == nil {
+		return nil
+	}
+
+	payload := arg.Payload
+	if payload == nil {
+		payload = map[string]any{}
+	}
+
+	return &WebhookDelivery{
+		ID:             arg.ID,
+		WebhookID:      arg.WebhookID,
+		EventID:        arg.EventID,
+		EventType:      arg.EventType,
+		Payload:        payload,
+		Status:         arg.Status,
+		Attempts:       arg.Attempts,
+		NextAttemptAt:  arg.NextAttemptAt,
+		ResponseStatus: arg.ResponseStatus,
+		ResponseBody:   arg.ResponseBody,
+		LastError:      arg.LastError,
+		DeliveredAt:    arg.DeliveredAt,
+		CreatedAt:      arg.CreatedAt,
+	}
+}
@@ -0,0 +1,177 @@
+package postgresrepository
+
+import (
+	"cmp"
+	"context"
+	"inventory-service/constant"
+	"inventory-service/internal/adapter/repository/postgres/model"
+	"inventory-service/internal/domain/entity"
+	"inventory-service/internal/shared/exception"
+	"slices"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+var _ OutboxRepository = (*outboxRepository)(nil)
+
+type OutboxRepository interface {
+	Create(ctx context.Context, events []*entity.OutboxEvent) error
+	FindDeliverable(ctx context.Context, now time.Time, limit int) ([]*entity.OutboxEvent, error)
+	MarkDelivered(ctx context.Context, ids []uint64, deliveredAt time.Time) error
+	MarkFailed(ctx context.Context, event *entity.OutboxEvent) error
+}
+
+type outboxRepository struct {
+	properties
+}
+
+func NewOutboxRepository(props properties) *outboxRepository {
+	return &outboxRepository{properties: props}
+}
+
+func (r *outboxRepository) GetTableName() string {
+	return "outbox_events"
+}
+
+// Create stores pending events, in the order given, with one multi-row INSERT.
+func (r *outboxRepository) Create(ctx context.Context, events []*entity.OutboxEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	dbEvents := make([]*model.OutboxEvent, len(events))
+
+	for i, event := range events {
+		if event == nil {
+			return exception.ErrDataNull
+		}
+
+		dbEvents[i] = model.AsOutboxEvent(event)
+		dbEvents[i].Status = constant.OutboxStatusPending
+		dbEvents[i].NextAttemptAt = now
+	}
+
+	if _, err := r.db.NewInsert().Model(&dbEvents).Exec(ctx); err != nil {
+		return exception.NewDBError(err, r.GetTableName(), "create outbox events")
+	}
+
+	return nil
+}
+
+// FindDeliverable locks and returns up to limit pending events, ordered by
+// ID, so the events of a partition key come oldest first. A key is taken
+// when its oldest pending event is due and not locked by another relay;
+// its later pending events are returned with it, so a busy key does not
+// wait for a poll per event. Keys whose oldest event waits for a retry are
+// left alone, so a later event never overtakes it.
+func (r *outboxRepository) FindDeliverable(ctx context.Context, now time.Time, limit int) ([]*entity.OutboxEvent, error) {
+	var heads []*model.OutboxEvent
+
+	earlier := r.db.NewSelect().
+		TableExpr("? AS earlier", bun.Ident(r.GetTableName())).
+		ColumnExpr("1").
+		Where("earlier.partition_key = outbox_event.partition_key").
+		Where("earlier.status = ?", constant.OutboxStatusPending).
+		Where("earlier.id < outbox_event.id")
+
+	query := r.db.NewSelect().
+		Model(&heads).
+		Where("outbox_event.status = ?", constant.OutboxStatusPending).
+		Where("outbox_event.next_attempt_at <= ?", now).
+		Where("NOT EXISTS (?)", earlier).
+		Order("outbox_event.id ASC").
+		For("UPDATE SKIP LOCKED")
+
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	if err := query.Scan(ctx); err != nil {
+		return nil, exception.NewDBError(err, r.GetTableName(), "find deliverable outbox events")
+	}
+
+	if len(heads) == 0 || (limit > 0 && len(heads) >= limit) {
+		return model.ToOutboxEventsDomain(heads), nil
+	}
+
+	keys := make([]string, 0, len(heads))
+	ids := make([]uint64, 0, len(heads))
+
+	for _, head := range heads {
+		keys = append(keys, head.PartitionKey)
+		ids = append(ids, head.ID)
+	}
+
+	// Only the relay holding the oldest event of a key takes the others, so
+	// waiting for their locks never blocks. Taking the lowest IDs takes the
+	// oldest events of every key.
+	var later []*model.OutboxEvent
+
+	query = r.db.NewSelect().
+		Model(&later).
+		Where("outbox_event.status = ?", constant.OutboxStatusPending).
+		Where("outbox_event.partition_key IN (?)", bun.In(keys)).
+		Where("outbox_event.id NOT IN (?)", bun.In(ids)).
+		Order("outbox_event.id ASC").
+		For("UPDATE")
+
+	if limit > 0 {
+		query = query.Limit(limit - len(heads))
+	}
+
+	if err := query.Scan(ctx); err != nil {
+		return nil, exception.NewDBError(err, r.GetTableName(), "find deliverable outbox events")
+	}
+
+	events := append(heads, later...)
+	slices.SortFunc(events, func(a, b *model.OutboxEvent) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	return model.ToOutboxEventsDomain(events), nil
+}
+
+func (r *outboxRepository) MarkDelivered(ctx context.Context, ids []uint64, deliveredAt time.Time) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	_, err := r.db.NewUpdate().
+		Model((*model.OutboxEvent)(nil)).
+		Set("status = ?", constant.OutboxStatusDelivered).
+		Set("delivered_at = ?", deliveredAt).
+		Set("last_error = ''").
+		Set("updated_at = CURRENT_TIMESTAMP").
+		Where("id IN (?)", bun.In(ids)).
+		Exec(ctx)
+	if err != nil {
+		return exception.NewDBError(err, r.GetTableName(), "mark outbox events delivered")
+	}
+
+	return nil
+}
+
+// MarkFailed stores the status, attempts, next attempt time and last error of
+// an event whose delivery failed.
+func (r *outboxRepository) MarkFailed(ctx context.Context, event *entity.OutboxEvent) error {
+	if event == nil {
+		return exception.ErrDataNull
+	}
+
+	_, err := r.db.NewUpdate().
+		Model((*model.OutboxEvent)(nil)).
+		Set("status = ?", event.Status).
+		Set("attempts = ?", event.Attempts).
+		Set("next_attempt_at = ?", event.NextAttemptAt).
+		Set("last_error = ?", event.LastError).
+		Set("updated_at = CURRENT_TIMESTAMP").
+		Where("id = ?", event.ID).
+		Exec(ctx)
+	if err != nil {
+		return exception.NewDBError(err, r.GetTableName(), "mark outbox event failed")
+	}
+
+	return nil
+}
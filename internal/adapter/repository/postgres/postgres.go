@@ -21,6 +21,18 @@ type PostgresRepository interface {
 	Close() error
 	Product() ProductRepository
 	Reservation() ReservationRepository
+	Category() CategoryRepository
+	KitComponent() KitComponentRepository
+	Lot() LotRepository
+	Serial() SerialRepository
+	ProductUnit() ProductUnitRepository
+	PriceChange() PriceChangeRepository
+	StockEvent() StockEventRepository
+	Outbox() OutboxRepository
+	Webhook() WebhookRepository
+	WebhookDelivery() WebhookDeliveryRepository
+	ProcessedMessage() ProcessedMessageRepository
+	AuditEvent() AuditEventRepository
 }
 
 type properties struct {
@@ -31,8 +43,20 @@ type properties struct {
 
 type postgresRepository struct {
 	properties
-	productRepository     ProductRepository
-	reservationRepository ReservationRepository
+	productRepository          ProductRepository
+	reservationRepository      ReservationRepository
+	categoryRepository         CategoryRepository
+	kitComponentRepository     KitComponentRepository
+	lotRepository              LotRepository
+	serialRepository           SerialRepository
+	productUnitRepository      ProductUnitRepository
+	priceChangeRepository      PriceChangeRepository
+	stockEventRepository       StockEventRepository
+	outboxRepository           OutboxRepository
+	webhookRepository          WebhookRepository
+	webhookDeliveryRepository  WebhookDeliveryRepository
+	processedMessageRepository ProcessedMessageRepository
+	auditEventRepository       AuditEventRepository
 }
 
 func NewPostgresRepository(config *config.Config, logger logger.Logger) (*postgresRepository, error) {
@@ -44,6 +68,19 @@ func NewPostgresRepository(config *config.Config, logger logger.Logger) (*postgr
 	db.DB().RegisterModel(
 		(*model.Product)(nil),
 		(*model.Reservation)(nil),
+		(*model.Category)(nil),
+		(*model.KitComponent)(nil),
+		(*model.Lot)(nil),
+		(*model.LotAllocation)(nil),
+		(*model.Serial)(nil),
+		(*model.ProductUnit)(nil),
+		(*model.PriceChange)(nil),
+		(*model.StockEvent)(nil),
+		(*model.OutboxEvent)(nil),
+		(*model.Webhook)(nil),
+		(*model.WebhookDelivery)(nil),
+		(*model.ProcessedMessage)(nil),
+		(*model.AuditEvent)(nil),
 	)
 
 	return create(config, db.DB(), logger), nil
@@ -86,9 +123,21 @@ func create(config *config.Config, db bun.IDB, logger logger.Logger) *postgresRe
 	}
 
 	return &postgresRepository{
-		properties:            props,
-		productRepository:     NewProductRepository(props),
-		reservationRepository: NewReservationRepository(props),
+		properties:                 props,
+		productRepository:          NewProductRepository(props),
+		reservationRepository:      NewReservationRepository(props),
+		categoryRepository:         NewCategoryRepository(props),
+		kitComponentRepository:     NewKitComponentRepository(props),
+		lotRepository:              NewLotRepository(props),
+		serialRepository:           NewSerialRepository(props),
+		productUnitRepository:      NewProductUnitRepository(props),
+		priceChangeRepository:      NewPriceChangeRepository(props),
+		stockEventRepository:       NewStockEventRepository(props),
+		outboxRepository:           NewOutboxRepository(props),
+		webhookRepository:          NewWebhookRepository(props),
+		webhookDeliveryRepository:  NewWebhookDeliveryRepository(props),
+		processedMessageRepository: NewProcessedMessageRepository(props),
+		auditEventRepository:       NewAuditEventRepository(props),
 	}
 }
 
@@ -99,3 +148,51 @@ func (r *postgresRepository) Product() ProductRepository {
 func (r *postgresRepository) Reservation() ReservationRepository {
 	return r.reservationRepository
 }
+
+func (r *postgresRepository) Category() CategoryRepository {
+	return r.categoryRepository
+}
+
+func (r *postgresRepository) KitComponent() KitComponentRepository {
+	return r.kitComponentRepository
+}
+
+func (r *postgresRepository) Lot() LotRepository {
+	return r.lotRepository
+}
+
+func (r *postgresRepository) Serial() SerialRepository {
+	return r.serialRepository
+}
+
+func (r *postgresRepository) ProductUnit() ProductUnitRepository {
+	return r.productUnitRepository
+}
+
+func (r *postgresRepository) PriceChange() PriceChangeRepository {
+	return r.priceChangeRepository
+}
+
+func (r *postgresRepository) StockEvent() StockEventRepository {
+	return r.stockEventRepository
+}
+
+func (r *postgresRepository) Outbox() OutboxRepository {
+	return r.outboxRepository
+}
+
+func (r *postgresRepository) Webhook() WebhookRepository {
+	return r.webhookRepository
+}
+
+func (r *postgresRepository) WebhookDelivery() WebhookDeliveryRepository {
+	return r.webhookDeliveryRepository
+}
+
+func (r *postgresRepository) ProcessedMessage() ProcessedMessageRepository {
+	return r.processedMessageRepository
+}
+
+func (r *postgresRepository) AuditEvent() AuditEventRepository {
+	return r.auditEventRepository
+}
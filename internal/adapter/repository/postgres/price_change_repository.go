@@ -0,0 +1,293 @@
+package postgresrepository
+
+import (
+	"context"
+	"database/sql"
+	"inventory-service/internal/adapter/repository/postgres/model"
+	"inventory-service/internal/domain/entity"
+	"inventory-service/internal/shared/exception"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/uptrace/bun"
+)
+
+var _ PriceChangeRepository = (*priceChangeRepository)(nil)
+
+type PriceChangeRepository interface {
+	Find(ctx context.Context, filter *FilterPriceChangePayload) ([]*entity.PriceChange, int, error)
+	FindByID(ctx context.Context, id uint32) (*entity.PriceChange, error)
+	FindEffective(ctx context.Context, productID uint32, at time.Time) (*entity.PriceChange, error)
+	FindDue(ctx context.Context, now time.Time, limit int) ([]*entity.PriceChange, error)
+	Create(ctx context.Context, change *entity.PriceChange) (*entity.PriceChange, error)
+	Record(ctx context.Context, productID uint32, price entity.Money) error
+	RecordMany(ctx context.Context, prices map[uint32]entity.Money) error
+	MarkApplied(ctx context.Context, ids []uint32, appliedAt time.Time) error
+	Delete(ctx context.Context, id uint32) error
+}
+
+type priceChangeRepository struct {
+	properties
+}
+
+func NewPriceChangeRepository(props properties) *priceChangeRepository {
+	return &priceChangeRepository{properties: props}
+}
+
+func (r *priceChangeRepository) GetTableName() string {
+	return "price_changes"
+}
+
+type FilterPriceChangePayload struct {
+	IDs        []uint32
+	ProductIDs []uint32
+	// PendingOnly keeps only scheduled changes that have not been applied yet.
+	PendingOnly bool
+	Page        int
+	PerPage     int
+}
+
+func (r *priceChangeRepository) Find(ctx context.Context, filter *FilterPriceChangePayload) ([]*entity.PriceChange, int, error) {
+	var changes []*model.PriceChange
+
+	query := r.db.NewSelect().Model(&changes)
+
+	if len(filter.IDs) > 0 {
+		query = query.Where("id IN (?)", bun.In(filter.IDs))
+	}
+
+	if len(filter.ProductIDs) > 0 {
+		query = query.Where("product_id IN (?)", bun.In(filter.ProductIDs))
+	}
+
+	if filter.PendingOnly {
+		query = query.Where("applied_at IS NULL")
+	}
+
+	totalCount, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, exception.NewDBError(err, r.GetTableName(), "count price change")
+	}
+
+	if totalCount == 0 {
+		return []*entity.PriceChange{}, 0, nil
+	}
+
+	if filter.PerPage > 0 {
+		query = query.Limit(filter.PerPage)
+	}
+
+	if filter.Page > 0 && filter.PerPage > 0 {
+		offset := (filter.Page - 1) * filter.PerPage
+		query = query.Offset(offset)
+	}
+
+	query = query.Order("effective_from DESC", "id DESC")
+	if err := query.Scan(ctx); err != nil {
+		return nil, 0, exception.NewDBError(err, r.GetTableName(), "find price change")
+	}
+
+	return model.ToPriceChangesDomain(changes), totalCount, nil
+}
+
+func (r *priceChangeRepository) FindByID(ctx context.Context, id uint32) (*entity.PriceChange, error) {
+	if id == 0 {
+		return nil, exception.ErrIDNull
+	}
+
+	dbChange := &model.PriceChange{}
+
+	err := r.db.NewSelect().Model(dbChange).Where("id = ?", id).Scan(ctx)
+	if err != nil {
+		return nil, exception.NewDBError(err, r.GetTableName(), "find price change by id")
+	}
+
+	return dbChange.ToDomain(), nil
+}
+
+// FindEffective returns the change in force for the product at the given
+// time, which is the latest one that took or takes effect no later than it.
+func (r *priceChangeRepository) FindEffective(ctx context.Context, productID uint32, at time.Time) (*entity.PriceChange, error) {
+	if productID == 0 {
+		return nil, exception.ErrIDNull
+	}
+
+	dbChange := &model.PriceChange{}
+
+	err := r.db.NewSelect().
+		Model(dbChange).
+		Where("product_id = ?", productID).
+		Where("effective_from <= ?", at).
+		Order("effective_from DESC", "id DESC").
+		Limit(1).
+		Scan(ctx)
+	if err != nil {
+		return nil, exception.NewDBError(err, r.GetTableName(), "find effective price change")
+	}
+
+	return dbChange.ToDomain(), nil
+}
+
+// FindDue locks and returns up to limit pending changes whose effective time
+// has come, oldest first. Rows locked by another scheduler are skipped.
+func (r *priceChangeRepository) FindDue(ctx context.Context, now time.Time, limit int) ([]*entity.PriceChange, error) {
+	var changes []*model.PriceChange
+
+	query := r.db.NewSelect().
+		Model(&changes).
+		Where("applied_at IS NULL").
+		Where("effective_from <= ?", now).
+		Order("effective_from ASC", "id ASC").
+		For("UPDATE SKIP LOCKED")
+
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	if err := query.Scan(ctx); err != nil {
+		return nil, exception.NewDBError(err, r.GetTableName(), "find due price changes")
+	}
+
+	return model.ToPriceChangesDomain(changes), nil
+}
+
+func (r *priceChangeRepository) Create(ctx context.Context, change *entity.PriceChange) (*entity.PriceChange, error) {
+	if change == nil {
+		return nil, exception.ErrDataNull
+	}
+
+	dbChange := model.AsPriceChange(change)
+
+	_, err := r.db.NewInsert().Model(dbChange).Exec(ctx)
+	if err != nil {
+		return nil, exception.NewDBError(err, r.GetTableName(), "create price change")
+	}
+
+	return dbChange.ToDomain(), nil
+}
+
+// Record adds an applied change taking effect now, unless the product's
+// latest applied price already equals the given one.
+func (r *priceChangeRepository) Record(ctx context.Context, productID uint32, price entity.Money) error {
+	if productID == 0 {
+		return exception.ErrIDNull
+	}
+
+	latest := &model.PriceChange{}
+
+	err := r.db.NewSelect().
+		Model(latest).
+		Where("product_id = ?", productID).
+		Where("applied_at IS NOT NULL").
+		Order("effective_from DESC", "id DESC").
+		Limit(1).
+		Scan(ctx)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return exception.NewDBError(err, r.GetTableName(), "find latest price change")
+	}
+
+	if err == nil && latest.ToDomain().Price == price {
+		return nil
+	}
+
+	now := time.Now()
+
+	_, err = r.Create(ctx, &entity.PriceChange{
+		ProductID:     productID,
+		Price:         price,
+		EffectiveFrom: now,
+		AppliedAt:     &now,
+	})
+
+	return err
+}
+
+// RecordMany is Record for many products at once: one query finds the latest
+// applied prices and one multi-row INSERT adds the changed ones.
+func (r *priceChangeRepository) RecordMany(ctx context.Context, prices map[uint32]entity.Money) error {
+	if len(prices) == 0 {
+		return nil
+	}
+
+	productIDs := make([]uint32, 0, len(prices))
+	for productID := range prices {
+		productIDs = append(productIDs, productID)
+	}
+
+	var latest []*model.PriceChange
+
+	err := r.db.NewSelect().
+		Model(&latest).
+		DistinctOn("product_id").
+		Where("product_id IN (?)", bun.In(productIDs)).
+		Where("applied_at IS NOT NULL").
+		Order("product_id ASC", "effective_from DESC", "id DESC").
+		Scan(ctx)
+	if err != nil {
+		return exception.NewDBError(err, r.GetTableName(), "find latest price changes")
+	}
+
+	current := make(map[uint32]entity.Money, len(latest))
+	for _, change := range latest {
+		current[change.ProductID] = change.ToDomain().Price
+	}
+
+	now := time.Now()
+	changes := make([]*model.PriceChange, 0, len(prices))
+
+	for _, productID := range productIDs {
+		if price, ok := current[productID]; ok && price == prices[productID] {
+			continue
+		}
+
+		changes = append(changes, model.AsPriceChange(&entity.PriceChange{
+			ProductID:     productID,
+			Price:         prices[productID],
+			EffectiveFrom: now,
+			AppliedAt:     &now,
+		}))
+	}
+
+	if len(changes) == 0 {
+		return nil
+	}
+
+	if _, err := r.db.NewInsert().Model(&changes).Exec(ctx); err != nil {
+		return exception.NewDBError(err, r.GetTableName(), "create price changes")
+	}
+
+	return nil
+}
+
+func (r *priceChangeRepository) MarkApplied(ctx context.Context, ids []uint32, appliedAt time.Time) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	_, err := r.db.NewUpdate().
+		Model((*model.PriceChange)(nil)).
+		Set("applied_at = ?", appliedAt).
+		Set("updated_at = CURRENT_TIMESTAMP").
+		Where("id IN (?)", bun.In(ids)).
+		Exec(ctx)
+	if err != nil {
+		return exception.NewDBError(err, r.GetTableName(), "mark price changes applied")
+	}
+
+	return nil
+}
+
+func (r *priceChangeRepository) Delete(ctx context.Context, id uint32) error {
+	if id == 0 {
+		return exception.ErrIDNull
+	}
+
+	dbChange := &model.PriceChange{Base: model.Base{ID: id}}
+
+	_, err := r.db.NewDelete().Model(dbChange).WherePK().Exec(ctx)
+	if err != nil {
+		return exception.NewDBError(err, r.GetTableName(), "delete price change")
+	}
+
+	return nil
+}
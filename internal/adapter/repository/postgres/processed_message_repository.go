@@ -0,0 +1,49 @@
+package postgresrepository
+
+import (
+	"context"
+	"inventory-service/internal/adapter/repository/postgres/model"
+	"inventory-service/internal/shared/exception"
+)
+
+var _ ProcessedMessageRepository = (*processedMessageRepository)(nil)
+
+type ProcessedMessageRepository interface {
+	Claim(ctx context.Context, messageID, topic string) (bool, error)
+}
+
+type processedMessageRepository struct {
+	properties
+}
+
+func NewProcessedMessageRepository(props properties) *processedMessageRepository {
+	return &processedMessageRepository{properties: props}
+}
+
+func (r *processedMessageRepository) GetTableName() string {
+	return "processed_messages"
+}
+
+// Claim records a message as processed and reports whether it was new. Run it
+// in the transaction that handles the message, so the claim is released if
+// handling fails.
+func (r *processedMessageRepository) Claim(ctx context.Context, messageID, topic string) (bool, error) {
+	if messageID == "" {
+		return false, exception.ErrIDNull
+	}
+
+	res, err := r.db.NewInsert().
+		Model(&model.ProcessedMessage{MessageID: messageID, Topic: topic}).
+		On("CONFLICT (message_id) DO NOTHING").
+		Exec(ctx)
+	if err != nil {
+		return false, exception.NewDBError(err, r.GetTableName(), "claim processed message")
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, exception.NewDBError(err, r.GetTableName(), "claim processed message")
+	}
+
+	return affected == 1, nil
+}
@@ -2,21 +2,36 @@ package postgresrepository
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
 	"inventory-service/internal/adapter/repository/postgres/model"
 	"inventory-service/internal/domain/entity"
 	"inventory-service/internal/shared/exception"
+	"strings"
 
+	"github.com/cockroachdb/errors"
 	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
 )
 
 var _ ProductRepository = (*productRepository)(nil)
 
 type ProductRepository interface {
 	FindByID(ctx context.Context, id uint32) (*entity.Product, error)
+	LockByID(ctx context.Context, id uint32) (*entity.Product, error)
+	FindBySKU(ctx context.Context, sku string) (*entity.Product, error)
+	FindByBarcode(ctx context.Context, barcode string) (*entity.Product, error)
 	Find(ctx context.Context, filter *FilterProductPayload) ([]*entity.Product, int, error)
+	Stream(ctx context.Context, filter *FilterProductPayload, fn func(*entity.Product) error) error
 	Create(ctx context.Context, product *entity.Product) (*entity.Product, error)
+	CreateMany(ctx context.Context, products []*entity.Product) ([]*entity.Product, error)
 	Delete(ctx context.Context, id uint32) error
 	Update(ctx context.Context, product *entity.Product) (*entity.Product, error)
+	UpdateMany(ctx context.Context, products []*entity.Product) ([]*entity.Product, error)
+	ReserveStock(ctx context.Context, id uint32, quantity int) error
+	ReleaseStock(ctx context.Context, id uint32, quantity int) error
+	UpdatePrice(ctx context.Context, id uint32, price entity.Money) error
+	UpdateStatus(ctx context.Context, id uint32, status string) error
 }
 
 type productRepository struct {
@@ -32,36 +47,57 @@ func (r *productRepository) GetTableName() string {
 }
 
 type FilterProductPayload struct {
-	IDs     []uint32
-	Names   []string
-	Search  string
-	Page    int
-	PerPage int
+	IDs        []uint32
+	SKUs       []string
+	Names      []string
+	CategoryID uint32
+	// IncludeDescendants widens CategoryID to every category below it.
+	IncludeDescendants bool
+	ParentIDs          []uint32
+	// ExcludeVariants limits the result to standalone and parent products.
+	ExcludeVariants bool
+	Statuses        []string
+	Attributes      []*AttributeFilter
+	Search          string
+	Page            int
+	PerPage         int
 }
 
-func (r *productRepository) Find(ctx context.Context, filter *FilterProductPayload) ([]*entity.Product, int, error) {
-	var products []*model.Product
+// AttributeFilter matches products on a single attribute. Key is a dotted path
+// into nested objects, e.g. "dimensions.length". Equals matches the value
+// exactly, Min and Max bound numeric values inclusively and Exists only
+// requires the attribute to be present.
+type AttributeFilter struct {
+	Key    string
+	Equals any
+	Min    *float64
+	Max    *float64
+	Exists bool
+}
 
-	query := r.db.NewSelect().Model(&products)
+// path splits the key into the segments of a JSONB path.
+func (f *AttributeFilter) path() []string {
+	return strings.Split(f.Key, ".")
+}
 
-	if len(filter.IDs) > 0 {
-		query = query.Where("id IN (?)", bun.In(filter.IDs))
-	}
+// containment nests Equals under the key's path for a @> match.
+func (f *AttributeFilter) containment() map[string]any {
+	path := f.path()
+	doc := map[string]any{path[len(path)-1]: f.Equals}
 
-	if len(filter.Names) > 0 {
-		query = query.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
-			for i := range filter.Names {
-				q = q.WhereOr("LOWER(name) LIKE LOWER(?)", "%"+filter.Names[i]+"%")
-			}
-			return q
-		})
+	for i := len(path) - 2; i >= 0; i-- {
+		doc = map[string]any{path[i]: doc}
 	}
 
-	if filter.Search != "" {
-		query = query.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
-			q = q.WhereOr("LOWER(name) LIKE LOWER(?)", "%"+filter.Search+"%")
-			return q
-		})
+	return doc
+}
+
+func (r *productRepository) Find(ctx context.Context, filter *FilterProductPayload) ([]*entity.Product, int, error) {
+	var products []*model.Product
+
+	query, err := applyProductFilter(r.db.NewSelect().Model(&products), filter)
+	if err != nil {
+		return nil, 0, exception.NewDBError(err, r.GetTableName(), "find product")
 	}
 
 	totalCount, err := query.Clone().Count(ctx)
@@ -90,6 +126,94 @@ func (r *productRepository) Find(ctx context.Context, filter *FilterProductPaylo
 	return model.ToProductsDomain(products), totalCount, nil
 }
 
+// Stream calls fn for every product matching the filter in id order, reading
+// them through a database cursor instead of loading the whole result. Paging
+// fields are ignored. Iteration stops at the first error returned by fn, which
+// is passed through as is.
+func (r *productRepository) Stream(ctx context.Context, filter *FilterProductPayload, fn func(*entity.Product) error) error {
+	query, err := applyProductFilter(r.db.NewSelect().Model((*model.Product)(nil)), filter)
+	if err != nil {
+		return exception.NewDBError(err, r.GetTableName(), "stream product")
+	}
+
+	var fnErr error
+
+	err = streamCursor(ctx, r.db, query.Order("id ASC"), func(products []model.Product) error {
+		for i := range products {
+			if fnErr = fn(products[i].ToDomain()); fnErr != nil {
+				return fnErr
+			}
+		}
+
+		return nil
+	})
+	if fnErr != nil {
+		return fnErr
+	}
+	if err != nil {
+		return exception.NewDBError(err, r.GetTableName(), "stream product")
+	}
+
+	return nil
+}
+
+func applyProductFilter(query *bun.SelectQuery, filter *FilterProductPayload) (*bun.SelectQuery, error) {
+	if len(filter.IDs) > 0 {
+		query = query.Where("id IN (?)", bun.In(filter.IDs))
+	}
+
+	if len(filter.SKUs) > 0 {
+		query = query.Where("sku IN (?)", bun.In(filter.SKUs))
+	}
+
+	if filter.CategoryID > 0 {
+		if filter.IncludeDescendants {
+			query = query.Where("category_id IN ("+descendantCategoryIDsQuery+")", filter.CategoryID)
+		} else {
+			query = query.Where("category_id = ?", filter.CategoryID)
+		}
+	}
+
+	if len(filter.ParentIDs) > 0 {
+		query = query.Where("parent_id IN (?)", bun.In(filter.ParentIDs))
+	}
+
+	if filter.ExcludeVariants {
+		query = query.Where("parent_id IS NULL")
+	}
+
+	if len(filter.Statuses) > 0 {
+		query = query.Where("status IN (?)", bun.In(filter.Statuses))
+	}
+
+	for _, attribute := range filter.Attributes {
+		var err error
+		if query, err = applyAttributeFilter(query, attribute); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(filter.Names) > 0 {
+		query = query.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			for i := range filter.Names {
+				q = q.WhereOr("LOWER(name) LIKE LOWER(?)", "%"+filter.Names[i]+"%")
+			}
+			return q
+		})
+	}
+
+	if filter.Search != "" {
+		query = query.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			q = q.WhereOr("LOWER(name) LIKE LOWER(?)", "%"+filter.Search+"%")
+			q = q.WhereOr("LOWER(sku) LIKE LOWER(?)", "%"+filter.Search+"%")
+			q = q.WhereOr("barcode = ?", filter.Search)
+			return q
+		})
+	}
+
+	return query, nil
+}
+
 func (r *productRepository) FindByID(ctx context.Context, id uint32) (*entity.Product, error) {
 	if id == 0 {
 		return nil, exception.ErrIDNull
@@ -104,6 +228,49 @@ func (r *productRepository) FindByID(ctx context.Context, id uint32) (*entity.Pr
 	return product.ToDomain(), nil
 }
 
+// LockByID returns the product and locks its row until the transaction ends.
+func (r *productRepository) LockByID(ctx context.Context, id uint32) (*entity.Product, error) {
+	if id == 0 {
+		return nil, exception.ErrIDNull
+	}
+
+	product := &model.Product{Base: model.Base{ID: id}}
+
+	if err := r.db.NewSelect().Model(product).WherePK().For("UPDATE").Scan(ctx); err != nil {
+		return nil, exception.NewDBError(err, r.GetTableName(), "lock product by id")
+	}
+
+	return product.ToDomain(), nil
+}
+
+func (r *productRepository) FindBySKU(ctx context.Context, sku string) (*entity.Product, error) {
+	if sku == "" {
+		return nil, exception.ErrIDNull
+	}
+
+	product := new(model.Product)
+
+	if err := r.db.NewSelect().Model(product).Where("sku = ?", sku).Scan(ctx); err != nil {
+		return nil, exception.NewDBError(err, r.GetTableName(), "find product by sku")
+	}
+
+	return product.ToDomain(), nil
+}
+
+func (r *productRepository) FindByBarcode(ctx context.Context, barcode string) (*entity.Product, error) {
+	if barcode == "" {
+		return nil, exception.ErrIDNull
+	}
+
+	product := new(model.Product)
+
+	if err := r.db.NewSelect().Model(product).Where("barcode = ?", barcode).Scan(ctx); err != nil {
+		return nil, exception.NewDBError(err, r.GetTableName(), "find product by barcode")
+	}
+
+	return product.ToDomain(), nil
+}
+
 func (r *productRepository) Create(ctx context.Context, product *entity.Product) (*entity.Product, error) {
 	if product == nil {
 		return nil, exception.ErrDataNull
@@ -126,14 +293,59 @@ func (r *productRepository) Update(ctx context.Context, product *entity.Product)
 
 	dbProduct := model.AsProduct(product)
 
-	_, err := r.db.NewUpdate().Model(dbProduct).WherePK().Exec(ctx)
+	// The status only changes through UpdateStatus, which checks the transition.
+	res, err := r.db.NewUpdate().Model(dbProduct).ExcludeColumn("status").WherePK().Returning("status").Exec(ctx)
 	if err != nil {
 		return nil, exception.NewDBError(err, r.GetTableName(), "update product")
 	}
 
+	if affected, err := res.RowsAffected(); err == nil && affected == 0 {
+		return nil, exception.NewDBError(sql.ErrNoRows, r.GetTableName(), "update product")
+	}
+
 	return dbProduct.ToDomain(), nil
 }
 
+// CreateMany inserts the products with a single multi-row INSERT and returns
+// them with their new IDs, in input order.
+func (r *productRepository) CreateMany(ctx context.Context, products []*entity.Product) ([]*entity.Product, error) {
+	if len(products) == 0 {
+		return []*entity.Product{}, nil
+	}
+
+	dbProducts := model.AsProducts(products)
+
+	_, err := r.db.NewInsert().Model(&dbProducts).Exec(ctx)
+	if err != nil {
+		return nil, exception.NewDBError(err, r.GetTableName(), "create products")
+	}
+
+	return model.ToProductsDomain(dbProducts), nil
+}
+
+// UpdateMany updates the products with a single UPDATE ... FROM (VALUES ...).
+// Like Update it leaves the status alone; the returned products carry no status.
+func (r *productRepository) UpdateMany(ctx context.Context, products []*entity.Product) ([]*entity.Product, error) {
+	if len(products) == 0 {
+		return []*entity.Product{}, nil
+	}
+
+	for _, product := range products {
+		if product == nil || product.ID == 0 {
+			return nil, exception.ErrIDNull
+		}
+	}
+
+	dbProducts := model.AsProducts(products)
+
+	_, err := r.db.NewUpdate().Model(&dbProducts).ExcludeColumn("status").Bulk().Exec(ctx)
+	if err != nil {
+		return nil, exception.NewDBError(err, r.GetTableName(), "update products")
+	}
+
+	return model.ToProductsDomain(dbProducts), nil
+}
+
 func (r *productRepository) Delete(ctx context.Context, id uint32) error {
 	if id == 0 {
 		return exception.ErrIDNull
@@ -148,3 +360,130 @@ func (r *productRepository) Delete(ctx context.Context, id uint32) error {
 
 	return nil
 }
+
+// ReserveStock takes quantity out of the product's stock, failing with
+// ErrInsufficientStock when less than quantity is on hand.
+func (r *productRepository) ReserveStock(ctx context.Context, id uint32, quantity int) error {
+	if id == 0 {
+		return exception.ErrIDNull
+	}
+
+	res, err := r.db.NewUpdate().
+		Model((*model.Product)(nil)).
+		Set("stock = stock - ?", quantity).
+		Set("updated_at = CURRENT_TIMESTAMP").
+		Where("id = ?", id).
+		Where("stock >= ?", quantity).
+		Exec(ctx)
+	if err != nil {
+		return exception.NewDBError(err, r.GetTableName(), "reserve product stock")
+	}
+
+	if affected, err := res.RowsAffected(); err == nil && affected == 0 {
+		exists, err := r.db.NewSelect().Model((*model.Product)(nil)).Where("id = ?", id).Exists(ctx)
+		if err != nil {
+			return exception.NewDBError(err, r.GetTableName(), "reserve product stock")
+		}
+
+		if !exists {
+			return exception.NewDBError(sql.ErrNoRows, r.GetTableName(), "reserve product stock")
+		}
+
+		return errors.Wrapf(exception.ErrInsufficientStock, "insufficient stock for product %d", id)
+	}
+
+	return nil
+}
+
+// ReleaseStock puts quantity back into the product's stock.
+func (r *productRepository) ReleaseStock(ctx context.Context, id uint32, quantity int) error {
+	if id == 0 {
+		return exception.ErrIDNull
+	}
+
+	_, err := r.db.NewUpdate().
+		Model((*model.Product)(nil)).
+		Set("stock = stock + ?", quantity).
+		Set("updated_at = CURRENT_TIMESTAMP").
+		Where("id = ?", id).
+		Exec(ctx)
+	if err != nil {
+		return exception.NewDBError(err, r.GetTableName(), "release product stock")
+	}
+
+	return nil
+}
+
+// UpdatePrice sets the product's price without touching its other columns.
+func (r *productRepository) UpdatePrice(ctx context.Context, id uint32, price entity.Money) error {
+	if id == 0 {
+		return exception.ErrIDNull
+	}
+
+	_, err := r.db.NewUpdate().
+		Model((*model.Product)(nil)).
+		Set("price = ?", price.String()).
+		Set("currency = ?", price.Currency).
+		Set("inherit_price = FALSE").
+		Set("updated_at = CURRENT_TIMESTAMP").
+		Where("id = ?", id).
+		Exec(ctx)
+	if err != nil {
+		return exception.NewDBError(err, r.GetTableName(), "update product price")
+	}
+
+	return nil
+}
+
+func (r *productRepository) UpdateStatus(ctx context.Context, id uint32, status string) error {
+	if id == 0 {
+		return exception.ErrIDNull
+	}
+
+	_, err := r.db.NewUpdate().
+		Model((*model.Product)(nil)).
+		Set("status = ?", status).
+		Set("updated_at = CURRENT_TIMESTAMP").
+		Where("id = ?", id).
+		Exec(ctx)
+	if err != nil {
+		return exception.NewDBError(err, r.GetTableName(), "update product status")
+	}
+
+	return nil
+}
+
+// applyAttributeFilter narrows the query by one attribute filter. Equality and
+// top-level existence use the GIN-indexed @> and ? operators.
+func applyAttributeFilter(query *bun.SelectQuery, filter *AttributeFilter) (*bun.SelectQuery, error) {
+	path := pgdialect.Array(filter.path())
+
+	if filter.Equals != nil {
+		doc, err := json.Marshal(filter.containment())
+		if err != nil {
+			return nil, errors.Wrapf(exception.ErrDataInvalid, "attribute %s: %v", filter.Key, err)
+		}
+
+		query = query.Where("attributes @> ?::jsonb", string(doc))
+	}
+
+	if filter.Exists {
+		if len(filter.path()) == 1 {
+			query = query.Where("attributes \\? ?", filter.Key)
+		} else {
+			query = query.Where("attributes #> ? IS NOT NULL", path)
+		}
+	}
+
+	const numeric = "(CASE WHEN jsonb_typeof(attributes #> ?) = 'number' THEN (attributes #>> ?)::numeric END)"
+
+	if filter.Min != nil {
+		query = query.Where(numeric+" >= ?", path, path, *filter.Min)
+	}
+
+	if filter.Max != nil {
+		query = query.Where(numeric+" <= ?", path, path, *filter.Max)
+	}
+
+	return query, nil
+}
@@ -0,0 +1,77 @@
+package postgresrepository
+
+import (
+	"context"
+	"inventory-service/internal/adapter/repository/postgres/model"
+	"inventory-service/internal/domain/entity"
+	"inventory-service/internal/shared/exception"
+
+	"github.com/uptrace/bun"
+)
+
+var _ ProductUnitRepository = (*productUnitRepository)(nil)
+
+type ProductUnitRepository interface {
+	FindByProductIDs(ctx context.Context, productIDs []uint32) ([]*entity.ProductUnit, error)
+	Replace(ctx context.Context, productID uint32, units []*entity.ProductUnit) error
+}
+
+type productUnitRepository struct {
+	properties
+}
+
+func NewProductUnitRepository(props properties) *productUnitRepository {
+	return &productUnitRepository{properties: props}
+}
+
+func (r *productUnitRepository) GetTableName() string {
+	return "product_units"
+}
+
+// FindByProductIDs returns the unit conversions of the given products,
+// smallest unit first.
+func (r *productUnitRepository) FindByProductIDs(ctx context.Context, productIDs []uint32) ([]*entity.ProductUnit, error) {
+	if len(productIDs) == 0 {
+		return []*entity.ProductUnit{}, nil
+	}
+
+	var units []*model.ProductUnit
+
+	err := r.db.NewSelect().
+		Model(&units).
+		Where("product_id IN (?)", bun.In(productIDs)).
+		Order("product_id ASC", "factor ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, exception.NewDBError(err, r.GetTableName(), "find product units")
+	}
+
+	return model.ToProductUnitsDomain(units), nil
+}
+
+// Replace swaps the product's unit conversions for the given ones.
+func (r *productUnitRepository) Replace(ctx context.Context, productID uint32, units []*entity.ProductUnit) error {
+	if productID == 0 {
+		return exception.ErrIDNull
+	}
+
+	_, err := r.db.NewDelete().Model((*model.ProductUnit)(nil)).Where("product_id = ?", productID).Exec(ctx)
+	if err != nil {
+		return exception.NewDBError(err, r.GetTableName(), "delete product units")
+	}
+
+	if len(units) == 0 {
+		return nil
+	}
+
+	dbUnits := model.AsProductUnits(units)
+	for _, unit := range dbUnits {
+		unit.ProductID = productID
+	}
+
+	if _, err := r.db.NewInsert().Model(&dbUnits).Exec(ctx); err != nil {
+		return exception.NewDBError(err, r.GetTableName(), "create product units")
+	}
+
+	return nil
+}
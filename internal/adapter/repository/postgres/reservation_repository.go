@@ -2,9 +2,11 @@ package postgresrepository
 
 import (
 	"context"
+	"inventory-service/constant"
 	"inventory-service/internal/adapter/repository/postgres/model"
 	"inventory-service/internal/domain/entity"
 	"inventory-service/internal/shared/exception"
+	"time"
 
 	"github.com/uptrace/bun"
 )
@@ -14,6 +16,8 @@ var _ ReservationRepository = (*reservationRepository)(nil)
 type ReservationRepository interface {
 	FindByID(ctx context.Context, id uint32) (*entity.Reservation, error)
 	Find(ctx context.Context, filter *FilterReservationPayload) ([]*entity.Reservation, int, error)
+	Stream(ctx context.Context, filter *FilterReservationPayload, fn func(*entity.Reservation) error) error
+	FindExpired(ctx context.Context, before time.Time, limit int) ([]*entity.Reservation, error)
 	Create(ctx context.Context, reservation *entity.Reservation) (*entity.Reservation, error)
 	UpdateStatus(ctx context.Context, ids []uint32, status string) error
 }
@@ -35,6 +39,7 @@ type FilterReservationPayload struct {
 	ProductIDs []uint32
 	OrderIDs   []uint32
 	Statuses   []string
+	ParentIDs  []uint32
 	Page       int
 	PerPage    int
 }
@@ -42,23 +47,7 @@ type FilterReservationPayload struct {
 func (r *reservationRepository) Find(ctx context.Context, filter *FilterReservationPayload) ([]*entity.Reservation, int, error) {
 	var reservations []*model.Reservation
 
-	query := r.db.NewSelect().Model(&reservations)
-
-	if len(filter.IDs) > 0 {
-		query = query.Where("id IN (?)", bun.In(filter.IDs))
-	}
-
-	if len(filter.ProductIDs) > 0 {
-		query = query.Where("product_id IN (?)", bun.In(filter.ProductIDs))
-	}
-
-	if len(filter.OrderIDs) > 0 {
-		query = query.Where("order_id IN (?)", bun.In(filter.OrderIDs))
-	}
-
-	if len(filter.Statuses) > 0 {
-		query = query.Where("status IN (?)", bun.In(filter.Statuses))
-	}
+	query := applyReservationFilter(r.db.NewSelect().Model(&reservations), filter)
 
 	totalCount, err := query.Clone().Count(ctx)
 	if err != nil {
@@ -86,6 +75,57 @@ func (r *reservationRepository) Find(ctx context.Context, filter *FilterReservat
 	return model.ToReservationsDomain(reservations), totalCount, nil
 }
 
+// Stream calls fn for every reservation matching the filter in id order,
+// reading them through a database cursor. Paging fields are ignored and errors
+// returned by fn are passed through as is.
+func (r *reservationRepository) Stream(ctx context.Context, filter *FilterReservationPayload, fn func(*entity.Reservation) error) error {
+	query := applyReservationFilter(r.db.NewSelect().Model((*model.Reservation)(nil)), filter)
+
+	var fnErr error
+
+	err := streamCursor(ctx, r.db, query.Order("id ASC"), func(reservations []model.Reservation) error {
+		for i := range reservations {
+			if fnErr = fn(reservations[i].ToDomain()); fnErr != nil {
+				return fnErr
+			}
+		}
+
+		return nil
+	})
+	if fnErr != nil {
+		return fnErr
+	}
+	if err != nil {
+		return exception.NewDBError(err, r.GetTableName(), "stream reservation")
+	}
+
+	return nil
+}
+
+func applyReservationFilter(query *bun.SelectQuery, filter *FilterReservationPayload) *bun.SelectQuery {
+	if len(filter.IDs) > 0 {
+		query = query.Where("id IN (?)", bun.In(filter.IDs))
+	}
+
+	if len(filter.ProductIDs) > 0 {
+		query = query.Where("product_id IN (?)", bun.In(filter.ProductIDs))
+	}
+
+	if len(filter.OrderIDs) > 0 {
+		query = query.Where("order_id IN (?)", bun.In(filter.OrderIDs))
+	}
+
+	if len(filter.Statuses) > 0 {
+		query = query.Where("status IN (?)", bun.In(filter.Statuses))
+	}
+
+	if len(filter.ParentIDs) > 0 {
+		query = query.Where("parent_id IN (?)", bun.In(filter.ParentIDs))
+	}
+
+	return query
+}
+
 func (r *reservationRepository) FindByID(ctx context.Context, id uint32) (*entity.Reservation, error) {
 	if id == 0 {
 		return nil, exception.ErrIDNull
@@ -100,6 +140,31 @@ func (r *reservationRepository) FindByID(ctx context.Context, id uint32) (*entit
 	return reservation.ToDomain(), nil
 }
 
+// FindExpired locks and returns up to limit pending reservations created
+// before the given time, oldest first. Kit components are left out since they
+// expire with their kit, and rows locked by another scheduler are skipped.
+func (r *reservationRepository) FindExpired(ctx context.Context, before time.Time, limit int) ([]*entity.Reservation, error) {
+	var reservations []*model.Reservation
+
+	query := r.db.NewSelect().
+		Model(&reservations).
+		Where("status = ?", constant.ReservationStatusPending).
+		Where("parent_id IS NULL").
+		Where("created_at <= ?", before).
+		Order("created_at ASC", "id ASC").
+		For("UPDATE SKIP LOCKED")
+
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	if err := query.Scan(ctx); err != nil {
+		return nil, exception.NewDBError(err, r.GetTableName(), "find expired reservations")
+	}
+
+	return model.ToReservationsDomain(reservations), nil
+}
+
 func (r *reservationRepository) Create(ctx context.Context, reservation *entity.Reservation) (*entity.Reservation, error) {
 	if reservation == nil {
 		return nil, exception.ErrDataNull
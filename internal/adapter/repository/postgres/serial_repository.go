@@ -0,0 +1,234 @@
+package postgresrepository
+
+import (
+	"context"
+	"inventory-service/constant"
+	"inventory-service/internal/adapter/repository/postgres/model"
+	"inventory-service/internal/domain/entity"
+	"inventory-service/internal/shared/exception"
+
+	"github.com/cockroachdb/errors"
+	"github.com/uptrace/bun"
+)
+
+var _ SerialRepository = (*serialRepository)(nil)
+
+type SerialRepository interface {
+	Find(ctx context.Context, filter *FilterSerialPayload) ([]*entity.Serial, int, error)
+	FindBySerialNumber(ctx context.Context, serialNumber string) (*entity.Serial, error)
+	CreateMany(ctx context.Context, serials []*entity.Serial) ([]*entity.Serial, error)
+	CountAvailable(ctx context.Context, productIDs []uint32) (map[uint32]int, error)
+	Hold(ctx context.Context, productID uint32, reservationID uint32, quantity int) ([]*entity.Serial, error)
+	Assign(ctx context.Context, reservationIDs []uint32) error
+	Release(ctx context.Context, reservationIDs []uint32) error
+}
+
+type serialRepository struct {
+	properties
+}
+
+func NewSerialRepository(props properties) *serialRepository {
+	return &serialRepository{properties: props}
+}
+
+func (r *serialRepository) GetTableName() string {
+	return "serials"
+}
+
+type FilterSerialPayload struct {
+	IDs            []uint32
+	ProductIDs     []uint32
+	ReservationIDs []uint32
+	Statuses       []string
+	Page           int
+	PerPage        int
+}
+
+func (r *serialRepository) Find(ctx context.Context, filter *FilterSerialPayload) ([]*entity.Serial, int, error) {
+	var serials []*model.Serial
+
+	query := r.db.NewSelect().Model(&serials)
+
+	if len(filter.IDs) > 0 {
+		query = query.Where("id IN (?)", bun.In(filter.IDs))
+	}
+
+	if len(filter.ProductIDs) > 0 {
+		query = query.Where("product_id IN (?)", bun.In(filter.ProductIDs))
+	}
+
+	if len(filter.ReservationIDs) > 0 {
+		query = query.Where("reservation_id IN (?)", bun.In(filter.ReservationIDs))
+	}
+
+	if len(filter.Statuses) > 0 {
+		query = query.Where("status IN (?)", bun.In(filter.Statuses))
+	}
+
+	totalCount, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, exception.NewDBError(err, r.GetTableName(), "count serial")
+	}
+
+	if totalCount == 0 {
+		return []*entity.Serial{}, 0, nil
+	}
+
+	if filter.PerPage > 0 {
+		query = query.Limit(filter.PerPage)
+	}
+
+	if filter.Page > 0 && filter.PerPage > 0 {
+		offset := (filter.Page - 1) * filter.PerPage
+		query = query.Offset(offset)
+	}
+
+	query = query.Order("id ASC")
+	if err := query.Scan(ctx); err != nil {
+		return nil, 0, exception.NewDBError(err, r.GetTableName(), "find serial")
+	}
+
+	return model.ToSerialsDomain(serials), totalCount, nil
+}
+
+// FindBySerialNumber returns the serial together with its product.
+func (r *serialRepository) FindBySerialNumber(ctx context.Context, serialNumber string) (*entity.Serial, error) {
+	if serialNumber == "" {
+		return nil, exception.ErrIDNull
+	}
+
+	serial := new(model.Serial)
+
+	err := r.db.NewSelect().
+		Model(serial).
+		Relation("Product").
+		Where("serial.serial_number = ?", serialNumber).
+		Scan(ctx)
+	if err != nil {
+		return nil, exception.NewDBError(err, r.GetTableName(), "find serial by serial number")
+	}
+
+	return serial.ToDomain(), nil
+}
+
+func (r *serialRepository) CreateMany(ctx context.Context, serials []*entity.Serial) ([]*entity.Serial, error) {
+	if len(serials) == 0 {
+		return nil, exception.ErrDataNull
+	}
+
+	dbSerials := model.AsSerials(serials)
+
+	if _, err := r.db.NewInsert().Model(&dbSerials).Returning("*").Exec(ctx); err != nil {
+		return nil, exception.NewDBError(err, r.GetTableName(), "create serials")
+	}
+
+	return model.ToSerialsDomain(dbSerials), nil
+}
+
+// CountAvailable returns the number of available serials of each product.
+func (r *serialRepository) CountAvailable(ctx context.Context, productIDs []uint32) (map[uint32]int, error) {
+	result := make(map[uint32]int, len(productIDs))
+	if len(productIDs) == 0 {
+		return result, nil
+	}
+
+	var rows []*model.SerialCount
+
+	err := r.db.NewSelect().
+		Model((*model.Serial)(nil)).
+		Column("product_id").
+		ColumnExpr("COUNT(*) AS count").
+		Where("product_id IN (?)", bun.In(productIDs)).
+		Where("status = ?", constant.SerialStatusAvailable).
+		Group("product_id").
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, exception.NewDBError(err, r.GetTableName(), "count available serials")
+	}
+
+	for _, row := range rows {
+		result[row.ProductID] = row.Count
+	}
+
+	return result, nil
+}
+
+// Hold marks quantity available serials of the product as reserved by the
+// reservation, oldest first.
+func (r *serialRepository) Hold(ctx context.Context, productID uint32, reservationID uint32, quantity int) ([]*entity.Serial, error) {
+	if productID == 0 || reservationID == 0 {
+		return nil, exception.ErrIDNull
+	}
+
+	available := r.db.NewSelect().
+		Model((*model.Serial)(nil)).
+		Column("id").
+		Where("product_id = ?", productID).
+		Where("status = ?", constant.SerialStatusAvailable).
+		Order("id ASC").
+		Limit(quantity).
+		For("UPDATE SKIP LOCKED")
+
+	var serials []*model.Serial
+
+	_, err := r.db.NewUpdate().
+		Model((*model.Serial)(nil)).
+		Set("status = ?", constant.SerialStatusReserved).
+		Set("reservation_id = ?", reservationID).
+		Set("updated_at = CURRENT_TIMESTAMP").
+		Where("id IN (?)", available).
+		Returning("*").
+		Exec(ctx, &serials)
+	if err != nil {
+		return nil, exception.NewDBError(err, r.GetTableName(), "hold serials")
+	}
+
+	if len(serials) < quantity {
+		return nil, errors.Wrapf(exception.ErrInsufficientStock, "insufficient serials for product %d", productID)
+	}
+
+	return model.ToSerialsDomain(serials), nil
+}
+
+// Assign hands the serials held by the reservations over to their orders.
+func (r *serialRepository) Assign(ctx context.Context, reservationIDs []uint32) error {
+	if len(reservationIDs) == 0 {
+		return nil
+	}
+
+	_, err := r.db.NewUpdate().
+		Model((*model.Serial)(nil)).
+		TableExpr("reservations AS reservation").
+		Set("status = ?", constant.SerialStatusAssigned).
+		Set("order_id = reservation.order_id").
+		Set("updated_at = CURRENT_TIMESTAMP").
+		Where("serial.reservation_id = reservation.id").
+		Where("serial.reservation_id IN (?)", bun.In(reservationIDs)).
+		Exec(ctx)
+	if err != nil {
+		return exception.NewDBError(err, r.GetTableName(), "assign serials")
+	}
+
+	return nil
+}
+
+// Release makes the serials held by or assigned to the reservations available again.
+func (r *serialRepository) Release(ctx context.Context, reservationIDs []uint32) error {
+	if len(reservationIDs) == 0 {
+		return nil
+	}
+
+	_, err := r.db.NewUpdate().
+		Model((*model.Serial)(nil)).
+		Set("status = ?", constant.SerialStatusAvailable).
+		Set("reservation_id = NULL").
+		Set("order_id = NULL").
+		Set("updated_at = CURRENT_TIMESTAMP").
+		Where("reservation_id IN (?)", bun.In(reservationIDs)).
+		Exec(ctx)
+	if err != nil {
+		return exception.NewDBError(err, r.GetTableName(), "release serials")
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package postgresrepository
+
+import (
+	"context"
+	"inventory-service/internal/adapter/repository/postgres/model"
+	"inventory-service/internal/domain/entity"
+	"inventory-service/internal/shared/exception"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+var _ StockEventRepository = (*stockEventRepository)(nil)
+
+// StockEventRepository reads the stock events written by the database
+// triggers on products and reservations.
+type StockEventRepository interface {
+	FindLatest(ctx context.Context, productIDs []uint32) ([]*entity.StockEvent, error)
+	FindAfter(ctx context.Context, after uint64, productIDs []uint32, limit int) ([]*entity.StockEvent, error)
+	LastSequence(ctx context.Context) (uint64, error)
+	DeleteBefore(ctx context.Context, before time.Time) (int, error)
+}
+
+type stockEventRepository struct {
+	properties
+}
+
+func NewStockEventRepository(props properties) *stockEventRepository {
+	return &stockEventRepository{properties: props}
+}
+
+func (r *stockEventRepository) GetTableName() string {
+	return "stock_events"
+}
+
+// FindLatest returns the latest event of each given product, which holds its
+// current stock and reserved totals.
+func (r *stockEventRepository) FindLatest(ctx context.Context, productIDs []uint32) ([]*entity.StockEvent, error) {
+	if len(productIDs) == 0 {
+		return nil, nil
+	}
+
+	var events []*model.StockEvent
+
+	err := r.db.NewSelect().
+		Model(&events).
+		DistinctOn("product_id").
+		Where("product_id IN (?)", bun.In(productIDs)).
+		Order("product_id ASC", "id DESC").
+		Scan(ctx)
+	if err != nil {
+		return nil, exception.NewDBError(err, r.GetTableName(), "find latest stock events")
+	}
+
+	return model.ToStockEventsDomain(events), nil
+}
+
+// FindAfter returns up to limit events with a sequence greater than after,
+// oldest first. Without product ids the events of all products are returned.
+func (r *stockEventRepository) FindAfter(ctx context.Context, after uint64, productIDs []uint32, limit int) ([]*entity.StockEvent, error) {
+	var events []*model.StockEvent
+
+	query := r.db.NewSelect().
+		Model(&events).
+		Where("id > ?", after).
+		Order("id ASC")
+
+	if len(productIDs) > 0 {
+		query = query.Where("product_id IN (?)", bun.In(productIDs))
+	}
+
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	if err := query.Scan(ctx); err != nil {
+		return nil, exception.NewDBError(err, r.GetTableName(), "find stock events")
+	}
+
+	return model.ToStockEventsDomain(events), nil
+}
+
+// LastSequence returns the sequence of the newest event, or zero when there
+// are none.
+func (r *stockEventRepository) LastSequence(ctx context.Context) (uint64, error) {
+	var sequence uint64
+
+	err := r.db.NewSelect().
+		Model((*model.StockEvent)(nil)).
+		ColumnExpr("COALESCE(MAX(id), 0)").
+		Scan(ctx, &sequence)
+	if err != nil {
+		return 0, exception.NewDBError(err, r.GetTableName(), "find last stock event")
+	}
+
+	return sequence, nil
+}
+
+// DeleteBefore removes events created before the given time, keeping the
+// latest event of every product so that it still holds the current totals and
+// a watcher resuming from an old sequence still receives them.
+func (r *stockEventRepository) DeleteBefore(ctx context.Context, before time.Time) (int, error) {
+	latest := r.db.NewSelect().
+		Model((*model.StockEvent)(nil)).
+		ColumnExpr("MAX(id)").
+		Group("product_id")
+
+	res, err := r.db.NewDelete().
+		Model((*model.StockEvent)(nil)).
+		Where("created_at < ?", before).
+		Where("id NOT IN (?)", latest).
+		Exec(ctx)
+	if err != nil {
+		return 0, exception.NewDBError(err, r.GetTableName(), "delete stock events")
+	}
+
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return 0, exception.NewDBError(err, r.GetTableName(), "delete stock events")
+	}
+
+	return int(deleted), nil
+}
@@ -0,0 +1,177 @@
+package postgresrepository
+
+import (
+	"context"
+	"inventory-service/constant"
+	"inventory-service/internal/adapter/repository/postgres/model"
+	"inventory-service/internal/domain/entity"
+	"inventory-service/internal/shared/exception"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+var _ WebhookDeliveryRepository = (*webhookDeliveryRepository)(nil)
+
+type WebhookDeliveryRepository interface {
+	Find(ctx context.Context, filter *FilterWebhookDeliveryPayload) ([]*entity.WebhookDelivery, int, error)
+	CreateMany(ctx context.Context, deliveries []*entity.WebhookDelivery) error
+	FindDue(ctx context.Context, now time.Time, limit int) ([]*entity.WebhookDelivery, error)
+	Lease(ctx context.Context, ids []uint64, until time.Time) error
+	Update(ctx context.Context, delivery *entity.WebhookDelivery) error
+}
+
+type webhookDeliveryRepository struct {
+	properties
+}
+
+func NewWebhookDeliveryRepository(props properties) *webhookDeliveryRepository {
+	return &webhookDeliveryRepository{properties: props}
+}
+
+func (r *webhookDeliveryRepository) GetTableName() string {
+	return "webhook_deliveries"
+}
+
+type FilterWebhookDeliveryPayload struct {
+	WebhookIDs []uint32
+	Statuses   []string
+	Page       int
+	PerPage    int
+}
+
+// Find returns deliveries newest first.
+func (r *webhookDeliveryRepository) Find(ctx context.Context, filter *FilterWebhookDeliveryPayload) ([]*entity.WebhookDelivery, int, error) {
+	var deliveries []*model.WebhookDelivery
+
+	query := r.db.NewSelect().Model(&deliveries)
+
+	if len(filter.WebhookIDs) > 0 {
+		query = query.Where("webhook_id IN (?)", bun.In(filter.WebhookIDs))
+	}
+
+	if len(filter.Statuses) > 0 {
+		query = query.Where("status IN (?)", bun.In(filter.Statuses))
+	}
+
+	totalCount, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, exception.NewDBError(err, r.GetTableName(), "count webhook delivery")
+	}
+
+	if totalCount == 0 {
+		return []*entity.WebhookDelivery{}, 0, nil
+	}
+
+	if filter.PerPage > 0 {
+		query = query.Limit(filter.PerPage)
+	}
+
+	if filter.Page > 0 && filter.PerPage > 0 {
+		offset := (filter.Page - 1) * filter.PerPage
+		query = query.Offset(offset)
+	}
+
+	query = query.Order("id DESC")
+	if err := query.Scan(ctx); err != nil {
+		return nil, 0, exception.NewDBError(err, r.GetTableName(), "find webhook delivery")
+	}
+
+	return model.ToWebhookDeliveriesDomain(deliveries), totalCount, nil
+}
+
+// CreateMany stores pending deliveries. A delivery of an event to a webhook
+// that already has one is skipped, so fanning an event out again is harmless.
+func (r *webhookDeliveryRepository) CreateMany(ctx context.Context, deliveries []*entity.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	dbDeliveries := make([]*model.WebhookDelivery, len(deliveries))
+	for i, delivery := range deliveries {
+		if delivery == nil {
+			return exception.ErrDataNull
+		}
+
+		dbDeliveries[i] = model.AsWebhookDelivery(delivery)
+	}
+
+	_, err := r.db.NewInsert().
+		Model(&dbDeliveries).
+		On("CONFLICT (webhook_id, event_id) DO NOTHING").
+		Exec(ctx)
+	if err != nil {
+		return exception.NewDBError(err, r.GetTableName(), "create webhook deliveries")
+	}
+
+	return nil
+}
+
+// FindDue locks and returns up to limit pending deliveries of active webhooks
+// whose next attempt is due, oldest first. Rows locked by another dispatcher
+// are skipped.
+func (r *webhookDeliveryRepository) FindDue(ctx context.Context, now time.Time, limit int) ([]*entity.WebhookDelivery, error) {
+	var deliveries []*model.WebhookDelivery
+
+	active := r.db.NewSelect().
+		Model((*model.Webhook)(nil)).
+		Column("id").
+		Where("active")
+
+	query := r.db.NewSelect().
+		Model(&deliveries).
+		Where("status = ?", constant.WebhookDeliveryStatusPending).
+		Where("next_attempt_at <= ?", now).
+		Where("webhook_id IN (?)", active).
+		Order("id ASC").
+		For("UPDATE SKIP LOCKED")
+
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	if err := query.Scan(ctx); err != nil {
+		return nil, exception.NewDBError(err, r.GetTableName(), "find due webhook deliveries")
+	}
+
+	return model.ToWebhookDeliveriesDomain(deliveries), nil
+}
+
+// Lease pushes the next attempt of deliveries back to until, so other
+// dispatchers leave them alone while they are being sent.
+func (r *webhookDeliveryRepository) Lease(ctx context.Context, ids []uint64, until time.Time) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	_, err := r.db.NewUpdate().
+		Model((*model.WebhookDelivery)(nil)).
+		Set("next_attempt_at = ?", until).
+		Set("updated_at = CURRENT_TIMESTAMP").
+		Where("id IN (?)", bun.In(ids)).
+		Exec(ctx)
+	if err != nil {
+		return exception.NewDBError(err, r.GetTableName(), "lease webhook deliveries")
+	}
+
+	return nil
+}
+
+// Update stores the outcome of a delivery attempt.
+func (r *webhookDeliveryRepository) Update(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	if delivery == nil || delivery.ID == 0 {
+		return exception.ErrDataNull
+	}
+
+	_, err := r.db.NewUpdate().
+		Model(model.AsWebhookDelivery(delivery)).
+		Column("status", "attempts", "next_attempt_at", "response_status", "response_body", "last_error", "delivered_at").
+		Set("updated_at = CURRENT_TIMESTAMP").
+		WherePK().
+		Exec(ctx)
+	if err != nil {
+		return exception.NewDBError(err, r.GetTableName(), "update webhook delivery")
+	}
+
+	return nil
+}
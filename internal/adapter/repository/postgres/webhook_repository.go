@@ -0,0 +1,211 @@
+package postgresrepository
+
+import (
+	"context"
+	"database/sql"
+	"inventory-service/internal/adapter/repository/postgres/model"
+	"inventory-service/internal/domain/entity"
+	"inventory-service/internal/shared/exception"
+
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+)
+
+var _ WebhookRepository = (*webhookRepository)(nil)
+
+type WebhookRepository interface {
+	Find(ctx context.Context, filter *FilterWebhookPayload) ([]*entity.Webhook, int, error)
+	FindByID(ctx context.Context, id uint32) (*entity.Webhook, error)
+	FindSubscribed(ctx context.Context, eventTypes []string) ([]*entity.Webhook, error)
+	Create(ctx context.Context, webhook *entity.Webhook) (*entity.Webhook, error)
+	Update(ctx context.Context, webhook *entity.Webhook) (*entity.Webhook, error)
+	Delete(ctx context.Context, id uint32) error
+	RecordAttempt(ctx context.Context, id uint32, succeeded bool, disableAfter int) (bool, error)
+}
+
+type webhookRepository struct {
+	properties
+}
+
+func NewWebhookRepository(props properties) *webhookRepository {
+	return &webhookRepository{properties: props}
+}
+
+func (r *webhookRepository) GetTableName() string {
+	return "webhooks"
+}
+
+type FilterWebhookPayload struct {
+	IDs        []uint32
+	ActiveOnly bool
+	Page       int
+	PerPage    int
+}
+
+func (r *webhookRepository) Find(ctx context.Context, filter *FilterWebhookPayload) ([]*entity.Webhook, int, error) {
+	var webhooks []*model.Webhook
+
+	query := r.db.NewSelect().Model(&webhooks)
+
+	if len(filter.IDs) > 0 {
+		query = query.Where("id IN (?)", bun.In(filter.IDs))
+	}
+
+	if filter.ActiveOnly {
+		query = query.Where("active")
+	}
+
+	totalCount, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, exception.NewDBError(err, r.GetTableName(), "count webhook")
+	}
+
+	if totalCount == 0 {
+		return []*entity.Webhook{}, 0, nil
+	}
+
+	if filter.PerPage > 0 {
+		query = query.Limit(filter.PerPage)
+	}
+
+	if filter.Page > 0 && filter.PerPage > 0 {
+		offset := (filter.Page - 1) * filter.PerPage
+		query = query.Offset(offset)
+	}
+
+	query = query.Order("id ASC")
+	if err := query.Scan(ctx); err != nil {
+		return nil, 0, exception.NewDBError(err, r.GetTableName(), "find webhook")
+	}
+
+	return model.ToWebhooksDomain(webhooks), totalCount, nil
+}
+
+func (r *webhookRepository) FindByID(ctx context.Context, id uint32) (*entity.Webhook, error) {
+	if id == 0 {
+		return nil, exception.ErrIDNull
+	}
+
+	webhook := &model.Webhook{Base: model.Base{ID: id}}
+
+	if err := r.db.NewSelect().Model(webhook).WherePK().Scan(ctx); err != nil {
+		return nil, exception.NewDBError(err, r.GetTableName(), "find webhook by id")
+	}
+
+	return webhook.ToDomain(), nil
+}
+
+// FindSubscribed returns the active webhooks subscribed to any of the given
+// event types.
+func (r *webhookRepository) FindSubscribed(ctx context.Context, eventTypes []string) ([]*entity.Webhook, error) {
+	if len(eventTypes) == 0 {
+		return nil, nil
+	}
+
+	var webhooks []*model.Webhook
+
+	err := r.db.NewSelect().
+		Model(&webhooks).
+		Where("active").
+		Where("event_types && ?", pgdialect.Array(eventTypes)).
+		Order("id ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, exception.NewDBError(err, r.GetTableName(), "find subscribed webhooks")
+	}
+
+	return model.ToWebhooksDomain(webhooks), nil
+}
+
+func (r *webhookRepository) Create(ctx context.Context, webhook *entity.Webhook) (*entity.Webhook, error) {
+	if webhook == nil {
+		return nil, exception.ErrDataNull
+	}
+
+	dbWebhook := model.AsWebhook(webhook)
+
+	_, err := r.db.NewInsert().Model(dbWebhook).Exec(ctx)
+	if err != nil {
+		return nil, exception.NewDBError(err, r.GetTableName(), "create webhook")
+	}
+
+	return dbWebhook.ToDomain(), nil
+}
+
+func (r *webhookRepository) Update(ctx context.Context, webhook *entity.Webhook) (*entity.Webhook, error) {
+	if webhook == nil || webhook.Base.ID == 0 {
+		return nil, exception.ErrDataNull
+	}
+
+	dbWebhook := model.AsWebhook(webhook)
+
+	res, err := r.db.NewUpdate().Model(dbWebhook).
+		Column("url", "event_types", "secret", "active", "failure_count", "disabled_at").
+		Set("updated_at = CURRENT_TIMESTAMP").
+		WherePK().
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		return nil, exception.NewDBError(err, r.GetTableName(), "update webhook")
+	}
+
+	if affected, err := res.RowsAffected(); err == nil && affected == 0 {
+		return nil, exception.NewDBError(sql.ErrNoRows, r.GetTableName(), "update webhook")
+	}
+
+	return dbWebhook.ToDomain(), nil
+}
+
+func (r *webhookRepository) Delete(ctx context.Context, id uint32) error {
+	if id == 0 {
+		return exception.ErrIDNull
+	}
+
+	dbWebhook := &model.Webhook{Base: model.Base{ID: id}}
+
+	_, err := r.db.NewDelete().Model(dbWebhook).WherePK().Exec(ctx)
+	if err != nil {
+		return exception.NewDBError(err, r.GetTableName(), "delete webhook")
+	}
+
+	return nil
+}
+
+// RecordAttempt resets the consecutive failure count of a webhook after a
+// successful attempt and increments it after a failed one, disabling the
+// webhook once it reaches disableAfter. It reports whether this attempt
+// disabled the webhook.
+func (r *webhookRepository) RecordAttempt(ctx context.Context, id uint32, succeeded bool, disableAfter int) (bool, error) {
+	if id == 0 {
+		return false, exception.ErrIDNull
+	}
+
+	query := r.db.NewUpdate().
+		Model((*model.Webhook)(nil)).
+		Where("id = ?", id)
+
+	if succeeded {
+		_, err := query.Set("failure_count = 0").Exec(ctx)
+		if err != nil {
+			return false, exception.NewDBError(err, r.GetTableName(), "record webhook attempt")
+		}
+
+		return false, nil
+	}
+
+	var disabled bool
+
+	// The right-hand sides see the row as it was before the update.
+	_, err := query.
+		Set("failure_count = failure_count + 1").
+		Set("active = active AND failure_count + 1 < ?", disableAfter).
+		Set("disabled_at = CASE WHEN active AND failure_count + 1 >= ? THEN CURRENT_TIMESTAMP ELSE disabled_at END", disableAfter).
+		Set("updated_at = CURRENT_TIMESTAMP").
+		Returning("disabled_at IS NOT NULL AND NOT active AND failure_count = ?", disableAfter).
+		Exec(ctx, &disabled)
+	if err != nil {
+		return false, exception.NewDBError(err, r.GetTableName(), "record webhook attempt")
+	}
+
+	return disabled, nil
+}
@@ -7,6 +7,8 @@ import (
 	"inventory-service/internal/adapter/repository"
 	"inventory-service/internal/adapter/restapi/handler"
 	"inventory-service/internal/domain/service"
+	"inventory-service/internal/shared/auth"
+	"inventory-service/internal/shared/ratelimit"
 	"inventory-service/pkg/logger"
 	"net/http"
 	"time"
@@ -28,11 +30,23 @@ type echoServer struct {
 	logger  logger.Logger
 	echo    *echo.Echo
 	handler handler.Handler
+	// authenticator and policy are nil when authentication is disabled.
+	authenticator auth.Authenticator
+	policy        *auth.Policy
+	// limiter is nil when rate limiting is disabled.
+	limiter *ratelimit.Limiter
 }
 
-func NewEchoServer(config *config.Config, logger logger.Logger, service service.Service, repository repository.Repository) (*echoServer, error) {
+func NewEchoServer(
+	config *config.Config,
+	logger logger.Logger,
+	service service.Service,
+	repository repository.Repository,
+	limiter *ratelimit.Limiter,
+) (*echoServer, error) {
 	e := echo.New()
 	e.HideBanner = true
+	e.IPExtractor = ipExtractor(config.HTTP)
 
 	handler, err := handler.NewHandler(config, logger, service, repository.Postgres().DB())
 	if err != nil {
@@ -44,6 +58,19 @@ func NewEchoServer(config *config.Config, logger logger.Logger, service service.
 		logger:  logger.NewInstance().Field("component", "http_server").Logger(),
 		echo:    e,
 		handler: handler,
+		limiter: limiter,
+	}
+
+	if config.Auth != nil && config.Auth.Enabled {
+		server.authenticator, err = auth.NewAuthenticator(config.Auth)
+		if err != nil {
+			return nil, err
+		}
+
+		server.policy, err = auth.LoadConfiguredPolicy(config.Auth)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	server.setupMiddlewares()
@@ -52,6 +79,28 @@ func NewEchoServer(config *config.Config, logger logger.Logger, service service.
 	return server, nil
 }
 
+// ipExtractor names the client of a request after its X-Forwarded-For header
+// only when the request came through one of the trusted proxies. Otherwise
+// the header could be set by anyone, to dodge rate limits or forge logs, so
+// the address the request came from is used.
+func ipExtractor(cfg *config.HTTPConfig) echo.IPExtractor {
+	if cfg == nil || len(cfg.TrustedProxies) == 0 {
+		return echo.ExtractIPDirect()
+	}
+
+	options := []echo.TrustOption{
+		echo.TrustLoopback(false),
+		echo.TrustLinkLocal(false),
+		echo.TrustPrivateNet(false),
+	}
+
+	for _, proxy := range cfg.TrustedProxies {
+		options = append(options, echo.TrustIPRange(proxy))
+	}
+
+	return echo.ExtractIPFromXFFHeader(options...)
+}
+
 func (s *echoServer) Echo() *echo.Echo {
 	return s.echo
 }
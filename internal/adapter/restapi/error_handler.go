@@ -105,6 +105,8 @@ func buildErrorPayload(ex *exception.Exception, initialStatusCode int, forceGene
 			statusCode = http.StatusNotFound
 		case exception.TypeConflict:
 			statusCode = http.StatusConflict
+		case exception.TypeFailedPrecondition:
+			statusCode = http.StatusPreconditionFailed
 		case exception.TypeUnsupportedMediaType:
 			statusCode = http.StatusUnsupportedMediaType
 		case exception.TypeRateLimitExceeded:
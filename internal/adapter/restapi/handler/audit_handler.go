@@ -0,0 +1,87 @@
+package handler
+
+import (
+	postgresrepository "inventory-service/internal/adapter/repository/postgres"
+	"inventory-service/internal/adapter/restapi/response"
+	"inventory-service/internal/adapter/restapi/serializer"
+	"inventory-service/internal/shared/exception"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+type AuditHandler interface {
+	List(c echo.Context) error
+}
+
+type auditHandler struct {
+	properties
+}
+
+func NewAuditHandler(props properties) AuditHandler {
+	return &auditHandler{properties: props}
+}
+
+// List returns audit events newest first, filtered by the entity_type,
+// entity_id, actor, from and to query parameters. Times are RFC 3339; from is
+// inclusive and to exclusive.
+func (h *auditHandler) List(c echo.Context) error {
+	page, perPage, err := pagination(c)
+	if err != nil {
+		return err
+	}
+
+	filter := &postgresrepository.FilterAuditEventPayload{
+		EntityType: c.QueryParam("entity_type"),
+		Actors:     c.QueryParams()["actor"],
+		Page:       page,
+		PerPage:    perPage,
+	}
+
+	for _, raw := range c.QueryParams()["entity_id"] {
+		id, err := strconv.ParseUint(raw, 10, 32)
+		if err != nil {
+			return invalidAuditFilter("entity_id", "Must be a positive integer")
+		}
+
+		filter.EntityIDs = append(filter.EntityIDs, uint32(id))
+	}
+
+	for name, target := range map[string]**time.Time{"from": &filter.From, "to": &filter.To} {
+		raw := c.QueryParam(name)
+		if raw == "" {
+			continue
+		}
+
+		t, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return invalidAuditFilter(name, "Time must be in RFC 3339 format")
+		}
+
+		*target = &t
+	}
+
+	events, total, err := h.service.Audit().Find(c.Request().Context(), filter)
+	if err != nil {
+		return err
+	}
+
+	totalPage := 1
+	if perPage > 0 {
+		totalPage = (total + perPage - 1) / perPage
+	}
+
+	return response.Paginate(c, "Audit events retrieved successfully", serializer.SerializeAuditEvents(events), response.Pagination{
+		Page:       page,
+		PerPage:    perPage,
+		TotalCount: total,
+		TotalPage:  totalPage,
+	})
+}
+
+func invalidAuditFilter(field, message string) error {
+	return exception.NewWithErrors(exception.TypeValidationError, exception.CodeValidationFailed, "Invalid filter", exception.FieldErrors{
+		field: {message},
+	})
+}
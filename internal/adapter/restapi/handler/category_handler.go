@@ -0,0 +1,156 @@
+package handler
+
+import (
+	postgresrepository "inventory-service/internal/adapter/repository/postgres"
+	"inventory-service/internal/adapter/restapi/response"
+	"inventory-service/internal/adapter/restapi/serializer"
+	"inventory-service/internal/domain/entity"
+	"inventory-service/internal/shared/validation"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+type CategoryHandler interface {
+	Create(c echo.Context) error
+	Get(c echo.Context) error
+	List(c echo.Context) error
+	Update(c echo.Context) error
+	Delete(c echo.Context) error
+}
+
+type categoryHandler struct {
+	properties
+}
+
+func NewCategoryHandler(props properties) CategoryHandler {
+	return &categoryHandler{properties: props}
+}
+
+type CreateCategoryRequest struct {
+	ParentID    uint32 `json:"parent_id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+func (r *CreateCategoryRequest) validate(v *validation.Validator) {
+	v.Name("name", r.Name)
+}
+
+func (h *categoryHandler) Create(c echo.Context) error {
+	var req CreateCategoryRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+	if err := h.validate(&req); err != nil {
+		return err
+	}
+
+	category := &entity.Category{
+		ParentID:    req.ParentID,
+		Name:        req.Name,
+		Description: req.Description,
+	}
+
+	createdCategory, err := h.service.Category().Create(c.Request().Context(), category)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, serializer.SerializeCategory(createdCategory))
+}
+
+func (h *categoryHandler) Get(c echo.Context) error {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return err
+	}
+
+	category, err := h.service.Category().FindByID(c.Request().Context(), uint32(id))
+	if err != nil {
+		return err
+	}
+
+	return response.Success(c, "Category retrieved successfully", serializer.SerializeCategory(category))
+}
+
+func (h *categoryHandler) List(c echo.Context) error {
+	page, perPage, err := pagination(c)
+	if err != nil {
+		return err
+	}
+
+	parentID, _ := strconv.ParseUint(c.QueryParam("parent_id"), 10, 32)
+	rootsOnly, _ := strconv.ParseBool(c.QueryParam("roots_only"))
+
+	filter := &postgresrepository.FilterCategoryPayload{
+		RootsOnly: rootsOnly,
+		Search:    c.QueryParam("search"),
+		Page:      page,
+		PerPage:   perPage,
+	}
+
+	if parentID > 0 {
+		filter.ParentIDs = []uint32{uint32(parentID)}
+	}
+
+	categories, total, err := h.service.Category().Find(c.Request().Context(), filter)
+	if err != nil {
+		return err
+	}
+
+	totalPage := 1
+	if perPage > 0 {
+		totalPage = (total + perPage - 1) / perPage
+	}
+
+	return response.Paginate(c, "Categories retrieved successfully", serializer.SerializeCategories(categories), response.Pagination{
+		Page:       page,
+		PerPage:    perPage,
+		TotalCount: total,
+		TotalPage:  totalPage,
+	})
+}
+
+func (h *categoryHandler) Update(c echo.Context) error {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return err
+	}
+
+	var req CreateCategoryRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+	if err := h.validate(&req); err != nil {
+		return err
+	}
+
+	category := &entity.Category{
+		Base:        entity.Base{ID: uint32(id)},
+		ParentID:    req.ParentID,
+		Name:        req.Name,
+		Description: req.Description,
+	}
+
+	updatedCategory, err := h.service.Category().Update(c.Request().Context(), category)
+	if err != nil {
+		return err
+	}
+
+	return response.Success(c, "Category updated successfully", serializer.SerializeCategory(updatedCategory))
+}
+
+func (h *categoryHandler) Delete(c echo.Context) error {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return err
+	}
+
+	if err := h.service.Category().Delete(c.Request().Context(), uint32(id)); err != nil {
+		return err
+	}
+
+	return response.Success(c, "Category deleted successfully", nil)
+}
@@ -0,0 +1,53 @@
+package handler
+
+import (
+	"inventory-service/internal/adapter/catalog"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	contentTypeCSV    = "text/csv; charset=utf-8"
+	contentTypeNDJSON = "application/x-ndjson"
+)
+
+// exportFormat picks CSV when the client accepts text/csv and NDJSON otherwise.
+func exportFormat(c echo.Context) (format, contentType string) {
+	if strings.Contains(c.Request().Header.Get(echo.HeaderAccept), "text/csv") {
+		return catalog.FormatCSV, contentTypeCSV
+	}
+
+	return catalog.FormatJSONL, contentTypeNDJSON
+}
+
+// streamWriter writes an export to the response as it is produced. The status
+// is only committed on the first write, so an export that fails before
+// writing anything still gets a regular error response.
+type streamWriter struct {
+	c           echo.Context
+	contentType string
+}
+
+func (w *streamWriter) Write(p []byte) (int, error) {
+	w.commit()
+
+	res := w.c.Response()
+	n, err := res.Write(p)
+	res.Flush()
+
+	return n, err
+}
+
+// commit sends the headers if nothing has been written yet, e.g. for an empty
+// NDJSON export.
+func (w *streamWriter) commit() {
+	res := w.c.Response()
+	if res.Committed {
+		return
+	}
+
+	res.Header().Set(echo.HeaderContentType, w.contentType)
+	res.WriteHeader(http.StatusOK)
+}
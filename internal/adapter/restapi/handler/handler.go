@@ -4,27 +4,46 @@ import (
 	"errors"
 	"inventory-service/config"
 	"inventory-service/internal/domain/service"
+	"inventory-service/internal/shared/validation"
 	"inventory-service/pkg/logger"
+	"strconv"
 
-	validator "github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
 	"github.com/uptrace/bun"
 )
 
 type Handler interface {
 	Product() ProductHandler
+	Category() CategoryHandler
+	Lot() LotHandler
+	Serial() SerialHandler
+	Price() PriceHandler
+	Reservation() ReservationHandler
+	Webhook() WebhookHandler
+	Audit() AuditHandler
+	Health() HealthHandler
+	Migration() MigrationHandler
 }
 
 type properties struct {
-	config    *config.Config
-	logger    logger.Logger
-	service   service.Service
-	validator *validator.Validate
-	db        *bun.DB
+	config  *config.Config
+	logger  logger.Logger
+	service service.Service
+	db      *bun.DB
 }
 
 type handler struct {
 	properties
-	productHandler ProductHandler
+	productHandler     ProductHandler
+	categoryHandler    CategoryHandler
+	lotHandler         LotHandler
+	serialHandler      SerialHandler
+	priceHandler       PriceHandler
+	reservationHandler ReservationHandler
+	webhookHandler     WebhookHandler
+	auditHandler       AuditHandler
+	healthHandler      HealthHandler
+	migrationHandler   MigrationHandler
 }
 
 func NewHandler(config *config.Config, logger logger.Logger, service service.Service, db *bun.DB) (*handler, error) {
@@ -32,19 +51,25 @@ func NewHandler(config *config.Config, logger logger.Logger, service service.Ser
 		return nil, errors.New("config cannot be nil")
 	}
 
-	validate := validator.New()
-
 	props := properties{
-		config:    config,
-		service:   service,
-		logger:    logger,
-		validator: validate,
-		db:        db,
+		config:  config,
+		service: service,
+		logger:  logger,
+		db:      db,
 	}
 
 	h := &handler{
-		properties:     props,
-		productHandler: NewProductHandler(props),
+		properties:         props,
+		productHandler:     NewProductHandler(props),
+		categoryHandler:    NewCategoryHandler(props),
+		lotHandler:         NewLotHandler(props),
+		serialHandler:      NewSerialHandler(props),
+		priceHandler:       NewPriceHandler(props),
+		reservationHandler: NewReservationHandler(props),
+		webhookHandler:     NewWebhookHandler(props),
+		auditHandler:       NewAuditHandler(props),
+		healthHandler:      NewHealthHandler(props),
+		migrationHandler:   NewMigrationHandler(props),
 	}
 
 	return h, nil
@@ -53,3 +78,66 @@ func NewHandler(config *config.Config, logger logger.Logger, service service.Ser
 func (h *handler) Product() ProductHandler {
 	return h.productHandler
 }
+
+func (h *handler) Category() CategoryHandler {
+	return h.categoryHandler
+}
+
+func (h *handler) Lot() LotHandler {
+	return h.lotHandler
+}
+
+func (h *handler) Serial() SerialHandler {
+	return h.serialHandler
+}
+
+func (h *handler) Price() PriceHandler {
+	return h.priceHandler
+}
+
+func (h *handler) Reservation() ReservationHandler {
+	return h.reservationHandler
+}
+
+func (h *handler) Webhook() WebhookHandler {
+	return h.webhookHandler
+}
+
+func (h *handler) Audit() AuditHandler {
+	return h.auditHandler
+}
+
+func (h *handler) Health() HealthHandler {
+	return h.healthHandler
+}
+
+func (h *handler) Migration() MigrationHandler {
+	return h.migrationHandler
+}
+
+// request is a request body checked by the rules its gRPC counterpart is held
+// to.
+type request interface {
+	validate(v *validation.Validator)
+}
+
+// validate checks a request, reporting every field that breaks a rule as a
+// validation exception.
+func (p properties) validate(req request) error {
+	v := validation.New()
+	req.validate(v)
+
+	return v.Err("Invalid request")
+}
+
+// pagination reads the page and per_page query parameters, which are held to
+// the same rules as the paging fields of gRPC requests.
+func pagination(c echo.Context) (int, int, error) {
+	page, _ := strconv.Atoi(c.QueryParam("page"))
+	perPage, _ := strconv.Atoi(c.QueryParam("per_page"))
+
+	v := validation.New()
+	v.Pagination(page, perPage)
+
+	return page, perPage, v.Err("Invalid pagination")
+}
@@ -0,0 +1,29 @@
+package handler
+
+import (
+	"inventory-service/internal/adapter/restapi/response"
+	"inventory-service/internal/shared/exception"
+
+	"github.com/labstack/echo/v4"
+)
+
+type HealthHandler interface {
+	Check(c echo.Context) error
+}
+
+type healthHandler struct {
+	properties
+}
+
+func NewHealthHandler(props properties) HealthHandler {
+	return &healthHandler{properties: props}
+}
+
+// Check reports whether the service can reach its database.
+func (h *healthHandler) Check(c echo.Context) error {
+	if err := h.db.PingContext(c.Request().Context()); err != nil {
+		return exception.Wrap(err, exception.TypeServiceUnavailable, exception.CodeServiceUnavailable, "Database unreachable")
+	}
+
+	return response.Success(c, "Service healthy", nil)
+}
@@ -0,0 +1,139 @@
+package handler
+
+import (
+	postgresrepository "inventory-service/internal/adapter/repository/postgres"
+	"inventory-service/internal/adapter/restapi/response"
+	"inventory-service/internal/adapter/restapi/serializer"
+	"inventory-service/internal/domain/entity"
+	"inventory-service/internal/shared/validation"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+type LotHandler interface {
+	Create(c echo.Context) error
+	ListByProduct(c echo.Context) error
+	ListExpiring(c echo.Context) error
+}
+
+type lotHandler struct {
+	properties
+}
+
+func NewLotHandler(props properties) LotHandler {
+	return &lotHandler{properties: props}
+}
+
+type CreateLotRequest struct {
+	LotNumber string     `json:"lot_number"`
+	ExpiresAt *time.Time `json:"expires_at"`
+	Quantity  int        `json:"quantity"`
+}
+
+func (r *CreateLotRequest) validate(v *validation.Validator) {
+	v.LotNumber("lot_number", r.LotNumber)
+	v.Min("quantity", r.Quantity, 1)
+}
+
+func (h *lotHandler) Create(c echo.Context) error {
+	productID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return err
+	}
+
+	var req CreateLotRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+	if err := h.validate(&req); err != nil {
+		return err
+	}
+
+	lot := &entity.Lot{
+		ProductID: uint32(productID),
+		LotNumber: req.LotNumber,
+		ExpiresAt: req.ExpiresAt,
+		Quantity:  req.Quantity,
+	}
+
+	createdLot, err := h.service.Lot().Create(c.Request().Context(), lot)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, serializer.SerializeLot(createdLot))
+}
+
+func (h *lotHandler) ListByProduct(c echo.Context) error {
+	productID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return err
+	}
+
+	page, perPage, err := pagination(c)
+	if err != nil {
+		return err
+	}
+
+	excludeExpired, _ := strconv.ParseBool(c.QueryParam("exclude_expired"))
+
+	filter := &postgresrepository.FilterLotPayload{
+		ProductIDs:     []uint32{uint32(productID)},
+		ExcludeExpired: excludeExpired,
+		Page:           page,
+		PerPage:        perPage,
+	}
+
+	lots, total, err := h.service.Lot().Find(c.Request().Context(), filter)
+	if err != nil {
+		return err
+	}
+
+	return paginateLots(c, lots, total, page, perPage)
+}
+
+func (h *lotHandler) ListExpiring(c echo.Context) error {
+	days, _ := strconv.Atoi(c.QueryParam("days"))
+	page, perPage, err := pagination(c)
+	if err != nil {
+		return err
+	}
+
+	filter := &postgresrepository.FilterLotPayload{
+		Page:    page,
+		PerPage: perPage,
+	}
+
+	for _, raw := range c.QueryParams()["product_id"] {
+		productID, err := strconv.ParseUint(raw, 10, 32)
+		if err != nil {
+			return err
+		}
+
+		filter.ProductIDs = append(filter.ProductIDs, uint32(productID))
+	}
+
+	lots, total, err := h.service.Lot().FindExpiring(c.Request().Context(), days, filter)
+	if err != nil {
+		return err
+	}
+
+	return paginateLots(c, lots, total, page, perPage)
+}
+
+func paginateLots(c echo.Context, lots []*entity.Lot, total, page, perPage int) error {
+	totalPage := 1
+	if perPage > 0 {
+		totalPage = (total + perPage - 1) / perPage
+	}
+
+	return response.Paginate(c, "Lots retrieved successfully", serializer.SerializeLots(lots), response.Pagination{
+		Page:       page,
+		PerPage:    perPage,
+		TotalCount: total,
+		TotalPage:  totalPage,
+	})
+}
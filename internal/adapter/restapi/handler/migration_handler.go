@@ -0,0 +1,40 @@
+package handler
+
+import (
+	"inventory-service/internal/adapter/restapi/response"
+	"inventory-service/pkg/bundb"
+
+	"github.com/labstack/echo/v4"
+)
+
+type MigrationHandler interface {
+	Run(c echo.Context) error
+}
+
+type migrationHandler struct {
+	properties
+}
+
+func NewMigrationHandler(props properties) MigrationHandler {
+	return &migrationHandler{properties: props}
+}
+
+// Run applies the pending database migrations, like the migrate command.
+func (h *migrationHandler) Run(c echo.Context) error {
+	db, err := bundb.NewBunDB(h.config, h.logger)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err := db.Close(); err != nil {
+			h.logger.Error().Err(err).Msg("Failed to close migration database connection")
+		}
+	}()
+
+	if err := db.Migrate(); err != nil {
+		return err
+	}
+
+	return response.Success(c, "Migrations applied successfully", nil)
+}
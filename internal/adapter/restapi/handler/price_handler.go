@@ -0,0 +1,132 @@
+package handler
+
+import (
+	postgresrepository "inventory-service/internal/adapter/repository/postgres"
+	"inventory-service/internal/adapter/restapi/response"
+	"inventory-service/internal/adapter/restapi/serializer"
+	"inventory-service/internal/domain/entity"
+	"inventory-service/internal/shared/exception"
+	"inventory-service/internal/shared/validation"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+type PriceHandler interface {
+	Schedule(c echo.Context) error
+	ListByProduct(c echo.Context) error
+	Cancel(c echo.Context) error
+}
+
+type priceHandler struct {
+	properties
+}
+
+func NewPriceHandler(props properties) PriceHandler {
+	return &priceHandler{properties: props}
+}
+
+type SchedulePriceChangeRequest struct {
+	Price         string    `json:"price"`
+	Currency      string    `json:"currency"`
+	EffectiveFrom time.Time `json:"effective_from"`
+}
+
+func (r *SchedulePriceChangeRequest) validate(v *validation.Validator) {
+	v.Required("price", r.Price)
+	v.Currency("currency", r.Currency)
+	v.Check(!r.EffectiveFrom.IsZero(), "effective_from", "This field is required")
+}
+
+func (h *priceHandler) Schedule(c echo.Context) error {
+	productID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return err
+	}
+
+	var req SchedulePriceChangeRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+	if err := h.validate(&req); err != nil {
+		return err
+	}
+
+	price, err := entity.ParseMoney(req.Price, req.Currency)
+	if err != nil {
+		return exception.NewWithErrors(exception.TypeValidationError, exception.CodeValidationFailed, "Invalid price", exception.FieldErrors{
+			"price": {"Price must be a decimal number such as 19.99"},
+		})
+	}
+
+	change := &entity.PriceChange{
+		ProductID:     uint32(productID),
+		Price:         price,
+		EffectiveFrom: req.EffectiveFrom,
+	}
+
+	scheduledChange, err := h.service.PriceChange().Schedule(c.Request().Context(), change)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, serializer.SerializePriceChange(scheduledChange))
+}
+
+func (h *priceHandler) ListByProduct(c echo.Context) error {
+	productID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return err
+	}
+
+	page, perPage, err := pagination(c)
+	if err != nil {
+		return err
+	}
+
+	pendingOnly, _ := strconv.ParseBool(c.QueryParam("pending"))
+
+	filter := &postgresrepository.FilterPriceChangePayload{
+		ProductIDs:  []uint32{uint32(productID)},
+		PendingOnly: pendingOnly,
+		Page:        page,
+		PerPage:     perPage,
+	}
+
+	changes, total, err := h.service.PriceChange().Find(c.Request().Context(), filter)
+	if err != nil {
+		return err
+	}
+
+	totalPage := 1
+	if perPage > 0 {
+		totalPage = (total + perPage - 1) / perPage
+	}
+
+	return response.Paginate(c, "Price history retrieved successfully", serializer.SerializePriceChanges(changes), response.Pagination{
+		Page:       page,
+		PerPage:    perPage,
+		TotalCount: total,
+		TotalPage:  totalPage,
+	})
+}
+
+func (h *priceHandler) Cancel(c echo.Context) error {
+	productID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return err
+	}
+
+	id, err := strconv.ParseUint(c.Param("price_id"), 10, 32)
+	if err != nil {
+		return err
+	}
+
+	if err := h.service.PriceChange().Cancel(c.Request().Context(), uint32(productID), uint32(id)); err != nil {
+		return err
+	}
+
+	return response.Success(c, "Price change cancelled successfully", nil)
+}
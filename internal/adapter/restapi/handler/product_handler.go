@@ -1,12 +1,22 @@
 package handler
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"inventory-service/constant"
+	"inventory-service/internal/adapter/catalog"
 	postgresrepository "inventory-service/internal/adapter/repository/postgres"
 	"inventory-service/internal/adapter/restapi/response"
 	"inventory-service/internal/adapter/restapi/serializer"
 	"inventory-service/internal/domain/entity"
+	"inventory-service/internal/shared/exception"
+	"inventory-service/internal/shared/validation"
 	"net/http"
+	"slices"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/labstack/echo/v4"
 )
@@ -14,8 +24,15 @@ import (
 type ProductHandler interface {
 	Create(c echo.Context) error
 	Get(c echo.Context) error
+	GetBySKU(c echo.Context) error
+	GetByBarcode(c echo.Context) error
 	List(c echo.Context) error
 	Update(c echo.Context) error
+	AdjustStock(c echo.Context) error
+	UpdateStatus(c echo.Context) error
+	Bulk(c echo.Context) error
+	Export(c echo.Context) error
+	WatchStock(c echo.Context) error
 }
 
 type productHandler struct {
@@ -27,9 +44,205 @@ func NewProductHandler(props properties) ProductHandler {
 }
 
 type CreateProductRequest struct {
-	Name  string  `json:"name" validate:"required"`
-	Stock int     `json:"stock" validate:"required,min=0"`
-	Price float64 `json:"price" validate:"required,min=0"`
+	SKU          string                 `json:"sku"`
+	Barcode      string                 `json:"barcode"`
+	CategoryID   uint32                 `json:"category_id"`
+	Name         string                 `json:"name"`
+	Stock        int                    `json:"stock"`
+	Price        string                 `json:"price"`
+	Currency     string                 `json:"currency"`
+	ParentID     uint32                 `json:"parent_id"`
+	Options      map[string]string      `json:"options"`
+	Components   []*KitComponentRequest `json:"components"`
+	TrackLots    bool                   `json:"track_lots"`
+	TrackSerials bool                   `json:"track_serials"`
+	Units        []*ProductUnitRequest  `json:"units"`
+	// Status only applies on create; later changes go through UpdateStatus.
+	Status     string         `json:"status"`
+	Attributes map[string]any `json:"attributes"`
+}
+
+func (r *CreateProductRequest) validate(v *validation.Validator) {
+	r.validateFields(v, "")
+}
+
+// validateFields checks the product fields; prefix places them within a bulk
+// request.
+func (r *CreateProductRequest) validateFields(v *validation.Validator, prefix string) {
+	v.Min(prefix+"stock", r.Stock, 0)
+	v.SKU(prefix+"sku", r.SKU)
+	v.Barcode(prefix+"barcode", r.Barcode)
+
+	// Variants inherit the name and price of their parent and are told apart
+	// by their options.
+	if r.ParentID > 0 {
+		v.MaxLength(prefix+"name", r.Name, constant.MaxNameLength)
+		v.Check(len(r.Options) > 0, prefix+"options", "This field is required for a variant")
+	} else {
+		v.Name(prefix+"name", r.Name)
+		v.Required(prefix+"price", r.Price)
+	}
+	v.Currency(prefix+"currency", r.Currency)
+
+	for i, component := range r.Components {
+		field := validation.Field(prefix+"components", i)
+		if component == nil {
+			v.Check(false, field, "This field is required")
+			continue
+		}
+
+		v.RequiredID(field+".product_id", component.ProductID)
+		v.Min(field+".quantity", component.Quantity, 1)
+	}
+
+	for i, unit := range r.Units {
+		field := validation.Field(prefix+"units", i)
+		if unit == nil {
+			v.Check(false, field, "This field is required")
+			continue
+		}
+
+		v.PackagingUnit(field+".unit", unit.Unit)
+		v.Min(field+".factor", unit.Factor, 1)
+	}
+
+	v.OneOf(prefix+"status", r.Status, constant.ProductStatusDraft, constant.ProductStatusActive)
+}
+
+// BulkProductRequest creates or updates many products at once. Update items
+// carry the product id next to the usual product fields.
+type BulkProductRequest struct {
+	Operation string                    `json:"operation"`
+	Atomic    bool                      `json:"atomic"`
+	Products  []*BulkProductRequestItem `json:"products"`
+}
+
+func (r *BulkProductRequest) validate(v *validation.Validator) {
+	v.Required("operation", r.Operation)
+	v.OneOf("operation", r.Operation, "create", "update")
+	v.Check(len(r.Products) > 0, "products", "This field is required")
+
+	for i, item := range r.Products {
+		prefix := validation.Field("products", i)
+		if item == nil {
+			v.Check(false, prefix, "This field is required")
+			continue
+		}
+
+		if r.Operation == "update" {
+			v.RequiredID(prefix+".id", item.ID)
+		}
+		item.validateFields(v, prefix+".")
+	}
+}
+
+type BulkProductRequestItem struct {
+	ID uint32 `json:"id"`
+	CreateProductRequest
+}
+
+type UpdateProductStatusRequest struct {
+	Status string `json:"status"`
+}
+
+func (r *UpdateProductStatusRequest) validate(v *validation.Validator) {
+	v.Required("status", r.Status)
+	v.OneOf("status", r.Status, constant.ProductStatusDraft, constant.ProductStatusActive, constant.ProductStatusDiscontinued)
+}
+
+type ProductUnitRequest struct {
+	Unit   string `json:"unit"`
+	Factor int    `json:"factor"`
+}
+
+type AdjustStockRequest struct {
+	Quantity int    `json:"quantity"`
+	Unit     string `json:"unit"`
+}
+
+func (r *AdjustStockRequest) validate(v *validation.Validator) {
+	v.Check(r.Quantity != 0, "quantity", "This field is required")
+	v.Unit("unit", r.Unit)
+}
+
+type KitComponentRequest struct {
+	ProductID uint32 `json:"product_id"`
+	Quantity  int    `json:"quantity"`
+}
+
+func (r *CreateProductRequest) kitComponents() []*entity.KitComponent {
+	if r.Components == nil {
+		return nil
+	}
+
+	components := make([]*entity.KitComponent, 0, len(r.Components))
+	for _, component := range r.Components {
+		components = append(components, &entity.KitComponent{
+			ComponentID: component.ProductID,
+			Quantity:    component.Quantity,
+		})
+	}
+
+	return components
+}
+
+// money parses the decimal price string; an omitted price on a variant is left
+// zero and inherited from the parent.
+func (r *CreateProductRequest) money() (entity.Money, error) {
+	if r.Price == "" {
+		return entity.Money{Currency: r.Currency}, nil
+	}
+
+	price, err := entity.ParseMoney(r.Price, r.Currency)
+	if err != nil {
+		return entity.Money{}, exception.NewWithErrors(exception.TypeValidationError, exception.CodeValidationFailed, "Invalid price", exception.FieldErrors{
+			"price": {"Price must be a decimal number such as 19.99"},
+		})
+	}
+
+	return price, nil
+}
+
+// product maps the request onto a product entity. Status is left to the
+// caller since it only applies on create.
+func (r *CreateProductRequest) product() (*entity.Product, error) {
+	price, err := r.money()
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.Product{
+		SKU:          r.SKU,
+		Barcode:      r.Barcode,
+		CategoryID:   r.CategoryID,
+		Name:         r.Name,
+		Stock:        r.Stock,
+		Price:        price,
+		InheritPrice: r.Price == "",
+		ParentID:     r.ParentID,
+		Options:      r.Options,
+		Components:   r.kitComponents(),
+		TrackLots:    r.TrackLots,
+		TrackSerials: r.TrackSerials,
+		Units:        r.productUnits(),
+		Attributes:   r.Attributes,
+	}, nil
+}
+
+func (r *CreateProductRequest) productUnits() []*entity.ProductUnit {
+	if r.Units == nil {
+		return nil
+	}
+
+	units := make([]*entity.ProductUnit, 0, len(r.Units))
+	for _, unit := range r.Units {
+		units = append(units, &entity.ProductUnit{
+			Unit:   unit.Unit,
+			Factor: unit.Factor,
+		})
+	}
+
+	return units
 }
 
 func (h *productHandler) Create(c echo.Context) error {
@@ -37,16 +250,17 @@ func (h *productHandler) Create(c echo.Context) error {
 	if err := c.Bind(&req); err != nil {
 		return err
 	}
-	if err := h.validator.Struct(req); err != nil {
+	if err := h.validate(&req); err != nil {
 		return err
 	}
 
-	product := &entity.Product{
-		Name:  req.Name,
-		Stock: req.Stock,
-		Price: req.Price,
+	product, err := req.product()
+	if err != nil {
+		return err
 	}
 
+	product.Status = req.Status
+
 	createdProduct, err := h.service.Product().Create(c.Request().Context(), product)
 	if err != nil {
 		return err
@@ -66,16 +280,45 @@ func (h *productHandler) Get(c echo.Context) error {
 		return err
 	}
 
+	if raw := c.QueryParam("price_at"); raw != "" {
+		priceAt, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return exception.NewWithErrors(exception.TypeValidationError, exception.CodeValidationFailed, "Invalid price time", exception.FieldErrors{
+				"price_at": {"Time must be in RFC 3339 format"},
+			})
+		}
+
+		product.Price, err = h.service.PriceChange().PriceAt(c.Request().Context(), product.ID, priceAt)
+		if err != nil {
+			return err
+		}
+	}
+
 	return response.Success(c, "Product retrieved successfully", serializer.SerializeProduct(product))
 }
 
-func (h *productHandler) List(c echo.Context) error {
-	page, _ := strconv.Atoi(c.QueryParam("page"))
-	perPage, _ := strconv.Atoi(c.QueryParam("per_page"))
+func (h *productHandler) GetBySKU(c echo.Context) error {
+	product, err := h.service.Product().FindBySKU(c.Request().Context(), c.Param("sku"))
+	if err != nil {
+		return err
+	}
 
-	filter := &postgresrepository.FilterProductPayload{
-		Page:    page,
-		PerPage: perPage,
+	return response.Success(c, "Product retrieved successfully", serializer.SerializeProduct(product))
+}
+
+func (h *productHandler) GetByBarcode(c echo.Context) error {
+	product, err := h.service.Product().FindByBarcode(c.Request().Context(), c.Param("barcode"))
+	if err != nil {
+		return err
+	}
+
+	return response.Success(c, "Product retrieved successfully", serializer.SerializeProduct(product))
+}
+
+func (h *productHandler) List(c echo.Context) error {
+	filter, err := productFilter(c)
+	if err != nil {
+		return err
 	}
 
 	products, total, err := h.service.Product().Find(c.Request().Context(), filter)
@@ -84,11 +327,149 @@ func (h *productHandler) List(c echo.Context) error {
 	}
 
 	return response.Paginate(c, "Products retrieved successfully", serializer.SerializeProducts(products), response.Pagination{
-		Page:       page,
-		PerPage:    perPage,
+		Page:       filter.Page,
+		PerPage:    filter.PerPage,
 		TotalCount: total,
-		TotalPage:  (total + perPage - 1) / perPage,
+		TotalPage:  (total + filter.PerPage - 1) / filter.PerPage,
+	})
+}
+
+// Export streams every product matching the List filters, with current stock,
+// as CSV or NDJSON depending on the Accept header. Paging is ignored.
+func (h *productHandler) Export(c echo.Context) error {
+	filter, err := productFilter(c)
+	if err != nil {
+		return err
+	}
+
+	format, contentType := exportFormat(c)
+	w := &streamWriter{c: c, contentType: contentType}
+
+	if _, err := catalog.NewExporter(h.service.Product()).Export(c.Request().Context(), w, format, filter); err != nil {
+		return err
+	}
+
+	w.commit()
+
+	return nil
+}
+
+// WatchStock streams the stock events of the product_id query products as
+// server-sent events. A reconnecting EventSource resumes after its
+// Last-Event-ID; other clients can pass after_sequence.
+func (h *productHandler) WatchStock(c echo.Context) error {
+	var productIDs []uint32
+
+	for _, raw := range c.QueryParams()["product_id"] {
+		id, err := strconv.ParseUint(raw, 10, 32)
+		if err != nil {
+			return exception.NewWithErrors(exception.TypeValidationError, exception.CodeValidationFailed, "Invalid product id", exception.FieldErrors{
+				"product_id": {"Must be a positive integer"},
+			})
+		}
+
+		productIDs = append(productIDs, uint32(id))
+	}
+
+	after := c.Request().Header.Get("Last-Event-ID")
+	if after == "" {
+		after = c.QueryParam("after_sequence")
+	}
+
+	var afterSequence uint64
+	if after != "" {
+		sequence, err := strconv.ParseUint(after, 10, 64)
+		if err != nil {
+			return exception.NewWithErrors(exception.TypeValidationError, exception.CodeValidationFailed, "Invalid sequence", exception.FieldErrors{
+				"after_sequence": {"Must be a non-negative integer"},
+			})
+		}
+
+		afterSequence = sequence
+	}
+
+	ctx, cancel := context.WithCancel(c.Request().Context())
+	defer cancel()
+
+	w := &sseWriter{c: c}
+
+	go func() {
+		ticker := time.NewTicker(h.heartbeatInterval())
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := w.Heartbeat(); err != nil {
+					cancel()
+					return
+				}
+			}
+		}
+	}()
+
+	err := h.service.StockWatch().Watch(ctx, productIDs, afterSequence, func(event *entity.StockEvent) error {
+		return w.Event(strconv.FormatUint(event.Sequence, 10), "stock", serializer.SerializeStockEvent(event))
 	})
+	if err == nil || ctx.Err() != nil {
+		return nil
+	}
+
+	// Once the stream has started the error can only be reported in it.
+	if w.Committed() {
+		_ = w.Error(err)
+		return nil
+	}
+
+	return err
+}
+
+func (h *productHandler) heartbeatInterval() time.Duration {
+	if h.config != nil && h.config.StockWatch != nil && h.config.StockWatch.HeartbeatInterval > 0 {
+		return time.Duration(h.config.StockWatch.HeartbeatInterval) * time.Second
+	}
+
+	return defaultHeartbeatInterval
+}
+
+// productFilter reads the List query parameters.
+func productFilter(c echo.Context) (*postgresrepository.FilterProductPayload, error) {
+	page, perPage, err := pagination(c)
+	if err != nil {
+		return nil, err
+	}
+
+	categoryID, _ := strconv.ParseUint(c.QueryParam("category_id"), 10, 32)
+	includeDescendants, _ := strconv.ParseBool(c.QueryParam("include_descendants"))
+	parentID, _ := strconv.ParseUint(c.QueryParam("parent_id"), 10, 32)
+	excludeVariants, _ := strconv.ParseBool(c.QueryParam("exclude_variants"))
+
+	attributes, err := attributeFilters(c)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := &postgresrepository.FilterProductPayload{
+		SKUs:               c.QueryParams()["sku"],
+		CategoryID:         uint32(categoryID),
+		IncludeDescendants: includeDescendants,
+		ExcludeVariants:    excludeVariants,
+		Attributes:         attributes,
+		Page:               page,
+		PerPage:            perPage,
+	}
+
+	if parentID > 0 {
+		filter.ParentIDs = []uint32{uint32(parentID)}
+	}
+
+	for _, status := range c.QueryParams()["status"] {
+		filter.Statuses = append(filter.Statuses, strings.ToUpper(status))
+	}
+
+	return filter, nil
 }
 
 func (h *productHandler) Update(c echo.Context) error {
@@ -101,17 +482,17 @@ func (h *productHandler) Update(c echo.Context) error {
 	if err := c.Bind(&req); err != nil {
 		return err
 	}
-	if err := h.validator.Struct(req); err != nil {
+	if err := h.validate(&req); err != nil {
 		return err
 	}
 
-	product := &entity.Product{
-		ID:    uint32(id),
-		Name:  req.Name,
-		Stock: req.Stock,
-		Price: req.Price,
+	product, err := req.product()
+	if err != nil {
+		return err
 	}
 
+	product.ID = uint32(id)
+
 	updatedProduct, err := h.service.Product().Update(c.Request().Context(), product)
 	if err != nil {
 		return err
@@ -119,3 +500,168 @@ func (h *productHandler) Update(c echo.Context) error {
 
 	return response.Success(c, "Product updated successfully", serializer.SerializeProduct(updatedProduct))
 }
+
+func (h *productHandler) AdjustStock(c echo.Context) error {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return err
+	}
+
+	var req AdjustStockRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+	if err := h.validate(&req); err != nil {
+		return err
+	}
+
+	adjustment, err := h.service.Product().AdjustStock(c.Request().Context(), &entity.StockAdjustment{
+		ProductID: uint32(id),
+		Quantity:  req.Quantity,
+		Unit:      req.Unit,
+	})
+	if err != nil {
+		return err
+	}
+
+	return response.Success(c, "Stock adjusted successfully", serializer.SerializeStockAdjustment(adjustment))
+}
+
+func (h *productHandler) UpdateStatus(c echo.Context) error {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return err
+	}
+
+	var req UpdateProductStatusRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+	if err := h.validate(&req); err != nil {
+		return err
+	}
+
+	product, err := h.service.Product().UpdateStatus(c.Request().Context(), uint32(id), req.Status)
+	if err != nil {
+		return err
+	}
+
+	return response.Success(c, "Product status updated successfully", serializer.SerializeProduct(product))
+}
+
+func (h *productHandler) Bulk(c echo.Context) error {
+	var req BulkProductRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+	if err := h.validate(&req); err != nil {
+		return err
+	}
+
+	products := make([]*entity.Product, len(req.Products))
+	for i, item := range req.Products {
+		product, err := item.product()
+		if err != nil {
+			return exception.NewWithErrors(exception.TypeValidationError, exception.CodeValidationFailed, "Invalid price", exception.FieldErrors{
+				fmt.Sprintf("products[%d].price", i): {"Price must be a decimal number such as 19.99"},
+			})
+		}
+
+		product.ID = item.ID
+		product.Status = item.Status
+		products[i] = product
+	}
+
+	var (
+		results []*entity.BatchItemResult
+		err     error
+	)
+
+	if req.Operation == "update" {
+		results, err = h.service.Product().BatchUpdate(c.Request().Context(), products, req.Atomic)
+	} else {
+		results, err = h.service.Product().BatchCreate(c.Request().Context(), products, req.Atomic)
+	}
+	if err != nil {
+		return err
+	}
+
+	return response.Success(c, "Bulk request processed", serializer.SerializeBatchResults(results))
+}
+
+// attributeFilters reads the attribute filters from the query string:
+// attr.<key>=<value> for equality, attr_min.<key> and attr_max.<key> for numeric
+// ranges and attr_exists=<key> for presence. Keys may be dotted paths. Values
+// that parse as JSON numbers or booleans are matched as such, anything else as
+// a string.
+func attributeFilters(c echo.Context) ([]*postgresrepository.AttributeFilter, error) {
+	filters := map[string]*postgresrepository.AttributeFilter{}
+	filterFor := func(key string) *postgresrepository.AttributeFilter {
+		if filters[key] == nil {
+			filters[key] = &postgresrepository.AttributeFilter{Key: key}
+		}
+
+		return filters[key]
+	}
+
+	for name, values := range c.QueryParams() {
+		if name == "attr_exists" {
+			for _, key := range values {
+				filterFor(key).Exists = true
+			}
+
+			continue
+		}
+
+		prefix, key, ok := strings.Cut(name, ".")
+		if !ok || len(values) == 0 {
+			continue
+		}
+
+		switch prefix {
+		case "attr":
+			var value any
+			if err := json.Unmarshal([]byte(values[0]), &value); err != nil || value == nil || isComposite(value) {
+				value = values[0]
+			}
+
+			filterFor(key).Equals = value
+		case "attr_min", "attr_max":
+			bound, err := strconv.ParseFloat(values[0], 64)
+			if err != nil {
+				return nil, exception.NewWithErrors(exception.TypeValidationError, exception.CodeValidationFailed, "Invalid attribute filter", exception.FieldErrors{
+					name: {"Bound must be a number"},
+				})
+			}
+
+			if prefix == "attr_min" {
+				filterFor(key).Min = &bound
+			} else {
+				filterFor(key).Max = &bound
+			}
+		}
+	}
+
+	keys := make([]string, 0, len(filters))
+	for key := range filters {
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+
+	res := make([]*postgresrepository.AttributeFilter, 0, len(keys))
+	for _, key := range keys {
+		res = append(res, filters[key])
+	}
+
+	return res, nil
+}
+
+func isComposite(value any) bool {
+	switch value.(type) {
+	case map[string]any, []any:
+		return true
+	default:
+		return false
+	}
+}
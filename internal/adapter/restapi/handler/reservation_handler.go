@@ -0,0 +1,103 @@
+package handler
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"inventory-service/internal/adapter/catalog"
+	postgresrepository "inventory-service/internal/adapter/repository/postgres"
+	"inventory-service/internal/adapter/restapi/serializer"
+	"inventory-service/internal/domain/entity"
+	"inventory-service/internal/shared/exception"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ReservationHandler interface {
+	Export(c echo.Context) error
+}
+
+type reservationHandler struct {
+	properties
+}
+
+func NewReservationHandler(props properties) ReservationHandler {
+	return &reservationHandler{properties: props}
+}
+
+// Export streams every reservation matching the product_id, order_id and
+// status query filters as CSV or NDJSON depending on the Accept header.
+func (h *reservationHandler) Export(c echo.Context) error {
+	filter := &postgresrepository.FilterReservationPayload{}
+
+	for name, target := range map[string]*[]uint32{"product_id": &filter.ProductIDs, "order_id": &filter.OrderIDs} {
+		for _, raw := range c.QueryParams()[name] {
+			id, err := strconv.ParseUint(raw, 10, 32)
+			if err != nil {
+				return exception.NewWithErrors(exception.TypeValidationError, exception.CodeValidationFailed, "Invalid filter", exception.FieldErrors{
+					name: {"Must be a positive integer"},
+				})
+			}
+
+			*target = append(*target, uint32(id))
+		}
+	}
+
+	for _, status := range c.QueryParams()["status"] {
+		filter.Statuses = append(filter.Statuses, strings.ToUpper(status))
+	}
+
+	format, contentType := exportFormat(c)
+	w := &streamWriter{c: c, contentType: contentType}
+
+	var (
+		write func(*entity.Reservation) error
+		flush func() error
+	)
+
+	if format == catalog.FormatCSV {
+		writer := csv.NewWriter(w)
+		headerWritten := false
+		write = func(reservation *entity.Reservation) error {
+			if !headerWritten {
+				headerWritten = true
+				if err := writer.Write(serializer.ReservationCSVColumns); err != nil {
+					return err
+				}
+			}
+
+			return writer.Write(serializer.SerializeReservationCSV(reservation))
+		}
+		flush = func() error {
+			if !headerWritten {
+				if err := writer.Write(serializer.ReservationCSVColumns); err != nil {
+					return err
+				}
+			}
+
+			writer.Flush()
+			return writer.Error()
+		}
+	} else {
+		buffered := bufio.NewWriter(w)
+		encoder := json.NewEncoder(buffered)
+		write = func(reservation *entity.Reservation) error {
+			return encoder.Encode(serializer.SerializeReservation(reservation))
+		}
+		flush = buffered.Flush
+	}
+
+	if err := h.service.Reservation().Export(c.Request().Context(), filter, write); err != nil {
+		return err
+	}
+
+	if err := flush(); err != nil {
+		return err
+	}
+
+	w.commit()
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package handler
+
+import (
+	postgresrepository "inventory-service/internal/adapter/repository/postgres"
+	"inventory-service/internal/adapter/restapi/response"
+	"inventory-service/internal/adapter/restapi/serializer"
+	"inventory-service/internal/shared/validation"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+type SerialHandler interface {
+	Register(c echo.Context) error
+	ListByProduct(c echo.Context) error
+	Get(c echo.Context) error
+}
+
+type serialHandler struct {
+	properties
+}
+
+func NewSerialHandler(props properties) SerialHandler {
+	return &serialHandler{properties: props}
+}
+
+type RegisterSerialsRequest struct {
+	SerialNumbers []string `json:"serial_numbers"`
+}
+
+func (r *RegisterSerialsRequest) validate(v *validation.Validator) {
+	v.SerialNumbers("serial_numbers", r.SerialNumbers)
+}
+
+func (h *serialHandler) Register(c echo.Context) error {
+	productID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return err
+	}
+
+	var req RegisterSerialsRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+	if err := h.validate(&req); err != nil {
+		return err
+	}
+
+	serials, err := h.service.Serial().Register(c.Request().Context(), uint32(productID), req.SerialNumbers)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, serializer.SerializeSerials(serials))
+}
+
+func (h *serialHandler) ListByProduct(c echo.Context) error {
+	productID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return err
+	}
+
+	page, perPage, err := pagination(c)
+	if err != nil {
+		return err
+	}
+
+	filter := &postgresrepository.FilterSerialPayload{
+		ProductIDs: []uint32{uint32(productID)},
+		Page:       page,
+		PerPage:    perPage,
+	}
+
+	if status := c.QueryParam("status"); status != "" {
+		filter.Statuses = []string{strings.ToUpper(status)}
+	}
+
+	serials, total, err := h.service.Serial().Find(c.Request().Context(), filter)
+	if err != nil {
+		return err
+	}
+
+	totalPage := 1
+	if perPage > 0 {
+		totalPage = (total + perPage - 1) / perPage
+	}
+
+	return response.Paginate(c, "Serials retrieved successfully", serializer.SerializeSerials(serials), response.Pagination{
+		Page:       page,
+		PerPage:    perPage,
+		TotalCount: total,
+		TotalPage:  totalPage,
+	})
+}
+
+func (h *serialHandler) Get(c echo.Context) error {
+	serial, err := h.service.Serial().FindBySerialNumber(c.Request().Context(), c.Param("serial_number"))
+	if err != nil {
+		return err
+	}
+
+	return response.Success(c, "Serial retrieved successfully", serializer.SerializeSerial(serial))
+}
@@ -0,0 +1,80 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	contentTypeEventStream   = "text/event-stream"
+	defaultHeartbeatInterval = 15 * time.Second
+)
+
+// sseWriter writes server-sent events. Like streamWriter it only commits the
+// response on the first write, and it serializes writes from the handler and
+// its heartbeat.
+type sseWriter struct {
+	c  echo.Context
+	mu sync.Mutex
+}
+
+// Event writes one event with the given id and JSON encoded data.
+func (w *sseWriter) Event(id, event string, data any) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	return w.write(fmt.Sprintf("id: %s\nevent: %s\ndata: %s\n\n", id, event, payload))
+}
+
+// Error writes an error event. It has no id, since an empty id would reset
+// the client's Last-Event-ID.
+func (w *sseWriter) Error(err error) error {
+	payload, jsonErr := json.Marshal(map[string]string{"message": err.Error()})
+	if jsonErr != nil {
+		return jsonErr
+	}
+
+	return w.write(fmt.Sprintf("event: error\ndata: %s\n\n", payload))
+}
+
+// Heartbeat writes a comment, which keeps proxies from closing an idle stream.
+func (w *sseWriter) Heartbeat() error {
+	return w.write(": heartbeat\n\n")
+}
+
+// Committed reports whether anything has been written.
+func (w *sseWriter) Committed() bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.c.Response().Committed
+}
+
+func (w *sseWriter) write(s string) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	res := w.c.Response()
+	if !res.Committed {
+		res.Header().Set(echo.HeaderContentType, contentTypeEventStream)
+		res.Header().Set(echo.HeaderCacheControl, "no-cache")
+		res.Header().Set(echo.HeaderConnection, "keep-alive")
+		res.Header().Set("X-Accel-Buffering", "no")
+		res.WriteHeader(http.StatusOK)
+	}
+
+	if _, err := res.Write([]byte(s)); err != nil {
+		return err
+	}
+
+	res.Flush()
+
+	return nil
+}
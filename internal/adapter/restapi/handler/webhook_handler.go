@@ -0,0 +1,205 @@
+package handler
+
+import (
+	postgresrepository "inventory-service/internal/adapter/repository/postgres"
+	"inventory-service/internal/adapter/restapi/response"
+	"inventory-service/internal/adapter/restapi/serializer"
+	"inventory-service/internal/domain/entity"
+	"inventory-service/internal/shared/validation"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+type WebhookHandler interface {
+	Create(c echo.Context) error
+	Get(c echo.Context) error
+	List(c echo.Context) error
+	Update(c echo.Context) error
+	Delete(c echo.Context) error
+	ListDeliveries(c echo.Context) error
+}
+
+type webhookHandler struct {
+	properties
+}
+
+func NewWebhookHandler(props properties) WebhookHandler {
+	return &webhookHandler{properties: props}
+}
+
+type CreateWebhookRequest struct {
+	URL        string   `json:"url"`
+	EventTypes []string `json:"event_types"`
+	Secret     string   `json:"secret"`
+}
+
+func (r *CreateWebhookRequest) validate(v *validation.Validator) {
+	v.Webhook(r.URL, r.EventTypes, r.Secret)
+}
+
+type UpdateWebhookRequest struct {
+	CreateWebhookRequest
+	Active bool `json:"active"`
+}
+
+// Create is the only response carrying the webhook's secret.
+func (h *webhookHandler) Create(c echo.Context) error {
+	var req CreateWebhookRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+	if err := h.validate(&req); err != nil {
+		return err
+	}
+
+	webhook := &entity.Webhook{
+		URL:        req.URL,
+		EventTypes: req.EventTypes,
+		Secret:     req.Secret,
+	}
+
+	createdWebhook, err := h.service.Webhook().Create(c.Request().Context(), webhook)
+	if err != nil {
+		return err
+	}
+
+	res := serializer.SerializeWebhook(createdWebhook)
+	res.Secret = createdWebhook.Secret
+
+	return c.JSON(http.StatusCreated, res)
+}
+
+func (h *webhookHandler) Get(c echo.Context) error {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return err
+	}
+
+	webhook, err := h.service.Webhook().FindByID(c.Request().Context(), uint32(id))
+	if err != nil {
+		return err
+	}
+
+	return response.Success(c, "Webhook retrieved successfully", serializer.SerializeWebhook(webhook))
+}
+
+func (h *webhookHandler) List(c echo.Context) error {
+	page, perPage, err := pagination(c)
+	if err != nil {
+		return err
+	}
+
+	activeOnly, _ := strconv.ParseBool(c.QueryParam("active"))
+
+	filter := &postgresrepository.FilterWebhookPayload{
+		ActiveOnly: activeOnly,
+		Page:       page,
+		PerPage:    perPage,
+	}
+
+	webhooks, total, err := h.service.Webhook().Find(c.Request().Context(), filter)
+	if err != nil {
+		return err
+	}
+
+	totalPage := 1
+	if perPage > 0 {
+		totalPage = (total + perPage - 1) / perPage
+	}
+
+	return response.Paginate(c, "Webhooks retrieved successfully", serializer.SerializeWebhooks(webhooks), response.Pagination{
+		Page:       page,
+		PerPage:    perPage,
+		TotalCount: total,
+		TotalPage:  totalPage,
+	})
+}
+
+// Update keeps the current secret when none is given. Setting active enables
+// a disabled webhook again and clears its failures.
+func (h *webhookHandler) Update(c echo.Context) error {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return err
+	}
+
+	var req UpdateWebhookRequest
+	if err := c.Bind(&req); err != nil {
+		return err
+	}
+	if err := h.validate(&req); err != nil {
+		return err
+	}
+
+	webhook := &entity.Webhook{
+		Base:       entity.Base{ID: uint32(id)},
+		URL:        req.URL,
+		EventTypes: req.EventTypes,
+		Secret:     req.Secret,
+		Active:     req.Active,
+	}
+
+	updatedWebhook, err := h.service.Webhook().Update(c.Request().Context(), webhook)
+	if err != nil {
+		return err
+	}
+
+	return response.Success(c, "Webhook updated successfully", serializer.SerializeWebhook(updatedWebhook))
+}
+
+func (h *webhookHandler) Delete(c echo.Context) error {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return err
+	}
+
+	if err := h.service.Webhook().Delete(c.Request().Context(), uint32(id)); err != nil {
+		return err
+	}
+
+	return response.Success(c, "Webhook deleted successfully", nil)
+}
+
+// ListDeliveries returns a webhook's deliveries newest first, optionally
+// filtered by status.
+func (h *webhookHandler) ListDeliveries(c echo.Context) error {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return err
+	}
+
+	page, perPage, err := pagination(c)
+	if err != nil {
+		return err
+	}
+
+	filter := &postgresrepository.FilterWebhookDeliveryPayload{
+		WebhookIDs: []uint32{uint32(id)},
+		Page:       page,
+		PerPage:    perPage,
+	}
+
+	for _, status := range c.QueryParams()["status"] {
+		filter.Statuses = append(filter.Statuses, strings.ToUpper(status))
+	}
+
+	deliveries, total, err := h.service.Webhook().FindDeliveries(c.Request().Context(), filter)
+	if err != nil {
+		return err
+	}
+
+	totalPage := 1
+	if perPage > 0 {
+		totalPage = (total + perPage - 1) / perPage
+	}
+
+	return response.Paginate(c, "Webhook deliveries retrieved successfully", serializer.SerializeWebhookDeliveries(deliveries), response.Pagination{
+		Page:       page,
+		PerPage:    perPage,
+		TotalCount: total,
+		TotalPage:  totalPage,
+	})
+}
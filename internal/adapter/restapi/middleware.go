@@ -2,8 +2,12 @@ package rest
 
 import (
 	"inventory-service/constant"
+	"inventory-service/internal/shared/auth"
+	"inventory-service/internal/shared/ratelimit"
 	"inventory-service/pkg/logger"
+	"math"
 	"net/http"
+	"strconv"
 	"time"
 
 	echo "github.com/labstack/echo/v4"
@@ -16,11 +20,14 @@ func (s *echoServer) setupMiddlewares() {
 	s.echo.Use(middleware.RequestID())
 	s.echo.Use(middleware.CORSWithConfig(middleware.CORSConfig{
 		AllowOrigins: []string{"*"},
-		AllowMethods: []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodDelete, http.MethodOptions},
+		AllowMethods: []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete, http.MethodOptions},
 		AllowHeaders: []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderAuthorization},
 	}))
 	s.echo.Use(s.requestLoggerMiddleware())
 	s.echo.Use(apmecho.Middleware())
+	s.echo.Use(s.ipRateLimitMiddleware())
+	s.echo.Use(s.authMiddleware())
+	s.echo.Use(s.rateLimitMiddleware())
 	s.echo.HTTPErrorHandler = s.httpErrorHandler
 }
 
@@ -36,6 +43,8 @@ func (s *echoServer) requestLoggerMiddleware() echo.MiddlewareFunc {
 			c.Set(constant.CtxKeySubLogger, reqLogger)
 
 			req := c.Request()
+			c.SetRequest(req.WithContext(auth.WithOrigin(req.Context(), &auth.Origin{Source: auth.SourceREST, RequestID: reqID})))
+
 			err := next(c)
 			res := c.Response()
 			status := res.Status
@@ -74,3 +83,81 @@ func (s *echoServer) requestLoggerMiddleware() echo.MiddlewareFunc {
 		}
 	}
 }
+
+// authMiddleware puts the caller of every non-public route into the request
+// context, rejecting requests without a valid bearer token or without the
+// permission the policy requires for the route.
+func (s *echoServer) authMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if s.authenticator == nil || publicRoutes[c.Path()] {
+				return next(c)
+			}
+
+			principal, err := s.authenticator.Authenticate(c.Request().Header.Get(echo.HeaderAuthorization))
+			if err != nil {
+				return err
+			}
+
+			req := c.Request()
+			if err := s.policy.Authorize(principal, auth.TransportREST, auth.RESTEndpoint(req.Method, c.Path())); err != nil {
+				return err
+			}
+
+			c.SetRequest(req.WithContext(auth.WithPrincipal(req.Context(), principal)))
+
+			return next(c)
+		}
+	}
+}
+
+// ipRateLimitMiddleware counts every non-public request against the IP it
+// came from before authentication, so requests without valid credentials are
+// limited too.
+func (s *echoServer) ipRateLimitMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if s.limiter == nil || publicRoutes[c.Path()] {
+				return next(c)
+			}
+
+			if err := s.limiter.AllowIP(c.Request().Context(), c.RealIP()); err != nil {
+				setRetryAfter(c, err)
+				return err
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// rateLimitMiddleware counts every non-public request against its caller,
+// once authentication has named it. Rejected requests are told in Retry-After
+// how many seconds to wait.
+func (s *echoServer) rateLimitMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if s.limiter == nil || publicRoutes[c.Path()] {
+				return next(c)
+			}
+
+			req := c.Request()
+			caller := ratelimit.Caller(req.Context(), c.RealIP())
+
+			if err := s.limiter.Allow(req.Context(), caller, auth.TransportREST, auth.RESTEndpoint(req.Method, c.Path())); err != nil {
+				setRetryAfter(c, err)
+				return err
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// setRetryAfter tells the client of a rate limited request how many seconds
+// to wait.
+func setRetryAfter(c echo.Context, err error) {
+	if retryAfter, ok := ratelimit.RetryAfter(err); ok {
+		c.Response().Header().Set(echo.HeaderRetryAfter, strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+	}
+}
@@ -1,14 +1,75 @@
 package rest
 
+// publicRoutes are served without authentication.
+var publicRoutes = map[string]bool{
+	"/health": true,
+}
+
 func (s *echoServer) setupRouter() {
+	s.echo.GET("/health", s.handler.Health().Check)
+
 	apiV1 := s.echo.Group("/api/v1")
 	{
 		productGroup := apiV1.Group("/products")
 		{
 			productGroup.POST("", s.handler.Product().Create)
 			productGroup.GET("", s.handler.Product().List)
+			productGroup.POST("/bulk", s.handler.Product().Bulk)
+			productGroup.GET("/export", s.handler.Product().Export)
+			productGroup.GET("/stock/watch", s.handler.Product().WatchStock)
+			productGroup.GET("/sku/:sku", s.handler.Product().GetBySKU)
+			productGroup.GET("/barcode/:barcode", s.handler.Product().GetByBarcode)
 			productGroup.GET("/:id", s.handler.Product().Get)
 			productGroup.PUT("/:id", s.handler.Product().Update)
+			productGroup.PATCH("/:id/status", s.handler.Product().UpdateStatus)
+			productGroup.POST("/:id/adjustments", s.handler.Product().AdjustStock)
+			productGroup.POST("/:id/lots", s.handler.Lot().Create)
+			productGroup.GET("/:id/lots", s.handler.Lot().ListByProduct)
+			productGroup.POST("/:id/serials", s.handler.Serial().Register)
+			productGroup.GET("/:id/serials", s.handler.Serial().ListByProduct)
+			productGroup.POST("/:id/prices", s.handler.Price().Schedule)
+			productGroup.GET("/:id/prices", s.handler.Price().ListByProduct)
+			productGroup.DELETE("/:id/prices/:price_id", s.handler.Price().Cancel)
+		}
+
+		reservationGroup := apiV1.Group("/reservations")
+		{
+			reservationGroup.GET("/export", s.handler.Reservation().Export)
+		}
+
+		lotGroup := apiV1.Group("/lots")
+		{
+			lotGroup.GET("/expiring", s.handler.Lot().ListExpiring)
+		}
+
+		serialGroup := apiV1.Group("/serials")
+		{
+			serialGroup.GET("/:serial_number", s.handler.Serial().Get)
+		}
+
+		categoryGroup := apiV1.Group("/categories")
+		{
+			categoryGroup.POST("", s.handler.Category().Create)
+			categoryGroup.GET("", s.handler.Category().List)
+			categoryGroup.GET("/:id", s.handler.Category().Get)
+			categoryGroup.PUT("/:id", s.handler.Category().Update)
+			categoryGroup.DELETE("/:id", s.handler.Category().Delete)
+		}
+
+		webhookGroup := apiV1.Group("/webhooks")
+		{
+			webhookGroup.POST("", s.handler.Webhook().Create)
+			webhookGroup.GET("", s.handler.Webhook().List)
+			webhookGroup.GET("/:id", s.handler.Webhook().Get)
+			webhookGroup.PUT("/:id", s.handler.Webhook().Update)
+			webhookGroup.DELETE("/:id", s.handler.Webhook().Delete)
+			webhookGroup.GET("/:id/deliveries", s.handler.Webhook().ListDeliveries)
+		}
+
+		apiV1.GET("/audit-events", s.handler.Audit().List)
+
+		if s.config.HTTP.EnableMigrationAPI {
+			apiV1.POST("/migrations", s.handler.Migration().Run)
 		}
 	}
 }
@@ -0,0 +1,333 @@
+package rest
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"inventory-service/config"
+	"inventory-service/internal/adapter/restapi/handler"
+	"inventory-service/internal/shared/auth"
+	"inventory-service/internal/shared/exception"
+	"inventory-service/internal/shared/ratelimit"
+	"inventory-service/pkg/logger"
+	"inventory-service/pkg/tokenbucket"
+
+	"github.com/golang-jwt/jwt/v5"
+	echo "github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+const testSecret = "test-secret"
+
+// setupAuthServer registers every route, including the optional ones, on a
+// server enforcing the policy the service ships with.
+func setupAuthServer(t *testing.T) *echoServer {
+	cfg := &config.Config{
+		HTTP: &config.HTTPConfig{EnableMigrationAPI: true},
+		Auth: &config.AuthConfig{Enabled: true, Algorithm: "HS256", Secret: testSecret, PolicyFile: "../../../config/policy.yaml"},
+	}
+
+	h, err := handler.NewHandler(cfg, logger.NewZerologLogger(false), nil, nil)
+	assert.NoError(t, err)
+
+	authenticator, err := auth.NewAuthenticator(cfg.Auth)
+	assert.NoError(t, err)
+
+	policy, err := auth.LoadConfiguredPolicy(cfg.Auth)
+	assert.NoError(t, err)
+
+	s := &echoServer{config: cfg, echo: echo.New(), handler: h, authenticator: authenticator, policy: policy}
+	s.setupRouter()
+
+	return s
+}
+
+func bearerToken(t *testing.T, roles ...string) string {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"sub":   "user-1",
+		"roles": roles,
+		"exp":   time.Now().Add(time.Hour).Unix(),
+	}).SignedString([]byte(testSecret))
+	assert.NoError(t, err)
+
+	return "Bearer " + token
+}
+
+// serveRoute runs the auth middleware for a route and reports whether the
+// route's handler would have been reached.
+func serveRoute(s *echoServer, method, path, authorization string) (bool, error) {
+	req := httptest.NewRequest(method, "/", nil)
+	if authorization != "" {
+		req.Header.Set(echo.HeaderAuthorization, authorization)
+	}
+
+	c := s.echo.NewContext(req, httptest.NewRecorder())
+	c.SetPath(path)
+
+	reached := false
+	err := s.authMiddleware()(func(c echo.Context) error {
+		_, reached = auth.PrincipalFromContext(c.Request().Context())
+		return nil
+	})(c)
+
+	return reached || publicRoutes[path], err
+}
+
+func assertPermissionDenied(t *testing.T, err error) {
+	ex, ok := exception.GetException(err)
+	if assert.True(t, ok, "expected an exception, got %v", err) {
+		assert.Equal(t, exception.TypePermissionDenied, ex.Type)
+	}
+}
+
+func TestPolicyCoversEveryRoute(t *testing.T) {
+	s := setupAuthServer(t)
+
+	for _, route := range s.echo.Routes() {
+		if publicRoutes[route.Path] {
+			continue
+		}
+
+		_, ok := s.policy.Permission(auth.TransportREST, auth.RESTEndpoint(route.Method, route.Path))
+		assert.True(t, ok, "%s %s has no permission in the policy file", route.Method, route.Path)
+	}
+}
+
+func TestAuthMiddlewareAuthorizesEveryRouteByRole(t *testing.T) {
+	s := setupAuthServer(t)
+
+	for _, route := range s.echo.Routes() {
+		if publicRoutes[route.Path] {
+			continue
+		}
+
+		t.Run(route.Method+" "+route.Path, func(t *testing.T) {
+			permission, _ := s.policy.Permission(auth.TransportREST, auth.RESTEndpoint(route.Method, route.Path))
+
+			reached, err := serveRoute(s, route.Method, route.Path, bearerToken(t, "admin"))
+			assert.NoError(t, err)
+			assert.True(t, reached)
+
+			reached, err = serveRoute(s, route.Method, route.Path, bearerToken(t, "viewer"))
+			if permission == "inventory.read" {
+				assert.NoError(t, err)
+				assert.True(t, reached)
+			} else {
+				assertPermissionDenied(t, err)
+				assert.False(t, reached)
+			}
+
+			_, err = serveRoute(s, route.Method, route.Path, bearerToken(t))
+			assertPermissionDenied(t, err)
+		})
+	}
+}
+
+func TestAuthMiddlewareRoles(t *testing.T) {
+	s := setupAuthServer(t)
+
+	tests := []struct {
+		role    string
+		method  string
+		path    string
+		allowed bool
+	}{
+		{"viewer", http.MethodGet, "/api/v1/products/:id", true},
+		{"viewer", http.MethodPost, "/api/v1/products/:id/adjustments", false},
+		{"operator", http.MethodPost, "/api/v1/products/:id/adjustments", true},
+		{"operator", http.MethodPost, "/api/v1/products", false},
+		{"operator", http.MethodPost, "/api/v1/migrations", false},
+		{"admin", http.MethodPost, "/api/v1/products", true},
+		{"admin", http.MethodPost, "/api/v1/migrations", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.role+" "+tt.method+" "+tt.path, func(t *testing.T) {
+			reached, err := serveRoute(s, tt.method, tt.path, bearerToken(t, tt.role))
+			assert.Equal(t, tt.allowed, reached)
+
+			if !tt.allowed {
+				assertPermissionDenied(t, err)
+			}
+		})
+	}
+}
+
+func TestAuthMiddlewarePublicAndUnauthenticated(t *testing.T) {
+	s := setupAuthServer(t)
+
+	reached, err := serveRoute(s, http.MethodGet, "/health", "")
+	assert.NoError(t, err)
+	assert.True(t, reached)
+
+	_, err = serveRoute(s, http.MethodGet, "/api/v1/products", "")
+	ex, ok := exception.GetException(err)
+	if assert.True(t, ok) {
+		assert.Equal(t, exception.CodeAuthHeaderMissing, ex.Code)
+	}
+}
+
+func TestRateLimitMiddlewareRespondsWithRetryAfter(t *testing.T) {
+	cfg := &config.Config{
+		Auth:      &config.AuthConfig{PolicyFile: "../../../config/policy.yaml"},
+		RateLimit: &config.RateLimitConfig{Enabled: true, Rate: 1, Burst: 1},
+	}
+
+	limiter, err := ratelimit.NewLimiter(cfg, tokenbucket.NewMemoryStore(), logger.NewZerologLogger(false))
+	assert.NoError(t, err)
+
+	s := &echoServer{config: cfg, echo: echo.New(), limiter: limiter}
+
+	serve := func(path string) (*httptest.ResponseRecorder, error) {
+		rec := httptest.NewRecorder()
+		c := s.echo.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+		c.SetPath(path)
+
+		err := s.rateLimitMiddleware()(func(c echo.Context) error { return c.NoContent(http.StatusOK) })(c)
+
+		return rec, err
+	}
+
+	_, err = serve("/api/v1/products")
+	assert.NoError(t, err)
+
+	rec, err := serve("/api/v1/products")
+	ex, ok := exception.GetException(err)
+	if assert.True(t, ok) {
+		assert.Equal(t, exception.TypeRateLimitExceeded, ex.Type)
+	}
+	assert.Equal(t, "1", rec.Header().Get(echo.HeaderRetryAfter))
+
+	// Public routes are not limited.
+	for range 2 {
+		_, err = serve("/health")
+		assert.NoError(t, err)
+	}
+}
+
+func TestIPRateLimitMiddlewareLimitsUnauthenticatedRequests(t *testing.T) {
+	s := setupAuthServer(t)
+	s.config.RateLimit = &config.RateLimitConfig{Enabled: true, IPRate: 1, IPBurst: 1}
+
+	limiter, err := ratelimit.NewLimiter(s.config, tokenbucket.NewMemoryStore(), logger.NewZerologLogger(false))
+	assert.NoError(t, err)
+	s.limiter = limiter
+
+	serve := func(remoteAddr string) (*httptest.ResponseRecorder, error) {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.RemoteAddr = remoteAddr
+		req.Header.Set(echo.HeaderAuthorization, "Bearer not-a-token")
+
+		rec := httptest.NewRecorder()
+		c := s.echo.NewContext(req, rec)
+		c.SetPath("/api/v1/products")
+
+		// The IP limit runs before authentication, as in setupMiddlewares.
+		handler := s.ipRateLimitMiddleware()(s.authMiddleware()(func(c echo.Context) error {
+			return c.NoContent(http.StatusOK)
+		}))
+
+		return rec, handler(c)
+	}
+
+	_, err = serve("203.0.113.7:5000")
+	ex, ok := exception.GetException(err)
+	if assert.True(t, ok) {
+		assert.Equal(t, exception.TypeTokenInvalid, ex.Type)
+	}
+
+	rec, err := serve("203.0.113.7:5000")
+	_, limited := ratelimit.RetryAfter(err)
+	assert.True(t, limited)
+	assert.Equal(t, "1", rec.Header().Get(echo.HeaderRetryAfter))
+
+	// Another address still has its tokens.
+	_, err = serve("203.0.113.8:5000")
+	_, limited = ratelimit.RetryAfter(err)
+	assert.False(t, limited)
+}
+
+func TestCORSPreflightAllowsPatch(t *testing.T) {
+	s := &echoServer{config: &config.Config{}, logger: logger.NewZerologLogger(false), echo: echo.New()}
+	s.setupMiddlewares()
+	s.echo.PATCH("/api/v1/products/:id/status", func(c echo.Context) error { return c.NoContent(http.StatusOK) })
+
+	req := httptest.NewRequest(http.MethodOptions, "/api/v1/products/1/status", nil)
+	req.Header.Set(echo.HeaderOrigin, "https://app.example.com")
+	req.Header.Set(echo.HeaderAccessControlRequestMethod, http.MethodPatch)
+
+	rec := httptest.NewRecorder()
+	s.echo.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Contains(t, rec.Header().Get(echo.HeaderAccessControlAllowMethods), http.MethodPatch)
+}
+
+func TestIPExtractorTrustsOnlyConfiguredProxies(t *testing.T) {
+	_, proxies, err := net.ParseCIDR("10.0.0.0/8")
+	assert.NoError(t, err)
+
+	request := func(remoteAddr string) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.RemoteAddr = remoteAddr
+		req.Header.Set(echo.HeaderXForwardedFor, "203.0.113.7")
+		return req
+	}
+
+	// Without trusted proxies the header is ignored.
+	direct := ipExtractor(&config.HTTPConfig{})
+	assert.Equal(t, "10.1.2.3", direct(request("10.1.2.3:5000")))
+
+	forwarded := ipExtractor(&config.HTTPConfig{TrustedProxies: []*net.IPNet{proxies}})
+	assert.Equal(t, "203.0.113.7", forwarded(request("10.1.2.3:5000")))
+	assert.Equal(t, "192.168.1.1", forwarded(request("192.168.1.1:5000")))
+}
+
+func TestHandlersReportInvalidRequestsAsFieldErrors(t *testing.T) {
+	h, err := handler.NewHandler(&config.Config{}, logger.NewZerologLogger(false), nil, nil)
+	assert.NoError(t, err)
+
+	e := echo.New()
+
+	tests := []struct {
+		name   string
+		req    *http.Request
+		serve  echo.HandlerFunc
+		fields []string
+	}{
+		{
+			"page beyond the limit",
+			httptest.NewRequest(http.MethodGet, "/?per_page=1000000", nil),
+			h.Webhook().List,
+			[]string{"per_page"},
+		},
+		{
+			"category without name",
+			func() *http.Request {
+				req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"description":"Tools"}`))
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+				return req
+			}(),
+			h.Category().Create,
+			[]string{"name"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.serve(e.NewContext(tt.req, httptest.NewRecorder()))
+
+			ex, ok := exception.GetException(err)
+			if assert.True(t, ok, "expected an exception, got %v", err) {
+				assert.Equal(t, exception.TypeValidationError, ex.Type)
+				for _, field := range tt.fields {
+					assert.Contains(t, ex.Errors, field)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package serializer
+
+import (
+	"inventory-service/internal/domain/entity"
+	"time"
+)
+
+type AuditEventResponse struct {
+	ID         uint64         `json:"id"`
+	EntityType string         `json:"entity_type"`
+	EntityID   uint32         `json:"entity_id"`
+	Action     string         `json:"action"`
+	Actor      string         `json:"actor"`
+	Source     string         `json:"source"`
+	RequestID  string         `json:"request_id,omitempty"`
+	Before     map[string]any `json:"before,omitempty"`
+	After      map[string]any `json:"after,omitempty"`
+	CreatedAt  time.Time      `json:"created_at"`
+}
+
+func SerializeAuditEvent(arg *entity.AuditEvent) *AuditEventResponse {
+	if arg == nil {
+		return nil
+	}
+
+	return &AuditEventResponse{
+		ID:         arg.ID,
+		EntityType: arg.EntityType,
+		EntityID:   arg.EntityID,
+		Action:     arg.Action,
+		Actor:      arg.Actor,
+		Source:     arg.Source,
+		RequestID:  arg.RequestID,
+		Before:     arg.Before,
+		After:      arg.After,
+		CreatedAt:  arg.CreatedAt,
+	}
+}
+
+func SerializeAuditEvents(arg []*entity.AuditEvent) []*AuditEventResponse {
+	if len(arg) == 0 {
+		return nil
+	}
+
+	res := make([]*AuditEventResponse, 0, len(arg))
+
+	for i := range arg {
+		if arg[i] == nil {
+			continue
+		}
+
+		res = append(res, SerializeAuditEvent(arg[i]))
+	}
+
+	return res
+}
@@ -0,0 +1,79 @@
+package serializer
+
+import (
+	"inventory-service/constant"
+	"inventory-service/internal/domain/entity"
+	"inventory-service/internal/shared/exception"
+
+	"github.com/cockroachdb/errors"
+)
+
+type BatchResponse struct {
+	Results   []*BatchItemResponse `json:"results"`
+	Succeeded int                  `json:"succeeded"`
+	Failed    int                  `json:"failed"`
+}
+
+type BatchItemResponse struct {
+	Index   int                     `json:"index"`
+	Status  string                  `json:"status"`
+	Product *ProductResponse        `json:"product,omitempty"`
+	Error   *BatchItemErrorResponse `json:"error,omitempty"`
+}
+
+type BatchItemErrorResponse struct {
+	Type    string                `json:"type,omitempty"`
+	Code    string                `json:"code,omitempty"`
+	Message string                `json:"message"`
+	Details exception.FieldErrors `json:"details,omitempty"`
+}
+
+func SerializeBatchResults(arg []*entity.BatchItemResult) *BatchResponse {
+	res := &BatchResponse{Results: make([]*BatchItemResponse, 0, len(arg))}
+
+	for i := range arg {
+		if arg[i] == nil {
+			continue
+		}
+
+		item := &BatchItemResponse{Index: arg[i].Index}
+
+		switch {
+		case arg[i].Err == nil:
+			item.Status = constant.BatchItemStatusSucceeded
+			item.Product = SerializeProduct(arg[i].Product)
+			res.Succeeded++
+		case errors.Is(arg[i].Err, entity.ErrBatchItemSkipped):
+			item.Status = constant.BatchItemStatusAborted
+			item.Error = &BatchItemErrorResponse{Message: arg[i].Err.Error()}
+			res.Failed++
+		default:
+			item.Status = constant.BatchItemStatusFailed
+			item.Error = serializeBatchItemError(arg[i].Err)
+			res.Failed++
+		}
+
+		res.Results = append(res.Results, item)
+	}
+
+	return res
+}
+
+// serializeBatchItemError exposes application errors only; anything else is
+// reported generically so driver details do not leak.
+func serializeBatchItemError(err error) *BatchItemErrorResponse {
+	ex, ok := exception.GetException(err)
+	if !ok || ex.Type == exception.TypeInternalError || ex.Type == exception.TypeQueryError {
+		return &BatchItemErrorResponse{
+			Type:    string(exception.TypeInternalError),
+			Message: "An internal server error occurred.",
+		}
+	}
+
+	return &BatchItemErrorResponse{
+		Type:    string(ex.Type),
+		Code:    ex.Code,
+		Message: ex.Message,
+		Details: ex.Errors,
+	}
+}
@@ -0,0 +1,54 @@
+package serializer
+
+import (
+	"inventory-service/internal/domain/entity"
+	"time"
+)
+
+type CategoryResponse struct {
+	ID           uint32              `json:"id"`
+	ParentID     uint32              `json:"parent_id,omitempty"`
+	Name         string              `json:"name"`
+	Description  string              `json:"description"`
+	ProductCount int                 `json:"product_count"`
+	TotalStock   int                 `json:"total_stock"`
+	Children     []*CategoryResponse `json:"children,omitempty"`
+	CreatedAt    time.Time           `json:"created_at"`
+	UpdatedAt    time.Time           `json:"updated_at"`
+}
+
+func SerializeCategory(arg *entity.Category) *CategoryResponse {
+	if arg == nil {
+		return nil
+	}
+
+	return &CategoryResponse{
+		ID:           arg.ID,
+		ParentID:     arg.ParentID,
+		Name:         arg.Name,
+		Description:  arg.Description,
+		ProductCount: arg.ProductCount,
+		TotalStock:   arg.TotalStock,
+		Children:     SerializeCategories(arg.Children),
+		CreatedAt:    arg.CreatedAt,
+		UpdatedAt:    arg.UpdatedAt,
+	}
+}
+
+func SerializeCategories(arg []*entity.Category) []*CategoryResponse {
+	if len(arg) == 0 {
+		return nil
+	}
+
+	res := make([]*CategoryResponse, 0, len(arg))
+
+	for i := range arg {
+		if arg[i] == nil {
+			continue
+		}
+
+		res = append(res, SerializeCategory(arg[i]))
+	}
+
+	return res
+}
@@ -0,0 +1,85 @@
+package serializer
+
+import (
+	"inventory-service/internal/domain/entity"
+	"time"
+)
+
+type LotResponse struct {
+	ID        uint32     `json:"id"`
+	ProductID uint32     `json:"product_id"`
+	LotNumber string     `json:"lot_number"`
+	ExpiresAt *time.Time `json:"expires_at"`
+	Quantity  int        `json:"quantity"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+type LotAllocationResponse struct {
+	LotID     uint32     `json:"lot_id"`
+	LotNumber string     `json:"lot_number,omitempty"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	Quantity  int        `json:"quantity"`
+}
+
+func SerializeLot(arg *entity.Lot) *LotResponse {
+	if arg == nil {
+		return nil
+	}
+
+	return &LotResponse{
+		ID:        arg.ID,
+		ProductID: arg.ProductID,
+		LotNumber: arg.LotNumber,
+		ExpiresAt: arg.ExpiresAt,
+		Quantity:  arg.Quantity,
+		CreatedAt: arg.CreatedAt,
+		UpdatedAt: arg.UpdatedAt,
+	}
+}
+
+func SerializeLots(arg []*entity.Lot) []*LotResponse {
+	if len(arg) == 0 {
+		return nil
+	}
+
+	res := make([]*LotResponse, 0, len(arg))
+
+	for i := range arg {
+		if arg[i] == nil {
+			continue
+		}
+
+		res = append(res, SerializeLot(arg[i]))
+	}
+
+	return res
+}
+
+func SerializeLotAllocations(arg []*entity.LotAllocation) []*LotAllocationResponse {
+	if len(arg) == 0 {
+		return nil
+	}
+
+	res := make([]*LotAllocationResponse, 0, len(arg))
+
+	for i := range arg {
+		if arg[i] == nil {
+			continue
+		}
+
+		allocation := &LotAllocationResponse{
+			LotID:    arg[i].LotID,
+			Quantity: arg[i].Quantity,
+		}
+
+		if arg[i].Lot != nil {
+			allocation.LotNumber = arg[i].Lot.LotNumber
+			allocation.ExpiresAt = arg[i].Lot.ExpiresAt
+		}
+
+		res = append(res, allocation)
+	}
+
+	return res
+}
@@ -0,0 +1,50 @@
+package serializer
+
+import (
+	"inventory-service/internal/domain/entity"
+	"time"
+)
+
+type PriceChangeResponse struct {
+	ID            uint32     `json:"id"`
+	ProductID     uint32     `json:"product_id"`
+	Price         string     `json:"price"`
+	Currency      string     `json:"currency"`
+	EffectiveFrom time.Time  `json:"effective_from"`
+	AppliedAt     *time.Time `json:"applied_at"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+func SerializePriceChange(arg *entity.PriceChange) *PriceChangeResponse {
+	if arg == nil {
+		return nil
+	}
+
+	return &PriceChangeResponse{
+		ID:            arg.ID,
+		ProductID:     arg.ProductID,
+		Price:         arg.Price.String(),
+		Currency:      arg.Price.Currency,
+		EffectiveFrom: arg.EffectiveFrom,
+		AppliedAt:     arg.AppliedAt,
+		CreatedAt:     arg.CreatedAt,
+	}
+}
+
+func SerializePriceChanges(arg []*entity.PriceChange) []*PriceChangeResponse {
+	if len(arg) == 0 {
+		return nil
+	}
+
+	res := make([]*PriceChangeResponse, 0, len(arg))
+
+	for i := range arg {
+		if arg[i] == nil {
+			continue
+		}
+
+		res = append(res, SerializePriceChange(arg[i]))
+	}
+
+	return res
+}
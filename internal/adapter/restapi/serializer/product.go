@@ -1,17 +1,52 @@
 package serializer
 
 import (
+	"inventory-service/constant"
 	"inventory-service/internal/domain/entity"
 	"time"
 )
 
 type ProductResponse struct {
-	ID        uint32    `json:"id"`
-	Name      string    `json:"name"`
-	Stock     int       `json:"stock"`
-	Price     float64   `json:"price"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID             uint32                  `json:"id"`
+	SKU            string                  `json:"sku"`
+	Barcode        string                  `json:"barcode,omitempty"`
+	CategoryID     uint32                  `json:"category_id,omitempty"`
+	Name           string                  `json:"name"`
+	Stock          int                     `json:"stock"`
+	Price          string                  `json:"price"`
+	Currency       string                  `json:"currency"`
+	Status         string                  `json:"status"`
+	Attributes     map[string]any          `json:"attributes"`
+	CreatedAt      time.Time               `json:"created_at"`
+	UpdatedAt      time.Time               `json:"updated_at"`
+	ParentID       uint32                  `json:"parent_id,omitempty"`
+	Options        map[string]string       `json:"options,omitempty"`
+	Variants       []*ProductResponse      `json:"variants,omitempty"`
+	Components     []*KitComponentResponse `json:"components,omitempty"`
+	AvailableStock int                     `json:"available_stock"`
+	TrackLots      bool                    `json:"track_lots"`
+	TrackSerials   bool                    `json:"track_serials"`
+	Units          []*ProductUnitResponse  `json:"units,omitempty"`
+}
+
+type ProductUnitResponse struct {
+	Unit   string `json:"unit"`
+	Factor int    `json:"factor"`
+}
+
+type StockAdjustmentResponse struct {
+	ProductID    uint32           `json:"product_id"`
+	Quantity     int              `json:"quantity"`
+	Unit         string           `json:"unit"`
+	BaseQuantity int              `json:"base_quantity"`
+	BaseUnit     string           `json:"base_unit"`
+	Product      *ProductResponse `json:"product"`
+}
+
+type KitComponentResponse struct {
+	ProductID uint32           `json:"product_id"`
+	Quantity  int              `json:"quantity"`
+	Product   *ProductResponse `json:"product,omitempty"`
 }
 
 func SerializeProduct(arg *entity.Product) *ProductResponse {
@@ -20,12 +55,26 @@ func SerializeProduct(arg *entity.Product) *ProductResponse {
 	}
 
 	return &ProductResponse{
-		ID:        arg.ID,
-		Name:      arg.Name,
-		Stock:     arg.Stock,
-		Price:     arg.Price,
-		CreatedAt: arg.CreatedAt,
-		UpdatedAt: arg.UpdatedAt,
+		ID:             arg.ID,
+		SKU:            arg.SKU,
+		Barcode:        arg.Barcode,
+		CategoryID:     arg.CategoryID,
+		Name:           arg.Name,
+		Stock:          arg.Stock,
+		Price:          arg.Price.String(),
+		Currency:       arg.Price.Currency,
+		Status:         arg.Status,
+		Attributes:     arg.Attributes,
+		CreatedAt:      arg.CreatedAt,
+		UpdatedAt:      arg.UpdatedAt,
+		ParentID:       arg.ParentID,
+		Options:        arg.Options,
+		Variants:       SerializeProducts(arg.Variants),
+		Components:     SerializeKitComponents(arg.Components),
+		AvailableStock: arg.AvailableStock,
+		TrackLots:      arg.TrackLots,
+		TrackSerials:   arg.TrackSerials,
+		Units:          SerializeProductUnits(arg.Units),
 	}
 }
 
@@ -46,3 +95,61 @@ func SerializeProducts(arg []*entity.Product) []*ProductResponse {
 
 	return res
 }
+
+func SerializeKitComponents(arg []*entity.KitComponent) []*KitComponentResponse {
+	if len(arg) == 0 {
+		return nil
+	}
+
+	res := make([]*KitComponentResponse, 0, len(arg))
+
+	for i := range arg {
+		if arg[i] == nil {
+			continue
+		}
+
+		res = append(res, &KitComponentResponse{
+			ProductID: arg[i].ComponentID,
+			Quantity:  arg[i].Quantity,
+			Product:   SerializeProduct(arg[i].Component),
+		})
+	}
+
+	return res
+}
+
+func SerializeProductUnits(arg []*entity.ProductUnit) []*ProductUnitResponse {
+	if len(arg) == 0 {
+		return nil
+	}
+
+	res := make([]*ProductUnitResponse, 0, len(arg))
+
+	for i := range arg {
+		if arg[i] == nil {
+			continue
+		}
+
+		res = append(res, &ProductUnitResponse{
+			Unit:   arg[i].Unit,
+			Factor: arg[i].Factor,
+		})
+	}
+
+	return res
+}
+
+func SerializeStockAdjustment(arg *entity.StockAdjustment) *StockAdjustmentResponse {
+	if arg == nil {
+		return nil
+	}
+
+	return &StockAdjustmentResponse{
+		ProductID:    arg.ProductID,
+		Quantity:     arg.Quantity,
+		Unit:         arg.Unit,
+		BaseQuantity: arg.BaseQuantity,
+		BaseUnit:     constant.UnitEach,
+		Product:      SerializeProduct(arg.Product),
+	}
+}
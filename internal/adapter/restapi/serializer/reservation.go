@@ -2,18 +2,25 @@ package serializer
 
 import (
 	"inventory-service/internal/domain/entity"
+	"strconv"
 	"time"
 )
 
 type ReservationResponse struct {
-	ID        uint32           `json:"id"`
-	ProductID uint32           `json:"product_id"`
-	OrderID   uint32           `json:"order_id"`
-	Quantity  int              `json:"quantity"`
-	Status    string           `json:"status"`
-	Product   *ProductResponse `json:"product"`
-	CreatedAt time.Time        `json:"created_at"`
-	UpdatedAt time.Time        `json:"updated_at"`
+	ID           uint32                   `json:"id"`
+	ProductID    uint32                   `json:"product_id"`
+	OrderID      uint32                   `json:"order_id"`
+	Quantity     int                      `json:"quantity"`
+	Unit         string                   `json:"unit"`
+	UnitQuantity int                      `json:"unit_quantity"`
+	Status       string                   `json:"status"`
+	Product      *ProductResponse         `json:"product"`
+	CreatedAt    time.Time                `json:"created_at"`
+	UpdatedAt    time.Time                `json:"updated_at"`
+	ParentID     uint32                   `json:"parent_id,omitempty"`
+	Components   []*ReservationResponse   `json:"components,omitempty"`
+	Lots         []*LotAllocationResponse `json:"lots,omitempty"`
+	Serials      []*SerialResponse        `json:"serials,omitempty"`
 }
 
 func SerializeReservation(arg *entity.Reservation) *ReservationResponse {
@@ -22,14 +29,20 @@ func SerializeReservation(arg *entity.Reservation) *ReservationResponse {
 	}
 
 	return &ReservationResponse{
-		ID:        arg.ID,
-		ProductID: arg.ProductID,
-		OrderID:   arg.OrderID,
-		Quantity:  arg.Quantity,
-		Status:    arg.Status,
-		Product:   SerializeProduct(arg.Product),
-		CreatedAt: arg.CreatedAt,
-		UpdatedAt: arg.UpdatedAt,
+		ID:           arg.ID,
+		ProductID:    arg.ProductID,
+		OrderID:      arg.OrderID,
+		Quantity:     arg.Quantity,
+		Unit:         arg.Unit,
+		UnitQuantity: arg.UnitQuantity,
+		Status:       arg.Status,
+		Product:      SerializeProduct(arg.Product),
+		CreatedAt:    arg.CreatedAt,
+		UpdatedAt:    arg.UpdatedAt,
+		ParentID:     arg.ParentID,
+		Components:   SerializeReservations(arg.Components),
+		Lots:         SerializeLotAllocations(arg.Lots),
+		Serials:      SerializeSerials(arg.Serials),
 	}
 }
 
@@ -50,3 +63,37 @@ func SerializeReservations(arg []*entity.Reservation) []*ReservationResponse {
 
 	return res
 }
+
+// ReservationCSVColumns is the header of the reservation CSV export.
+var ReservationCSVColumns = []string{
+	"id",
+	"order_id",
+	"product_id",
+	"parent_id",
+	"quantity",
+	"unit",
+	"unit_quantity",
+	"status",
+	"created_at",
+	"updated_at",
+}
+
+func SerializeReservationCSV(arg *entity.Reservation) []string {
+	parentID := ""
+	if arg.ParentID > 0 {
+		parentID = strconv.FormatUint(uint64(arg.ParentID), 10)
+	}
+
+	return []string{
+		strconv.FormatUint(uint64(arg.ID), 10),
+		strconv.FormatUint(uint64(arg.OrderID), 10),
+		strconv.FormatUint(uint64(arg.ProductID), 10),
+		parentID,
+		strconv.Itoa(arg.Quantity),
+		arg.Unit,
+		strconv.Itoa(arg.UnitQuantity),
+		arg.Status,
+		arg.CreatedAt.Format(time.RFC3339),
+		arg.UpdatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,54 @@
+package serializer
+
+import (
+	"inventory-service/internal/domain/entity"
+	"time"
+)
+
+type SerialResponse struct {
+	ID            uint32           `json:"id"`
+	ProductID     uint32           `json:"product_id"`
+	SerialNumber  string           `json:"serial_number"`
+	Status        string           `json:"status"`
+	ReservationID uint32           `json:"reservation_id,omitempty"`
+	OrderID       uint32           `json:"order_id,omitempty"`
+	Product       *ProductResponse `json:"product,omitempty"`
+	CreatedAt     time.Time        `json:"created_at"`
+	UpdatedAt     time.Time        `json:"updated_at"`
+}
+
+func SerializeSerial(arg *entity.Serial) *SerialResponse {
+	if arg == nil {
+		return nil
+	}
+
+	return &SerialResponse{
+		ID:            arg.ID,
+		ProductID:     arg.ProductID,
+		SerialNumber:  arg.SerialNumber,
+		Status:        arg.Status,
+		ReservationID: arg.ReservationID,
+		OrderID:       arg.OrderID,
+		Product:       SerializeProduct(arg.Product),
+		CreatedAt:     arg.CreatedAt,
+		UpdatedAt:     arg.UpdatedAt,
+	}
+}
+
+func SerializeSerials(arg []*entity.Serial) []*SerialResponse {
+	if len(arg) == 0 {
+		return nil
+	}
+
+	res := make([]*SerialResponse, 0, len(arg))
+
+	for i := range arg {
+		if arg[i] == nil {
+			continue
+		}
+
+		res = append(res, SerializeSerial(arg[i]))
+	}
+
+	return res
+}
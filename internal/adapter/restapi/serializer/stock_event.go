@@ -0,0 +1,32 @@
+package serializer
+
+import (
+	"inventory-service/internal/domain/entity"
+	"time"
+)
+
+type StockEventResponse struct {
+	Sequence       uint64    `json:"sequence"`
+	ProductID      uint32    `json:"product_id"`
+	Stock          int       `json:"stock"`
+	Reserved       int       `json:"reserved"`
+	AvailableStock int       `json:"available_stock"`
+	ChangedAt      time.Time `json:"changed_at"`
+	Snapshot       bool      `json:"snapshot"`
+}
+
+func SerializeStockEvent(arg *entity.StockEvent) *StockEventResponse {
+	if arg == nil {
+		return nil
+	}
+
+	return &StockEventResponse{
+		Sequence:       arg.Sequence,
+		ProductID:      arg.ProductID,
+		Stock:          arg.Stock,
+		Reserved:       arg.Reserved,
+		AvailableStock: arg.AvailableStock(),
+		ChangedAt:      arg.CreatedAt,
+		Snapshot:       arg.Snapshot,
+	}
+}
@@ -0,0 +1,115 @@
+package serializer
+
+import (
+	"inventory-service/constant"
+	"inventory-service/internal/domain/entity"
+	"time"
+)
+
+// WebhookResponse leaves the secret empty except in the response to the
+// webhook's creation.
+type WebhookResponse struct {
+	ID           uint32     `json:"id"`
+	URL          string     `json:"url"`
+	EventTypes   []string   `json:"event_types"`
+	Secret       string     `json:"secret,omitempty"`
+	Active       bool       `json:"active"`
+	FailureCount int        `json:"failure_count"`
+	DisabledAt   *time.Time `json:"disabled_at,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
+func SerializeWebhook(arg *entity.Webhook) *WebhookResponse {
+	if arg == nil {
+		return nil
+	}
+
+	return &WebhookResponse{
+		ID:           arg.ID,
+		URL:          arg.URL,
+		EventTypes:   arg.EventTypes,
+		Active:       arg.Active,
+		FailureCount: arg.FailureCount,
+		DisabledAt:   arg.DisabledAt,
+		CreatedAt:    arg.CreatedAt,
+		UpdatedAt:    arg.UpdatedAt,
+	}
+}
+
+func SerializeWebhooks(arg []*entity.Webhook) []*WebhookResponse {
+	if len(arg) == 0 {
+		return nil
+	}
+
+	res := make([]*WebhookResponse, 0, len(arg))
+
+	for i := range arg {
+		if arg[i] == nil {
+			continue
+		}
+
+		res = append(res, SerializeWebhook(arg[i]))
+	}
+
+	return res
+}
+
+type WebhookDeliveryResponse struct {
+	ID             uint64     `json:"id"`
+	WebhookID      uint32     `json:"webhook_id"`
+	EventID        uint64     `json:"event_id"`
+	EventType      string     `json:"event_type"`
+	Status         string     `json:"status"`
+	Attempts       int        `json:"attempts"`
+	NextAttemptAt  *time.Time `json:"next_attempt_at,omitempty"`
+	ResponseStatus int        `json:"response_status,omitempty"`
+	ResponseBody   string     `json:"response_body,omitempty"`
+	LastError      string     `json:"last_error,omitempty"`
+	DeliveredAt    *time.Time `json:"delivered_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+func SerializeWebhookDelivery(arg *entity.WebhookDelivery) *WebhookDeliveryResponse {
+	if arg == nil {
+		return nil
+	}
+
+	res := &WebhookDeliveryResponse{
+		ID:             arg.ID,
+		WebhookID:      arg.WebhookID,
+		EventID:        arg.EventID,
+		EventType:      arg.EventType,
+		Status:         arg.Status,
+		Attempts:       arg.Attempts,
+		ResponseStatus: arg.ResponseStatus,
+		ResponseBody:   arg.ResponseBody,
+		LastError:      arg.LastError,
+		DeliveredAt:    arg.DeliveredAt,
+		CreatedAt:      arg.CreatedAt,
+	}
+
+	if arg.Status == constant.WebhookDeliveryStatusPending {
+		res.NextAttemptAt = &arg.NextAttemptAt
+	}
+
+	return res
+}
+
+func SerializeWebhookDeliveries(arg []*entity.WebhookDelivery) []*WebhookDeliveryResponse {
+	if len(arg) == 0 {
+		return nil
+	}
+
+	res := make([]*WebhookDeliveryResponse, 0, len(arg))
+
+	for i := range arg {
+		if arg[i] == nil {
+			continue
+		}
+
+		res = append(res, SerializeWebhookDelivery(arg[i]))
+	}
+
+	return res
+}
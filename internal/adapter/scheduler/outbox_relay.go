@@ -0,0 +1,119 @@
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"inventory-service/config"
+	"inventory-service/constant"
+	"inventory-service/internal/domain/entity"
+	"inventory-service/internal/domain/service"
+	"inventory-service/pkg/logger"
+	"inventory-service/pkg/pubsub"
+	"strconv"
+	"time"
+)
+
+const defaultOutboxPollInterval = time.Second
+
+// OutboxRelay periodically publishes the domain events recorded in the
+// outbox. Without a publisher it only fans them out to webhooks.
+type OutboxRelay struct {
+	service   service.Service
+	publisher pubsub.Publisher
+	logger    logger.Logger
+	interval  time.Duration
+	batchSize int
+	done      chan struct{}
+}
+
+func NewOutboxRelay(config *config.Config, service service.Service, publisher pubsub.Publisher, logger logger.Logger) *OutboxRelay {
+	relay := &OutboxRelay{
+		service:   service,
+		publisher: publisher,
+		logger:    logger,
+		interval:  defaultOutboxPollInterval,
+		batchSize: constant.DefaultOutboxBatchSize,
+		done:      make(chan struct{}),
+	}
+
+	if config.Outbox != nil && config.Outbox.PollInterval > 0 {
+		relay.interval = time.Duration(config.Outbox.PollInterval) * time.Second
+	}
+
+	if config.Outbox != nil && config.Outbox.BatchSize > 0 {
+		relay.batchSize = config.Outbox.BatchSize
+	}
+
+	return relay
+}
+
+// Start runs the relay in the background until ctx is cancelled.
+func (r *OutboxRelay) Start(ctx context.Context) {
+	go func() {
+		defer close(r.done)
+
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+
+		r.run(ctx)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				r.run(ctx)
+			}
+		}
+	}()
+}
+
+// Wait blocks until the relay has stopped.
+func (r *OutboxRelay) Wait() {
+	<-r.done
+}
+
+// run delivers due events batch by batch until a batch comes back short, so
+// no key is left with work until the next poll.
+func (r *OutboxRelay) run(ctx context.Context) {
+	for ctx.Err() == nil {
+		delivery, err := r.service.Outbox().Deliver(ctx, r.batchSize, r.publish)
+		if err != nil {
+			r.logger.Error().Err(err).Msg("Failed to deliver outbox events")
+			return
+		}
+
+		if delivery.Retried > 0 || delivery.Dead > 0 {
+			r.logger.Warn().Msgf("Outbox delivery: %d delivered, %d to retry, %d dead-lettered", delivery.Delivered, delivery.Retried, delivery.Dead)
+		}
+
+		if !delivery.Full(r.batchSize) {
+			return
+		}
+	}
+}
+
+func (r *OutboxRelay) publish(ctx context.Context, event *entity.OutboxEvent) error {
+	if r.publisher == nil {
+		return nil
+	}
+
+	payload, err := json.Marshal(event.Payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode payload: %w", err)
+	}
+
+	err = r.publisher.Publish(ctx, &pubsub.Message{
+		ID:         strconv.FormatUint(event.ID, 10),
+		Topic:      event.Type,
+		Key:        event.PartitionKey,
+		Payload:    payload,
+		OccurredAt: event.CreatedAt,
+	})
+	if err != nil {
+		r.logger.Error().Err(err).Msgf("Failed to publish outbox event %d (%s)", event.ID, event.Type)
+	}
+
+	return err
+}
@@ -0,0 +1,89 @@
+package scheduler
+
+import (
+	"context"
+	"inventory-service/config"
+	"inventory-service/internal/domain/service"
+	"inventory-service/pkg/logger"
+	"time"
+)
+
+const (
+	defaultPriceChangeInterval  = time.Minute
+	defaultPriceChangeBatchSize = 100
+)
+
+// PriceScheduler periodically applies scheduled price changes whose
+// effective time has come.
+type PriceScheduler struct {
+	service   service.Service
+	logger    logger.Logger
+	interval  time.Duration
+	batchSize int
+	done      chan struct{}
+}
+
+func NewPriceScheduler(config *config.Config, service service.Service, logger logger.Logger) *PriceScheduler {
+	scheduler := &PriceScheduler{
+		service:   service,
+		logger:    logger,
+		interval:  defaultPriceChangeInterval,
+		batchSize: defaultPriceChangeBatchSize,
+		done:      make(chan struct{}),
+	}
+
+	if config.Scheduler != nil && config.Scheduler.PriceChangeInterval > 0 {
+		scheduler.interval = time.Duration(config.Scheduler.PriceChangeInterval) * time.Second
+	}
+
+	if config.Scheduler != nil && config.Scheduler.PriceChangeBatchSize > 0 {
+		scheduler.batchSize = config.Scheduler.PriceChangeBatchSize
+	}
+
+	return scheduler
+}
+
+// Start runs the scheduler in the background until ctx is cancelled.
+func (s *PriceScheduler) Start(ctx context.Context) {
+	go func() {
+		defer close(s.done)
+
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		s.run(ctx)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s.run(ctx)
+			}
+		}
+	}()
+}
+
+// Wait blocks until the scheduler has stopped.
+func (s *PriceScheduler) Wait() {
+	<-s.done
+}
+
+// run applies due changes batch by batch until none are left.
+func (s *PriceScheduler) run(ctx context.Context) {
+	for ctx.Err() == nil {
+		applied, err := s.service.PriceChange().ApplyDue(ctx, s.batchSize)
+		if err != nil {
+			s.logger.Error().Err(err).Msg("Failed to apply scheduled price changes")
+			return
+		}
+
+		if applied > 0 {
+			s.logger.Info().Msgf("Applied %d scheduled price changes", applied)
+		}
+
+		if applied < s.batchSize {
+			return
+		}
+	}
+}
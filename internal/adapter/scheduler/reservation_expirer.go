@@ -0,0 +1,89 @@
+package scheduler
+
+import (
+	"context"
+	"inventory-service/config"
+	"inventory-service/internal/domain/service"
+	"inventory-service/pkg/logger"
+	"time"
+)
+
+const (
+	defaultReservationExpiryInterval  = time.Minute
+	defaultReservationExpiryBatchSize = 100
+)
+
+// ReservationExpirer periodically expires pending reservations that have
+// outlived the configured reservation TTL.
+type ReservationExpirer struct {
+	service   service.Service
+	logger    logger.Logger
+	interval  time.Duration
+	batchSize int
+	done      chan struct{}
+}
+
+func NewReservationExpirer(config *config.Config, service service.Service, logger logger.Logger) *ReservationExpirer {
+	expirer := &ReservationExpirer{
+		service:   service,
+		logger:    logger,
+		interval:  defaultReservationExpiryInterval,
+		batchSize: defaultReservationExpiryBatchSize,
+		done:      make(chan struct{}),
+	}
+
+	if config.Scheduler != nil && config.Scheduler.ReservationExpiryInterval > 0 {
+		expirer.interval = time.Duration(config.Scheduler.ReservationExpiryInterval) * time.Second
+	}
+
+	if config.Scheduler != nil && config.Scheduler.ReservationExpiryBatchSize > 0 {
+		expirer.batchSize = config.Scheduler.ReservationExpiryBatchSize
+	}
+
+	return expirer
+}
+
+// Start runs the expirer in the background until ctx is cancelled.
+func (s *ReservationExpirer) Start(ctx context.Context) {
+	go func() {
+		defer close(s.done)
+
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		s.run(ctx)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s.run(ctx)
+			}
+		}
+	}()
+}
+
+// Wait blocks until the expirer has stopped.
+func (s *ReservationExpirer) Wait() {
+	<-s.done
+}
+
+// run expires overdue reservations batch by batch until none are left.
+func (s *ReservationExpirer) run(ctx context.Context) {
+	for ctx.Err() == nil {
+		expired, err := s.service.Reservation().ExpireDue(ctx, s.batchSize)
+		if err != nil {
+			s.logger.Error().Err(err).Msg("Failed to expire reservations")
+			return
+		}
+
+		if expired > 0 {
+			s.logger.Info().Msgf("Expired %d reservations", expired)
+		}
+
+		if expired < s.batchSize {
+			return
+		}
+	}
+}
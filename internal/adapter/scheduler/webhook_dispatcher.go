@@ -0,0 +1,90 @@
+package scheduler
+
+import (
+	"context"
+	"inventory-service/config"
+	"inventory-service/constant"
+	"inventory-service/internal/domain/service"
+	"inventory-service/pkg/logger"
+	"time"
+)
+
+const defaultWebhookPollInterval = time.Second
+
+// WebhookDispatcher periodically sends the webhook deliveries that are due.
+type WebhookDispatcher struct {
+	service   service.Service
+	logger    logger.Logger
+	interval  time.Duration
+	batchSize int
+	done      chan struct{}
+}
+
+func NewWebhookDispatcher(config *config.Config, service service.Service, logger logger.Logger) *WebhookDispatcher {
+	dispatcher := &WebhookDispatcher{
+		service:   service,
+		logger:    logger,
+		interval:  defaultWebhookPollInterval,
+		batchSize: constant.DefaultWebhookBatchSize,
+		done:      make(chan struct{}),
+	}
+
+	if config.Webhook != nil && config.Webhook.PollInterval > 0 {
+		dispatcher.interval = time.Duration(config.Webhook.PollInterval) * time.Second
+	}
+
+	if config.Webhook != nil && config.Webhook.BatchSize > 0 {
+		dispatcher.batchSize = config.Webhook.BatchSize
+	}
+
+	return dispatcher
+}
+
+// Start runs the dispatcher in the background until ctx is cancelled.
+func (d *WebhookDispatcher) Start(ctx context.Context) {
+	go func() {
+		defer close(d.done)
+
+		ticker := time.NewTicker(d.interval)
+		defer ticker.Stop()
+
+		d.run(ctx)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				d.run(ctx)
+			}
+		}
+	}()
+}
+
+// Wait blocks until the dispatcher has stopped.
+func (d *WebhookDispatcher) Wait() {
+	<-d.done
+}
+
+// run dispatches due deliveries batch by batch until a batch comes back short.
+func (d *WebhookDispatcher) run(ctx context.Context) {
+	for ctx.Err() == nil {
+		dispatch, err := d.service.Webhook().Dispatch(ctx, d.batchSize)
+		if err != nil {
+			d.logger.Error().Err(err).Msg("Failed to dispatch webhook deliveries")
+			return
+		}
+
+		if dispatch.Retried > 0 || dispatch.Dead > 0 {
+			d.logger.Warn().Msgf("Webhook dispatch: %d succeeded, %d to retry, %d dead", dispatch.Succeeded, dispatch.Retried, dispatch.Dead)
+		}
+
+		if dispatch.Disabled > 0 {
+			d.logger.Warn().Msgf("Webhook dispatch disabled %d webhooks after repeated failures", dispatch.Disabled)
+		}
+
+		if dispatch.Total() < d.batchSize {
+			return
+		}
+	}
+}
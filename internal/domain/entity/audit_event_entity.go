@@ -0,0 +1,19 @@
+package entity
+
+import "time"
+
+// AuditEvent records one change to an entity: who made it, through which
+// transport and request, and the entity's state before and after. Before is
+// nil for creations and After for deletions.
+type AuditEvent struct {
+	ID         uint64
+	EntityType string
+	EntityID   uint32
+	Action     string
+	Actor      string
+	Source     string
+	RequestID  string
+	Before     map[string]any
+	After      map[string]any
+	CreatedAt  time.Time
+}
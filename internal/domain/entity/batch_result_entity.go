@@ -0,0 +1,15 @@
+package entity
+
+import "github.com/cockroachdb/errors"
+
+// ErrBatchItemSkipped marks items of an all-or-nothing batch that were valid
+// but not written because another item failed.
+var ErrBatchItemSkipped = errors.New("batch item skipped because another item failed")
+
+// BatchItemResult reports the outcome of one item of a bulk call. Product is
+// set when the item was written and Err when it was not.
+type BatchItemResult struct {
+	Index   int
+	Product *Product
+	Err     error
+}
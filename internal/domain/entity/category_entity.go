@@ -0,0 +1,15 @@
+package entity
+
+type Category struct {
+	Base
+
+	ParentID    uint32
+	Name        string
+	Description string
+
+	// ProductCount and TotalStock aggregate the category and all of its descendants.
+	ProductCount int
+	TotalStock   int
+
+	Children []*Category
+}
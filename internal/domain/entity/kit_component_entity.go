@@ -0,0 +1,10 @@
+package entity
+
+// KitComponent is one line of a kit's bill of materials.
+type KitComponent struct {
+	KitID       uint32
+	ComponentID uint32
+	Quantity    int
+
+	Component *Product
+}
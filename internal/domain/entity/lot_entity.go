@@ -0,0 +1,23 @@
+package entity
+
+import "time"
+
+// Lot is a batch of a lot-tracked product. Quantity is what remains
+// unreserved; lots past ExpiresAt no longer count towards availability.
+type Lot struct {
+	Base
+
+	ProductID uint32
+	LotNumber string
+	ExpiresAt *time.Time
+	Quantity  int
+}
+
+// LotAllocation records how much of a lot a reservation holds.
+type LotAllocation struct {
+	ReservationID uint32
+	LotID         uint32
+	Quantity      int
+
+	Lot *Lot
+}
@@ -0,0 +1,156 @@
+package entity
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+const nanosPerUnit = 1_000_000_000
+
+var ErrInvalidMoney = errors.New("invalid money amount")
+
+// currencyScales holds the number of minor-unit digits of the supported
+// ISO 4217 currencies.
+var currencyScales = map[string]int{
+	"AUD": 2,
+	"CAD": 2,
+	"CHF": 2,
+	"CNY": 2,
+	"EUR": 2,
+	"GBP": 2,
+	"IDR": 2,
+	"JPY": 0,
+	"KRW": 0,
+	"SGD": 2,
+	"USD": 2,
+}
+
+// Money is an exact decimal amount in a currency: Units whole units plus Nanos
+// billionths of a unit, both carrying the sign of the amount.
+type Money struct {
+	Currency string
+	Units    int64
+	Nanos    int32
+}
+
+// CurrencyScale returns how many decimal places the currency allows and whether
+// the currency is supported at all.
+func CurrencyScale(currency string) (int, bool) {
+	scale, ok := currencyScales[currency]
+	return scale, ok
+}
+
+// ParseMoney reads a decimal string such as "19.99" without going through a
+// floating point value.
+func ParseMoney(amount, currency string) (Money, error) {
+	amount = strings.TrimSpace(amount)
+
+	// At most one leading sign is accepted.
+	amount, negative := strings.CutPrefix(amount, "-")
+	if !negative {
+		amount, _ = strings.CutPrefix(amount, "+")
+	}
+
+	whole, fraction, _ := strings.Cut(amount, ".")
+	if whole == "" && fraction == "" || len(whole) > 18 || len(fraction) > 9 ||
+		!isDigits(whole) || !isDigits(fraction) {
+		return Money{}, errors.Wrapf(ErrInvalidMoney, "%q", amount)
+	}
+
+	money := Money{Currency: currency}
+
+	if whole != "" {
+		money.Units, _ = strconv.ParseInt(whole, 10, 64)
+	}
+
+	if fraction != "" {
+		nanos, _ := strconv.ParseInt(fraction+strings.Repeat("0", 9-len(fraction)), 10, 32)
+		money.Nanos = int32(nanos)
+	}
+
+	if negative {
+		money.Units, money.Nanos = -money.Units, -money.Nanos
+	}
+
+	return money, nil
+}
+
+// IsZero reports whether the amount is zero, whatever the currency.
+func (m Money) IsZero() bool {
+	return m.Units == 0 && m.Nanos == 0
+}
+
+func (m Money) IsNegative() bool {
+	return m.Units < 0 || m.Nanos < 0
+}
+
+// Valid reports whether Units and Nanos agree in sign and Nanos is within range.
+func (m Money) Valid() bool {
+	if m.Nanos <= -nanosPerUnit || m.Nanos >= nanosPerUnit {
+		return false
+	}
+
+	return !(m.Units > 0 && m.Nanos < 0) && !(m.Units < 0 && m.Nanos > 0)
+}
+
+// Scale returns the number of significant decimal places of the amount.
+func (m Money) Scale() int {
+	nanos := abs(int64(m.Nanos))
+	if nanos == 0 {
+		return 0
+	}
+
+	scale := 9
+	for nanos%10 == 0 {
+		nanos /= 10
+		scale--
+	}
+
+	return scale
+}
+
+// String formats the amount as a plain decimal with at least as many decimal
+// places as the currency uses, e.g. "19.90" for USD.
+func (m Money) String() string {
+	scale, ok := CurrencyScale(m.Currency)
+	if !ok {
+		scale = 2
+	}
+
+	scale = max(scale, m.Scale())
+
+	var b strings.Builder
+	if m.IsNegative() {
+		b.WriteByte('-')
+	}
+
+	b.WriteString(strconv.FormatInt(abs(m.Units), 10))
+
+	if scale > 0 {
+		fraction := strconv.FormatInt(abs(int64(m.Nanos))+nanosPerUnit, 10)[1:]
+		b.WriteByte('.')
+		b.WriteString(fraction[:scale])
+	}
+
+	return b.String()
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+func abs(n int64) int64 {
+	if n < 0 {
+		return -n
+	}
+
+	return n
+}
@@ -0,0 +1,43 @@
+package entity_test
+
+import (
+	"testing"
+
+	"inventory-service/internal/domain/entity"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		amount  string
+		want    entity.Money
+		wantErr bool
+	}{
+		{amount: "19.99", want: entity.Money{Currency: "USD", Units: 19, Nanos: 990_000_000}},
+		{amount: " +5 ", want: entity.Money{Currency: "USD", Units: 5}},
+		{amount: "-0.5", want: entity.Money{Currency: "USD", Nanos: -500_000_000}},
+		{amount: ".25", want: entity.Money{Currency: "USD", Nanos: 250_000_000}},
+		{amount: "--5", wantErr: true},
+		{amount: "-+5", wantErr: true},
+		{amount: "+-5", wantErr: true},
+		{amount: "++5", wantErr: true},
+		{amount: "-", wantErr: true},
+		{amount: "5-", wantErr: true},
+		{amount: "1.2.3", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.amount, func(t *testing.T) {
+			money, err := entity.ParseMoney(tt.amount, "USD")
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, entity.ErrInvalidMoney)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, money)
+		})
+	}
+}
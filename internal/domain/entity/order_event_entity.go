@@ -0,0 +1,10 @@
+package entity
+
+// OrderEvent is an order lifecycle event received from the order service.
+// MessageID identifies the message that carried it, so redeliveries of the
+// same message are recognised.
+type OrderEvent struct {
+	MessageID string
+	Type      string
+	OrderID   uint32
+}
@@ -0,0 +1,19 @@
+package entity
+
+import "time"
+
+// OutboxEvent is a domain event stored with the change it describes and
+// delivered afterwards by the outbox relay. Events sharing a partition key
+// are delivered in the order they were recorded.
+type OutboxEvent struct {
+	ID            uint64
+	Type          string
+	PartitionKey  string
+	Payload       map[string]any
+	Status        string
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+	DeliveredAt   *time.Time
+	CreatedAt     time.Time
+}
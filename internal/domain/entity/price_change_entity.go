@@ -0,0 +1,19 @@
+package entity
+
+import "time"
+
+// PriceChange is one entry of a product's price history. Changes scheduled
+// for the future stay pending until AppliedAt is set by the price scheduler.
+type PriceChange struct {
+	Base
+
+	ProductID     uint32
+	Price         Money
+	EffectiveFrom time.Time
+	AppliedAt     *time.Time
+}
+
+// IsPending reports whether the change has not been applied to the product yet.
+func (c *PriceChange) IsPending() bool {
+	return c.AppliedAt == nil
+}
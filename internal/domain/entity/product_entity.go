@@ -2,8 +2,37 @@ package entity
 
 type Product struct {
 	Base
-	ID    uint32
-	Name  string
-	Stock int
-	Price float64
+	SKU        string
+	Barcode    string
+	CategoryID uint32
+	Name       string
+	Stock      int
+	Price      Money
+	// InheritPrice marks variants without a price of their own; they are read
+	// with their parent's current price.
+	InheritPrice bool
+	// Status is the lifecycle status; draft and discontinued products cannot
+	// be reserved.
+	Status string
+	// Attributes holds free-form, line-specific properties such as material,
+	// voltage, weight or dimensions. Values are JSON scalars, arrays or objects.
+	Attributes map[string]any
+
+	// ParentID is set on variants and points at the product they belong to.
+	ParentID uint32
+	// Options holds the variant option values, e.g. {"size": "M", "color": "red"}.
+	Options  map[string]string
+	Variants []*Product
+	// TrackLots marks products whose stock is held in lots with expiry dates.
+	TrackLots bool
+	// TrackSerials marks products whose units are individually serial-numbered;
+	// their stock is the number of available serials.
+	TrackSerials bool
+	// Units lists the product's pack sizes in terms of its base unit.
+	Units []*ProductUnit
+	// Components is the bill of materials of a kit.
+	Components []*KitComponent
+	// AvailableStock is the product's own stock, the sum over its variants, or
+	// for kits the number of complete kits the scarcest component allows.
+	AvailableStock int
 }
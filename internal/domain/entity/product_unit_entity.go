@@ -0,0 +1,9 @@
+package entity
+
+// ProductUnit converts a unit of measure of a product into its base unit:
+// one Unit holds Factor base units, e.g. a case of 24.
+type ProductUnit struct {
+	ProductID uint32
+	Unit      string
+	Factor    int
+}
@@ -5,8 +5,17 @@ type Reservation struct {
 
 	ProductID uint32
 	OrderID   uint32
-	Quantity  int
-	Status    string
+	// Quantity is in the product's base unit; UnitQuantity is the amount that
+	// was requested in Unit.
+	Quantity     int
+	Unit         string
+	UnitQuantity int
+	Status       string
+	// ParentID links a component reservation to the kit reservation it belongs to.
+	ParentID uint32
 
-	Product *Product
+	Product    *Product
+	Components []*Reservation
+	Lots       []*LotAllocation
+	Serials    []*Serial
 }
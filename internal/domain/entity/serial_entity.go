@@ -0,0 +1,16 @@
+package entity
+
+// Serial is a single serial-numbered unit of a serialized product. A serial is
+// held by a reservation while it is pending and assigned to the reservation's
+// order once it is confirmed.
+type Serial struct {
+	Base
+
+	ProductID     uint32
+	SerialNumber  string
+	Status        string
+	ReservationID uint32
+	OrderID       uint32
+
+	Product *Product
+}
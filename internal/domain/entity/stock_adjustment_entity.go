@@ -0,0 +1,12 @@
+package entity
+
+// StockAdjustment adds Quantity units of Unit to a product's stock, or removes
+// them when Quantity is negative. BaseQuantity is the change in the base unit.
+type StockAdjustment struct {
+	ProductID    uint32
+	Quantity     int
+	Unit         string
+	BaseQuantity int
+
+	Product *Product
+}
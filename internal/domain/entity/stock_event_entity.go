@@ -0,0 +1,22 @@
+package entity
+
+import "time"
+
+// StockEvent records a product's stock and pending reservation total after a
+// change. Sequence orders events across all products and lets a watcher
+// resume after reconnecting.
+type StockEvent struct {
+	Sequence  uint64
+	ProductID uint32
+	Stock     int
+	Reserved  int
+	CreatedAt time.Time
+	// Snapshot marks an event sent to a new watcher as the product's current
+	// state rather than a change.
+	Snapshot bool
+}
+
+// AvailableStock is the stock left after pending reservations.
+func (e *StockEvent) AvailableStock() int {
+	return e.Stock - e.Reserved
+}
@@ -0,0 +1,35 @@
+package entity
+
+import "time"
+
+// Webhook subscribes a URL to domain events. Deliveries are signed with
+// Secret. A webhook is disabled after too many consecutive failed attempts,
+// and its pending deliveries wait until it is enabled again.
+type Webhook struct {
+	Base
+
+	URL          string
+	EventTypes   []string
+	Secret       string
+	Active       bool
+	FailureCount int
+	DisabledAt   *time.Time
+}
+
+// WebhookDelivery is one event sent, or to be sent, to one webhook, with the
+// outcome of its latest attempt.
+type WebhookDelivery struct {
+	ID             uint64
+	WebhookID      uint32
+	EventID        uint64
+	EventType      string
+	Payload        map[string]any
+	Status         string
+	Attempts       int
+	NextAttemptAt  time.Time
+	ResponseStatus int
+	ResponseBody   string
+	LastError      string
+	DeliveredAt    *time.Time
+	CreatedAt      time.Time
+}
@@ -0,0 +1,195 @@
+package service
+
+import (
+	"context"
+	"inventory-service/constant"
+	postgresrepository "inventory-service/internal/adapter/repository/postgres"
+	"inventory-service/internal/domain/entity"
+	serviceerror "inventory-service/internal/domain/service/error"
+	"inventory-service/internal/shared/auth"
+	"inventory-service/internal/shared/exception"
+)
+
+var _ AuditService = (*auditService)(nil)
+
+// AuditService reads the audit log of changes to products and reservations.
+type AuditService interface {
+	Find(ctx context.Context, filter *postgresrepository.FilterAuditEventPayload) ([]*entity.AuditEvent, int, error)
+}
+
+type auditService struct {
+	Properties
+}
+
+func NewAuditService(props Properties) *auditService {
+	return &auditService{Properties: props}
+}
+
+// auditEntityTypes are the entity types the audit log holds events for.
+var auditEntityTypes = map[string]bool{
+	constant.AuditEntityProduct:     true,
+	constant.AuditEntityReservation: true,
+}
+
+func (s *auditService) Find(ctx context.Context, filter *postgresrepository.FilterAuditEventPayload) ([]*entity.AuditEvent, int, error) {
+	errs := exception.FieldErrors{}
+
+	if filter.EntityType != "" && !auditEntityTypes[filter.EntityType] {
+		errs["entity_type"] = append(errs["entity_type"], "Entity type must be product or reservation")
+	}
+
+	if len(filter.EntityIDs) > 0 && filter.EntityType == "" {
+		errs["entity_type"] = append(errs["entity_type"], "Entity type is required when filtering by entity ID")
+	}
+
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		errs["to"] = append(errs["to"], "End of the time range must be after its start")
+	}
+
+	if len(errs) > 0 {
+		return nil, 0, exception.NewWithErrors(exception.TypeValidationError, exception.CodeValidationFailed, "Invalid audit event filter", errs)
+	}
+
+	events, total, err := s.Repo.Postgres().AuditEvent().Find(ctx, filter)
+	if err != nil {
+		return nil, 0, serviceerror.TranslateRepoError(err)
+	}
+
+	return events, total, nil
+}
+
+func (p Properties) auditsChanges() bool {
+	return p.Config != nil && p.Config.Audit != nil && p.Config.Audit.Enabled
+}
+
+// recordAudit stores audit events in the transaction of the change they
+// describe, so a change is never stored without its audit trail.
+func (p Properties) recordAudit(ctx context.Context, r postgresrepository.PostgresRepository, events ...*entity.AuditEvent) error {
+	if !p.auditsChanges() || len(events) == 0 {
+		return nil
+	}
+
+	return r.AuditEvent().Create(ctx, events)
+}
+
+// newAuditEvent attributes a change to the caller and the request found in
+// ctx. Changes the service makes on its own are made by the system.
+func newAuditEvent(ctx context.Context, entityType string, entityID uint32, action string, before, after map[string]any) *entity.AuditEvent {
+	event := &entity.AuditEvent{
+		EntityType: entityType,
+		EntityID:   entityID,
+		Action:     action,
+		Actor:      auth.Actor(ctx),
+		Source:     auth.SourceSystem,
+		Before:     before,
+		After:      after,
+	}
+
+	if origin, ok := auth.OriginFromContext(ctx); ok {
+		event.Source = origin.Source
+		event.RequestID = origin.RequestID
+	}
+
+	return event
+}
+
+// productAuditEvents describes saved products: those missing from previous
+// were created, the others updated.
+func productAuditEvents(ctx context.Context, previous map[uint32]*entity.Product, saved ...*entity.Product) []*entity.AuditEvent {
+	events := make([]*entity.AuditEvent, 0, len(saved))
+
+	for _, product := range saved {
+		action := constant.AuditActionUpdated
+		before, ok := previous[product.ID]
+		if !ok {
+			action = constant.AuditActionCreated
+		}
+
+		events = append(events, newAuditEvent(ctx, constant.AuditEntityProduct, product.ID, action, productAuditState(before), productAuditState(product)))
+	}
+
+	return events
+}
+
+// productAuditState is the part of a product the audit log keeps; nil stands
+// for a product that does not exist.
+func productAuditState(product *entity.Product) map[string]any {
+	if product == nil {
+		return nil
+	}
+
+	return map[string]any{
+		"sku":           product.SKU,
+		"barcode":       product.Barcode,
+		"category_id":   product.CategoryID,
+		"name":          product.Name,
+		"stock":         product.Stock,
+		"price":         product.Price.String(),
+		"currency":      product.Price.Currency,
+		"status":        product.Status,
+		"attributes":    product.Attributes,
+		"parent_id":     product.ParentID,
+		"options":       product.Options,
+		"track_lots":    product.TrackLots,
+		"track_serials": product.TrackSerials,
+	}
+}
+
+// reservationAuditEvents describes a new reservation and, for a kit, the
+// reservations of its components.
+func reservationAuditEvents(ctx context.Context, reservation *entity.Reservation) []*entity.AuditEvent {
+	events := make([]*entity.AuditEvent, 0, 1+len(reservation.Components))
+
+	for _, r := range append([]*entity.Reservation{reservation}, reservation.Components...) {
+		events = append(events, newAuditEvent(ctx, constant.AuditEntityReservation, r.ID, constant.AuditActionCreated, nil, reservationAuditState(r)))
+	}
+
+	return events
+}
+
+// lotAuditState describes the stock a received lot adds to its product.
+func lotAuditState(lot *entity.Lot) map[string]any {
+	return map[string]any{
+		"lot_id":     lot.ID,
+		"lot_number": lot.LotNumber,
+		"expires_at": lot.ExpiresAt,
+		"quantity":   lot.Quantity,
+	}
+}
+
+// serialsAuditState describes the stock registered serials add to their
+// product.
+func serialsAuditState(serials []*entity.Serial) map[string]any {
+	serialNumbers := make([]string, len(serials))
+	for i, serial := range serials {
+		serialNumbers[i] = serial.SerialNumber
+	}
+
+	return map[string]any{
+		"serial_numbers": serialNumbers,
+		"quantity":       len(serials),
+	}
+}
+
+func priceAuditState(price entity.Money) map[string]any {
+	return map[string]any{
+		"price":    price.String(),
+		"currency": price.Currency,
+	}
+}
+
+func reservationAuditState(reservation *entity.Reservation) map[string]any {
+	if reservation == nil {
+		return nil
+	}
+
+	return map[string]any{
+		"product_id":    reservation.ProductID,
+		"order_id":      reservation.OrderID,
+		"quantity":      reservation.Quantity,
+		"unit":          reservation.Unit,
+		"unit_quantity": reservation.UnitQuantity,
+		"status":        reservation.Status,
+		"parent_id":     reservation.ParentID,
+	}
+}
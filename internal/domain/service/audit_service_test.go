@@ -0,0 +1,319 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"inventory-service/config"
+	"inventory-service/constant"
+	postgresrepository "inventory-service/internal/adapter/repository/postgres"
+	"inventory-service/internal/domain/entity"
+	"inventory-service/internal/domain/service"
+	"inventory-service/internal/shared/auth"
+	"inventory-service/internal/shared/exception"
+	"inventory-service/mocks"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var auditConfig = &config.Config{Audit: &config.AuditConfig{Enabled: true}}
+
+// Helper to link an audit event repository mock into the postgres mock
+func setupAuditEventMock(t *testing.T, mPostgres *mocks.MockPostgresRepository) *mocks.MockAuditEventRepository {
+	mAuditEvent := mocks.NewMockAuditEventRepository(t)
+	mPostgres.EXPECT().AuditEvent().Return(mAuditEvent).Maybe()
+
+	return mAuditEvent
+}
+
+// restContext is a REST request made by user-1.
+func restContext() context.Context {
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{Subject: "user-1", Roles: []string{"admin"}})
+	return auth.WithOrigin(ctx, &auth.Origin{Source: auth.SourceREST, RequestID: "req-1"})
+}
+
+func TestAuditServiceFind(t *testing.T) {
+	mockRepo := mocks.NewMockRepository(t)
+	mockPostgres := mocks.NewMockPostgresRepository(t)
+	mockAuditEvent := setupAuditEventMock(t, mockPostgres)
+	mockRepo.EXPECT().Postgres().Return(mockPostgres)
+
+	ctx := context.Background()
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	filter := &postgresrepository.FilterAuditEventPayload{
+		EntityType: constant.AuditEntityProduct,
+		EntityIDs:  []uint32{1},
+		Actors:     []string{"user-1"},
+		From:       &from,
+	}
+	events := []*entity.AuditEvent{{ID: 2, EntityType: constant.AuditEntityProduct, EntityID: 1, Action: constant.AuditActionUpdated}}
+
+	mockAuditEvent.EXPECT().Find(ctx, filter).Return(events, 1, nil)
+
+	auditService := service.NewAuditService(service.Properties{Repo: mockRepo})
+	result, total, err := auditService.Find(ctx, filter)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, total)
+	assert.Equal(t, events, result)
+}
+
+func TestAuditServiceFindInvalidFilter(t *testing.T) {
+	from := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+	to := from.Add(-time.Hour)
+
+	tests := []struct {
+		name   string
+		filter *postgresrepository.FilterAuditEventPayload
+		field  string
+	}{
+		{"unknown entity type", &postgresrepository.FilterAuditEventPayload{EntityType: "category"}, "entity_type"},
+		{"entity ids without type", &postgresrepository.FilterAuditEventPayload{EntityIDs: []uint32{1}}, "entity_type"},
+		{"range ends before it starts", &postgresrepository.FilterAuditEventPayload{From: &from, To: &to}, "to"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			auditService := service.NewAuditService(service.Properties{Repo: mocks.NewMockRepository(t)})
+			_, _, err := auditService.Find(context.Background(), tt.filter)
+
+			ex, ok := exception.GetException(err)
+			if assert.True(t, ok) {
+				assert.Equal(t, exception.TypeValidationError, ex.Type)
+				assert.Contains(t, ex.Errors, tt.field)
+			}
+		})
+	}
+}
+
+func TestProductServiceUpdateRecordsAudit(t *testing.T) {
+	mockRepo, mockPostgres, mockProduct := setupProductMocks(t)
+	mockPriceChange := setupPriceChangeMock(t, mockPostgres)
+	mockAuditEvent := setupAuditEventMock(t, mockPostgres)
+	expectProductAtomic(mockPostgres)
+
+	ctx := restContext()
+	input := &entity.Product{Base: entity.Base{ID: 1}, SKU: "SKU-1", Name: "Updated Product", Stock: 7}
+
+	mockProduct.EXPECT().
+		Find(ctx, &postgresrepository.FilterProductPayload{IDs: []uint32{1}}).
+		Return([]*entity.Product{{Base: entity.Base{ID: 1}, SKU: "SKU-1", Name: "Product", Stock: 10}}, 1, nil)
+	mockProduct.EXPECT().Update(ctx, input).Return(input, nil)
+	mockPriceChange.EXPECT().Record(ctx, uint32(1), entity.Money{Currency: "USD"}).Return(nil)
+	mockAuditEvent.EXPECT().
+		Create(ctx, mock.MatchedBy(func(events []*entity.AuditEvent) bool {
+			return len(events) == 1 &&
+				events[0].EntityType == constant.AuditEntityProduct &&
+				events[0].EntityID == 1 &&
+				events[0].Action == constant.AuditActionUpdated &&
+				events[0].Actor == "user-1" &&
+				events[0].Source == auth.SourceREST &&
+				events[0].RequestID == "req-1" &&
+				events[0].Before["stock"] == 10 && events[0].Before["name"] == "Product" &&
+				events[0].After["stock"] == 7 && events[0].After["name"] == "Updated Product"
+		})).
+		Return(nil)
+
+	productService := service.NewProductService(service.Properties{Config: auditConfig, Repo: mockRepo})
+	_, err := productService.Update(ctx, input)
+
+	assert.NoError(t, err)
+}
+
+func TestProductServiceDeleteRecordsAudit(t *testing.T) {
+	mockRepo, mockPostgres, mockProduct := setupProductMocks(t)
+	mockAuditEvent := setupAuditEventMock(t, mockPostgres)
+	expectProductAtomic(mockPostgres)
+
+	ctx := auth.WithClient(context.Background(), &auth.Client{Name: "order-service", Method: auth.ClientAuthAPIKey})
+	ctx = auth.WithOrigin(ctx, &auth.Origin{Source: auth.SourceGRPC, RequestID: "req-2"})
+
+	mockProduct.EXPECT().
+		Find(ctx, &postgresrepository.FilterProductPayload{ParentIDs: []uint32{1}}).
+		Return([]*entity.Product{}, 0, nil)
+	mockProduct.EXPECT().FindByID(ctx, uint32(1)).Return(&entity.Product{Base: entity.Base{ID: 1}, SKU: "SKU-1"}, nil)
+	mockProduct.EXPECT().Delete(ctx, uint32(1)).Return(nil)
+	mockAuditEvent.EXPECT().
+		Create(ctx, mock.MatchedBy(func(events []*entity.AuditEvent) bool {
+			return len(events) == 1 &&
+				events[0].Action == constant.AuditActionDeleted &&
+				events[0].Actor == "order-service" &&
+				events[0].Source == auth.SourceGRPC &&
+				events[0].Before["sku"] == "SKU-1" &&
+				events[0].After == nil
+		})).
+		Return(nil)
+
+	productService := service.NewProductService(service.Properties{Config: auditConfig, Repo: mockRepo})
+	err := productService.Delete(ctx, 1)
+
+	assert.NoError(t, err)
+}
+
+func TestProductServiceAdjustStockFailsWhenAuditFails(t *testing.T) {
+	mockRepo, mockPostgres, mockProduct := setupProductMocks(t)
+	mockAuditEvent := setupAuditEventMock(t, mockPostgres)
+	expectProductAtomic(mockPostgres)
+
+	ctx := restContext()
+	before := &entity.Product{Base: entity.Base{ID: 1}, Stock: 10}
+	after := &entity.Product{Base: entity.Base{ID: 1}, Stock: 4}
+
+	mockProduct.EXPECT().FindByID(ctx, uint32(1)).Return(before, nil).Once()
+	mockProduct.EXPECT().ReserveStock(ctx, uint32(1), 6).Return(nil)
+	mockProduct.EXPECT().FindByID(ctx, uint32(1)).Return(after, nil).Once()
+	mockAuditEvent.EXPECT().
+		Create(ctx, mock.MatchedBy(func(events []*entity.AuditEvent) bool {
+			return len(events) == 1 &&
+				events[0].Action == constant.AuditActionStockAdjusted &&
+				events[0].Before["stock"] == 10 &&
+				events[0].After["stock"] == 4
+		})).
+		Return(errors.New("audit log unavailable"))
+
+	productService := service.NewProductService(service.Properties{Config: auditConfig, Repo: mockRepo})
+	_, err := productService.AdjustStock(ctx, &entity.StockAdjustment{ProductID: 1, Quantity: -6})
+
+	// The adjustment is rolled back with the audit event it could not store.
+	assert.Error(t, err)
+}
+
+func TestReservationServiceUpdateStatusRecordsAudit(t *testing.T) {
+	mockRepo, mockPostgres, mockRes := setupReservationMocks(t)
+	mockAuditEvent := setupAuditEventMock(t, mockPostgres)
+	expectReservationAtomic(mockPostgres)
+
+	ctx := auth.WithOrigin(context.Background(), &auth.Origin{Source: auth.SourceConsumer, RequestID: "msg-1"})
+	ids := []uint32{1, 2}
+	status := constant.ReservationStatusConfirmed
+
+	mockSerial := mocks.NewMockSerialRepository(t)
+	mockPostgres.EXPECT().Serial().Return(mockSerial)
+
+	mockRes.EXPECT().
+		Find(ctx, &postgresrepository.FilterReservationPayload{IDs: ids}).
+		Return([]*entity.Reservation{
+			{Base: entity.Base{ID: 1}, Status: constant.ReservationStatusPending},
+			{Base: entity.Base{ID: 2}, Status: status},
+		}, 2, nil)
+	mockRes.EXPECT().
+		Find(ctx, &postgresrepository.FilterReservationPayload{ParentIDs: ids}).
+		Return([]*entity.Reservation{}, 0, nil)
+	mockAuditEvent.EXPECT().
+		Create(ctx, mock.MatchedBy(func(events []*entity.AuditEvent) bool {
+			return len(events) == 1 &&
+				events[0].EntityType == constant.AuditEntityReservation &&
+				events[0].EntityID == 1 &&
+				events[0].Action == constant.AuditActionStatusChanged &&
+				events[0].Actor == auth.ActorSystem &&
+				events[0].Source == auth.SourceConsumer &&
+				events[0].RequestID == "msg-1" &&
+				events[0].Before["status"] == constant.ReservationStatusPending &&
+				events[0].After["status"] == status
+		})).
+		Return(nil)
+	mockSerial.EXPECT().Assign(ctx, []uint32{1}).Return(nil)
+	mockRes.EXPECT().UpdateStatus(ctx, ids, status).Return(nil)
+
+	resService := service.NewReservationService(service.Properties{Config: auditConfig, Repo: mockRepo})
+	err := resService.UpdateStatus(ctx, ids, status)
+
+	assert.NoError(t, err)
+}
+
+func TestLotServiceCreateRecordsAudit(t *testing.T) {
+	mockRepo, mockPostgres, mockLot := setupLotMocks(t)
+	mockAuditEvent := setupAuditEventMock(t, mockPostgres)
+	mockProduct := mocks.NewMockProductRepository(t)
+	mockPostgres.EXPECT().Product().Return(mockProduct)
+
+	ctx := restContext()
+	input := &entity.Lot{ProductID: 10, LotNumber: "L-1", Quantity: 40}
+
+	mockProduct.EXPECT().FindByID(ctx, uint32(10)).Return(&entity.Product{Base: entity.Base{ID: 10}, TrackLots: true}, nil)
+	mockLot.EXPECT().Create(ctx, input).Return(&entity.Lot{Base: entity.Base{ID: 1}, ProductID: 10, LotNumber: "L-1", Quantity: 40}, nil)
+	mockAuditEvent.EXPECT().
+		Create(ctx, mock.MatchedBy(func(events []*entity.AuditEvent) bool {
+			return len(events) == 1 &&
+				events[0].EntityType == constant.AuditEntityProduct &&
+				events[0].EntityID == 10 &&
+				events[0].Action == constant.AuditActionStockAdjusted &&
+				events[0].Actor == "user-1" &&
+				events[0].After["lot_id"] == uint32(1) &&
+				events[0].After["quantity"] == 40
+		})).
+		Return(nil)
+
+	lotService := service.NewLotService(service.Properties{Config: auditConfig, Repo: mockRepo})
+	_, err := lotService.Create(ctx, input)
+
+	assert.NoError(t, err)
+}
+
+func TestSerialServiceRegisterRecordsAudit(t *testing.T) {
+	mockRepo, mockPostgres, mockSerial := setupSerialMocks(t)
+	mockAuditEvent := setupAuditEventMock(t, mockPostgres)
+	mockProduct := mocks.NewMockProductRepository(t)
+	mockPostgres.EXPECT().Product().Return(mockProduct)
+
+	ctx := restContext()
+
+	mockProduct.EXPECT().FindByID(ctx, uint32(10)).Return(&entity.Product{Base: entity.Base{ID: 10}, TrackSerials: true}, nil)
+	mockSerial.EXPECT().CreateMany(ctx, mock.Anything).
+		Return([]*entity.Serial{
+			{Base: entity.Base{ID: 1}, ProductID: 10, SerialNumber: "SN-1"},
+			{Base: entity.Base{ID: 2}, ProductID: 10, SerialNumber: "SN-2"},
+		}, nil)
+	mockAuditEvent.EXPECT().
+		Create(ctx, mock.MatchedBy(func(events []*entity.AuditEvent) bool {
+			return len(events) == 1 &&
+				events[0].EntityType == constant.AuditEntityProduct &&
+				events[0].EntityID == 10 &&
+				events[0].Action == constant.AuditActionStockAdjusted &&
+				assert.ObjectsAreEqual([]string{"SN-1", "SN-2"}, events[0].After["serial_numbers"]) &&
+				events[0].After["quantity"] == 2
+		})).
+		Return(nil)
+
+	serialService := service.NewSerialService(service.Properties{Config: auditConfig, Repo: mockRepo})
+	_, err := serialService.Register(ctx, 10, []string{"SN-1", "SN-2"})
+
+	assert.NoError(t, err)
+}
+
+func TestPriceChangeServiceApplyDueRecordsAudit(t *testing.T) {
+	mockRepo, mockPostgres, mockPriceChange := setupPriceChangeMocks(t)
+	mockAuditEvent := setupAuditEventMock(t, mockPostgres)
+	mockProduct := mocks.NewMockProductRepository(t)
+	mockPostgres.EXPECT().Product().Return(mockProduct)
+
+	ctx := context.Background()
+	due := []*entity.PriceChange{
+		{Base: entity.Base{ID: 1}, ProductID: 3, Price: entity.Money{Currency: "USD", Units: 10}},
+		{Base: entity.Base{ID: 2}, ProductID: 3, Price: entity.Money{Currency: "USD", Units: 12}},
+	}
+
+	mockPriceChange.EXPECT().FindDue(ctx, mock.AnythingOfType("time.Time"), 50).Return(due, nil)
+	mockProduct.EXPECT().Find(ctx, &postgresrepository.FilterProductPayload{IDs: []uint32{3}}).
+		Return([]*entity.Product{{Base: entity.Base{ID: 3}, Price: entity.Money{Currency: "USD", Units: 8}}}, 1, nil)
+	mockProduct.EXPECT().UpdatePrice(ctx, uint32(3), mock.Anything).Return(nil)
+	mockPriceChange.EXPECT().MarkApplied(ctx, []uint32{1, 2}, mock.AnythingOfType("time.Time")).Return(nil)
+	mockAuditEvent.EXPECT().
+		Create(ctx, mock.MatchedBy(func(events []*entity.AuditEvent) bool {
+			return len(events) == 2 &&
+				events[0].EntityID == 3 &&
+				events[0].Action == constant.AuditActionUpdated &&
+				events[0].Actor == auth.ActorSystem &&
+				events[0].Before["price"] == "8.00" && events[0].After["price"] == "10.00" &&
+				events[1].Before["price"] == "10.00" && events[1].After["price"] == "12.00"
+		})).
+		Return(nil)
+
+	priceChangeService := service.NewPriceChangeService(service.Properties{Config: auditConfig, Repo: mockRepo})
+	_, err := priceChangeService.ApplyDue(ctx, 50)
+
+	assert.NoError(t, err)
+}
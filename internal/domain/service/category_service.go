@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+	postgresrepository "inventory-service/internal/adapter/repository/postgres"
+	"inventory-service/internal/domain/entity"
+	serviceerror "inventory-service/internal/domain/service/error"
+	"inventory-service/internal/shared/exception"
+	"slices"
+
+	"github.com/cockroachdb/errors"
+)
+
+var _ CategoryService = (*categoryService)(nil)
+
+type CategoryService interface {
+	Create(ctx context.Context, category *entity.Category) (*entity.Category, error)
+	Update(ctx context.Context, category *entity.Category) (*entity.Category, error)
+	Delete(ctx context.Context, id uint32) error
+	Find(ctx context.Context, filter *postgresrepository.FilterCategoryPayload) ([]*entity.Category, int, error)
+	FindByID(ctx context.Context, id uint32) (*entity.Category, error)
+}
+
+type categoryService struct {
+	Properties
+}
+
+func NewCategoryService(props Properties) *categoryService {
+	return &categoryService{Properties: props}
+}
+
+func (s *categoryService) Find(ctx context.Context, filter *postgresrepository.FilterCategoryPayload) ([]*entity.Category, int, error) {
+	categories, total, err := s.Repo.Postgres().Category().Find(ctx, filter)
+	if err != nil {
+		return nil, 0, serviceerror.TranslateRepoError(err)
+	}
+
+	return categories, total, nil
+}
+
+// FindByID returns the category together with its direct children.
+func (s *categoryService) FindByID(ctx context.Context, id uint32) (*entity.Category, error) {
+	category, err := s.Repo.Postgres().Category().FindByID(ctx, id)
+	if err != nil {
+		return nil, serviceerror.TranslateRepoError(err)
+	}
+
+	children, _, err := s.Repo.Postgres().Category().Find(ctx, &postgresrepository.FilterCategoryPayload{
+		ParentIDs: []uint32{id},
+	})
+	if err != nil {
+		return nil, serviceerror.TranslateRepoError(err)
+	}
+
+	category.Children = children
+
+	return category, nil
+}
+
+func (s *categoryService) Create(ctx context.Context, category *entity.Category) (*entity.Category, error) {
+	var createdCategory *entity.Category
+
+	atomic := func(r postgresrepository.PostgresRepository) error {
+		if err := validateCategoryParent(ctx, r, category); err != nil {
+			return err
+		}
+
+		var err error
+		createdCategory, err = r.Category().Create(ctx, category)
+		return err
+	}
+
+	err := s.Repo.Postgres().Atomic(ctx, s.Config, atomic)
+	if err != nil {
+		return nil, serviceerror.TranslateRepoError(err)
+	}
+
+	return createdCategory, nil
+}
+
+func (s *categoryService) Update(ctx context.Context, category *entity.Category) (*entity.Category, error) {
+	var updatedCategory *entity.Category
+
+	atomic := func(r postgresrepository.PostgresRepository) error {
+		if err := validateCategoryParent(ctx, r, category); err != nil {
+			return err
+		}
+
+		var err error
+		updatedCategory, err = r.Category().Update(ctx, category)
+		return err
+	}
+
+	err := s.Repo.Postgres().Atomic(ctx, s.Config, atomic)
+	if err != nil {
+		return nil, serviceerror.TranslateRepoError(err)
+	}
+
+	return updatedCategory, nil
+}
+
+func (s *categoryService) Delete(ctx context.Context, id uint32) error {
+	atomic := func(r postgresrepository.PostgresRepository) error {
+		_, children, err := r.Category().Find(ctx, &postgresrepository.FilterCategoryPayload{ParentIDs: []uint32{id}})
+		if err != nil {
+			return err
+		}
+
+		if children > 0 {
+			return exception.New(exception.TypeConflict, exception.CodeConflict, "Category still has child categories")
+		}
+
+		_, products, err := r.Product().Find(ctx, &postgresrepository.FilterProductPayload{CategoryID: id})
+		if err != nil {
+			return err
+		}
+
+		if products > 0 {
+			return exception.New(exception.TypeConflict, exception.CodeConflict, "Category still has products assigned")
+		}
+
+		return r.Category().Delete(ctx, id)
+	}
+
+	err := s.Repo.Postgres().Atomic(ctx, s.Config, atomic)
+	if err != nil {
+		return serviceerror.TranslateRepoError(err)
+	}
+
+	return nil
+}
+
+// validateCategoryParent makes sure the parent exists and that moving a
+// category does not place it beneath itself.
+func validateCategoryParent(ctx context.Context, r postgresrepository.PostgresRepository, category *entity.Category) error {
+	if category == nil || category.ParentID == 0 {
+		return nil
+	}
+
+	if _, err := r.Category().FindByID(ctx, category.ParentID); err != nil {
+		if !errors.Is(err, exception.ErrNotFound) {
+			return err
+		}
+
+		return exception.NewWithErrors(exception.TypeValidationError, exception.CodeValidationFailed, "Invalid parent category", exception.FieldErrors{
+			"parent_id": {"Parent category does not exist"},
+		})
+	}
+
+	if category.ID == 0 {
+		return nil
+	}
+
+	descendants, err := r.Category().FindDescendantIDs(ctx, category.ID)
+	if err != nil {
+		return err
+	}
+
+	if slices.Contains(descendants, category.ParentID) {
+		return exception.NewWithErrors(exception.TypeValidationError, exception.CodeValidationFailed, "Invalid parent category", exception.FieldErrors{
+			"parent_id": {"Category cannot be moved beneath itself or one of its descendants"},
+		})
+	}
+
+	return nil
+}
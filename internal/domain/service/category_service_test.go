@@ -0,0 +1,130 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"inventory-service/config"
+	postgresrepository "inventory-service/internal/adapter/repository/postgres"
+	"inventory-service/internal/domain/entity"
+	"inventory-service/internal/domain/service"
+	"inventory-service/internal/shared/exception"
+	"inventory-service/mocks"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Helper function to initialize the mock chain
+func setupCategoryMocks(t *testing.T) (*mocks.MockRepository, *mocks.MockPostgresRepository, *mocks.MockCategoryRepository) {
+	mRepo := mocks.NewMockRepository(t)
+	mPostgres := mocks.NewMockPostgresRepository(t)
+	mCategory := mocks.NewMockCategoryRepository(t)
+
+	mRepo.EXPECT().Postgres().Return(mPostgres).Maybe()
+	mPostgres.EXPECT().Category().Return(mCategory).Maybe()
+	mPostgres.EXPECT().
+		Atomic(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, cfg *config.Config, fn postgresrepository.RepositoryAtomicCallback) error {
+			return fn(mPostgres)
+		}).Maybe()
+
+	return mRepo, mPostgres, mCategory
+}
+
+func TestCategoryServiceCreate(t *testing.T) {
+	mockRepo, _, mockCategory := setupCategoryMocks(t)
+
+	ctx := context.Background()
+	input := &entity.Category{ParentID: 1, Name: "Laptops"}
+	expected := &entity.Category{Base: entity.Base{ID: 2}, ParentID: 1, Name: "Laptops"}
+
+	mockCategory.EXPECT().FindByID(ctx, uint32(1)).Return(&entity.Category{Base: entity.Base{ID: 1}}, nil)
+	mockCategory.EXPECT().Create(ctx, input).Return(expected, nil)
+
+	categoryService := service.NewCategoryService(service.Properties{Repo: mockRepo})
+	result, err := categoryService.Create(ctx, input)
+
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(2), result.ID)
+}
+
+func TestCategoryServiceCreateMissingParent(t *testing.T) {
+	mockRepo, _, mockCategory := setupCategoryMocks(t)
+
+	ctx := context.Background()
+	input := &entity.Category{ParentID: 99, Name: "Laptops"}
+
+	mockCategory.EXPECT().FindByID(ctx, uint32(99)).Return(nil, errors.Wrap(exception.ErrNotFound, "record not found"))
+
+	categoryService := service.NewCategoryService(service.Properties{Repo: mockRepo})
+	_, err := categoryService.Create(ctx, input)
+
+	ex, ok := exception.GetException(err)
+	assert.True(t, ok)
+	assert.Equal(t, exception.TypeValidationError, ex.Type)
+	assert.Contains(t, ex.Errors, "parent_id")
+}
+
+func TestCategoryServiceUpdateRejectsCycle(t *testing.T) {
+	mockRepo, _, mockCategory := setupCategoryMocks(t)
+
+	ctx := context.Background()
+	input := &entity.Category{Base: entity.Base{ID: 1}, ParentID: 3, Name: "Electronics"}
+
+	mockCategory.EXPECT().FindByID(ctx, uint32(3)).Return(&entity.Category{Base: entity.Base{ID: 3}}, nil)
+	mockCategory.EXPECT().FindDescendantIDs(ctx, uint32(1)).Return([]uint32{1, 2, 3}, nil)
+
+	categoryService := service.NewCategoryService(service.Properties{Repo: mockRepo})
+	_, err := categoryService.Update(ctx, input)
+
+	ex, ok := exception.GetException(err)
+	assert.True(t, ok)
+	assert.Equal(t, exception.TypeValidationError, ex.Type)
+	assert.Contains(t, ex.Errors, "parent_id")
+	mockCategory.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestCategoryServiceFindByIDLoadsChildren(t *testing.T) {
+	mockRepo, _, mockCategory := setupCategoryMocks(t)
+
+	ctx := context.Background()
+	parent := &entity.Category{Base: entity.Base{ID: 1}, Name: "Electronics", ProductCount: 5, TotalStock: 40}
+	children := []*entity.Category{{Base: entity.Base{ID: 2}, ParentID: 1, Name: "Laptops"}}
+
+	mockCategory.EXPECT().FindByID(ctx, uint32(1)).Return(parent, nil)
+	mockCategory.EXPECT().
+		Find(ctx, &postgresrepository.FilterCategoryPayload{ParentIDs: []uint32{1}}).
+		Return(children, 1, nil)
+
+	categoryService := service.NewCategoryService(service.Properties{Repo: mockRepo})
+	result, err := categoryService.FindByID(ctx, 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 40, result.TotalStock)
+	assert.Len(t, result.Children, 1)
+}
+
+func TestCategoryServiceDeleteWithProducts(t *testing.T) {
+	mockRepo, mockPostgres, mockCategory := setupCategoryMocks(t)
+	mockProduct := mocks.NewMockProductRepository(t)
+	mockPostgres.EXPECT().Product().Return(mockProduct).Maybe()
+
+	ctx := context.Background()
+
+	mockCategory.EXPECT().
+		Find(ctx, &postgresrepository.FilterCategoryPayload{ParentIDs: []uint32{1}}).
+		Return([]*entity.Category{}, 0, nil)
+	mockProduct.EXPECT().
+		Find(ctx, &postgresrepository.FilterProductPayload{CategoryID: 1}).
+		Return([]*entity.Product{{Base: entity.Base{ID: 7}}}, 1, nil)
+
+	categoryService := service.NewCategoryService(service.Properties{Repo: mockRepo})
+	err := categoryService.Delete(ctx, 1)
+
+	ex, ok := exception.GetException(err)
+	assert.True(t, ok)
+	assert.Equal(t, exception.TypeConflict, ex.Type)
+	mockCategory.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+}
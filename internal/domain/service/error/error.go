@@ -88,6 +88,11 @@ func TranslateRepoError(err error) error {
 		return exception.Wrap(err, exception.TypeValidationError, exception.CodeValidationFailed, detailedMsg)
 	}
 
+	if errors.Is(err, exception.ErrInsufficientStock) {
+		detailedMsg := getDetailedRepoMessage(err, exception.ErrInsufficientStock)
+		return exception.Wrap(err, exception.TypeConflict, exception.CodeInsufficientStock, detailedMsg)
+	}
+
 	if errors.Is(err, exception.ErrNotFound) {
 		return exception.Wrap(err, exception.TypeNotFound, exception.CodeNotFound, "Data not found")
 	}
@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"inventory-service/constant"
+	postgresrepository "inventory-service/internal/adapter/repository/postgres"
+	"inventory-service/internal/domain/entity"
+	serviceerror "inventory-service/internal/domain/service/error"
+	"inventory-service/internal/shared/exception"
+	"time"
+)
+
+var _ LotService = (*lotService)(nil)
+
+type LotService interface {
+	Create(ctx context.Context, lot *entity.Lot) (*entity.Lot, error)
+	Find(ctx context.Context, filter *postgresrepository.FilterLotPayload) ([]*entity.Lot, int, error)
+	FindExpiring(ctx context.Context, withinDays int, filter *postgresrepository.FilterLotPayload) ([]*entity.Lot, int, error)
+}
+
+type lotService struct {
+	Properties
+}
+
+func NewLotService(props Properties) *lotService {
+	return &lotService{Properties: props}
+}
+
+func (s *lotService) Find(ctx context.Context, filter *postgresrepository.FilterLotPayload) ([]*entity.Lot, int, error) {
+	lots, total, err := s.Repo.Postgres().Lot().Find(ctx, filter)
+	if err != nil {
+		return nil, 0, serviceerror.TranslateRepoError(err)
+	}
+
+	return lots, total, nil
+}
+
+// FindExpiring returns the unexpired lots with stock left that expire within
+// the given number of days, soonest first.
+func (s *lotService) FindExpiring(ctx context.Context, withinDays int, filter *postgresrepository.FilterLotPayload) ([]*entity.Lot, int, error) {
+	if withinDays <= 0 {
+		return nil, 0, exception.NewWithErrors(exception.TypeValidationError, exception.CodeValidationFailed, "Invalid expiry window", exception.FieldErrors{
+			"within_days": {"Days must be greater than zero"},
+		})
+	}
+
+	if filter == nil {
+		filter = &postgresrepository.FilterLotPayload{}
+	}
+
+	expiresBefore := time.Now().AddDate(0, 0, withinDays)
+
+	filter.ExcludeExpired = true
+	filter.InStockOnly = true
+	filter.ExpiresBefore = &expiresBefore
+
+	return s.Find(ctx, filter)
+}
+
+// Create receives a new lot of a lot-tracked product.
+func (s *lotService) Create(ctx context.Context, lot *entity.Lot) (*entity.Lot, error) {
+	if err := validateLot(lot); err != nil {
+		return nil, err
+	}
+
+	var createdLot *entity.Lot
+
+	atomic := func(r postgresrepository.PostgresRepository) error {
+		product, err := r.Product().FindByID(ctx, lot.ProductID)
+		if err != nil {
+			return err
+		}
+
+		if !product.TrackLots {
+			return exception.NewWithErrors(exception.TypeValidationError, exception.CodeValidationFailed, "Invalid lot", exception.FieldErrors{
+				"product_id": {"Product does not track lots"},
+			})
+		}
+
+		createdLot, err = r.Lot().Create(ctx, lot)
+		if err != nil {
+			return err
+		}
+
+		if err := s.recordEvents(ctx, r, stockChangedEvent(lot.ProductID, createdLot.Quantity, stockChangeLotReceived)); err != nil {
+			return err
+		}
+
+		return s.recordAudit(ctx, r, newAuditEvent(ctx, constant.AuditEntityProduct, lot.ProductID, constant.AuditActionStockAdjusted,
+			nil, lotAuditState(createdLot)))
+	}
+
+	err := s.Repo.Postgres().Atomic(ctx, s.Config, atomic)
+	if err != nil {
+		return nil, serviceerror.TranslateRepoError(err)
+	}
+
+	return createdLot, nil
+}
+
+func validateLot(lot *entity.Lot) error {
+	if lot == nil {
+		return exception.New(exception.TypeBadRequest, exception.CodeBadRequest, "Input data cannot be null")
+	}
+
+	errs := exception.FieldErrors{}
+
+	if lot.LotNumber == "" {
+		errs["lot_number"] = append(errs["lot_number"], "Lot number is required")
+	}
+
+	if lot.Quantity <= 0 {
+		errs["quantity"] = append(errs["quantity"], "Quantity must be greater than zero")
+	}
+
+	if len(errs) > 0 {
+		return exception.NewWithErrors(exception.TypeValidationError, exception.CodeValidationFailed, "Invalid lot", errs)
+	}
+
+	return nil
+}
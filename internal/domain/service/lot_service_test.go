@@ -0,0 +1,106 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"inventory-service/config"
+	postgresrepository "inventory-service/internal/adapter/repository/postgres"
+	"inventory-service/internal/domain/entity"
+	"inventory-service/internal/domain/service"
+	"inventory-service/internal/shared/exception"
+	"inventory-service/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Helper function to initialize the mock chain
+func setupLotMocks(t *testing.T) (*mocks.MockRepository, *mocks.MockPostgresRepository, *mocks.MockLotRepository) {
+	mRepo := mocks.NewMockRepository(t)
+	mPostgres := mocks.NewMockPostgresRepository(t)
+	mLot := mocks.NewMockLotRepository(t)
+
+	mRepo.EXPECT().Postgres().Return(mPostgres).Maybe()
+	mPostgres.EXPECT().Lot().Return(mLot).Maybe()
+	mPostgres.EXPECT().
+		Atomic(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, cfg *config.Config, fn postgresrepository.RepositoryAtomicCallback) error {
+			return fn(mPostgres)
+		}).Maybe()
+
+	return mRepo, mPostgres, mLot
+}
+
+func TestLotServiceCreate(t *testing.T) {
+	mockRepo, mockPostgres, mockLot := setupLotMocks(t)
+	mockProduct := mocks.NewMockProductRepository(t)
+	mockPostgres.EXPECT().Product().Return(mockProduct)
+
+	ctx := context.Background()
+	expiresAt := time.Now().AddDate(0, 3, 0)
+	input := &entity.Lot{ProductID: 10, LotNumber: "L-2024-01", ExpiresAt: &expiresAt, Quantity: 40}
+
+	mockProduct.EXPECT().FindByID(ctx, uint32(10)).Return(&entity.Product{Base: entity.Base{ID: 10}, TrackLots: true}, nil)
+	mockLot.EXPECT().Create(ctx, input).Return(&entity.Lot{Base: entity.Base{ID: 1}, ProductID: 10, Quantity: 40}, nil)
+
+	lotService := service.NewLotService(service.Properties{Repo: mockRepo})
+	result, err := lotService.Create(ctx, input)
+
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(1), result.ID)
+}
+
+func TestLotServiceCreateUntrackedProduct(t *testing.T) {
+	mockRepo, mockPostgres, mockLot := setupLotMocks(t)
+	mockProduct := mocks.NewMockProductRepository(t)
+	mockPostgres.EXPECT().Product().Return(mockProduct)
+
+	ctx := context.Background()
+	input := &entity.Lot{ProductID: 10, LotNumber: "L-2024-01", Quantity: 40}
+
+	mockProduct.EXPECT().FindByID(ctx, uint32(10)).Return(&entity.Product{Base: entity.Base{ID: 10}}, nil)
+
+	lotService := service.NewLotService(service.Properties{Repo: mockRepo})
+	_, err := lotService.Create(ctx, input)
+
+	ex, ok := exception.GetException(err)
+	assert.True(t, ok)
+	assert.Equal(t, exception.TypeValidationError, ex.Type)
+	assert.Contains(t, ex.Errors, "product_id")
+	mockLot.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestLotServiceFindExpiring(t *testing.T) {
+	mockRepo, _, mockLot := setupLotMocks(t)
+
+	ctx := context.Background()
+	filter := &postgresrepository.FilterLotPayload{ProductIDs: []uint32{10}, Page: 1, PerPage: 20}
+	limit := time.Now().AddDate(0, 0, 30)
+
+	mockLot.EXPECT().
+		Find(ctx, mock.MatchedBy(func(f *postgresrepository.FilterLotPayload) bool {
+			return f.ExcludeExpired && f.InStockOnly &&
+				f.ExpiresBefore != nil && f.ExpiresBefore.Sub(limit).Abs() < time.Minute
+		})).
+		Return([]*entity.Lot{{Base: entity.Base{ID: 1}}}, 1, nil)
+
+	lotService := service.NewLotService(service.Properties{Repo: mockRepo})
+	lots, total, err := lotService.FindExpiring(ctx, 30, filter)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, total)
+	assert.Len(t, lots, 1)
+}
+
+func TestLotServiceFindExpiringInvalidDays(t *testing.T) {
+	mockRepo, _, _ := setupLotMocks(t)
+
+	lotService := service.NewLotService(service.Properties{Repo: mockRepo})
+	_, _, err := lotService.FindExpiring(context.Background(), 0, nil)
+
+	ex, ok := exception.GetException(err)
+	assert.True(t, ok)
+	assert.Contains(t, ex.Errors, "within_days")
+}
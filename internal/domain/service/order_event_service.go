@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"inventory-service/constant"
+	postgresrepository "inventory-service/internal/adapter/repository/postgres"
+	"inventory-service/internal/domain/entity"
+	serviceerror "inventory-service/internal/domain/service/error"
+	"inventory-service/internal/shared/exception"
+)
+
+var _ OrderEventService = (*orderEventService)(nil)
+
+// OrderEventService applies the order lifecycle events of the order service
+// to the reservations of each order.
+type OrderEventService interface {
+	Handle(ctx context.Context, event *entity.OrderEvent) (bool, error)
+}
+
+type orderEventService struct {
+	Properties
+}
+
+func NewOrderEventService(props Properties) *orderEventService {
+	return &orderEventService{Properties: props}
+}
+
+// Handle confirms the pending reservations of a paid order, or cancels every
+// reservation of a cancelled order, and reports whether the event was
+// applied. An event whose message was handled before is skipped, so
+// redelivered messages are harmless. Reservations already cancelled are left
+// as they are when their order is paid.
+func (s *orderEventService) Handle(ctx context.Context, event *entity.OrderEvent) (bool, error) {
+	if err := validateOrderEvent(event); err != nil {
+		return false, err
+	}
+
+	status := constant.ReservationStatusConfirmed
+	if event.Type == constant.EventOrderCancelled {
+		status = constant.ReservationStatusCancelled
+	}
+
+	var applied bool
+
+	atomic := func(r postgresrepository.PostgresRepository) error {
+		claimed, err := r.ProcessedMessage().Claim(ctx, event.MessageID, event.Type)
+		if err != nil || !claimed {
+			return err
+		}
+
+		applied = true
+
+		reservations, _, err := r.Reservation().Find(ctx, &postgresrepository.FilterReservationPayload{
+			OrderIDs: []uint32{event.OrderID},
+		})
+		if err != nil {
+			return err
+		}
+
+		var ids []uint32
+
+		for _, reservation := range reservations {
+			// Components follow their kit reservation.
+			if reservation.ParentID != 0 || reservation.Status == status || reservation.Status == constant.ReservationStatusCancelled {
+				continue
+			}
+
+			ids = append(ids, reservation.ID)
+		}
+
+		if len(ids) == 0 {
+			return nil
+		}
+
+		return s.updateReservationStatus(ctx, r, ids, status)
+	}
+
+	err := s.Repo.Postgres().Atomic(ctx, s.Config, atomic)
+	if err != nil {
+		return false, serviceerror.TranslateRepoError(err)
+	}
+
+	return applied, nil
+}
+
+func validateOrderEvent(event *entity.OrderEvent) error {
+	if event == nil {
+		return exception.New(exception.TypeBadRequest, exception.CodeBadRequest, "Input data cannot be null")
+	}
+
+	errs := exception.FieldErrors{}
+
+	if event.MessageID == "" {
+		errs["message_id"] = append(errs["message_id"], "Message ID is required")
+	}
+
+	if event.Type != constant.EventOrderPaid && event.Type != constant.EventOrderCancelled {
+		errs["type"] = append(errs["type"], "Type must be "+constant.EventOrderPaid+" or "+constant.EventOrderCancelled)
+	}
+
+	if event.OrderID == 0 {
+		errs["order_id"] = append(errs["order_id"], "Order ID is required")
+	}
+
+	if len(errs) > 0 {
+		return exception.NewWithErrors(exception.TypeValidationError, exception.CodeValidationFailed, "Invalid order event", errs)
+	}
+
+	return nil
+}
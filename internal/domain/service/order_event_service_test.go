@@ -0,0 +1,106 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"inventory-service/config"
+	"inventory-service/constant"
+	postgresrepository "inventory-service/internal/adapter/repository/postgres"
+	"inventory-service/internal/domain/entity"
+	"inventory-service/internal/domain/service"
+	"inventory-service/internal/shared/exception"
+	"inventory-service/mocks"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Helper to initialize the mock chain for order events
+func setupOrderEventMocks(t *testing.T) (*mocks.MockRepository, *mocks.MockPostgresRepository, *mocks.MockReservationRepository, *mocks.MockProcessedMessageRepository) {
+	mRepo, mPostgres, mReservation := setupReservationMocks(t)
+	mProcessed := mocks.NewMockProcessedMessageRepository(t)
+
+	mPostgres.EXPECT().ProcessedMessage().Return(mProcessed).Maybe()
+
+	return mRepo, mPostgres, mReservation, mProcessed
+}
+
+func TestOrderEventServiceHandlePaidConfirmsPendingReservations(t *testing.T) {
+	mockRepo, mockPostgres, mockRes, mockProcessed := setupOrderEventMocks(t)
+	mockSerial := mocks.NewMockSerialRepository(t)
+	mockPostgres.EXPECT().Serial().Return(mockSerial)
+	expectReservationAtomic(mockPostgres)
+	ctx := context.Background()
+
+	mockProcessed.EXPECT().Claim(ctx, "msg-1", constant.EventOrderPaid).Return(true, nil)
+	mockRes.EXPECT().
+		Find(ctx, &postgresrepository.FilterReservationPayload{OrderIDs: []uint32{42}}).
+		Return([]*entity.Reservation{
+			{Base: entity.Base{ID: 1}, OrderID: 42, Status: constant.ReservationStatusPending},
+			{Base: entity.Base{ID: 2}, OrderID: 42, Status: constant.ReservationStatusCancelled},
+			{Base: entity.Base{ID: 3}, OrderID: 42, Status: constant.ReservationStatusConfirmed},
+			{Base: entity.Base{ID: 4}, OrderID: 42, ParentID: 1, Status: constant.ReservationStatusPending},
+		}, 4, nil)
+	mockRes.EXPECT().
+		Find(ctx, &postgresrepository.FilterReservationPayload{IDs: []uint32{1}}).
+		Return([]*entity.Reservation{{Base: entity.Base{ID: 1}, Status: constant.ReservationStatusPending}}, 1, nil)
+	mockRes.EXPECT().
+		Find(ctx, &postgresrepository.FilterReservationPayload{ParentIDs: []uint32{1}}).
+		Return([]*entity.Reservation{{Base: entity.Base{ID: 4}, ParentID: 1, Status: constant.ReservationStatusPending}}, 1, nil)
+	mockSerial.EXPECT().Assign(ctx, []uint32{4}).Return(nil)
+	mockRes.EXPECT().UpdateStatus(ctx, []uint32{1, 4}, constant.ReservationStatusConfirmed).Return(nil)
+
+	orderEventService := service.NewOrderEventService(service.Properties{Repo: mockRepo, Config: &config.Config{}})
+	applied, err := orderEventService.Handle(ctx, &entity.OrderEvent{MessageID: "msg-1", Type: constant.EventOrderPaid, OrderID: 42})
+
+	assert.NoError(t, err)
+	assert.True(t, applied)
+}
+
+func TestOrderEventServiceHandleSkipsDuplicateMessage(t *testing.T) {
+	mockRepo, mockPostgres, _, mockProcessed := setupOrderEventMocks(t)
+	expectReservationAtomic(mockPostgres)
+	ctx := context.Background()
+
+	// Claim fails for a message handled before; the reservations are not touched.
+	mockProcessed.EXPECT().Claim(ctx, "msg-1", constant.EventOrderCancelled).Return(false, nil)
+
+	orderEventService := service.NewOrderEventService(service.Properties{Repo: mockRepo, Config: &config.Config{}})
+	applied, err := orderEventService.Handle(ctx, &entity.OrderEvent{MessageID: "msg-1", Type: constant.EventOrderCancelled, OrderID: 42})
+
+	assert.NoError(t, err)
+	assert.False(t, applied)
+}
+
+func TestOrderEventServiceHandleCancelledWithoutOpenReservations(t *testing.T) {
+	mockRepo, mockPostgres, mockRes, mockProcessed := setupOrderEventMocks(t)
+	expectReservationAtomic(mockPostgres)
+	ctx := context.Background()
+
+	mockProcessed.EXPECT().Claim(ctx, "msg-2", constant.EventOrderCancelled).Return(true, nil)
+	mockRes.EXPECT().
+		Find(ctx, &postgresrepository.FilterReservationPayload{OrderIDs: []uint32{42}}).
+		Return([]*entity.Reservation{
+			{Base: entity.Base{ID: 1}, OrderID: 42, Status: constant.ReservationStatusCancelled},
+		}, 1, nil)
+
+	orderEventService := service.NewOrderEventService(service.Properties{Repo: mockRepo, Config: &config.Config{}})
+	applied, err := orderEventService.Handle(ctx, &entity.OrderEvent{MessageID: "msg-2", Type: constant.EventOrderCancelled, OrderID: 42})
+
+	assert.NoError(t, err)
+	assert.True(t, applied)
+}
+
+func TestOrderEventServiceHandleValidation(t *testing.T) {
+	mockRepo, _, _, _ := setupOrderEventMocks(t)
+
+	orderEventService := service.NewOrderEventService(service.Properties{Repo: mockRepo})
+	_, err := orderEventService.Handle(context.Background(), &entity.OrderEvent{Type: "order.shipped"})
+
+	ex, ok := exception.GetException(err)
+	assert.True(t, ok)
+	assert.Equal(t, exception.TypeValidationError, ex.Type)
+	assert.Contains(t, ex.Errors, "message_id")
+	assert.Contains(t, ex.Errors, "type")
+	assert.Contains(t, ex.Errors, "order_id")
+}
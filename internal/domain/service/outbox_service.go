@@ -0,0 +1,252 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"inventory-service/constant"
+	postgresrepository "inventory-service/internal/adapter/repository/postgres"
+	"inventory-service/internal/domain/entity"
+	serviceerror "inventory-service/internal/domain/service/error"
+	"slices"
+	"time"
+)
+
+const (
+	outboxMinRetryDelay = time.Second
+	outboxMaxRetryDelay = 10 * time.Minute
+)
+
+// Reasons given on stock changed events.
+const (
+	stockChangeAdjustment        = "adjustment"
+	stockChangeUpdate            = "update"
+	stockChangeLotReceived       = "lot_received"
+	stockChangeSerialsRegistered = "serials_registered"
+	stockChangeReserved          = "reserved"
+	stockChangeReleased          = "released"
+)
+
+var _ OutboxService = (*outboxService)(nil)
+
+// OutboxService delivers the domain events recorded in the outbox.
+type OutboxService interface {
+	Deliver(ctx context.Context, limit int, publish func(context.Context, *entity.OutboxEvent) error) (*OutboxDelivery, error)
+}
+
+// OutboxDelivery counts the outcome of one Deliver call. Retried events
+// failed and will be tried again after a delay; dead ones ran out of attempts.
+// Deferred events came after a failed event of their partition key and were
+// left for after its retry.
+type OutboxDelivery struct {
+	Delivered int
+	Retried   int
+	Dead      int
+	Deferred  int
+}
+
+// Total is the number of events Deliver handled.
+func (d *OutboxDelivery) Total() int {
+	return d.Delivered + d.Retried + d.Dead
+}
+
+// Full reports whether Deliver found as many events as it was allowed to
+// take, so more may be waiting.
+func (d *OutboxDelivery) Full(limit int) bool {
+	return limit > 0 && d.Total()+d.Deferred >= limit
+}
+
+type outboxService struct {
+	Properties
+	maxAttempts int
+}
+
+func NewOutboxService(props Properties) *outboxService {
+	s := &outboxService{
+		Properties:  props,
+		maxAttempts: constant.DefaultOutboxMaxAttempts,
+	}
+
+	if props.Config != nil && props.Config.Outbox != nil && props.Config.Outbox.MaxAttempts > 0 {
+		s.maxAttempts = props.Config.Outbox.MaxAttempts
+	}
+
+	return s
+}
+
+// Deliver publishes up to limit due events, each partition key's oldest
+// first. Once an event fails, the later events of its key wait for its retry.
+// The events stay locked until their outcome is stored, so an event published
+// just before a crash is published again: delivery is at least once.
+func (s *outboxService) Deliver(ctx context.Context, limit int, publish func(context.Context, *entity.OutboxEvent) error) (*OutboxDelivery, error) {
+	var delivery *OutboxDelivery
+
+	atomic := func(r postgresrepository.PostgresRepository) error {
+		delivery = &OutboxDelivery{}
+		now := time.Now()
+
+		events, err := r.Outbox().FindDeliverable(ctx, now, limit)
+		if err != nil {
+			return err
+		}
+
+		if err := s.fanOutToWebhooks(ctx, r, events); err != nil {
+			return err
+		}
+
+		delivered := make([]uint64, 0, len(events))
+		failedKeys := map[string]bool{}
+
+		for _, event := range events {
+			if failedKeys[event.PartitionKey] {
+				delivery.Deferred++
+				continue
+			}
+
+			if err := publish(ctx, event); err != nil {
+				failedKeys[event.PartitionKey] = true
+				event.Attempts++
+				event.LastError = err.Error()
+
+				if event.Attempts >= s.maxAttempts {
+					event.Status = constant.OutboxStatusDead
+					delivery.Dead++
+				} else {
+					event.NextAttemptAt = now.Add(retryDelay(event.Attempts, outboxMinRetryDelay, outboxMaxRetryDelay))
+					delivery.Retried++
+				}
+
+				if err := r.Outbox().MarkFailed(ctx, event); err != nil {
+					return err
+				}
+
+				continue
+			}
+
+			delivered = append(delivered, event.ID)
+			delivery.Delivered++
+		}
+
+		return r.Outbox().MarkDelivered(ctx, delivered, time.Now())
+	}
+
+	err := s.Repo.Postgres().Atomic(ctx, s.Config, atomic)
+	if err != nil {
+		return nil, serviceerror.TranslateRepoError(err)
+	}
+
+	return delivery, nil
+}
+
+// fanOutToWebhooks queues a delivery of each event to every active webhook
+// subscribed to its type. Events fanned out before, e.g. when their publishing
+// failed, are not queued twice.
+func (s *outboxService) fanOutToWebhooks(ctx context.Context, r postgresrepository.PostgresRepository, events []*entity.OutboxEvent) error {
+	if !s.webhooksEnabled() || len(events) == 0 {
+		return nil
+	}
+
+	eventTypes := make([]string, 0, len(events))
+	for _, event := range events {
+		if !slices.Contains(eventTypes, event.Type) {
+			eventTypes = append(eventTypes, event.Type)
+		}
+	}
+
+	webhooks, err := r.Webhook().FindSubscribed(ctx, eventTypes)
+	if err != nil {
+		return err
+	}
+
+	var deliveries []*entity.WebhookDelivery
+
+	for _, event := range events {
+		for _, webhook := range webhooks {
+			if !slices.Contains(webhook.EventTypes, event.Type) {
+				continue
+			}
+
+			deliveries = append(deliveries, &entity.WebhookDelivery{
+				WebhookID: webhook.ID,
+				EventID:   event.ID,
+				EventType: event.Type,
+				Payload:   event.Payload,
+				Status:    constant.WebhookDeliveryStatusPending,
+			})
+		}
+	}
+
+	return r.WebhookDelivery().CreateMany(ctx, deliveries)
+}
+
+// retryDelay doubles the wait after each failed attempt, from minDelay up to
+// maxDelay.
+func retryDelay(attempts int, minDelay, maxDelay time.Duration) time.Duration {
+	delay := minDelay
+
+	for i := 1; i < attempts && delay < maxDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, maxDelay)
+}
+
+// recordsEvents reports whether domain events are recorded at all; without
+// pubsub or webhooks nothing would deliver them.
+func (p Properties) recordsEvents() bool {
+	return p.Config != nil && p.Config.App != nil && p.Config.App.UsePubsub || p.webhooksEnabled()
+}
+
+func (p Properties) webhooksEnabled() bool {
+	return p.Config != nil && p.Config.Webhook != nil && p.Config.Webhook.Enabled
+}
+
+// recordEvents adds events to the outbox in the transaction of the change
+// they describe, so they are stored if and only if the change is.
+func (p Properties) recordEvents(ctx context.Context, r postgresrepository.PostgresRepository, events ...*entity.OutboxEvent) error {
+	if !p.recordsEvents() || len(events) == 0 {
+		return nil
+	}
+
+	return r.Outbox().Create(ctx, events)
+}
+
+// newProductEvent keys the event by product, which orders the events of one
+// product.
+func newProductEvent(eventType string, productID uint32, payload map[string]any) *entity.OutboxEvent {
+	payload["product_id"] = productID
+
+	return &entity.OutboxEvent{
+		Type:         eventType,
+		PartitionKey: fmt.Sprintf("product:%d", productID),
+		Payload:      payload,
+	}
+}
+
+func productCreatedEvent(product *entity.Product) *entity.OutboxEvent {
+	return newProductEvent(constant.EventProductCreated, product.ID, map[string]any{
+		"sku":      product.SKU,
+		"name":     product.Name,
+		"status":   product.Status,
+		"stock":    product.Stock,
+		"price":    product.Price.String(),
+		"currency": product.Price.Currency,
+	})
+}
+
+// stockChangedEvent reports a change of quantity units to the stock of a
+// product, negative when stock was taken.
+func stockChangedEvent(productID uint32, quantity int, reason string) *entity.OutboxEvent {
+	return newProductEvent(constant.EventStockChanged, productID, map[string]any{
+		"quantity": quantity,
+		"reason":   reason,
+	})
+}
+
+func reservationEvent(eventType string, reservation *entity.Reservation) *entity.OutboxEvent {
+	return newProductEvent(eventType, reservation.ProductID, map[string]any{
+		"reservation_id": reservation.ID,
+		"order_id":       reservation.OrderID,
+		"quantity":       reservation.Quantity,
+		"parent_id":      reservation.ParentID,
+	})
+}
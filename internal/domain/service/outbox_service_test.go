@@ -0,0 +1,216 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"inventory-service/config"
+	"inventory-service/constant"
+	postgresrepository "inventory-service/internal/adapter/repository/postgres"
+	"inventory-service/internal/domain/entity"
+	"inventory-service/internal/domain/service"
+	"inventory-service/mocks"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var pubsubConfig = &config.Config{App: &config.AppConfig{UsePubsub: true}}
+
+// Helper to initialize the mock chain for the outbox
+func setupOutboxMocks(t *testing.T) (*mocks.MockRepository, *mocks.MockPostgresRepository, *mocks.MockOutboxRepository) {
+	mRepo := mocks.NewMockRepository(t)
+	mPostgres := mocks.NewMockPostgresRepository(t)
+	mOutbox := mocks.NewMockOutboxRepository(t)
+
+	mRepo.EXPECT().Postgres().Return(mPostgres).Maybe()
+	mPostgres.EXPECT().Outbox().Return(mOutbox).Maybe()
+	mPostgres.EXPECT().
+		Atomic(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, cfg *config.Config, fn postgresrepository.RepositoryAtomicCallback) error {
+			return fn(mPostgres)
+		}).
+		Maybe()
+
+	return mRepo, mPostgres, mOutbox
+}
+
+func TestOutboxServiceDeliver(t *testing.T) {
+	mockRepo, _, mockOutbox := setupOutboxMocks(t)
+	ctx := context.Background()
+
+	events := []*entity.OutboxEvent{
+		{ID: 1, Type: constant.EventProductCreated, PartitionKey: "product:1"},
+		{ID: 2, Type: constant.EventStockChanged, PartitionKey: "product:2"},
+	}
+
+	mockOutbox.EXPECT().FindDeliverable(ctx, mock.Anything, 10).Return(events, nil)
+	mockOutbox.EXPECT().MarkDelivered(ctx, []uint64{1, 2}, mock.Anything).Return(nil)
+
+	var published []uint64
+
+	outboxService := service.NewOutboxService(service.Properties{Repo: mockRepo})
+	delivery, err := outboxService.Deliver(ctx, 10, func(_ context.Context, event *entity.OutboxEvent) error {
+		published = append(published, event.ID)
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []uint64{1, 2}, published)
+	assert.Equal(t, &service.OutboxDelivery{Delivered: 2}, delivery)
+}
+
+func TestOutboxServiceDeliverSchedulesRetry(t *testing.T) {
+	mockRepo, _, mockOutbox := setupOutboxMocks(t)
+	ctx := context.Background()
+	start := time.Now()
+
+	mockOutbox.EXPECT().FindDeliverable(ctx, mock.Anything, 10).Return([]*entity.OutboxEvent{
+		{ID: 1, PartitionKey: "product:1", Status: constant.OutboxStatusPending, Attempts: 2},
+		{ID: 2, PartitionKey: "product:2", Status: constant.OutboxStatusPending},
+	}, nil)
+	mockOutbox.EXPECT().
+		MarkFailed(ctx, mock.MatchedBy(func(event *entity.OutboxEvent) bool {
+			// The third failure waits 4s before the next attempt.
+			return event.ID == 1 &&
+				event.Attempts == 3 &&
+				event.Status == constant.OutboxStatusPending &&
+				event.LastError == "broker unavailable" &&
+				!event.NextAttemptAt.Before(start.Add(4*time.Second))
+		})).
+		Return(nil)
+	mockOutbox.EXPECT().MarkDelivered(ctx, []uint64{2}, mock.Anything).Return(nil)
+
+	outboxService := service.NewOutboxService(service.Properties{Repo: mockRepo})
+	delivery, err := outboxService.Deliver(ctx, 10, func(_ context.Context, event *entity.OutboxEvent) error {
+		if event.ID == 1 {
+			return errors.New("broker unavailable")
+		}
+
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, &service.OutboxDelivery{Delivered: 1, Retried: 1}, delivery)
+}
+
+func TestOutboxServiceDeliverStopsKeyAtFirstFailure(t *testing.T) {
+	mockRepo, _, mockOutbox := setupOutboxMocks(t)
+	ctx := context.Background()
+
+	mockOutbox.EXPECT().FindDeliverable(ctx, mock.Anything, 10).Return([]*entity.OutboxEvent{
+		{ID: 1, PartitionKey: "product:1", Status: constant.OutboxStatusPending},
+		{ID: 2, PartitionKey: "product:2", Status: constant.OutboxStatusPending},
+		{ID: 3, PartitionKey: "product:1", Status: constant.OutboxStatusPending},
+		{ID: 4, PartitionKey: "product:2", Status: constant.OutboxStatusPending},
+		{ID: 5, PartitionKey: "product:1", Status: constant.OutboxStatusPending},
+	}, nil)
+	mockOutbox.EXPECT().
+		MarkFailed(ctx, mock.MatchedBy(func(event *entity.OutboxEvent) bool { return event.ID == 3 })).
+		Return(nil)
+	mockOutbox.EXPECT().MarkDelivered(ctx, []uint64{1, 2, 4}, mock.Anything).Return(nil)
+
+	var published []uint64
+
+	outboxService := service.NewOutboxService(service.Properties{Repo: mockRepo})
+	delivery, err := outboxService.Deliver(ctx, 10, func(_ context.Context, event *entity.OutboxEvent) error {
+		published = append(published, event.ID)
+		if event.ID == 3 {
+			return errors.New("broker unavailable")
+		}
+
+		return nil
+	})
+
+	assert.NoError(t, err)
+	// Event 5 waits for the retry of event 3; the other key carries on.
+	assert.Equal(t, []uint64{1, 2, 3, 4}, published)
+	assert.Equal(t, &service.OutboxDelivery{Delivered: 3, Retried: 1, Deferred: 1}, delivery)
+	assert.False(t, delivery.Full(10))
+	assert.True(t, delivery.Full(5))
+}
+
+func TestOutboxServiceDeliverDeadLettersAfterMaxAttempts(t *testing.T) {
+	mockRepo, _, mockOutbox := setupOutboxMocks(t)
+	ctx := context.Background()
+
+	mockOutbox.EXPECT().FindDeliverable(ctx, mock.Anything, 10).Return([]*entity.OutboxEvent{
+		{ID: 1, Status: constant.OutboxStatusPending, Attempts: 2},
+	}, nil)
+	mockOutbox.EXPECT().
+		MarkFailed(ctx, mock.MatchedBy(func(event *entity.OutboxEvent) bool {
+			return event.Attempts == 3 && event.Status == constant.OutboxStatusDead
+		})).
+		Return(nil)
+	mockOutbox.EXPECT().MarkDelivered(ctx, []uint64{}, mock.Anything).Return(nil)
+
+	outboxService := service.NewOutboxService(service.Properties{
+		Config: &config.Config{Outbox: &config.OutboxConfig{MaxAttempts: 3}},
+		Repo:   mockRepo,
+	})
+	delivery, err := outboxService.Deliver(ctx, 10, func(context.Context, *entity.OutboxEvent) error {
+		return errors.New("rejected")
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, &service.OutboxDelivery{Dead: 1}, delivery)
+}
+
+func TestProductServiceCreateRecordsEvent(t *testing.T) {
+	mockRepo, mockPostgres, mockProduct := setupProductMocks(t)
+	mockPriceChange := setupPriceChangeMock(t, mockPostgres)
+	mockOutbox := mocks.NewMockOutboxRepository(t)
+	mockPostgres.EXPECT().Outbox().Return(mockOutbox)
+	expectProductAtomic(mockPostgres)
+
+	ctx := context.Background()
+	input := &entity.Product{SKU: "SKU-1", Name: "Test Product", Stock: 5}
+	created := &entity.Product{Base: entity.Base{ID: 1}, SKU: "SKU-1", Name: "Test Product", Stock: 5}
+
+	mockProduct.EXPECT().Create(ctx, input).Return(created, nil)
+	mockPriceChange.EXPECT().Record(ctx, uint32(1), created.Price).Return(nil)
+	mockOutbox.EXPECT().
+		Create(ctx, mock.MatchedBy(func(events []*entity.OutboxEvent) bool {
+			return len(events) == 1 &&
+				events[0].Type == constant.EventProductCreated &&
+				events[0].PartitionKey == "product:1" &&
+				events[0].Payload["stock"] == 5
+		})).
+		Return(nil)
+
+	productService := service.NewProductService(service.Properties{Config: pubsubConfig, Repo: mockRepo})
+	_, err := productService.Create(ctx, input)
+
+	assert.NoError(t, err)
+}
+
+func TestProductServiceUpdateRecordsStockChange(t *testing.T) {
+	mockRepo, mockPostgres, mockProduct := setupProductMocks(t)
+	mockPriceChange := setupPriceChangeMock(t, mockPostgres)
+	mockOutbox := mocks.NewMockOutboxRepository(t)
+	mockPostgres.EXPECT().Outbox().Return(mockOutbox)
+	expectProductAtomic(mockPostgres)
+
+	ctx := context.Background()
+	input := &entity.Product{Base: entity.Base{ID: 1}, SKU: "SKU-1", Name: "Updated Product", Stock: 7}
+
+	mockProduct.EXPECT().
+		Find(ctx, &postgresrepository.FilterProductPayload{IDs: []uint32{1}}).
+		Return([]*entity.Product{{Base: entity.Base{ID: 1}, SKU: "SKU-1", Stock: 10}}, 1, nil)
+	mockProduct.EXPECT().Update(ctx, input).Return(input, nil)
+	mockPriceChange.EXPECT().Record(ctx, uint32(1), entity.Money{Currency: "USD"}).Return(nil)
+	mockOutbox.EXPECT().
+		Create(ctx, mock.MatchedBy(func(events []*entity.OutboxEvent) bool {
+			return len(events) == 1 &&
+				events[0].Type == constant.EventStockChanged &&
+				events[0].Payload["quantity"] == -3
+		})).
+		Return(nil)
+
+	productService := service.NewProductService(service.Properties{Config: pubsubConfig, Repo: mockRepo})
+	_, err := productService.Update(ctx, input)
+
+	assert.NoError(t, err)
+}
@@ -0,0 +1,208 @@
+package service
+
+import (
+	"context"
+	"inventory-service/constant"
+	postgresrepository "inventory-service/internal/adapter/repository/postgres"
+	"inventory-service/internal/domain/entity"
+	serviceerror "inventory-service/internal/domain/service/error"
+	"inventory-service/internal/shared/exception"
+	"slices"
+	"time"
+)
+
+var _ PriceChangeService = (*priceChangeService)(nil)
+
+type PriceChangeService interface {
+	Find(ctx context.Context, filter *postgresrepository.FilterPriceChangePayload) ([]*entity.PriceChange, int, error)
+	PriceAt(ctx context.Context, productID uint32, at time.Time) (entity.Money, error)
+	Schedule(ctx context.Context, change *entity.PriceChange) (*entity.PriceChange, error)
+	Cancel(ctx context.Context, productID, id uint32) error
+	ApplyDue(ctx context.Context, limit int) (int, error)
+}
+
+type priceChangeService struct {
+	Properties
+}
+
+func NewPriceChangeService(props Properties) *priceChangeService {
+	return &priceChangeService{Properties: props}
+}
+
+func (s *priceChangeService) Find(ctx context.Context, filter *postgresrepository.FilterPriceChangePayload) ([]*entity.PriceChange, int, error) {
+	changes, total, err := s.Repo.Postgres().PriceChange().Find(ctx, filter)
+	if err != nil {
+		return nil, 0, serviceerror.TranslateRepoError(err)
+	}
+
+	return changes, total, nil
+}
+
+// PriceAt returns the price the product had, or is scheduled to have, at the
+// given time.
+func (s *priceChangeService) PriceAt(ctx context.Context, productID uint32, at time.Time) (entity.Money, error) {
+	change, err := s.Repo.Postgres().PriceChange().FindEffective(ctx, productID, at)
+	if err != nil {
+		return entity.Money{}, serviceerror.TranslateRepoError(err)
+	}
+
+	return change.Price, nil
+}
+
+// Schedule records a price change that the price scheduler applies to the
+// product once its effective time has come. The currency defaults to the
+// product's current one.
+func (s *priceChangeService) Schedule(ctx context.Context, change *entity.PriceChange) (*entity.PriceChange, error) {
+	if change == nil {
+		return nil, exception.New(exception.TypeBadRequest, exception.CodeBadRequest, "Input data cannot be null")
+	}
+
+	if !change.EffectiveFrom.After(time.Now()) {
+		return nil, exception.NewWithErrors(exception.TypeValidationError, exception.CodeValidationFailed, "Invalid price change", exception.FieldErrors{
+			"effective_from": {"Effective time must be in the future"},
+		})
+	}
+
+	var scheduledChange *entity.PriceChange
+
+	atomic := func(r postgresrepository.PostgresRepository) error {
+		product, err := r.Product().FindByID(ctx, change.ProductID)
+		if err != nil {
+			return err
+		}
+
+		if change.Price.Currency == "" {
+			change.Price.Currency = product.Price.Currency
+		}
+
+		if err := validateMoney(&change.Price); err != nil {
+			return err
+		}
+
+		change.AppliedAt = nil
+
+		scheduledChange, err = r.PriceChange().Create(ctx, change)
+		return err
+	}
+
+	err := s.Repo.Postgres().Atomic(ctx, s.Config, atomic)
+	if err != nil {
+		return nil, serviceerror.TranslateRepoError(err)
+	}
+
+	return scheduledChange, nil
+}
+
+// Cancel drops a scheduled price change of the product; changes that have
+// already been applied are part of the history and cannot be cancelled.
+func (s *priceChangeService) Cancel(ctx context.Context, productID, id uint32) error {
+	atomic := func(r postgresrepository.PostgresRepository) error {
+		change, err := r.PriceChange().FindByID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if change.ProductID != productID {
+			return exception.New(exception.TypeNotFound, exception.CodeNotFound, "Price change not found")
+		}
+
+		if !change.IsPending() {
+			return exception.New(exception.TypeConflict, exception.CodeConflict, "Price change has already been applied")
+		}
+
+		return r.PriceChange().Delete(ctx, id)
+	}
+
+	err := s.Repo.Postgres().Atomic(ctx, s.Config, atomic)
+	if err != nil {
+		return serviceerror.TranslateRepoError(err)
+	}
+
+	return nil
+}
+
+// ApplyDue sets the price of every product with a scheduled change whose
+// effective time has passed, handling at most limit changes, and returns how
+// many were applied. Changes of the same product are applied oldest first so
+// the latest one wins.
+func (s *priceChangeService) ApplyDue(ctx context.Context, limit int) (int, error) {
+	var applied int
+
+	atomic := func(r postgresrepository.PostgresRepository) error {
+		now := time.Now()
+
+		due, err := r.PriceChange().FindDue(ctx, now, limit)
+		if err != nil {
+			return err
+		}
+
+		if len(due) == 0 {
+			return nil
+		}
+
+		prices, err := s.currentPrices(ctx, r, due)
+		if err != nil {
+			return err
+		}
+
+		ids := make([]uint32, 0, len(due))
+		events := make([]*entity.AuditEvent, 0, len(due))
+
+		for _, change := range due {
+			if err := r.Product().UpdatePrice(ctx, change.ProductID, change.Price); err != nil {
+				return err
+			}
+
+			ids = append(ids, change.ID)
+
+			if prices != nil {
+				events = append(events, newAuditEvent(ctx, constant.AuditEntityProduct, change.ProductID, constant.AuditActionUpdated,
+					priceAuditState(prices[change.ProductID]), priceAuditState(change.Price)))
+				prices[change.ProductID] = change.Price
+			}
+		}
+
+		if err := r.PriceChange().MarkApplied(ctx, ids, now); err != nil {
+			return err
+		}
+
+		applied = len(ids)
+
+		return s.recordAudit(ctx, r, events...)
+	}
+
+	err := s.Repo.Postgres().Atomic(ctx, s.Config, atomic)
+	if err != nil {
+		return 0, serviceerror.TranslateRepoError(err)
+	}
+
+	return applied, nil
+}
+
+// currentPrices returns the price of each product the changes apply to, for
+// the audit trail to compare against. It reads nothing unless changes are
+// audited.
+func (s *priceChangeService) currentPrices(ctx context.Context, r postgresrepository.PostgresRepository, changes []*entity.PriceChange) (map[uint32]entity.Money, error) {
+	if !s.auditsChanges() {
+		return nil, nil
+	}
+
+	ids := make([]uint32, 0, len(changes))
+	for _, change := range changes {
+		if !slices.Contains(ids, change.ProductID) {
+			ids = append(ids, change.ProductID)
+		}
+	}
+
+	products, _, err := r.Product().Find(ctx, &postgresrepository.FilterProductPayload{IDs: ids})
+	if err != nil {
+		return nil, err
+	}
+
+	prices := make(map[uint32]entity.Money, len(products))
+	for _, product := range products {
+		prices[product.ID] = product.Price
+	}
+
+	return prices, nil
+}
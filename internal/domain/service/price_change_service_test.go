@@ -0,0 +1,172 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"inventory-service/config"
+	postgresrepository "inventory-service/internal/adapter/repository/postgres"
+	"inventory-service/internal/domain/entity"
+	"inventory-service/internal/domain/service"
+	"inventory-service/internal/shared/exception"
+	"inventory-service/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Helper function to initialize the mock chain
+func setupPriceChangeMocks(t *testing.T) (*mocks.MockRepository, *mocks.MockPostgresRepository, *mocks.MockPriceChangeRepository) {
+	mRepo := mocks.NewMockRepository(t)
+	mPostgres := mocks.NewMockPostgresRepository(t)
+	mPriceChange := mocks.NewMockPriceChangeRepository(t)
+
+	mRepo.EXPECT().Postgres().Return(mPostgres).Maybe()
+	mPostgres.EXPECT().PriceChange().Return(mPriceChange).Maybe()
+	mPostgres.EXPECT().
+		Atomic(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, cfg *config.Config, fn postgresrepository.RepositoryAtomicCallback) error {
+			return fn(mPostgres)
+		}).Maybe()
+
+	return mRepo, mPostgres, mPriceChange
+}
+
+func TestPriceChangeServiceScheduleDefaultsToProductCurrency(t *testing.T) {
+	mockRepo, mockPostgres, mockPriceChange := setupPriceChangeMocks(t)
+	mockProduct := mocks.NewMockProductRepository(t)
+	mockPostgres.EXPECT().Product().Return(mockProduct)
+
+	ctx := context.Background()
+	input := &entity.PriceChange{ProductID: 3, Price: entity.Money{Units: 24, Nanos: 500_000_000}, EffectiveFrom: time.Now().Add(time.Hour)}
+
+	mockProduct.EXPECT().FindByID(ctx, uint32(3)).Return(&entity.Product{Base: entity.Base{ID: 3}, Price: entity.Money{Currency: "EUR", Units: 19}}, nil)
+	mockPriceChange.EXPECT().Create(ctx, input).Return(input, nil)
+
+	priceChangeService := service.NewPriceChangeService(service.Properties{Repo: mockRepo})
+	result, err := priceChangeService.Schedule(ctx, input)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "EUR", result.Price.Currency)
+	assert.True(t, result.IsPending())
+}
+
+func TestPriceChangeServiceScheduleInPast(t *testing.T) {
+	mockRepo, _, mockPriceChange := setupPriceChangeMocks(t)
+
+	input := &entity.PriceChange{ProductID: 3, Price: entity.Money{Currency: "USD", Units: 5}, EffectiveFrom: time.Now().Add(-time.Minute)}
+
+	priceChangeService := service.NewPriceChangeService(service.Properties{Repo: mockRepo})
+	_, err := priceChangeService.Schedule(context.Background(), input)
+
+	ex, ok := exception.GetException(err)
+	assert.True(t, ok)
+	assert.Contains(t, ex.Errors, "effective_from")
+	mockPriceChange.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestPriceChangeServiceScheduleInvalidPrice(t *testing.T) {
+	mockRepo, mockPostgres, mockPriceChange := setupPriceChangeMocks(t)
+	mockProduct := mocks.NewMockProductRepository(t)
+	mockPostgres.EXPECT().Product().Return(mockProduct)
+
+	ctx := context.Background()
+	input := &entity.PriceChange{ProductID: 3, Price: entity.Money{Currency: "JPY", Units: 500, Nanos: 500_000_000}, EffectiveFrom: time.Now().Add(time.Hour)}
+
+	mockProduct.EXPECT().FindByID(ctx, uint32(3)).Return(&entity.Product{Base: entity.Base{ID: 3}}, nil)
+
+	priceChangeService := service.NewPriceChangeService(service.Properties{Repo: mockRepo})
+	_, err := priceChangeService.Schedule(ctx, input)
+
+	ex, ok := exception.GetException(err)
+	assert.True(t, ok)
+	assert.Contains(t, ex.Errors, "price")
+	mockPriceChange.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestPriceChangeServiceCancelApplied(t *testing.T) {
+	mockRepo, _, mockPriceChange := setupPriceChangeMocks(t)
+
+	ctx := context.Background()
+	appliedAt := time.Now().Add(-time.Hour)
+
+	mockPriceChange.EXPECT().FindByID(ctx, uint32(8)).Return(&entity.PriceChange{Base: entity.Base{ID: 8}, ProductID: 3, AppliedAt: &appliedAt}, nil)
+
+	priceChangeService := service.NewPriceChangeService(service.Properties{Repo: mockRepo})
+	err := priceChangeService.Cancel(ctx, 3, 8)
+
+	ex, ok := exception.GetException(err)
+	assert.True(t, ok)
+	assert.Equal(t, exception.TypeConflict, ex.Type)
+	mockPriceChange.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+}
+
+func TestPriceChangeServiceCancelOtherProduct(t *testing.T) {
+	mockRepo, _, mockPriceChange := setupPriceChangeMocks(t)
+
+	ctx := context.Background()
+
+	mockPriceChange.EXPECT().FindByID(ctx, uint32(8)).Return(&entity.PriceChange{Base: entity.Base{ID: 8}, ProductID: 4}, nil)
+
+	priceChangeService := service.NewPriceChangeService(service.Properties{Repo: mockRepo})
+	err := priceChangeService.Cancel(ctx, 3, 8)
+
+	ex, ok := exception.GetException(err)
+	assert.True(t, ok)
+	assert.Equal(t, exception.TypeNotFound, ex.Type)
+}
+
+func TestPriceChangeServicePriceAt(t *testing.T) {
+	mockRepo, _, mockPriceChange := setupPriceChangeMocks(t)
+
+	ctx := context.Background()
+	at := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	mockPriceChange.EXPECT().FindEffective(ctx, uint32(3), at).
+		Return(&entity.PriceChange{ProductID: 3, Price: entity.Money{Currency: "USD", Units: 9, Nanos: 990_000_000}}, nil)
+
+	priceChangeService := service.NewPriceChangeService(service.Properties{Repo: mockRepo})
+	price, err := priceChangeService.PriceAt(ctx, 3, at)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "9.99", price.String())
+}
+
+func TestPriceChangeServiceApplyDue(t *testing.T) {
+	mockRepo, mockPostgres, mockPriceChange := setupPriceChangeMocks(t)
+	mockProduct := mocks.NewMockProductRepository(t)
+	mockPostgres.EXPECT().Product().Return(mockProduct)
+
+	ctx := context.Background()
+	due := []*entity.PriceChange{
+		{Base: entity.Base{ID: 1}, ProductID: 3, Price: entity.Money{Currency: "USD", Units: 10}},
+		{Base: entity.Base{ID: 2}, ProductID: 3, Price: entity.Money{Currency: "USD", Units: 12}},
+	}
+
+	mockPriceChange.EXPECT().FindDue(ctx, mock.AnythingOfType("time.Time"), 50).Return(due, nil)
+	updatePrice := mockProduct.EXPECT().UpdatePrice(ctx, uint32(3), due[0].Price).Return(nil).Call
+	mockProduct.EXPECT().UpdatePrice(ctx, uint32(3), due[1].Price).Return(nil).NotBefore(updatePrice)
+	mockPriceChange.EXPECT().MarkApplied(ctx, []uint32{1, 2}, mock.AnythingOfType("time.Time")).Return(nil)
+
+	priceChangeService := service.NewPriceChangeService(service.Properties{Repo: mockRepo})
+	applied, err := priceChangeService.ApplyDue(ctx, 50)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, applied)
+}
+
+func TestPriceChangeServiceApplyDueNothingDue(t *testing.T) {
+	mockRepo, _, mockPriceChange := setupPriceChangeMocks(t)
+
+	ctx := context.Background()
+
+	mockPriceChange.EXPECT().FindDue(ctx, mock.AnythingOfType("time.Time"), 50).Return(nil, nil)
+
+	priceChangeService := service.NewPriceChangeService(service.Properties{Repo: mockRepo})
+	applied, err := priceChangeService.ApplyDue(ctx, 50)
+
+	assert.NoError(t, err)
+	assert.Zero(t, applied)
+	mockPriceChange.AssertNotCalled(t, "MarkApplied", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,490 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"inventory-service/constant"
+	postgresrepository "inventory-service/internal/adapter/repository/postgres"
+	"inventory-service/internal/domain/entity"
+	serviceerror "inventory-service/internal/domain/service/error"
+	"inventory-service/internal/shared/exception"
+)
+
+// batchWriter stores the pending items of a batch, either all at once or one
+// at a time.
+type batchWriter struct {
+	many func(ctx context.Context, r postgresrepository.PostgresRepository, products []*entity.Product) ([]*entity.Product, error)
+	one  func(ctx context.Context, r postgresrepository.PostgresRepository, product *entity.Product) (*entity.Product, error)
+}
+
+var (
+	batchCreateWriter = batchWriter{many: createProducts, one: createProduct}
+	batchUpdateWriter = batchWriter{many: updateProducts, one: updateProduct}
+)
+
+// BatchCreate creates many products in one call. In atomic mode nothing is
+// written unless every item is valid; otherwise the valid items are created
+// and the invalid ones reported. Results are in input order.
+func (s *productService) BatchCreate(ctx context.Context, products []*entity.Product, atomic bool) ([]*entity.BatchItemResult, error) {
+	results, err := s.ValidateBatchCreate(ctx, products)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.writeBatch(ctx, products, results, atomic, batchCreateWriter)
+}
+
+// ValidateBatchCreate runs the checks of BatchCreate without writing anything.
+// Items that would be created have neither a product nor an error.
+func (s *productService) ValidateBatchCreate(ctx context.Context, products []*entity.Product) ([]*entity.BatchItemResult, error) {
+	if err := s.validateBatchSize(len(products)); err != nil {
+		return nil, err
+	}
+
+	results := newBatchResults(len(products))
+
+	for i, product := range products {
+		if product == nil {
+			results[i].Err = exception.New(exception.TypeBadRequest, exception.CodeBadRequest, "Input data cannot be null")
+			continue
+		}
+
+		if err := validateProductFields(product); err != nil {
+			results[i].Err = err
+			continue
+		}
+
+		results[i].Err = validateInitialStatus(product)
+	}
+
+	if err := s.checkBatchSKUs(ctx, products, results); err != nil {
+		return nil, err
+	}
+
+	s.checkBatchRelations(ctx, products, results)
+
+	return results, nil
+}
+
+// BatchUpdate updates many existing products in one call, with the same
+// all-or-nothing or per-item semantics as BatchCreate.
+func (s *productService) BatchUpdate(ctx context.Context, products []*entity.Product, atomic bool) ([]*entity.BatchItemResult, error) {
+	results, statuses, err := s.checkBatchUpdate(ctx, products)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err = s.writeBatch(ctx, products, results, atomic, batchUpdateWriter)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, result := range results {
+		if result.Product != nil {
+			result.Product.Status = statuses[result.Product.ID]
+		}
+	}
+
+	return results, nil
+}
+
+// ValidateBatchUpdate runs the checks of BatchUpdate without writing anything.
+func (s *productService) ValidateBatchUpdate(ctx context.Context, products []*entity.Product) ([]*entity.BatchItemResult, error) {
+	results, _, err := s.checkBatchUpdate(ctx, products)
+
+	return results, err
+}
+
+// BatchUpsert creates and updates the products of one batch in a single
+// transaction. Updates whose status differs from the stored one also move to
+// that status. Invalid items are reported and skipped; if the write fails,
+// nothing of the batch is stored and every valid item reports the failure.
+// Results are in input order.
+func (s *productService) BatchUpsert(ctx context.Context, creates, updates []*entity.Product) ([]*entity.BatchItemResult, []*entity.BatchItemResult, error) {
+	createResults, updateResults, statuses, err := s.checkBatchUpsert(ctx, creates, updates)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pendingCreates := pendingItems(creates, createResults)
+	pendingUpdates := pendingItems(updates, updateResults)
+
+	if len(pendingCreates) == 0 && len(pendingUpdates) == 0 {
+		return createResults, updateResults, nil
+	}
+
+	var created, updated []*entity.Product
+
+	err = s.Repo.Postgres().Atomic(ctx, s.Config, func(r postgresrepository.PostgresRepository) error {
+		previous, err := s.productsBefore(ctx, r, itemsOf(updates, pendingUpdates)...)
+		if err != nil {
+			return err
+		}
+
+		if len(pendingCreates) > 0 {
+			if created, err = createProducts(ctx, r, itemsOf(creates, pendingCreates)); err != nil {
+				return err
+			}
+		}
+
+		if len(pendingUpdates) > 0 {
+			if updated, err = updateProducts(ctx, r, itemsOf(updates, pendingUpdates)); err != nil {
+				return err
+			}
+		}
+
+		for _, product := range updated {
+			status := product.Status
+			product.Status = statuses[product.ID]
+
+			if status == "" || status == product.Status {
+				continue
+			}
+
+			if err := s.changeStatus(ctx, r, product, status); err != nil {
+				return err
+			}
+		}
+
+		return s.recordProductChanges(ctx, r, previous, append(created, updated...)...)
+	})
+	if err != nil {
+		err = serviceerror.TranslateRepoError(err)
+	}
+
+	for k, i := range pendingCreates {
+		if err != nil {
+			createResults[i].Err = err
+			continue
+		}
+
+		createResults[i].Product = created[k]
+	}
+
+	for k, i := range pendingUpdates {
+		if err != nil {
+			updateResults[i].Err = err
+			continue
+		}
+
+		updateResults[i].Product = updated[k]
+	}
+
+	return createResults, updateResults, nil
+}
+
+// ValidateBatchUpsert runs the checks of BatchUpsert without writing anything.
+func (s *productService) ValidateBatchUpsert(ctx context.Context, creates, updates []*entity.Product) ([]*entity.BatchItemResult, []*entity.BatchItemResult, error) {
+	createResults, updateResults, _, err := s.checkBatchUpsert(ctx, creates, updates)
+
+	return createResults, updateResults, err
+}
+
+// checkBatchUpsert validates the creates and updates of an upsert batch,
+// including the status changes of the updates, and returns the current status
+// of each product updated.
+func (s *productService) checkBatchUpsert(ctx context.Context, creates, updates []*entity.Product) ([]*entity.BatchItemResult, []*entity.BatchItemResult, map[uint32]string, error) {
+	if err := s.validateBatchSize(len(creates) + len(updates)); err != nil {
+		return nil, nil, nil, err
+	}
+
+	createResults := newBatchResults(len(creates))
+	updateResults := newBatchResults(len(updates))
+	statuses := map[uint32]string{}
+
+	var err error
+
+	if len(creates) > 0 {
+		if createResults, err = s.ValidateBatchCreate(ctx, creates); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	if len(updates) > 0 {
+		if updateResults, statuses, err = s.checkBatchUpdate(ctx, updates); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	for i, product := range updates {
+		if updateResults[i].Err == nil && product.Status != "" {
+			updateResults[i].Err = checkStatusTransition(statuses[product.ID], product.Status)
+		}
+	}
+
+	return createResults, updateResults, statuses, nil
+}
+
+// pendingItems returns the indexes of the items that passed validation.
+func pendingItems(products []*entity.Product, results []*entity.BatchItemResult) []int {
+	pending := make([]int, 0, len(products))
+	for i := range results {
+		if results[i].Err == nil {
+			pending = append(pending, i)
+		}
+	}
+
+	return pending
+}
+
+func itemsOf(products []*entity.Product, indexes []int) []*entity.Product {
+	items := make([]*entity.Product, len(indexes))
+	for k, i := range indexes {
+		items[k] = products[i]
+	}
+
+	return items
+}
+
+// checkBatchUpdate validates the items of an update batch and returns the
+// current status of each product found.
+func (s *productService) checkBatchUpdate(ctx context.Context, products []*entity.Product) ([]*entity.BatchItemResult, map[uint32]string, error) {
+	if err := s.validateBatchSize(len(products)); err != nil {
+		return nil, nil, err
+	}
+
+	results := newBatchResults(len(products))
+	ids := make([]uint32, 0, len(products))
+	seen := make(map[uint32]int, len(products))
+
+	for i, product := range products {
+		switch {
+		case product == nil:
+			results[i].Err = exception.New(exception.TypeBadRequest, exception.CodeBadRequest, "Input data cannot be null")
+			continue
+		case product.ID == 0:
+			results[i].Err = batchItemError("id", "ID is required")
+			continue
+		}
+
+		if first, ok := seen[product.ID]; ok {
+			results[i].Err = batchItemError("id", fmt.Sprintf("Product %d is already updated by item %d", product.ID, first))
+			continue
+		}
+
+		seen[product.ID] = i
+		ids = append(ids, product.ID)
+
+		results[i].Err = validateProductFields(product)
+	}
+
+	existing, _, err := s.Repo.Postgres().Product().Find(ctx, &postgresrepository.FilterProductPayload{IDs: ids})
+	if err != nil {
+		return nil, nil, serviceerror.TranslateRepoError(err)
+	}
+
+	statuses := make(map[uint32]string, len(existing))
+	for _, product := range existing {
+		statuses[product.ID] = product.Status
+	}
+
+	for i, product := range products {
+		if results[i].Err != nil {
+			continue
+		}
+
+		if _, ok := statuses[product.ID]; !ok {
+			results[i].Err = exception.Newf(exception.TypeNotFound, exception.CodeNotFound, "Product %d not found", product.ID)
+		}
+	}
+
+	if err := s.checkBatchSKUs(ctx, products, results); err != nil {
+		return nil, nil, err
+	}
+
+	s.checkBatchRelations(ctx, products, results)
+
+	return results, statuses, nil
+}
+
+func (s *productService) validateBatchSize(size int) error {
+	maxItems := constant.DefaultBatchMaxItems
+	if s.Config != nil && s.Config.App != nil && s.Config.App.BatchMaxItems > 0 {
+		maxItems = s.Config.App.BatchMaxItems
+	}
+
+	switch {
+	case size == 0:
+		return batchItemError("items", "At least one item is required")
+	case size > maxItems:
+		return batchItemError("items", fmt.Sprintf("A batch cannot have more than %d items", maxItems))
+	}
+
+	return nil
+}
+
+// checkBatchSKUs reports SKUs repeated within the batch or already used by
+// another product, so that one clash does not fail the multi-row write.
+func (s *productService) checkBatchSKUs(ctx context.Context, products []*entity.Product, results []*entity.BatchItemResult) error {
+	seen := make(map[string]int, len(products))
+	skus := make([]string, 0, len(products))
+
+	for i, product := range products {
+		if results[i].Err != nil || product.SKU == "" {
+			continue
+		}
+
+		if first, ok := seen[product.SKU]; ok {
+			results[i].Err = exception.Newf(exception.TypeConflict, exception.CodeConflict, "SKU %s is also used by item %d", product.SKU, first)
+			continue
+		}
+
+		seen[product.SKU] = i
+		skus = append(skus, product.SKU)
+	}
+
+	if len(skus) == 0 {
+		return nil
+	}
+
+	taken, _, err := s.Repo.Postgres().Product().Find(ctx, &postgresrepository.FilterProductPayload{SKUs: skus})
+	if err != nil {
+		return serviceerror.TranslateRepoError(err)
+	}
+
+	for _, product := range taken {
+		i := seen[product.SKU]
+		if products[i].ID != product.ID {
+			results[i].Err = exception.Newf(exception.TypeConflict, exception.CodeConflict, "SKU %s is already in use", product.SKU)
+		}
+	}
+
+	return nil
+}
+
+func (s *productService) checkBatchRelations(ctx context.Context, products []*entity.Product, results []*entity.BatchItemResult) {
+	for i, product := range products {
+		if results[i].Err != nil {
+			continue
+		}
+
+		if err := validateProductRelations(ctx, s.Repo.Postgres(), product); err != nil {
+			results[i].Err = serviceerror.TranslateRepoError(err)
+		}
+	}
+}
+
+// writeBatch stores the items that passed validation with multi-row
+// statements. When the batch is not atomic and the multi-row write fails, the
+// items are retried one by one so the failure is reported against its item.
+func (s *productService) writeBatch(
+	ctx context.Context,
+	products []*entity.Product,
+	results []*entity.BatchItemResult,
+	atomic bool,
+	writer batchWriter,
+) ([]*entity.BatchItemResult, error) {
+	pending := pendingItems(products, results)
+
+	if atomic && len(pending) < len(results) {
+		for _, i := range pending {
+			results[i].Err = entity.ErrBatchItemSkipped
+		}
+
+		return results, nil
+	}
+
+	if len(pending) == 0 {
+		return results, nil
+	}
+
+	items := itemsOf(products, pending)
+
+	var saved []*entity.Product
+
+	err := s.Repo.Postgres().Atomic(ctx, s.Config, func(r postgresrepository.PostgresRepository) error {
+		previous, err := s.productsBefore(ctx, r, items...)
+		if err != nil {
+			return err
+		}
+
+		saved, err = writer.many(ctx, r, items)
+		if err != nil {
+			return err
+		}
+
+		return s.recordProductChanges(ctx, r, previous, saved...)
+	})
+	if err == nil {
+		for k, i := range pending {
+			results[i].Product = saved[k]
+		}
+
+		return results, nil
+	}
+
+	if atomic {
+		return nil, serviceerror.TranslateRepoError(err)
+	}
+
+	for _, i := range pending {
+		err := s.Repo.Postgres().Atomic(ctx, s.Config, func(r postgresrepository.PostgresRepository) error {
+			previous, err := s.productsBefore(ctx, r, products[i])
+			if err != nil {
+				return err
+			}
+
+			results[i].Product, err = writer.one(ctx, r, products[i])
+			if err != nil {
+				return err
+			}
+
+			return s.recordProductChanges(ctx, r, previous, results[i].Product)
+		})
+		if err != nil {
+			results[i].Product = nil
+			results[i].Err = serviceerror.TranslateRepoError(err)
+		}
+	}
+
+	return results, nil
+}
+
+func createProducts(ctx context.Context, r postgresrepository.PostgresRepository, products []*entity.Product) ([]*entity.Product, error) {
+	created, err := r.Product().CreateMany(ctx, products)
+	if err != nil {
+		return nil, err
+	}
+
+	return created, saveBatchDetails(ctx, r, created, products)
+}
+
+func updateProducts(ctx context.Context, r postgresrepository.PostgresRepository, products []*entity.Product) ([]*entity.Product, error) {
+	updated, err := r.Product().UpdateMany(ctx, products)
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, saveBatchDetails(ctx, r, updated, products)
+}
+
+// saveBatchDetails stores the kit components and pack sizes of each saved
+// product and records the price changes of the whole batch at once.
+func saveBatchDetails(ctx context.Context, r postgresrepository.PostgresRepository, saved, products []*entity.Product) error {
+	prices := make(map[uint32]entity.Money, len(saved))
+
+	for i := range saved {
+		if err := saveProductDetails(ctx, r, saved[i], products[i]); err != nil {
+			return err
+		}
+
+		prices[saved[i].ID] = saved[i].Price
+	}
+
+	return r.PriceChange().RecordMany(ctx, prices)
+}
+
+func newBatchResults(size int) []*entity.BatchItemResult {
+	results := make([]*entity.BatchItemResult, size)
+	for i := range results {
+		results[i] = &entity.BatchItemResult{Index: i}
+	}
+
+	return results
+}
+
+func batchItemError(field, message string) error {
+	return exception.NewWithErrors(exception.TypeValidationError, exception.CodeValidationFailed, "Invalid batch", exception.FieldErrors{
+		field: {message},
+	})
+}
@@ -0,0 +1,251 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"inventory-service/config"
+	"inventory-service/constant"
+	postgresrepository "inventory-service/internal/adapter/repository/postgres"
+	"inventory-service/internal/domain/entity"
+	"inventory-service/internal/domain/service"
+	"inventory-service/internal/shared/exception"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestProductServiceBatchCreatePartial(t *testing.T) {
+	mockRepo, mockPostgres, mockProduct := setupProductMocks(t)
+	mockPriceChange := setupPriceChangeMock(t, mockPostgres)
+	expectProductAtomic(mockPostgres)
+
+	ctx := context.Background()
+	inputs := []*entity.Product{
+		{SKU: "SKU-1", Name: "First"},
+		{SKU: "SKU-1", Name: "Duplicate in batch"},
+		{SKU: "SKU-2", Name: "Already taken"},
+		{SKU: "SKU-3", Name: "Bad barcode", Barcode: "123"},
+	}
+
+	mockProduct.EXPECT().Find(ctx, &postgresrepository.FilterProductPayload{SKUs: []string{"SKU-1", "SKU-2"}}).
+		Return([]*entity.Product{{Base: entity.Base{ID: 9}, SKU: "SKU-2"}}, 1, nil)
+	mockProduct.EXPECT().CreateMany(ctx, []*entity.Product{inputs[0]}).
+		Return([]*entity.Product{{Base: entity.Base{ID: 1}, SKU: "SKU-1", Status: constant.ProductStatusActive}}, nil)
+	mockPriceChange.EXPECT().RecordMany(ctx, map[uint32]entity.Money{1: {}}).Return(nil)
+
+	productService := service.NewProductService(service.Properties{Repo: mockRepo})
+	results, err := productService.BatchCreate(ctx, inputs, false)
+
+	assert.NoError(t, err)
+	assert.Len(t, results, 4)
+
+	assert.NoError(t, results[0].Err)
+	assert.Equal(t, uint32(1), results[0].Product.ID)
+
+	for i, errType := range map[int]exception.ErrorType{
+		1: exception.TypeConflict,
+		2: exception.TypeConflict,
+		3: exception.TypeValidationError,
+	} {
+		assert.Equal(t, i, results[i].Index)
+		assert.Nil(t, results[i].Product)
+
+		ex, ok := exception.GetException(results[i].Err)
+		assert.True(t, ok)
+		assert.Equal(t, errType, ex.Type)
+	}
+}
+
+func TestProductServiceBatchCreateAtomicAborts(t *testing.T) {
+	mockRepo, _, mockProduct := setupProductMocks(t)
+
+	ctx := context.Background()
+	inputs := []*entity.Product{
+		{SKU: "SKU-1", Name: "Valid"},
+		{SKU: "SKU-2", Name: "Invalid", Barcode: "123"},
+	}
+
+	mockProduct.EXPECT().Find(ctx, &postgresrepository.FilterProductPayload{SKUs: []string{"SKU-1"}}).
+		Return([]*entity.Product{}, 0, nil)
+
+	productService := service.NewProductService(service.Properties{Repo: mockRepo})
+	results, err := productService.BatchCreate(ctx, inputs, true)
+
+	assert.NoError(t, err)
+	assert.ErrorIs(t, results[0].Err, entity.ErrBatchItemSkipped)
+	assert.Nil(t, results[0].Product)
+	assert.True(t, exception.HasFieldErrors(results[1].Err))
+	mockProduct.AssertNotCalled(t, "CreateMany", mock.Anything, mock.Anything)
+}
+
+func TestProductServiceBatchCreateFallsBackPerItem(t *testing.T) {
+	mockRepo, mockPostgres, mockProduct := setupProductMocks(t)
+	mockPriceChange := setupPriceChangeMock(t, mockPostgres)
+	expectProductAtomic(mockPostgres)
+
+	ctx := context.Background()
+	inputs := []*entity.Product{
+		{SKU: "SKU-1", Name: "First"},
+		{SKU: "SKU-2", Name: "Second"},
+	}
+
+	mockProduct.EXPECT().Find(ctx, mock.Anything).Return([]*entity.Product{}, 0, nil)
+	mockProduct.EXPECT().CreateMany(ctx, inputs).Return(nil, exception.ErrDuplicateEntry)
+	mockProduct.EXPECT().Create(ctx, inputs[0]).Return(&entity.Product{Base: entity.Base{ID: 1}, SKU: "SKU-1"}, nil)
+	mockProduct.EXPECT().Create(ctx, inputs[1]).
+		Return(nil, errors.Wrapf(exception.ErrDuplicateEntry, "duplicate value '%s' for field '%s'", "SKU-2", "sku"))
+	mockPriceChange.EXPECT().Record(ctx, uint32(1), entity.Money{}).Return(nil)
+
+	productService := service.NewProductService(service.Properties{Repo: mockRepo})
+	results, err := productService.BatchCreate(ctx, inputs, false)
+
+	assert.NoError(t, err)
+	assert.NoError(t, results[0].Err)
+	assert.Equal(t, uint32(1), results[0].Product.ID)
+
+	ex, ok := exception.GetException(results[1].Err)
+	assert.True(t, ok)
+	assert.Equal(t, exception.TypeConflict, ex.Type)
+	assert.Nil(t, results[1].Product)
+}
+
+func TestProductServiceBatchCreateTooManyItems(t *testing.T) {
+	mockRepo, _, _ := setupProductMocks(t)
+
+	cfg := &config.Config{App: &config.AppConfig{BatchMaxItems: 2}}
+	productService := service.NewProductService(service.Properties{Repo: mockRepo, Config: cfg})
+
+	for name, inputs := range map[string][]*entity.Product{
+		"empty":    {},
+		"too many": {{Name: "A"}, {Name: "B"}, {Name: "C"}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			results, err := productService.BatchCreate(context.Background(), inputs, false)
+
+			assert.Nil(t, results)
+
+			ex, ok := exception.GetException(err)
+			assert.True(t, ok)
+			assert.Equal(t, exception.TypeValidationError, ex.Type)
+			assert.Contains(t, ex.Errors, "items")
+		})
+	}
+}
+
+func TestProductServiceBatchUpdateMissingProduct(t *testing.T) {
+	mockRepo, mockPostgres, mockProduct := setupProductMocks(t)
+	mockPriceChange := setupPriceChangeMock(t, mockPostgres)
+	expectProductAtomic(mockPostgres)
+
+	ctx := context.Background()
+	inputs := []*entity.Product{
+		{Base: entity.Base{ID: 1}, SKU: "SKU-1", Name: "Renamed"},
+		{Base: entity.Base{ID: 2}, SKU: "SKU-2", Name: "Missing"},
+		{Base: entity.Base{ID: 1}, SKU: "SKU-1", Name: "Repeated"},
+	}
+
+	mockProduct.EXPECT().Find(ctx, &postgresrepository.FilterProductPayload{IDs: []uint32{1, 2}}).
+		Return([]*entity.Product{{Base: entity.Base{ID: 1}, SKU: "SKU-1", Status: constant.ProductStatusDraft}}, 1, nil)
+	mockProduct.EXPECT().Find(ctx, &postgresrepository.FilterProductPayload{SKUs: []string{"SKU-1"}}).
+		Return([]*entity.Product{{Base: entity.Base{ID: 1}, SKU: "SKU-1"}}, 1, nil)
+	mockProduct.EXPECT().UpdateMany(ctx, []*entity.Product{inputs[0]}).
+		Return([]*entity.Product{{Base: entity.Base{ID: 1}, Name: "Renamed"}}, nil)
+	mockPriceChange.EXPECT().RecordMany(ctx, mock.Anything).Return(nil)
+
+	productService := service.NewProductService(service.Properties{Repo: mockRepo})
+	results, err := productService.BatchUpdate(ctx, inputs, false)
+
+	assert.NoError(t, err)
+	assert.NoError(t, results[0].Err)
+	assert.Equal(t, constant.ProductStatusDraft, results[0].Product.Status)
+
+	ex, ok := exception.GetException(results[1].Err)
+	assert.True(t, ok)
+	assert.Equal(t, exception.TypeNotFound, ex.Type)
+
+	ex, ok = exception.GetException(results[2].Err)
+	assert.True(t, ok)
+	assert.Contains(t, ex.Errors, "id")
+}
+
+func TestProductServiceBatchUpsert(t *testing.T) {
+	mockRepo, mockPostgres, mockProduct := setupProductMocks(t)
+	mockPriceChange := setupPriceChangeMock(t, mockPostgres)
+	mockPostgres.EXPECT().
+		Atomic(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, cfg *config.Config, fn postgresrepository.RepositoryAtomicCallback) error {
+			return fn(mockPostgres)
+		}).
+		Once()
+
+	ctx := context.Background()
+	creates := []*entity.Product{{SKU: "SKU-1", Name: "New"}}
+	updates := []*entity.Product{
+		{Base: entity.Base{ID: 5}, SKU: "SKU-5", Name: "Retired", Status: constant.ProductStatusDiscontinued},
+		{Base: entity.Base{ID: 6}, SKU: "SKU-6", Name: "Back to draft", Status: constant.ProductStatusDraft},
+	}
+
+	mockProduct.EXPECT().Find(ctx, &postgresrepository.FilterProductPayload{SKUs: []string{"SKU-1"}}).
+		Return([]*entity.Product{}, 0, nil)
+	mockProduct.EXPECT().Find(ctx, &postgresrepository.FilterProductPayload{IDs: []uint32{5, 6}}).
+		Return([]*entity.Product{
+			{Base: entity.Base{ID: 5}, SKU: "SKU-5", Status: constant.ProductStatusActive},
+			{Base: entity.Base{ID: 6}, SKU: "SKU-6", Status: constant.ProductStatusActive},
+		}, 2, nil)
+	mockProduct.EXPECT().Find(ctx, &postgresrepository.FilterProductPayload{SKUs: []string{"SKU-5", "SKU-6"}}).
+		Return([]*entity.Product{{Base: entity.Base{ID: 5}, SKU: "SKU-5"}, {Base: entity.Base{ID: 6}, SKU: "SKU-6"}}, 2, nil)
+	mockProduct.EXPECT().CreateMany(ctx, creates).
+		Return([]*entity.Product{{Base: entity.Base{ID: 1}, SKU: "SKU-1", Status: constant.ProductStatusActive}}, nil)
+	mockProduct.EXPECT().UpdateMany(ctx, updates[:1]).
+		Return([]*entity.Product{{Base: entity.Base{ID: 5}, SKU: "SKU-5", Status: constant.ProductStatusDiscontinued}}, nil)
+	mockProduct.EXPECT().UpdateStatus(ctx, uint32(5), constant.ProductStatusDiscontinued).Return(nil)
+	mockPriceChange.EXPECT().RecordMany(ctx, map[uint32]entity.Money{1: {}}).Return(nil)
+	mockPriceChange.EXPECT().RecordMany(ctx, map[uint32]entity.Money{5: {}}).Return(nil)
+
+	productService := service.NewProductService(service.Properties{Repo: mockRepo})
+	createResults, updateResults, err := productService.BatchUpsert(ctx, creates, updates)
+
+	assert.NoError(t, err)
+	assert.NoError(t, createResults[0].Err)
+	assert.Equal(t, uint32(1), createResults[0].Product.ID)
+	assert.NoError(t, updateResults[0].Err)
+	assert.Equal(t, constant.ProductStatusDiscontinued, updateResults[0].Product.Status)
+
+	// Active products never return to draft.
+	ex, ok := exception.GetException(updateResults[1].Err)
+	if assert.True(t, ok) {
+		assert.Contains(t, ex.Errors, "status")
+	}
+}
+
+func TestProductServiceBatchUpsertFailsTogether(t *testing.T) {
+	mockRepo, mockPostgres, mockProduct := setupProductMocks(t)
+	mockPriceChange := setupPriceChangeMock(t, mockPostgres)
+	expectProductAtomic(mockPostgres)
+
+	ctx := context.Background()
+	creates := []*entity.Product{{SKU: "SKU-1", Name: "New"}}
+	updates := []*entity.Product{{Base: entity.Base{ID: 5}, SKU: "SKU-5", Name: "Renamed"}}
+
+	mockProduct.EXPECT().Find(ctx, &postgresrepository.FilterProductPayload{SKUs: []string{"SKU-1"}}).
+		Return([]*entity.Product{}, 0, nil)
+	mockProduct.EXPECT().Find(ctx, &postgresrepository.FilterProductPayload{IDs: []uint32{5}}).
+		Return([]*entity.Product{{Base: entity.Base{ID: 5}, SKU: "SKU-5"}}, 1, nil)
+	mockProduct.EXPECT().Find(ctx, &postgresrepository.FilterProductPayload{SKUs: []string{"SKU-5"}}).
+		Return([]*entity.Product{{Base: entity.Base{ID: 5}, SKU: "SKU-5"}}, 1, nil)
+	mockProduct.EXPECT().CreateMany(ctx, creates).Return([]*entity.Product{{Base: entity.Base{ID: 1}, SKU: "SKU-1"}}, nil)
+	mockPriceChange.EXPECT().RecordMany(ctx, map[uint32]entity.Money{1: {}}).Return(nil)
+	mockProduct.EXPECT().UpdateMany(ctx, updates).Return(nil, exception.ErrTxFailed)
+
+	productService := service.NewProductService(service.Properties{Repo: mockRepo})
+	createResults, updateResults, err := productService.BatchUpsert(ctx, creates, updates)
+
+	// The create is rolled back with the failed update, so both report it.
+	assert.NoError(t, err)
+	assert.Error(t, createResults[0].Err)
+	assert.Nil(t, createResults[0].Product)
+	assert.Error(t, updateResults[0].Err)
+	assert.Nil(t, updateResults[0].Product)
+}
@@ -2,18 +2,55 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"inventory-service/constant"
 	postgresrepository "inventory-service/internal/adapter/repository/postgres"
 	"inventory-service/internal/domain/entity"
+	serviceerror "inventory-service/internal/domain/service/error"
+	"inventory-service/internal/shared/exception"
+	"inventory-service/internal/shared/utils"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/cockroachdb/errors"
 )
 
 var _ ProductService = (*productService)(nil)
 
+// maxPriceUnits is the largest whole amount the DECIMAL(10,2) price column holds.
+const maxPriceUnits = 99_999_999
+
+// exportChunkSize is how many streamed products Export loads derived stock for
+// at a time.
+const exportChunkSize = 500
+
+// productStatusTransitions lists the statuses each lifecycle status may move
+// to. Once active, a product never returns to draft.
+var productStatusTransitions = map[string][]string{
+	constant.ProductStatusDraft:        {constant.ProductStatusActive, constant.ProductStatusDiscontinued},
+	constant.ProductStatusActive:       {constant.ProductStatusDiscontinued},
+	constant.ProductStatusDiscontinued: {constant.ProductStatusActive},
+}
+
 type ProductService interface {
 	Create(ctx context.Context, product *entity.Product) (*entity.Product, error)
 	Update(ctx context.Context, product *entity.Product) (*entity.Product, error)
+	UpdateFields(ctx context.Context, changes *entity.Product, fields []string) (*entity.Product, error)
 	Delete(ctx context.Context, id uint32) error
 	Find(ctx context.Context, filter *postgresrepository.FilterProductPayload) ([]*entity.Product, int, error)
 	FindByID(ctx context.Context, id uint32) (*entity.Product, error)
+	FindBySKU(ctx context.Context, sku string) (*entity.Product, error)
+	FindByBarcode(ctx context.Context, barcode string) (*entity.Product, error)
+	AdjustStock(ctx context.Context, adjustment *entity.StockAdjustment) (*entity.StockAdjustment, error)
+	UpdateStatus(ctx context.Context, id uint32, status string) (*entity.Product, error)
+	BatchCreate(ctx context.Context, products []*entity.Product, atomic bool) ([]*entity.BatchItemResult, error)
+	BatchUpdate(ctx context.Context, products []*entity.Product, atomic bool) ([]*entity.BatchItemResult, error)
+	ValidateBatchCreate(ctx context.Context, products []*entity.Product) ([]*entity.BatchItemResult, error)
+	ValidateBatchUpdate(ctx context.Context, products []*entity.Product) ([]*entity.BatchItemResult, error)
+	BatchUpsert(ctx context.Context, creates, updates []*entity.Product) ([]*entity.BatchItemResult, []*entity.BatchItemResult, error)
+	ValidateBatchUpsert(ctx context.Context, creates, updates []*entity.Product) ([]*entity.BatchItemResult, []*entity.BatchItemResult, error)
+	Export(ctx context.Context, filter *postgresrepository.FilterProductPayload, fn func(*entity.Product) error) error
 }
 
 type productService struct {
@@ -25,56 +62,1152 @@ func NewProductService(props Properties) *productService {
 }
 
 func (s *productService) Find(ctx context.Context, filter *postgresrepository.FilterProductPayload) ([]*entity.Product, int, error) {
-	return s.Repo.Postgres().Product().Find(ctx, filter)
+	if err := validateAttributeFilters(filter); err != nil {
+		return nil, 0, err
+	}
+
+	products, total, err := s.Repo.Postgres().Product().Find(ctx, filter)
+	if err != nil {
+		return nil, 0, serviceerror.TranslateRepoError(err)
+	}
+
+	if err := s.applyDerived(ctx, products...); err != nil {
+		return nil, 0, serviceerror.TranslateRepoError(err)
+	}
+
+	return products, total, nil
 }
 
+// Export calls fn for every product matching the filter, in id order, with the
+// same derived stock as Find. Products are read from a stream and handed over
+// in chunks so memory stays bounded regardless of the catalog size.
+func (s *productService) Export(ctx context.Context, filter *postgresrepository.FilterProductPayload, fn func(*entity.Product) error) error {
+	if err := validateAttributeFilters(filter); err != nil {
+		return err
+	}
+
+	var fnErr error
+
+	chunk := make([]*entity.Product, 0, exportChunkSize)
+	flush := func() error {
+		if err := s.applyDerived(ctx, chunk...); err != nil {
+			return serviceerror.TranslateRepoError(err)
+		}
+
+		for _, product := range chunk {
+			if fnErr = fn(product); fnErr != nil {
+				return fnErr
+			}
+		}
+
+		chunk = chunk[:0]
+
+		return nil
+	}
+
+	err := s.Repo.Postgres().Product().Stream(ctx, filter, func(product *entity.Product) error {
+		chunk = append(chunk, product)
+		if len(chunk) < exportChunkSize {
+			return nil
+		}
+
+		return flush()
+	})
+	if fnErr != nil {
+		return fnErr
+	}
+	if err != nil {
+		return serviceerror.TranslateRepoError(err)
+	}
+
+	return flush()
+}
+
+// FindByID returns the product with its unit conversions; parent products come
+// with their variants and the stock available across all of them, kits with
+// their components.
 func (s *productService) FindByID(ctx context.Context, id uint32) (*entity.Product, error) {
-	return s.Repo.Postgres().Product().FindByID(ctx, id)
+	product, err := s.Repo.Postgres().Product().FindByID(ctx, id)
+	if err != nil {
+		return nil, serviceerror.TranslateRepoError(err)
+	}
+
+	units, err := s.Repo.Postgres().ProductUnit().FindByProductIDs(ctx, []uint32{id})
+	if err != nil {
+		return nil, serviceerror.TranslateRepoError(err)
+	}
+
+	if len(units) > 0 {
+		product.Units = units
+	}
+
+	if err := s.applyDerived(ctx, product); err != nil {
+		return nil, serviceerror.TranslateRepoError(err)
+	}
+
+	if product.ParentID != 0 {
+		return product, nil
+	}
+
+	variants, _, err := s.Repo.Postgres().Product().Find(ctx, &postgresrepository.FilterProductPayload{
+		ParentIDs: []uint32{product.ID},
+	})
+	if err != nil {
+		return nil, serviceerror.TranslateRepoError(err)
+	}
+
+	if len(variants) == 0 {
+		return s.withKitComponents(ctx, product)
+	}
+
+	if err := s.applyDerived(ctx, variants...); err != nil {
+		return nil, serviceerror.TranslateRepoError(err)
+	}
+
+	product.Variants = variants
+	product.AvailableStock = 0
+
+	for _, variant := range variants {
+		product.AvailableStock += variant.AvailableStock
+	}
+
+	return product, nil
+}
+
+func (s *productService) withKitComponents(ctx context.Context, product *entity.Product) (*entity.Product, error) {
+	components, err := s.Repo.Postgres().KitComponent().FindByKitIDs(ctx, []uint32{product.ID})
+	if err != nil {
+		return nil, serviceerror.TranslateRepoError(err)
+	}
+
+	if len(components) == 0 {
+		return product, nil
+	}
+
+	componentProducts := make([]*entity.Product, 0, len(components))
+	for _, component := range components {
+		if component.Component != nil {
+			componentProducts = append(componentProducts, component.Component)
+		}
+	}
+
+	if err := s.applyDerived(ctx, componentProducts...); err != nil {
+		return nil, serviceerror.TranslateRepoError(err)
+	}
+
+	product.Components = components
+	product.AvailableStock = -1
+
+	for _, component := range components {
+		available := 0
+		if component.Component != nil {
+			available = component.Component.AvailableStock / component.Quantity
+		}
+
+		if product.AvailableStock < 0 || available < product.AvailableStock {
+			product.AvailableStock = available
+		}
+	}
+
+	return product, nil
+}
+
+// applyDerived fills in what products are read with but do not store: their
+// derived stock and inherited prices.
+func (s *productService) applyDerived(ctx context.Context, products ...*entity.Product) error {
+	if err := s.applyDerivedStock(ctx, products...); err != nil {
+		return err
+	}
+
+	return s.applyInheritedPrices(ctx, products...)
+}
+
+// applyInheritedPrices gives variants that inherit their price the current
+// price of their parent.
+func (s *productService) applyInheritedPrices(ctx context.Context, products ...*entity.Product) error {
+	var parentIDs []uint32
+
+	for _, product := range products {
+		if product.InheritPrice && product.ParentID != 0 && !slices.Contains(parentIDs, product.ParentID) {
+			parentIDs = append(parentIDs, product.ParentID)
+		}
+	}
+
+	if len(parentIDs) == 0 {
+		return nil
+	}
+
+	parents, _, err := s.Repo.Postgres().Product().Find(ctx, &postgresrepository.FilterProductPayload{IDs: parentIDs})
+	if err != nil {
+		return err
+	}
+
+	prices := make(map[uint32]entity.Money, len(parents))
+	for _, parent := range parents {
+		prices[parent.ID] = parent.Price
+	}
+
+	for _, product := range products {
+		if price, ok := prices[product.ParentID]; ok && product.InheritPrice {
+			product.Price = price
+		}
+	}
+
+	return nil
+}
+
+// applyDerivedStock replaces the stock column with the unexpired lot stock for
+// lot-tracked products and the number of available serials for serialized ones.
+func (s *productService) applyDerivedStock(ctx context.Context, products ...*entity.Product) error {
+	var lotTracked, serialized []uint32
+
+	for _, product := range products {
+		switch {
+		case product.TrackSerials:
+			serialized = append(serialized, product.ID)
+		case product.TrackLots:
+			lotTracked = append(lotTracked, product.ID)
+		}
+	}
+
+	if len(lotTracked) > 0 {
+		available, err := s.Repo.Postgres().Lot().SumAvailable(ctx, lotTracked)
+		if err != nil {
+			return err
+		}
+
+		for _, product := range products {
+			if product.TrackLots && !product.TrackSerials {
+				product.AvailableStock = available[product.ID]
+			}
+		}
+	}
+
+	if len(serialized) > 0 {
+		available, err := s.Repo.Postgres().Serial().CountAvailable(ctx, serialized)
+		if err != nil {
+			return err
+		}
+
+		for _, product := range products {
+			if product.TrackSerials {
+				product.AvailableStock = available[product.ID]
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *productService) FindBySKU(ctx context.Context, sku string) (*entity.Product, error) {
+	if sku == "" {
+		return nil, exception.NewWithErrors(exception.TypeValidationError, exception.CodeValidationFailed, "Invalid SKU", exception.FieldErrors{
+			"sku": {"This field is required"},
+		})
+	}
+
+	product, err := s.Repo.Postgres().Product().FindBySKU(ctx, sku)
+	if err != nil {
+		return nil, serviceerror.TranslateRepoError(err)
+	}
+
+	if err := s.applyDerived(ctx, product); err != nil {
+		return nil, serviceerror.TranslateRepoError(err)
+	}
+
+	return product, nil
+}
+
+func (s *productService) FindByBarcode(ctx context.Context, barcode string) (*entity.Product, error) {
+	if barcode == "" {
+		return nil, exception.NewWithErrors(exception.TypeValidationError, exception.CodeValidationFailed, "Invalid barcode", exception.FieldErrors{
+			"barcode": {"This field is required"},
+		})
+	}
+
+	product, err := s.Repo.Postgres().Product().FindByBarcode(ctx, barcode)
+	if err != nil {
+		return nil, serviceerror.TranslateRepoError(err)
+	}
+
+	if err := s.applyDerived(ctx, product); err != nil {
+		return nil, serviceerror.TranslateRepoError(err)
+	}
+
+	return product, nil
 }
 
 func (s *productService) Create(ctx context.Context, product *entity.Product) (*entity.Product, error) {
+	if err := validateProductFields(product); err != nil {
+		return nil, err
+	}
+
+	if err := validateInitialStatus(product); err != nil {
+		return nil, err
+	}
+
 	var createdProduct *entity.Product
 
 	atomic := func(r postgresrepository.PostgresRepository) error {
+		if err := validateProductRelations(ctx, r, product); err != nil {
+			return err
+		}
+
 		var err error
-		createdProduct, err = r.Product().Create(ctx, product)
-		return err
+		createdProduct, err = createProduct(ctx, r, product)
+		if err != nil {
+			return err
+		}
+
+		return s.recordProductChanges(ctx, r, nil, createdProduct)
 	}
 
 	err := s.Repo.Postgres().Atomic(ctx, s.Config, atomic)
 	if err != nil {
-		return nil, err
+		return nil, serviceerror.TranslateRepoError(err)
 	}
 
 	return createdProduct, nil
 }
 
 func (s *productService) Update(ctx context.Context, product *entity.Product) (*entity.Product, error) {
+	if err := validateProductFields(product); err != nil {
+		return nil, err
+	}
+
 	var updatedProduct *entity.Product
 
 	atomic := func(r postgresrepository.PostgresRepository) error {
 		var err error
-		updatedProduct, err = r.Product().Update(ctx, product)
+		updatedProduct, err = s.saveUpdate(ctx, r, product)
+
+		return err
+	}
+
+	err := s.Repo.Postgres().Atomic(ctx, s.Config, atomic)
+	if err != nil {
+		return nil, serviceerror.TranslateRepoError(err)
+	}
+
+	return updatedProduct, nil
+}
+
+// productFieldSetters copy one field of a partial update onto the stored
+// product, keyed by the API name of the field. Units and components given as
+// empty remove the stored ones, and a variant given no price inherits it.
+var productFieldSetters = map[string]func(product, changes *entity.Product){
+	"sku":           func(product, changes *entity.Product) { product.SKU = changes.SKU },
+	"barcode":       func(product, changes *entity.Product) { product.Barcode = changes.Barcode },
+	"category_id":   func(product, changes *entity.Product) { product.CategoryID = changes.CategoryID },
+	"name":          func(product, changes *entity.Product) { product.Name = changes.Name },
+	"stock":         func(product, changes *entity.Product) { product.Stock = changes.Stock },
+	"attributes":    func(product, changes *entity.Product) { product.Attributes = changes.Attributes },
+	"parent_id":     func(product, changes *entity.Product) { product.ParentID = changes.ParentID },
+	"options":       func(product, changes *entity.Product) { product.Options = changes.Options },
+	"track_lots":    func(product, changes *entity.Product) { product.TrackLots = changes.TrackLots },
+	"track_serials": func(product, changes *entity.Product) { product.TrackSerials = changes.TrackSerials },
+	"price": func(product, changes *entity.Product) {
+		product.Price, product.InheritPrice = changes.Price, changes.InheritPrice
+	},
+	"units": func(product, changes *entity.Product) {
+		product.Units = changes.Units
+		if product.Units == nil {
+			product.Units = []*entity.ProductUnit{}
+		}
+	},
+	"components": func(product, changes *entity.Product) {
+		product.Components = changes.Components
+		if product.Components == nil {
+			product.Components = []*entity.KitComponent{}
+		}
+	},
+}
+
+// UpdateFields changes only the given fields of a stored product, named as in
+// the API (sku, category_id, ...), and keeps the others as stored. The product
+// is locked while the change is applied.
+func (s *productService) UpdateFields(ctx context.Context, changes *entity.Product, fields []string) (*entity.Product, error) {
+	if changes == nil {
+		return nil, exception.New(exception.TypeBadRequest, exception.CodeBadRequest, "Input data cannot be null")
+	}
+
+	for _, field := range fields {
+		if _, ok := productFieldSetters[field]; !ok {
+			return nil, exception.NewWithErrors(exception.TypeValidationError, exception.CodeValidationFailed, "Invalid update fields", exception.FieldErrors{
+				"update_mask": {fmt.Sprintf("Unknown field %q", field)},
+			})
+		}
+	}
+
+	var updatedProduct *entity.Product
+
+	atomic := func(r postgresrepository.PostgresRepository) error {
+		product, err := r.Product().LockByID(ctx, changes.ID)
+		if err != nil {
+			return err
+		}
+
+		for _, field := range fields {
+			productFieldSetters[field](product, changes)
+		}
+
+		if err := validateProductFields(product); err != nil {
+			return err
+		}
+
+		updatedProduct, err = s.saveUpdate(ctx, r, product)
+
 		return err
 	}
 
 	err := s.Repo.Postgres().Atomic(ctx, s.Config, atomic)
 	if err != nil {
+		return nil, serviceerror.TranslateRepoError(err)
+	}
+
+	return updatedProduct, nil
+}
+
+// saveUpdate stores a validated product change and records its events and
+// audit trail.
+func (s *productService) saveUpdate(ctx context.Context, r postgresrepository.PostgresRepository, product *entity.Product) (*entity.Product, error) {
+	if err := validateProductRelations(ctx, r, product); err != nil {
+		return nil, err
+	}
+
+	previous, err := s.productsBefore(ctx, r, product)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedProduct, err := updateProduct(ctx, r, product)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.recordProductChanges(ctx, r, previous, updatedProduct); err != nil {
 		return nil, err
 	}
 
 	return updatedProduct, nil
 }
 
+// createProduct stores a validated product together with its kit components,
+// pack sizes and first price history entry.
+func createProduct(ctx context.Context, r postgresrepository.PostgresRepository, product *entity.Product) (*entity.Product, error) {
+	createdProduct, err := r.Product().Create(ctx, product)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.PriceChange().Record(ctx, createdProduct.ID, createdProduct.Price); err != nil {
+		return nil, err
+	}
+
+	if err := saveProductDetails(ctx, r, createdProduct, product); err != nil {
+		return nil, err
+	}
+
+	return createdProduct, nil
+}
+
+func updateProduct(ctx context.Context, r postgresrepository.PostgresRepository, product *entity.Product) (*entity.Product, error) {
+	updatedProduct, err := r.Product().Update(ctx, product)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.PriceChange().Record(ctx, product.ID, updatedProduct.Price); err != nil {
+		return nil, err
+	}
+
+	if err := saveProductDetails(ctx, r, updatedProduct, product); err != nil {
+		return nil, err
+	}
+
+	return updatedProduct, nil
+}
+
+// productsBefore returns the stored state of the given products that already
+// exist, for productEvents and productAuditEvents to compare against. It reads
+// nothing unless events or audit events are recorded.
+func (s *productService) productsBefore(ctx context.Context, r postgresrepository.PostgresRepository, products ...*entity.Product) (map[uint32]*entity.Product, error) {
+	ids := make([]uint32, 0, len(products))
+	for _, product := range products {
+		if product.ID != 0 {
+			ids = append(ids, product.ID)
+		}
+	}
+
+	if !s.recordsEvents() && !s.auditsChanges() || len(ids) == 0 {
+		return nil, nil
+	}
+
+	existing, _, err := r.Product().Find(ctx, &postgresrepository.FilterProductPayload{IDs: ids})
+	if err != nil {
+		return nil, err
+	}
+
+	previous := make(map[uint32]*entity.Product, len(existing))
+	for _, product := range existing {
+		previous[product.ID] = product
+	}
+
+	return previous, nil
+}
+
+// recordProductChanges records the events and the audit trail of saved
+// products.
+func (s *productService) recordProductChanges(ctx context.Context, r postgresrepository.PostgresRepository, previous map[uint32]*entity.Product, saved ...*entity.Product) error {
+	if err := s.recordEvents(ctx, r, productEvents(previous, saved...)...); err != nil {
+		return err
+	}
+
+	return s.recordAudit(ctx, r, productAuditEvents(ctx, previous, saved...)...)
+}
+
+// productEvents describes saved products: those missing from previous were
+// created, the others changed stock if it differs from the previous one.
+func productEvents(previous map[uint32]*entity.Product, saved ...*entity.Product) []*entity.OutboxEvent {
+	events := make([]*entity.OutboxEvent, 0, len(saved))
+
+	for _, product := range saved {
+		before, ok := previous[product.ID]
+
+		switch {
+		case !ok:
+			events = append(events, productCreatedEvent(product))
+		case before.Stock != product.Stock:
+			events = append(events, stockChangedEvent(product.ID, product.Stock-before.Stock, stockChangeUpdate))
+		}
+	}
+
+	return events
+}
+
+// saveProductDetails replaces the saved product's kit components and pack
+// sizes with the ones given on the input; nil leaves them untouched.
+func saveProductDetails(ctx context.Context, r postgresrepository.PostgresRepository, saved, product *entity.Product) error {
+	if product.Components != nil {
+		saved.Components = product.Components
+
+		if err := r.KitComponent().Replace(ctx, saved.ID, product.Components); err != nil {
+			return err
+		}
+	}
+
+	if product.Units == nil {
+		return nil
+	}
+
+	saved.Units = product.Units
+
+	return r.ProductUnit().Replace(ctx, saved.ID, product.Units)
+}
+
+// AdjustStock changes the stock of a product by a quantity given in any of its
+// units; removing more than is in stock fails with insufficient stock.
+func (s *productService) AdjustStock(ctx context.Context, adjustment *entity.StockAdjustment) (*entity.StockAdjustment, error) {
+	if adjustment == nil {
+		return nil, exception.New(exception.TypeBadRequest, exception.CodeBadRequest, "Input data cannot be null")
+	}
+
+	if adjustment.Quantity == 0 {
+		return nil, exception.NewWithErrors(exception.TypeValidationError, exception.CodeValidationFailed, "Invalid stock adjustment", exception.FieldErrors{
+			"quantity": {"Quantity cannot be zero"},
+		})
+	}
+
+	if adjustment.Unit == "" {
+		adjustment.Unit = constant.UnitEach
+	}
+
+	atomic := func(r postgresrepository.PostgresRepository) error {
+		product, err := r.Product().FindByID(ctx, adjustment.ProductID)
+		if err != nil {
+			return err
+		}
+
+		if product.TrackLots || product.TrackSerials {
+			return exception.NewWithErrors(exception.TypeValidationError, exception.CodeValidationFailed, "Invalid stock adjustment", exception.FieldErrors{
+				"product_id": {"Stock of lot-tracked and serialized products is received as lots or serials"},
+			})
+		}
+
+		factor, err := unitFactor(ctx, r, product.ID, adjustment.Unit)
+		if err != nil {
+			return err
+		}
+
+		adjustment.BaseQuantity = adjustment.Quantity * factor
+
+		if adjustment.BaseQuantity > 0 {
+			err = r.Product().ReleaseStock(ctx, product.ID, adjustment.BaseQuantity)
+		} else {
+			err = r.Product().ReserveStock(ctx, product.ID, -adjustment.BaseQuantity)
+		}
+		if err != nil {
+			return err
+		}
+
+		adjustment.Product, err = r.Product().FindByID(ctx, product.ID)
+		if err != nil {
+			return err
+		}
+
+		if err := s.recordEvents(ctx, r, stockChangedEvent(product.ID, adjustment.BaseQuantity, stockChangeAdjustment)); err != nil {
+			return err
+		}
+
+		return s.recordAudit(ctx, r, newAuditEvent(ctx, constant.AuditEntityProduct, product.ID, constant.AuditActionStockAdjusted,
+			productAuditState(product), productAuditState(adjustment.Product)))
+	}
+
+	err := s.Repo.Postgres().Atomic(ctx, s.Config, atomic)
+	if err != nil {
+		return nil, serviceerror.TranslateRepoError(err)
+	}
+
+	return adjustment, nil
+}
+
+// UpdateStatus moves the product to another lifecycle status along the allowed
+// transitions. Setting the current status again is a no-op.
+func (s *productService) UpdateStatus(ctx context.Context, id uint32, status string) (*entity.Product, error) {
+	if _, ok := productStatusTransitions[status]; !ok {
+		return nil, invalidStatusError("Status must be one of DRAFT, ACTIVE or DISCONTINUED")
+	}
+
+	var updatedProduct *entity.Product
+
+	atomic := func(r postgresrepository.PostgresRepository) error {
+		product, err := r.Product().FindByID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		updatedProduct = product
+
+		if product.Status == status {
+			return nil
+		}
+
+		return s.changeStatus(ctx, r, product, status)
+	}
+
+	err := s.Repo.Postgres().Atomic(ctx, s.Config, atomic)
+	if err != nil {
+		return nil, serviceerror.TranslateRepoError(err)
+	}
+
+	return updatedProduct, nil
+}
+
+// changeStatus moves a stored product from its current status to status and
+// records the audit event of the move.
+func (s *productService) changeStatus(ctx context.Context, r postgresrepository.PostgresRepository, product *entity.Product, status string) error {
+	if err := checkStatusTransition(product.Status, status); err != nil {
+		return err
+	}
+
+	if err := r.Product().UpdateStatus(ctx, product.ID, status); err != nil {
+		return err
+	}
+
+	before := productAuditState(product)
+	product.Status = status
+
+	return s.recordAudit(ctx, r, newAuditEvent(ctx, constant.AuditEntityProduct, product.ID, constant.AuditActionStatusChanged,
+		before, productAuditState(product)))
+}
+
+// checkStatusTransition rejects unknown statuses and moves the transitions do
+// not allow.
+func checkStatusTransition(from, to string) error {
+	if _, ok := productStatusTransitions[to]; !ok {
+		return invalidStatusError("Status must be one of DRAFT, ACTIVE or DISCONTINUED")
+	}
+
+	if from != to && !slices.Contains(productStatusTransitions[from], to) {
+		return invalidStatusError(fmt.Sprintf("Status cannot change from %s to %s", from, to))
+	}
+
+	return nil
+}
+
 func (s *productService) Delete(ctx context.Context, id uint32) error {
 	atomic := func(r postgresrepository.PostgresRepository) error {
-		return r.Product().Delete(ctx, id)
+		_, variants, err := r.Product().Find(ctx, &postgresrepository.FilterProductPayload{ParentIDs: []uint32{id}})
+		if err != nil {
+			return err
+		}
+
+		if variants > 0 {
+			return exception.New(exception.TypeConflict, exception.CodeConflict, "Product still has variants")
+		}
+
+		var before map[string]any
+
+		if s.auditsChanges() {
+			product, err := r.Product().FindByID(ctx, id)
+			if err != nil {
+				return err
+			}
+
+			before = productAuditState(product)
+		}
+
+		if err := r.Product().Delete(ctx, id); err != nil {
+			return err
+		}
+
+		return s.recordAudit(ctx, r, newAuditEvent(ctx, constant.AuditEntityProduct, id, constant.AuditActionDeleted, before, nil))
 	}
 
 	err := s.Repo.Postgres().Atomic(ctx, s.Config, atomic)
+	if err != nil {
+		return serviceerror.TranslateRepoError(err)
+	}
+
+	return nil
+}
+
+// validateProductFields runs the checks that need nothing but the product itself.
+func validateProductFields(product *entity.Product) error {
+	checks := []func(*entity.Product) error{
+		validateSKU,
+		validateName,
+		validateStock,
+		validateBarcode,
+		validateStockTracking,
+		validateUnits,
+		validatePrice,
+		validateAttributes,
+	}
+
+	for _, check := range checks {
+		if err := check(product); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateProductRelations checks the product's parent and kit components
+// against the stored products.
+func validateProductRelations(ctx context.Context, r postgresrepository.PostgresRepository, product *entity.Product) error {
+	if err := validateVariant(ctx, r, product); err != nil {
+		return err
+	}
+
+	return validateKit(ctx, r, product)
+}
+
+func validateSKU(product *entity.Product) error {
+	if product == nil {
+		return nil
+	}
+
+	var message string
+
+	switch {
+	case strings.TrimSpace(product.SKU) == "":
+		message = "SKU is required"
+	case utf8.RuneCountInString(product.SKU) > constant.MaxSKULength:
+		message = fmt.Sprintf("SKU cannot be longer than %d characters", constant.MaxSKULength)
+	default:
+		return nil
+	}
+
+	return exception.NewWithErrors(exception.TypeValidationError, exception.CodeValidationFailed, "Invalid SKU", exception.FieldErrors{
+		"sku": {message},
+	})
+}
+
+func validateName(product *entity.Product) error {
+	if product == nil {
+		return nil
+	}
+
+	var message string
+
+	switch {
+	case product.Name == "" && product.ParentID != 0:
+		// Variants without a name of their own take their parent's.
+		return nil
+	case strings.TrimSpace(product.Name) == "":
+		message = "Name is required"
+	case utf8.RuneCountInString(product.Name) > constant.MaxNameLength:
+		message = fmt.Sprintf("Name cannot be longer than %d characters", constant.MaxNameLength)
+	default:
+		return nil
+	}
+
+	return exception.NewWithErrors(exception.TypeValidationError, exception.CodeValidationFailed, "Invalid name", exception.FieldErrors{
+		"name": {message},
+	})
+}
+
+func validateStock(product *entity.Product) error {
+	if product == nil || product.Stock >= 0 {
+		return nil
+	}
+
+	return exception.NewWithErrors(exception.TypeValidationError, exception.CodeValidationFailed, "Invalid stock", exception.FieldErrors{
+		"stock": {"Stock cannot be negative"},
+	})
+}
+
+func validateBarcode(product *entity.Product) error {
+	if product == nil || product.Barcode == "" || utils.IsValidGTIN(product.Barcode) {
+		return nil
+	}
+
+	return exception.NewWithErrors(exception.TypeValidationError, exception.CodeValidationFailed, "Invalid barcode", exception.FieldErrors{
+		"barcode": {"Barcode must be a valid GTIN-8, GTIN-12, GTIN-13 or GTIN-14 code"},
+	})
+}
+
+// validatePrice defaults the currency and makes sure the price is a
+// non-negative amount that fits the currency's decimal places and the price
+// column.
+func validatePrice(product *entity.Product) error {
+	if product == nil {
+		return nil
+	}
+
+	return validateMoney(&product.Price)
+}
+
+func validateMoney(price *entity.Money) error {
+	if price.Currency == "" {
+		price.Currency = constant.DefaultCurrency
+	}
+
+	scale, ok := entity.CurrencyScale(price.Currency)
+	if !ok {
+		return invalidPriceError("currency", "Currency "+price.Currency+" is not supported")
+	}
+
+	switch {
+	case !price.Valid():
+		return invalidPriceError("price", "Price units and nanos must have the same sign")
+	case price.IsNegative():
+		return invalidPriceError("price", "Price cannot be negative")
+	case price.Scale() > scale:
+		return invalidPriceError("price", fmt.Sprintf("Price cannot have more than %d decimal places in %s", scale, price.Currency))
+	case price.Units > maxPriceUnits:
+		return invalidPriceError("price", "Price exceeds the maximum of 99999999.99")
+	}
+
+	return nil
+}
+
+func invalidPriceError(field, message string) error {
+	return exception.NewWithErrors(exception.TypeValidationError, exception.CodeValidationFailed, "Invalid price", exception.FieldErrors{
+		field: {message},
+	})
+}
+
+// validateInitialStatus defaults new products to active; they may also start
+// out as drafts, but not discontinued.
+func validateInitialStatus(product *entity.Product) error {
+	if product == nil {
+		return nil
+	}
+
+	switch product.Status {
+	case "":
+		product.Status = constant.ProductStatusActive
+	case constant.ProductStatusDraft, constant.ProductStatusActive:
+	default:
+		return invalidStatusError("New products must be DRAFT or ACTIVE")
+	}
+
+	return nil
+}
+
+func invalidStatusError(message string) error {
+	return exception.NewWithErrors(exception.TypeValidationError, exception.CodeValidationFailed, "Invalid status", exception.FieldErrors{
+		"status": {message},
+	})
+}
+
+// validateAttributes rejects attribute keys containing dots, which filters use
+// as path separators, and checks the shape of the shipping attributes.
+func validateAttributes(product *entity.Product) error {
+	if product == nil || len(product.Attributes) == 0 {
+		return nil
+	}
+
+	errs := exception.FieldErrors{}
+	checkAttributeKeys("attributes", product.Attributes, errs)
+
+	if weight, ok := product.Attributes[constant.AttributeWeight]; ok && !isNonNegativeNumber(weight) {
+		errs["attributes.weight"] = append(errs["attributes.weight"], "Weight must be a non-negative number")
+	}
+
+	if dimensions, ok := product.Attributes[constant.AttributeDimensions]; ok {
+		values, isObject := dimensions.(map[string]any)
+
+		for _, side := range []string{"length", "width", "height"} {
+			if !isObject || !isNonNegativeNumber(values[side]) {
+				field := "attributes.dimensions." + side
+				errs[field] = append(errs[field], "Dimension must be a non-negative number")
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return exception.NewWithErrors(exception.TypeValidationError, exception.CodeValidationFailed, "Invalid attributes", errs)
+	}
+
+	return nil
+}
+
+func checkAttributeKeys(prefix string, attributes map[string]any, errs exception.FieldErrors) {
+	for key, value := range attributes {
+		if key == "" || strings.Contains(key, ".") {
+			errs[prefix] = append(errs[prefix], fmt.Sprintf("Attribute key %q must be non-empty and cannot contain dots", key))
+			continue
+		}
+
+		if nested, ok := value.(map[string]any); ok {
+			checkAttributeKeys(prefix+"."+key, nested, errs)
+		}
+	}
+}
+
+func isNonNegativeNumber(value any) bool {
+	number, ok := value.(float64)
+	return ok && number >= 0
+}
+
+func validateAttributeFilters(filter *postgresrepository.FilterProductPayload) error {
+	if filter == nil {
+		return nil
+	}
+
+	for _, attribute := range filter.Attributes {
+		if attribute.Key == "" || slices.Contains(strings.Split(attribute.Key, "."), "") {
+			return exception.NewWithErrors(exception.TypeValidationError, exception.CodeValidationFailed, "Invalid attribute filter", exception.FieldErrors{
+				"attributes": {fmt.Sprintf("Attribute filter key %q is not a valid path", attribute.Key)},
+			})
+		}
+
+		if attribute.Min != nil && attribute.Max != nil && *attribute.Min > *attribute.Max {
+			return exception.NewWithErrors(exception.TypeValidationError, exception.CodeValidationFailed, "Invalid attribute filter", exception.FieldErrors{
+				"attributes": {fmt.Sprintf("Minimum of %s is greater than its maximum", attribute.Key)},
+			})
+		}
+	}
+
+	return nil
+}
+
+// validateUnits checks the product's pack sizes; the base unit is implicit and
+// always converts one to one.
+func validateUnits(product *entity.Product) error {
+	if product == nil || len(product.Units) == 0 {
+		return nil
+	}
+
+	seen := make(map[string]bool, len(product.Units))
+
+	for _, unit := range product.Units {
+		switch {
+		case unit.Unit == constant.UnitEach:
+			return invalidUnitsError("The base unit each cannot be redefined")
+		case !slices.Contains([]string{constant.UnitPack, constant.UnitCase, constant.UnitPallet}, unit.Unit):
+			return invalidUnitsError("Unit must be one of pack, case or pallet")
+		case unit.Factor <= 0:
+			return invalidUnitsError("Unit factors must be greater than zero")
+		case seen[unit.Unit]:
+			return invalidUnitsError("Each unit may only be listed once")
+		}
+
+		seen[unit.Unit] = true
+	}
+
+	return nil
+}
+
+func invalidUnitsError(message string) error {
+	return exception.NewWithErrors(exception.TypeValidationError, exception.CodeValidationFailed, "Invalid units", exception.FieldErrors{
+		"units": {message},
+	})
+}
+
+// unitFactor returns how many base units one unit of the product holds.
+func unitFactor(ctx context.Context, r postgresrepository.PostgresRepository, productID uint32, unit string) (int, error) {
+	if unit == "" || unit == constant.UnitEach {
+		return 1, nil
+	}
+
+	units, err := r.ProductUnit().FindByProductIDs(ctx, []uint32{productID})
+	if err != nil {
+		return 0, err
+	}
+
+	for _, productUnit := range units {
+		if productUnit.Unit == unit {
+			return productUnit.Factor, nil
+		}
+	}
+
+	return 0, exception.NewWithErrors(exception.TypeValidationError, exception.CodeValidationFailed, "Invalid unit", exception.FieldErrors{
+		"unit": {"Product has no conversion for unit " + unit},
+	})
+}
+
+// validateStockTracking rejects products that track both lots and serials; a
+// product's stock is derived from one or the other.
+func validateStockTracking(product *entity.Product) error {
+	if product == nil || !product.TrackLots || !product.TrackSerials {
+		return nil
+	}
+
+	return exception.NewWithErrors(exception.TypeValidationError, exception.CodeValidationFailed, "Invalid stock tracking", exception.FieldErrors{
+		"track_serials": {"Product cannot track both lots and serials"},
+	})
+}
+
+// validateVariant checks the parent of a variant and fills the price and
+// category the variant inherits when they are not overridden. Only variants
+// inherit a price.
+func validateVariant(ctx context.Context, r postgresrepository.PostgresRepository, product *entity.Product) error {
+	if product == nil {
+		return nil
+	}
+
+	if product.ParentID == 0 {
+		product.InheritPrice = false
+		return nil
+	}
+
+	if product.ParentID == product.ID {
+		return invalidVariantError("parent_id", "Product cannot be a variant of itself")
+	}
+
+	if len(product.Options) == 0 {
+		return invalidVariantError("options", "Variants must have at least one option value")
+	}
+
+	parent, err := r.Product().FindByID(ctx, product.ParentID)
+	if err != nil {
+		if errors.Is(err, exception.ErrNotFound) {
+			return invalidVariantError("parent_id", "Parent product does not exist")
+		}
+
+		return err
+	}
+
+	if parent.ParentID != 0 {
+		return invalidVariantError("parent_id", "Variants cannot have variants of their own")
+	}
+
+	if len(product.Components) > 0 {
+		return invalidVariantError("components", "Variants cannot be kits")
+	}
+
+	kitComponents, err := r.KitComponent().FindByKitIDs(ctx, []uint32{parent.ID})
 	if err != nil {
 		return err
 	}
 
+	if len(kitComponents) > 0 {
+		return invalidVariantError("parent_id", "Kits cannot have variants")
+	}
+
+	if product.ID != 0 {
+		_, variants, err := r.Product().Find(ctx, &postgresrepository.FilterProductPayload{ParentIDs: []uint32{product.ID}})
+		if err != nil {
+			return err
+		}
+
+		if variants > 0 {
+			return invalidVariantError("parent_id", "Products with variants cannot become a variant")
+		}
+	}
+
+	// The stored price of an inheriting variant is its parent's at the time;
+	// reads resolve the current one.
+	if product.InheritPrice {
+		product.Price = parent.Price
+	}
+
+	if product.CategoryID == 0 {
+		product.CategoryID = parent.CategoryID
+	}
+
+	if product.Name == "" {
+		product.Name = parent.Name
+	}
+
 	return nil
 }
+
+func invalidVariantError(field, message string) error {
+	return exception.NewWithErrors(exception.TypeValidationError, exception.CodeValidationFailed, "Invalid variant", exception.FieldErrors{
+		field: {message},
+	})
+}
+
+// validateKit checks a kit's bill of materials: each component must be an
+// existing, stock-holding product listed once with a positive quantity.
+func validateKit(ctx context.Context, r postgresrepository.PostgresRepository, product *entity.Product) error {
+	if product == nil || len(product.Components) == 0 {
+		return nil
+	}
+
+	componentIDs := make([]uint32, 0, len(product.Components))
+
+	for _, component := range product.Components {
+		switch {
+		case component.Quantity <= 0:
+			return invalidKitError("Component quantities must be greater than zero")
+		case component.ComponentID == 0 || component.ComponentID == product.ID:
+			return invalidKitError("Kits cannot contain themselves")
+		case slices.Contains(componentIDs, component.ComponentID):
+			return invalidKitError("Each component may only be listed once")
+		}
+
+		componentIDs = append(componentIDs, component.ComponentID)
+	}
+
+	_, found, err := r.Product().Find(ctx, &postgresrepository.FilterProductPayload{IDs: componentIDs})
+	if err != nil {
+		return err
+	}
+
+	if found != len(componentIDs) {
+		return invalidKitError("One or more components do not exist")
+	}
+
+	_, parents, err := r.Product().Find(ctx, &postgresrepository.FilterProductPayload{ParentIDs: componentIDs})
+	if err != nil {
+		return err
+	}
+
+	if parents > 0 {
+		return invalidKitError("Components must reference a specific variant")
+	}
+
+	nested, err := r.KitComponent().FindByKitIDs(ctx, componentIDs)
+	if err != nil {
+		return err
+	}
+
+	if len(nested) > 0 {
+		return invalidKitError("Kits cannot contain other kits")
+	}
+
+	return nil
+}
+
+func invalidKitError(message string) error {
+	return exception.NewWithErrors(exception.TypeValidationError, exception.CodeValidationFailed, "Invalid kit", exception.FieldErrors{
+		"components": {message},
+	})
+}
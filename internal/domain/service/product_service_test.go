@@ -2,14 +2,20 @@ package service_test
 
 import (
 	"context"
+	"strings"
 	"testing"
 
+	"inventory-service/config"
+	"inventory-service/constant"
 	postgresrepository "inventory-service/internal/adapter/repository/postgres"
 	"inventory-service/internal/domain/entity"
 	"inventory-service/internal/domain/service"
+	"inventory-service/internal/shared/exception"
 	"inventory-service/mocks"
 
+	"github.com/cockroachdb/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 // Helper function to initialize the mock chain
@@ -25,15 +31,51 @@ func setupProductMocks(t *testing.T) (*mocks.MockRepository, *mocks.MockPostgres
 	return mRepo, mPostgres, mProduct
 }
 
+// Helper to link a kit component repository mock into the postgres mock
+func setupKitComponentMock(t *testing.T, mPostgres *mocks.MockPostgresRepository) *mocks.MockKitComponentRepository {
+	mKitComponent := mocks.NewMockKitComponentRepository(t)
+	mPostgres.EXPECT().KitComponent().Return(mKitComponent).Maybe()
+
+	return mKitComponent
+}
+
+// Helper to link a product unit repository mock into the postgres mock
+func setupProductUnitMock(t *testing.T, mPostgres *mocks.MockPostgresRepository) *mocks.MockProductUnitRepository {
+	mProductUnit := mocks.NewMockProductUnitRepository(t)
+	mPostgres.EXPECT().ProductUnit().Return(mProductUnit).Maybe()
+
+	return mProductUnit
+}
+
+// Helper to link a price change repository mock into the postgres mock
+func setupPriceChangeMock(t *testing.T, mPostgres *mocks.MockPostgresRepository) *mocks.MockPriceChangeRepository {
+	mPriceChange := mocks.NewMockPriceChangeRepository(t)
+	mPostgres.EXPECT().PriceChange().Return(mPriceChange).Maybe()
+
+	return mPriceChange
+}
+
+// Helper to run the Atomic callback against the mocked postgres repository
+func expectProductAtomic(mPostgres *mocks.MockPostgresRepository) {
+	mPostgres.EXPECT().
+		Atomic(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, cfg *config.Config, fn postgresrepository.RepositoryAtomicCallback) error {
+			return fn(mPostgres)
+		})
+}
+
 func TestProductServiceCreate(t *testing.T) {
-	mockRepo, _, mockProduct := setupProductMocks(t)
+	mockRepo, mockPostgres, mockProduct := setupProductMocks(t)
+	mockPriceChange := setupPriceChangeMock(t, mockPostgres)
+	expectProductAtomic(mockPostgres)
 
 	ctx := context.Background()
-	input := &entity.Product{Name: "Test Product"}
-	expectedOutput := &entity.Product{ID: 1, Name: "Test Product"}
+	input := &entity.Product{SKU: "SKU-1", Name: "Test Product"}
+	expectedOutput := &entity.Product{Base: entity.Base{ID: 1}, SKU: "SKU-1", Name: "Test Product"}
 
 	// Mock the call on the leaf repository
 	mockProduct.EXPECT().Create(ctx, input).Return(expectedOutput, nil)
+	mockPriceChange.EXPECT().Record(ctx, uint32(1), expectedOutput.Price).Return(nil)
 
 	productService := service.NewProductService(service.Properties{Repo: mockRepo})
 	result, err := productService.Create(ctx, input)
@@ -43,12 +85,15 @@ func TestProductServiceCreate(t *testing.T) {
 }
 
 func TestProductServiceUpdate(t *testing.T) {
-	mockRepo, _, mockProduct := setupProductMocks(t)
+	mockRepo, mockPostgres, mockProduct := setupProductMocks(t)
+	mockPriceChange := setupPriceChangeMock(t, mockPostgres)
+	expectProductAtomic(mockPostgres)
 
 	ctx := context.Background()
-	input := &entity.Product{ID: 1, Name: "Updated Product"}
+	input := &entity.Product{Base: entity.Base{ID: 1}, SKU: "SKU-1", Name: "Updated Product"}
 
 	mockProduct.EXPECT().Update(ctx, input).Return(input, nil)
+	mockPriceChange.EXPECT().Record(ctx, uint32(1), entity.Money{Currency: "USD"}).Return(nil)
 
 	productService := service.NewProductService(service.Properties{Repo: mockRepo})
 	result, err := productService.Update(ctx, input)
@@ -57,12 +102,88 @@ func TestProductServiceUpdate(t *testing.T) {
 	assert.Equal(t, "Updated Product", result.Name)
 }
 
+func TestProductServiceUpdateMissingProduct(t *testing.T) {
+	mockRepo, mockPostgres, mockProduct := setupProductMocks(t)
+	mockOutbox := mocks.NewMockOutboxRepository(t)
+	mockPostgres.EXPECT().Outbox().Return(mockOutbox).Maybe()
+	expectProductAtomic(mockPostgres)
+
+	ctx := context.Background()
+	input := &entity.Product{Base: entity.Base{ID: 404}, Name: "Ghost", SKU: "GHOST-1"}
+
+	mockProduct.EXPECT().Find(ctx, &postgresrepository.FilterProductPayload{IDs: []uint32{404}}).Return([]*entity.Product{}, 0, nil)
+	mockProduct.EXPECT().Update(ctx, input).
+		Return(nil, errors.Wrap(exception.ErrNotFound, "record not found on table 'products' during 'update product'"))
+
+	productService := service.NewProductService(service.Properties{Repo: mockRepo, Config: pubsubConfig})
+	result, err := productService.Update(ctx, input)
+
+	// No ProductCreated event is recorded for a product that does not exist.
+	assert.Nil(t, result)
+
+	ex, ok := exception.GetException(err)
+	assert.True(t, ok)
+	assert.Equal(t, exception.TypeNotFound, ex.Type)
+}
+
+func TestProductServiceUpdateFields(t *testing.T) {
+	mockRepo, mockPostgres, mockProduct := setupProductMocks(t)
+	mockPriceChange := setupPriceChangeMock(t, mockPostgres)
+	expectProductAtomic(mockPostgres)
+
+	ctx := context.Background()
+	stored := &entity.Product{
+		Base:    entity.Base{ID: 1},
+		SKU:     "TSHIRT-RED-M",
+		Barcode: "4006381333931",
+		Name:    "T-Shirt",
+		Stock:   7,
+		Price:   entity.Money{Currency: "USD"},
+	}
+
+	mockProduct.EXPECT().LockByID(ctx, uint32(1)).Return(stored, nil)
+	mockProduct.EXPECT().
+		Update(ctx, mock.MatchedBy(func(product *entity.Product) bool {
+			// Fields outside the update keep their stored values.
+			return product.Name == "Shirt" && product.SKU == "TSHIRT-RED-M" && product.Barcode == "" &&
+				product.Stock == 7 && product.Units == nil
+		})).
+		RunAndReturn(func(_ context.Context, product *entity.Product) (*entity.Product, error) {
+			return product, nil
+		})
+	mockPriceChange.EXPECT().Record(ctx, uint32(1), entity.Money{Currency: "USD"}).Return(nil)
+
+	productService := service.NewProductService(service.Properties{Repo: mockRepo})
+	result, err := productService.UpdateFields(ctx, &entity.Product{Base: entity.Base{ID: 1}, Name: "Shirt", SKU: "IGNORED"}, []string{"name", "barcode"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Shirt", result.Name)
+	assert.Equal(t, "TSHIRT-RED-M", result.SKU)
+}
+
+func TestProductServiceUpdateFieldsUnknownField(t *testing.T) {
+	mockRepo, _, _ := setupProductMocks(t)
+
+	productService := service.NewProductService(service.Properties{Repo: mockRepo})
+	_, err := productService.UpdateFields(context.Background(), &entity.Product{Base: entity.Base{ID: 1}}, []string{"status"})
+
+	ex, ok := exception.GetException(err)
+	if assert.True(t, ok) {
+		assert.Equal(t, exception.TypeValidationError, ex.Type)
+		assert.Contains(t, ex.Errors, "update_mask")
+	}
+}
+
 func TestProductServiceDelete(t *testing.T) {
-	mockRepo, _, mockProduct := setupProductMocks(t)
+	mockRepo, mockPostgres, mockProduct := setupProductMocks(t)
+	expectProductAtomic(mockPostgres)
 
 	ctx := context.Background()
 	id := uint32(1)
 
+	mockProduct.EXPECT().
+		Find(ctx, &postgresrepository.FilterProductPayload{ParentIDs: []uint32{id}}).
+		Return([]*entity.Product{}, 0, nil)
 	mockProduct.EXPECT().Delete(ctx, id).Return(nil)
 
 	productService := service.NewProductService(service.Properties{Repo: mockRepo})
@@ -76,7 +197,7 @@ func TestProductServiceFind(t *testing.T) {
 
 	ctx := context.Background()
 	filter := &postgresrepository.FilterProductPayload{Page: 1, PerPage: 10}
-	expectedList := []*entity.Product{{ID: 1, Name: "Item A"}}
+	expectedList := []*entity.Product{{Base: entity.Base{ID: 1}, Name: "Item A"}}
 
 	mockProduct.EXPECT().Find(ctx, filter).Return(expectedList, 1, nil)
 
@@ -89,13 +210,22 @@ func TestProductServiceFind(t *testing.T) {
 }
 
 func TestProductServiceFindByID(t *testing.T) {
-	mockRepo, _, mockProduct := setupProductMocks(t)
+	mockRepo, mockPostgres, mockProduct := setupProductMocks(t)
+	mockKitComponent := setupKitComponentMock(t, mockPostgres)
+	mockProductUnit := setupProductUnitMock(t, mockPostgres)
 
 	ctx := context.Background()
 	id := uint32(1)
-	expected := &entity.Product{ID: 1, Name: "Item A"}
+	expected := &entity.Product{Base: entity.Base{ID: 1}, Name: "Item A"}
 
 	mockProduct.EXPECT().FindByID(ctx, id).Return(expected, nil)
+	mockProductUnit.EXPECT().FindByProductIDs(ctx, []uint32{id}).Return([]*entity.ProductUnit{
+		{ProductID: id, Unit: "case", Factor: 24},
+	}, nil)
+	mockProduct.EXPECT().
+		Find(ctx, &postgresrepository.FilterProductPayload{ParentIDs: []uint32{id}}).
+		Return([]*entity.Product{}, 0, nil)
+	mockKitComponent.EXPECT().FindByKitIDs(ctx, []uint32{id}).Return([]*entity.KitComponent{}, nil)
 
 	productService := service.NewProductService(service.Properties{Repo: mockRepo})
 	result, err := productService.FindByID(ctx, id)
@@ -103,4 +233,765 @@ func TestProductServiceFindByID(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
 	assert.Equal(t, id, result.ID)
+	assert.Len(t, result.Units, 1)
+}
+
+func TestProductServiceFindBySKU(t *testing.T) {
+	mockRepo, _, mockProduct := setupProductMocks(t)
+
+	ctx := context.Background()
+	expected := &entity.Product{Base: entity.Base{ID: 1}, SKU: "TSHIRT-RED-M", Name: "Item A"}
+
+	mockProduct.EXPECT().FindBySKU(ctx, "TSHIRT-RED-M").Return(expected, nil)
+
+	productService := service.NewProductService(service.Properties{Repo: mockRepo})
+	result, err := productService.FindBySKU(ctx, "TSHIRT-RED-M")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "TSHIRT-RED-M", result.SKU)
+}
+
+func TestProductServiceFindByBarcodeNotFound(t *testing.T) {
+	mockRepo, _, mockProduct := setupProductMocks(t)
+
+	ctx := context.Background()
+
+	mockProduct.EXPECT().FindByBarcode(ctx, "4006381333931").
+		Return(nil, errors.Wrap(exception.ErrNotFound, "record not found on table 'products' during 'find product by barcode'"))
+
+	productService := service.NewProductService(service.Properties{Repo: mockRepo})
+	result, err := productService.FindByBarcode(ctx, "4006381333931")
+
+	assert.Nil(t, result)
+
+	ex, ok := exception.GetException(err)
+	assert.True(t, ok)
+	assert.Equal(t, exception.TypeNotFound, ex.Type)
+}
+
+func TestProductServiceFindByEmptyCode(t *testing.T) {
+	mockRepo, _, _ := setupProductMocks(t)
+
+	ctx := context.Background()
+	productService := service.NewProductService(service.Properties{Repo: mockRepo})
+
+	_, err := productService.FindBySKU(ctx, "")
+	ex, ok := exception.GetException(err)
+	if assert.True(t, ok) {
+		assert.Equal(t, exception.TypeValidationError, ex.Type)
+		assert.Contains(t, ex.Errors, "sku")
+	}
+
+	_, err = productService.FindByBarcode(ctx, "")
+	ex, ok = exception.GetException(err)
+	if assert.True(t, ok) {
+		assert.Equal(t, exception.TypeValidationError, ex.Type)
+		assert.Contains(t, ex.Errors, "barcode")
+	}
+}
+
+func TestProductServiceCreateInvalidBarcode(t *testing.T) {
+	mockRepo, _, _ := setupProductMocks(t)
+
+	ctx := context.Background()
+	input := &entity.Product{SKU: "SKU-1", Barcode: "4006381333932", Name: "Test Product"}
+
+	productService := service.NewProductService(service.Properties{Repo: mockRepo})
+	result, err := productService.Create(ctx, input)
+
+	assert.Nil(t, result)
+
+	ex, ok := exception.GetException(err)
+	assert.True(t, ok)
+	assert.Equal(t, exception.TypeValidationError, ex.Type)
+	assert.Contains(t, ex.Errors, "barcode")
+}
+
+func TestProductServiceRejectsBrokenInvariants(t *testing.T) {
+	tests := []struct {
+		name    string
+		product entity.Product
+		field   string
+	}{
+		{"missing SKU", entity.Product{Name: "Product"}, "sku"},
+		{"blank SKU", entity.Product{SKU: "  ", Name: "Product"}, "sku"},
+		{"SKU too long", entity.Product{SKU: strings.Repeat("S", constant.MaxSKULength+1), Name: "Product"}, "sku"},
+		{"missing name", entity.Product{SKU: "SKU-1"}, "name"},
+		{"blank name", entity.Product{SKU: "SKU-1", Name: "   "}, "name"},
+		{"name too long", entity.Product{SKU: "SKU-1", Name: strings.Repeat("é", constant.MaxNameLength+1)}, "name"},
+		{"negative stock", entity.Product{SKU: "SKU-1", Name: "Product", Stock: -1}, "stock"},
+		{"negative price", entity.Product{SKU: "SKU-1", Name: "Product", Price: entity.Money{Currency: "USD", Units: -1}}, "price"},
+		{"price finer than cents", entity.Product{SKU: "SKU-1", Name: "Product", Price: entity.Money{Currency: "USD", Units: 19, Nanos: 999_000_000}}, "price"},
+		{"yen with decimals", entity.Product{SKU: "SKU-1", Name: "Product", Price: entity.Money{Currency: "JPY", Units: 100, Nanos: 500_000_000}}, "price"},
+		{"price beyond the column", entity.Product{SKU: "SKU-1", Name: "Product", Price: entity.Money{Currency: "USD", Units: 100_000_000}}, "price"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo, _, mockProduct := setupProductMocks(t)
+			productService := service.NewProductService(service.Properties{Repo: mockRepo})
+
+			create := tt.product
+			_, createErr := productService.Create(context.Background(), &create)
+
+			update := tt.product
+			update.ID = 1
+			_, updateErr := productService.Update(context.Background(), &update)
+
+			for _, err := range []error{createErr, updateErr} {
+				ex, ok := exception.GetException(err)
+				if assert.True(t, ok, "expected an exception, got %v", err) {
+					assert.Equal(t, exception.TypeValidationError, ex.Type)
+					assert.Contains(t, ex.Errors, tt.field)
+				}
+			}
+
+			mockProduct.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+			mockProduct.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestProductServiceCreateDuplicateSKU(t *testing.T) {
+	mockRepo, mockPostgres, mockProduct := setupProductMocks(t)
+	expectProductAtomic(mockPostgres)
+
+	ctx := context.Background()
+	input := &entity.Product{SKU: "SKU-1", Barcode: "4006381333931", Name: "Test Product"}
+
+	mockProduct.EXPECT().Create(ctx, input).
+		Return(nil, errors.Wrapf(exception.ErrDuplicateEntry, "duplicate value '%s' for field '%s'", "SKU-1", "sku"))
+
+	productService := service.NewProductService(service.Properties{Repo: mockRepo})
+	result, err := productService.Create(ctx, input)
+
+	assert.Nil(t, result)
+
+	ex, ok := exception.GetException(err)
+	assert.True(t, ok)
+	assert.Equal(t, exception.TypeConflict, ex.Type)
+	assert.Equal(t, "duplicate value 'SKU-1' for field 'sku'", ex.Message)
+	assert.Contains(t, ex.Errors, "sku")
+}
+
+func TestProductServiceFindByIDWithVariants(t *testing.T) {
+	mockRepo, mockPostgres, mockProduct := setupProductMocks(t)
+	mockProductUnit := setupProductUnitMock(t, mockPostgres)
+
+	ctx := context.Background()
+	parent := &entity.Product{Base: entity.Base{ID: 1}, Name: "T-shirt"}
+	variants := []*entity.Product{
+		{Base: entity.Base{ID: 2}, ParentID: 1, Options: map[string]string{"size": "S"}, Stock: 4, AvailableStock: 4},
+		{Base: entity.Base{ID: 3}, ParentID: 1, Options: map[string]string{"size": "M"}, Stock: 6, AvailableStock: 6},
+	}
+
+	mockProduct.EXPECT().FindByID(ctx, uint32(1)).Return(parent, nil)
+	mockProductUnit.EXPECT().FindByProductIDs(ctx, []uint32{1}).Return([]*entity.ProductUnit{}, nil)
+	mockProduct.EXPECT().
+		Find(ctx, &postgresrepository.FilterProductPayload{ParentIDs: []uint32{1}}).
+		Return(variants, 2, nil)
+
+	productService := service.NewProductService(service.Properties{Repo: mockRepo})
+	result, err := productService.FindByID(ctx, 1)
+
+	assert.NoError(t, err)
+	assert.Len(t, result.Variants, 2)
+	assert.Equal(t, 10, result.AvailableStock)
+}
+
+func TestProductServiceCreateVariantInheritsPrice(t *testing.T) {
+	mockRepo, mockPostgres, mockProduct := setupProductMocks(t)
+	mockKitComponent := setupKitComponentMock(t, mockPostgres)
+	mockPriceChange := setupPriceChangeMock(t, mockPostgres)
+	expectProductAtomic(mockPostgres)
+
+	ctx := context.Background()
+	parent := &entity.Product{Base: entity.Base{ID: 1}, Name: "T-shirt", Price: entity.Money{Currency: "EUR", Units: 19, Nanos: 990_000_000}, CategoryID: 4}
+	input := &entity.Product{ParentID: 1, SKU: "TS-M-RED", Options: map[string]string{"size": "M", "color": "red"}, InheritPrice: true}
+
+	mockProduct.EXPECT().FindByID(ctx, uint32(1)).Return(parent, nil)
+	mockKitComponent.EXPECT().FindByKitIDs(ctx, []uint32{1}).Return([]*entity.KitComponent{}, nil)
+	mockProduct.EXPECT().Create(ctx, input).Return(input, nil)
+	mockPriceChange.EXPECT().Record(ctx, input.ID, entity.Money{Currency: "EUR", Units: 19, Nanos: 990_000_000}).Return(nil)
+
+	productService := service.NewProductService(service.Properties{Repo: mockRepo})
+	result, err := productService.Create(ctx, input)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "19.99", result.Price.String())
+	assert.Equal(t, "EUR", result.Price.Currency)
+	assert.Equal(t, uint32(4), result.CategoryID)
+	assert.Equal(t, "T-shirt", result.Name)
+}
+
+func TestProductServiceCreateFreeVariant(t *testing.T) {
+	mockRepo, mockPostgres, mockProduct := setupProductMocks(t)
+	mockKitComponent := setupKitComponentMock(t, mockPostgres)
+	mockPriceChange := setupPriceChangeMock(t, mockPostgres)
+	expectProductAtomic(mockPostgres)
+
+	ctx := context.Background()
+	parent := &entity.Product{Base: entity.Base{ID: 1}, Name: "T-shirt", Price: entity.Money{Currency: "USD", Units: 19}}
+	input := &entity.Product{ParentID: 1, SKU: "TS-SAMPLE", Options: map[string]string{"size": "sample"}, Price: entity.Money{Currency: "USD"}}
+
+	mockProduct.EXPECT().FindByID(ctx, uint32(1)).Return(parent, nil)
+	mockKitComponent.EXPECT().FindByKitIDs(ctx, []uint32{1}).Return([]*entity.KitComponent{}, nil)
+	mockProduct.EXPECT().Create(ctx, input).Return(input, nil)
+	mockPriceChange.EXPECT().Record(ctx, input.ID, entity.Money{Currency: "USD"}).Return(nil)
+
+	productService := service.NewProductService(service.Properties{Repo: mockRepo})
+	result, err := productService.Create(ctx, input)
+
+	assert.NoError(t, err)
+	assert.True(t, result.Price.IsZero())
+	assert.False(t, result.InheritPrice)
+}
+
+func TestProductServiceFindReadsInheritedPrice(t *testing.T) {
+	mockRepo, _, mockProduct := setupProductMocks(t)
+
+	ctx := context.Background()
+	filter := &postgresrepository.FilterProductPayload{ParentIDs: []uint32{1}}
+	variants := []*entity.Product{
+		{Base: entity.Base{ID: 2}, ParentID: 1, Price: entity.Money{Currency: "USD", Units: 19}, InheritPrice: true},
+		{Base: entity.Base{ID: 3}, ParentID: 1, Price: entity.Money{Currency: "USD", Units: 25}},
+	}
+
+	mockProduct.EXPECT().Find(ctx, filter).Return(variants, 2, nil)
+	mockProduct.EXPECT().Find(ctx, &postgresrepository.FilterProductPayload{IDs: []uint32{1}}).
+		Return([]*entity.Product{{Base: entity.Base{ID: 1}, Price: entity.Money{Currency: "USD", Units: 21}}}, 1, nil)
+
+	productService := service.NewProductService(service.Properties{Repo: mockRepo})
+	result, _, err := productService.Find(ctx, filter)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "21.00", result[0].Price.String())
+	assert.Equal(t, "25.00", result[1].Price.String())
+}
+
+func TestProductServiceCreateNestedVariant(t *testing.T) {
+	mockRepo, mockPostgres, mockProduct := setupProductMocks(t)
+	expectProductAtomic(mockPostgres)
+
+	ctx := context.Background()
+	parent := &entity.Product{Base: entity.Base{ID: 2}, ParentID: 1}
+	input := &entity.Product{ParentID: 2, SKU: "TS-M-RED-X", Options: map[string]string{"fit": "slim"}}
+
+	mockProduct.EXPECT().FindByID(ctx, uint32(2)).Return(parent, nil)
+
+	productService := service.NewProductService(service.Properties{Repo: mockRepo})
+	_, err := productService.Create(ctx, input)
+
+	ex, ok := exception.GetException(err)
+	assert.True(t, ok)
+	assert.Equal(t, exception.TypeValidationError, ex.Type)
+	assert.Contains(t, ex.Errors, "parent_id")
+	mockProduct.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestProductServiceDeleteWithVariants(t *testing.T) {
+	mockRepo, mockPostgres, mockProduct := setupProductMocks(t)
+	expectProductAtomic(mockPostgres)
+
+	ctx := context.Background()
+
+	mockProduct.EXPECT().
+		Find(ctx, &postgresrepository.FilterProductPayload{ParentIDs: []uint32{1}}).
+		Return([]*entity.Product{{Base: entity.Base{ID: 2}, ParentID: 1}}, 1, nil)
+
+	productService := service.NewProductService(service.Properties{Repo: mockRepo})
+	err := productService.Delete(ctx, 1)
+
+	ex, ok := exception.GetException(err)
+	assert.True(t, ok)
+	assert.Equal(t, exception.TypeConflict, ex.Type)
+	mockProduct.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+}
+
+func TestProductServiceFindByIDKitAvailability(t *testing.T) {
+	mockRepo, mockPostgres, mockProduct := setupProductMocks(t)
+	mockKitComponent := setupKitComponentMock(t, mockPostgres)
+
+	ctx := context.Background()
+	kit := &entity.Product{Base: entity.Base{ID: 1}, Name: "Starter kit"}
+	mockProductUnit := setupProductUnitMock(t, mockPostgres)
+	components := []*entity.KitComponent{
+		{KitID: 1, ComponentID: 2, Quantity: 2, Component: &entity.Product{Base: entity.Base{ID: 2}, AvailableStock: 9}},
+		{KitID: 1, ComponentID: 3, Quantity: 1, Component: &entity.Product{Base: entity.Base{ID: 3}, AvailableStock: 7}},
+	}
+
+	mockProduct.EXPECT().FindByID(ctx, uint32(1)).Return(kit, nil)
+	mockProductUnit.EXPECT().FindByProductIDs(ctx, []uint32{1}).Return([]*entity.ProductUnit{}, nil)
+	mockProduct.EXPECT().
+		Find(ctx, &postgresrepository.FilterProductPayload{ParentIDs: []uint32{1}}).
+		Return([]*entity.Product{}, 0, nil)
+	mockKitComponent.EXPECT().FindByKitIDs(ctx, []uint32{1}).Return(components, nil)
+
+	productService := service.NewProductService(service.Properties{Repo: mockRepo})
+	result, err := productService.FindByID(ctx, 1)
+
+	assert.NoError(t, err)
+	assert.Len(t, result.Components, 2)
+	// The first component allows 9/2 = 4 kits, the second 7.
+	assert.Equal(t, 4, result.AvailableStock)
+}
+
+func TestProductServiceCreateKit(t *testing.T) {
+	mockRepo, mockPostgres, mockProduct := setupProductMocks(t)
+	mockKitComponent := setupKitComponentMock(t, mockPostgres)
+	mockPriceChange := setupPriceChangeMock(t, mockPostgres)
+	expectProductAtomic(mockPostgres)
+
+	ctx := context.Background()
+	components := []*entity.KitComponent{{ComponentID: 2, Quantity: 2}, {ComponentID: 3, Quantity: 1}}
+	input := &entity.Product{SKU: "KIT-1", Name: "Starter kit", Components: components}
+	created := &entity.Product{Base: entity.Base{ID: 10}, SKU: "KIT-1", Name: "Starter kit"}
+
+	mockProduct.EXPECT().
+		Find(ctx, &postgresrepository.FilterProductPayload{IDs: []uint32{2, 3}}).
+		Return([]*entity.Product{{Base: entity.Base{ID: 2}}, {Base: entity.Base{ID: 3}}}, 2, nil)
+	mockProduct.EXPECT().
+		Find(ctx, &postgresrepository.FilterProductPayload{ParentIDs: []uint32{2, 3}}).
+		Return([]*entity.Product{}, 0, nil)
+	mockKitComponent.EXPECT().FindByKitIDs(ctx, []uint32{2, 3}).Return([]*entity.KitComponent{}, nil)
+	mockProduct.EXPECT().Create(ctx, input).Return(created, nil)
+	mockKitComponent.EXPECT().Replace(ctx, uint32(10), components).Return(nil)
+	mockPriceChange.EXPECT().Record(ctx, uint32(10), created.Price).Return(nil)
+
+	productService := service.NewProductService(service.Properties{Repo: mockRepo})
+	result, err := productService.Create(ctx, input)
+
+	assert.NoError(t, err)
+	assert.Len(t, result.Components, 2)
+}
+
+func TestProductServiceCreateKitDuplicateComponent(t *testing.T) {
+	mockRepo, mockPostgres, mockProduct := setupProductMocks(t)
+	expectProductAtomic(mockPostgres)
+
+	ctx := context.Background()
+	input := &entity.Product{SKU: "KIT-1", Name: "Kit", Components: []*entity.KitComponent{
+		{ComponentID: 2, Quantity: 1},
+		{ComponentID: 2, Quantity: 3},
+	}}
+
+	productService := service.NewProductService(service.Properties{Repo: mockRepo})
+	_, err := productService.Create(ctx, input)
+
+	ex, ok := exception.GetException(err)
+	assert.True(t, ok)
+	assert.Equal(t, exception.TypeValidationError, ex.Type)
+	assert.Contains(t, ex.Errors, "components")
+	mockProduct.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestProductServiceFindLotTrackedAvailability(t *testing.T) {
+	mockRepo, mockPostgres, mockProduct := setupProductMocks(t)
+	mockLot := mocks.NewMockLotRepository(t)
+	mockPostgres.EXPECT().Lot().Return(mockLot)
+
+	ctx := context.Background()
+	filter := &postgresrepository.FilterProductPayload{Page: 1, PerPage: 10}
+	products := []*entity.Product{
+		{Base: entity.Base{ID: 1}, Stock: 100, AvailableStock: 100, TrackLots: true},
+		{Base: entity.Base{ID: 2}, Stock: 5, AvailableStock: 5},
+	}
+
+	mockProduct.EXPECT().Find(ctx, filter).Return(products, 2, nil)
+	mockLot.EXPECT().SumAvailable(ctx, []uint32{1}).Return(map[uint32]int{1: 12}, nil)
+
+	productService := service.NewProductService(service.Properties{Repo: mockRepo})
+	result, _, err := productService.Find(ctx, filter)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 12, result[0].AvailableStock)
+	assert.Equal(t, 5, result[1].AvailableStock)
+}
+
+func TestProductServiceFindSerializedAvailability(t *testing.T) {
+	mockRepo, mockPostgres, mockProduct := setupProductMocks(t)
+	mockSerial := mocks.NewMockSerialRepository(t)
+	mockPostgres.EXPECT().Serial().Return(mockSerial)
+
+	ctx := context.Background()
+	filter := &postgresrepository.FilterProductPayload{Page: 1, PerPage: 10}
+	products := []*entity.Product{
+		{Base: entity.Base{ID: 1}, Stock: 100, AvailableStock: 100, TrackSerials: true},
+		{Base: entity.Base{ID: 2}, Stock: 5, AvailableStock: 5},
+	}
+
+	mockProduct.EXPECT().Find(ctx, filter).Return(products, 2, nil)
+	mockSerial.EXPECT().CountAvailable(ctx, []uint32{1}).Return(map[uint32]int{1: 3}, nil)
+
+	productService := service.NewProductService(service.Properties{Repo: mockRepo})
+	result, _, err := productService.Find(ctx, filter)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, result[0].AvailableStock)
+	assert.Equal(t, 5, result[1].AvailableStock)
+}
+
+func TestProductServiceCreateLotsAndSerials(t *testing.T) {
+	mockRepo, _, mockProduct := setupProductMocks(t)
+	ctx := context.Background()
+	input := &entity.Product{Name: "Laptop", SKU: "LAP-1", TrackLots: true, TrackSerials: true}
+
+	productService := service.NewProductService(service.Properties{Repo: mockRepo, Config: &config.Config{}})
+	_, err := productService.Create(ctx, input)
+
+	ex, ok := exception.GetException(err)
+	assert.True(t, ok)
+	assert.Equal(t, exception.CodeValidationFailed, ex.Code)
+	assert.Contains(t, ex.Errors, "track_serials")
+	mockProduct.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestProductServiceCreateInvalidUnits(t *testing.T) {
+	tests := []struct {
+		name  string
+		units []*entity.ProductUnit
+	}{
+		{name: "base unit", units: []*entity.ProductUnit{{Unit: "each", Factor: 1}}},
+		{name: "unknown unit", units: []*entity.ProductUnit{{Unit: "crate", Factor: 12}}},
+		{name: "zero factor", units: []*entity.ProductUnit{{Unit: "case", Factor: 0}}},
+		{name: "duplicate unit", units: []*entity.ProductUnit{{Unit: "case", Factor: 24}, {Unit: "case", Factor: 12}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo, _, mockProduct := setupProductMocks(t)
+			input := &entity.Product{Name: "Soda", SKU: "SODA-1", Units: tt.units}
+
+			productService := service.NewProductService(service.Properties{Repo: mockRepo, Config: &config.Config{}})
+			_, err := productService.Create(context.Background(), input)
+
+			ex, ok := exception.GetException(err)
+			assert.True(t, ok)
+			assert.Contains(t, ex.Errors, "units")
+			mockProduct.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestProductServiceCreateWithUnits(t *testing.T) {
+	mockRepo, mockPostgres, mockProduct := setupProductMocks(t)
+	mockProductUnit := setupProductUnitMock(t, mockPostgres)
+	mockPriceChange := setupPriceChangeMock(t, mockPostgres)
+	expectProductAtomic(mockPostgres)
+
+	ctx := context.Background()
+	units := []*entity.ProductUnit{{Unit: "pack", Factor: 6}, {Unit: "case", Factor: 24}}
+	input := &entity.Product{Name: "Soda", SKU: "SODA-1", Units: units}
+
+	mockProduct.EXPECT().Create(ctx, input).Return(&entity.Product{Base: entity.Base{ID: 5}, Name: "Soda"}, nil)
+	mockProductUnit.EXPECT().Replace(ctx, uint32(5), units).Return(nil)
+	mockPriceChange.EXPECT().Record(ctx, uint32(5), entity.Money{}).Return(nil)
+
+	productService := service.NewProductService(service.Properties{Repo: mockRepo, Config: &config.Config{}})
+	result, err := productService.Create(ctx, input)
+
+	assert.NoError(t, err)
+	assert.Len(t, result.Units, 2)
+}
+
+func TestProductServiceAdjustStockInCases(t *testing.T) {
+	mockRepo, mockPostgres, mockProduct := setupProductMocks(t)
+	mockProductUnit := setupProductUnitMock(t, mockPostgres)
+	expectProductAtomic(mockPostgres)
+
+	ctx := context.Background()
+	product := &entity.Product{Base: entity.Base{ID: 5}, Stock: 10}
+
+	mockProduct.EXPECT().FindByID(ctx, uint32(5)).Return(product, nil).Once()
+	mockProductUnit.EXPECT().FindByProductIDs(ctx, []uint32{5}).Return([]*entity.ProductUnit{
+		{ProductID: 5, Unit: "case", Factor: 24},
+	}, nil)
+	mockProduct.EXPECT().ReleaseStock(ctx, uint32(5), 48).Return(nil)
+	mockProduct.EXPECT().FindByID(ctx, uint32(5)).Return(&entity.Product{Base: entity.Base{ID: 5}, Stock: 58}, nil).Once()
+
+	productService := service.NewProductService(service.Properties{Repo: mockRepo, Config: &config.Config{}})
+	result, err := productService.AdjustStock(ctx, &entity.StockAdjustment{ProductID: 5, Quantity: 2, Unit: "case"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 48, result.BaseQuantity)
+	assert.Equal(t, 58, result.Product.Stock)
+}
+
+func TestProductServiceAdjustStockRemovesBelowZero(t *testing.T) {
+	mockRepo, mockPostgres, mockProduct := setupProductMocks(t)
+	expectProductAtomic(mockPostgres)
+
+	ctx := context.Background()
+
+	mockProduct.EXPECT().FindByID(ctx, uint32(5)).Return(&entity.Product{Base: entity.Base{ID: 5}, Stock: 3}, nil)
+	mockProduct.EXPECT().ReserveStock(ctx, uint32(5), 4).
+		Return(errors.Wrap(exception.ErrInsufficientStock, "insufficient stock for product 5"))
+
+	productService := service.NewProductService(service.Properties{Repo: mockRepo, Config: &config.Config{}})
+	_, err := productService.AdjustStock(ctx, &entity.StockAdjustment{ProductID: 5, Quantity: -4})
+
+	ex, ok := exception.GetException(err)
+	assert.True(t, ok)
+	assert.Equal(t, exception.CodeInsufficientStock, ex.Code)
+}
+
+func TestProductServiceAdjustStockUnknownUnit(t *testing.T) {
+	mockRepo, mockPostgres, mockProduct := setupProductMocks(t)
+	mockProductUnit := setupProductUnitMock(t, mockPostgres)
+	expectProductAtomic(mockPostgres)
+
+	ctx := context.Background()
+
+	mockProduct.EXPECT().FindByID(ctx, uint32(5)).Return(&entity.Product{Base: entity.Base{ID: 5}}, nil)
+	mockProductUnit.EXPECT().FindByProductIDs(ctx, []uint32{5}).Return([]*entity.ProductUnit{}, nil)
+
+	productService := service.NewProductService(service.Properties{Repo: mockRepo, Config: &config.Config{}})
+	_, err := productService.AdjustStock(ctx, &entity.StockAdjustment{ProductID: 5, Quantity: 1, Unit: "pallet"})
+
+	ex, ok := exception.GetException(err)
+	assert.True(t, ok)
+	assert.Contains(t, ex.Errors, "unit")
+	mockProduct.AssertNotCalled(t, "ReleaseStock", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestProductServiceCreateInvalidPrice(t *testing.T) {
+	tests := []struct {
+		name  string
+		price entity.Money
+		field string
+	}{
+		{name: "too many decimals", price: entity.Money{Currency: "USD", Units: 19, Nanos: 989_999_999}, field: "price"},
+		{name: "decimals on yen", price: entity.Money{Currency: "JPY", Units: 500, Nanos: 500_000_000}, field: "price"},
+		{name: "negative", price: entity.Money{Currency: "USD", Units: -1}, field: "price"},
+		{name: "mixed signs", price: entity.Money{Currency: "USD", Units: 1, Nanos: -500_000_000}, field: "price"},
+		{name: "too large", price: entity.Money{Currency: "USD", Units: 100_000_000}, field: "price"},
+		{name: "unknown currency", price: entity.Money{Currency: "XYZ", Units: 1}, field: "currency"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo, _, mockProduct := setupProductMocks(t)
+			input := &entity.Product{Name: "Mug", SKU: "MUG-1", Price: tt.price}
+
+			productService := service.NewProductService(service.Properties{Repo: mockRepo, Config: &config.Config{}})
+			_, err := productService.Create(context.Background(), input)
+
+			ex, ok := exception.GetException(err)
+			assert.True(t, ok)
+			assert.Contains(t, ex.Errors, tt.field)
+			mockProduct.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestProductServiceCreateDefaultsCurrency(t *testing.T) {
+	mockRepo, mockPostgres, mockProduct := setupProductMocks(t)
+	mockPriceChange := setupPriceChangeMock(t, mockPostgres)
+	expectProductAtomic(mockPostgres)
+
+	ctx := context.Background()
+	price, err := entity.ParseMoney("19.99", "")
+	assert.NoError(t, err)
+
+	input := &entity.Product{Name: "Mug", SKU: "MUG-1", Price: price}
+
+	mockProduct.EXPECT().Create(ctx, input).Return(input, nil)
+	mockPriceChange.EXPECT().Record(ctx, input.ID, entity.Money{Currency: "USD", Units: 19, Nanos: 990_000_000}).Return(nil)
+
+	productService := service.NewProductService(service.Properties{Repo: mockRepo, Config: &config.Config{}})
+	result, err := productService.Create(ctx, input)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "USD", result.Price.Currency)
+	assert.Equal(t, entity.Money{Currency: "USD", Units: 19, Nanos: 990_000_000}, result.Price)
+	assert.Equal(t, "19.99", result.Price.String())
+}
+
+func TestProductServiceUpdateStatus(t *testing.T) {
+	tests := []struct {
+		name    string
+		from    string
+		to      string
+		wantErr bool
+	}{
+		{name: "publish draft", from: constant.ProductStatusDraft, to: constant.ProductStatusActive},
+		{name: "discontinue active", from: constant.ProductStatusActive, to: constant.ProductStatusDiscontinued},
+		{name: "reactivate discontinued", from: constant.ProductStatusDiscontinued, to: constant.ProductStatusActive},
+		{name: "active back to draft", from: constant.ProductStatusActive, to: constant.ProductStatusDraft, wantErr: true},
+		{name: "discontinued back to draft", from: constant.ProductStatusDiscontinued, to: constant.ProductStatusDraft, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo, mockPostgres, mockProduct := setupProductMocks(t)
+			expectProductAtomic(mockPostgres)
+
+			ctx := context.Background()
+
+			mockProduct.EXPECT().FindByID(ctx, uint32(1)).Return(&entity.Product{Base: entity.Base{ID: 1}, Status: tt.from}, nil)
+			if !tt.wantErr {
+				mockProduct.EXPECT().UpdateStatus(ctx, uint32(1), tt.to).Return(nil)
+			}
+
+			productService := service.NewProductService(service.Properties{Repo: mockRepo, Config: &config.Config{}})
+			result, err := productService.UpdateStatus(ctx, 1, tt.to)
+
+			if tt.wantErr {
+				ex, ok := exception.GetException(err)
+				assert.True(t, ok)
+				assert.Contains(t, ex.Errors, "status")
+				mockProduct.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.to, result.Status)
+		})
+	}
+}
+
+func TestProductServiceCreateDiscontinued(t *testing.T) {
+	mockRepo, _, mockProduct := setupProductMocks(t)
+	input := &entity.Product{Name: "Mug", SKU: "MUG-1", Status: constant.ProductStatusDiscontinued}
+
+	productService := service.NewProductService(service.Properties{Repo: mockRepo, Config: &config.Config{}})
+	_, err := productService.Create(context.Background(), input)
+
+	ex, ok := exception.GetException(err)
+	assert.True(t, ok)
+	assert.Contains(t, ex.Errors, "status")
+	mockProduct.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestProductServiceCreateInvalidAttributes(t *testing.T) {
+	tests := []struct {
+		name       string
+		attributes map[string]any
+		field      string
+	}{
+		{name: "dotted key", attributes: map[string]any{"power.rating": 5.0}, field: "attributes"},
+		{name: "negative weight", attributes: map[string]any{"weight": -1.5}, field: "attributes.weight"},
+		{name: "weight as text", attributes: map[string]any{"weight": "2kg"}, field: "attributes.weight"},
+		{name: "missing height", attributes: map[string]any{"dimensions": map[string]any{"length": 10.0, "width": 5.0}}, field: "attributes.dimensions.height"},
+		{name: "dimensions not an object", attributes: map[string]any{"dimensions": "10x5x2"}, field: "attributes.dimensions.length"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo, _, mockProduct := setupProductMocks(t)
+			input := &entity.Product{Name: "Kettle", SKU: "KET-1", Attributes: tt.attributes}
+
+			productService := service.NewProductService(service.Properties{Repo: mockRepo, Config: &config.Config{}})
+			_, err := productService.Create(context.Background(), input)
+
+			ex, ok := exception.GetException(err)
+			assert.True(t, ok)
+			assert.Contains(t, ex.Errors, tt.field)
+			mockProduct.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestProductServiceCreateWithShippingAttributes(t *testing.T) {
+	mockRepo, mockPostgres, mockProduct := setupProductMocks(t)
+	mockPriceChange := setupPriceChangeMock(t, mockPostgres)
+	expectProductAtomic(mockPostgres)
+
+	ctx := context.Background()
+	attributes := map[string]any{
+		"material":   "steel",
+		"voltage":    230.0,
+		"weight":     1.2,
+		"dimensions": map[string]any{"length": 22.0, "width": 16.0, "height": 25.0},
+	}
+	input := &entity.Product{Name: "Kettle", SKU: "KET-1", Attributes: attributes}
+
+	mockProduct.EXPECT().Create(ctx, input).Return(&entity.Product{Base: entity.Base{ID: 7}, Attributes: attributes}, nil)
+	mockPriceChange.EXPECT().Record(ctx, uint32(7), entity.Money{}).Return(nil)
+
+	productService := service.NewProductService(service.Properties{Repo: mockRepo, Config: &config.Config{}})
+	result, err := productService.Create(ctx, input)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "steel", result.Attributes["material"])
+}
+
+func TestProductServiceFindInvalidAttributeFilter(t *testing.T) {
+	mockRepo, _, mockProduct := setupProductMocks(t)
+	minWeight, maxWeight := 5.0, 1.0
+
+	filters := [][]*postgresrepository.AttributeFilter{
+		{{Key: "dimensions..length", Exists: true}},
+		{{Key: "weight", Min: &minWeight, Max: &maxWeight}},
+	}
+
+	productService := service.NewProductService(service.Properties{Repo: mockRepo})
+
+	for _, attributes := range filters {
+		_, _, err := productService.Find(context.Background(), &postgresrepository.FilterProductPayload{Attributes: attributes})
+
+		ex, ok := exception.GetException(err)
+		assert.True(t, ok)
+		assert.Contains(t, ex.Errors, "attributes")
+	}
+
+	mockProduct.AssertNotCalled(t, "Find", mock.Anything, mock.Anything)
+}
+
+func TestProductServiceExport(t *testing.T) {
+	mockRepo, mockPostgres, mockProduct := setupProductMocks(t)
+	mockLot := mocks.NewMockLotRepository(t)
+	mockPostgres.EXPECT().Lot().Return(mockLot).Maybe()
+
+	ctx := context.Background()
+	filter := &postgresrepository.FilterProductPayload{}
+	streamed := []*entity.Product{
+		{Base: entity.Base{ID: 1}, Stock: 5, AvailableStock: 5},
+		{Base: entity.Base{ID: 2}, TrackLots: true},
+	}
+
+	mockProduct.EXPECT().Stream(ctx, filter, mock.Anything).
+		RunAndReturn(func(ctx context.Context, filter *postgresrepository.FilterProductPayload, fn func(*entity.Product) error) error {
+			for _, product := range streamed {
+				if err := fn(product); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+	mockLot.EXPECT().SumAvailable(ctx, []uint32{2}).Return(map[uint32]int{2: 7}, nil)
+
+	var exported []*entity.Product
+
+	productService := service.NewProductService(service.Properties{Repo: mockRepo})
+	err := productService.Export(ctx, filter, func(product *entity.Product) error {
+		exported = append(exported, product)
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, exported, 2)
+	assert.Equal(t, 7, exported[1].AvailableStock)
+}
+
+func TestProductServiceExportStopsOnCallbackError(t *testing.T) {
+	mockRepo, _, mockProduct := setupProductMocks(t)
+
+	ctx := context.Background()
+	writeErr := errors.New("broken pipe")
+
+	mockProduct.EXPECT().Stream(ctx, mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, filter *postgresrepository.FilterProductPayload, fn func(*entity.Product) error) error {
+			return fn(&entity.Product{Base: entity.Base{ID: 1}})
+		})
+
+	productService := service.NewProductService(service.Properties{Repo: mockRepo})
+	err := productService.Export(ctx, &postgresrepository.FilterProductPayload{}, func(*entity.Product) error {
+		return writeErr
+	})
+
+	assert.ErrorIs(t, err, writeErr)
+	_, isException := exception.GetException(err)
+	assert.False(t, isException)
 }
@@ -2,17 +2,37 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"inventory-service/constant"
 	postgresrepository "inventory-service/internal/adapter/repository/postgres"
 	"inventory-service/internal/domain/entity"
+	serviceerror "inventory-service/internal/domain/service/error"
+	"inventory-service/internal/shared/exception"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
 )
 
 var _ ReservationService = (*reservationService)(nil)
 
+// reservationStatusTransitions lists the statuses each reservation status may
+// move to. Confirmed reservations can still be cancelled, which releases their
+// stock; cancelled ones are final.
+var reservationStatusTransitions = map[string][]string{
+	constant.ReservationStatusPending:   {constant.ReservationStatusConfirmed, constant.ReservationStatusCancelled},
+	constant.ReservationStatusConfirmed: {constant.ReservationStatusCancelled},
+	constant.ReservationStatusCancelled: {},
+}
+
 type ReservationService interface {
 	Find(ctx context.Context, filter *postgresrepository.FilterReservationPayload) ([]*entity.Reservation, int, error)
+	Export(ctx context.Context, filter *postgresrepository.FilterReservationPayload, fn func(*entity.Reservation) error) error
 	FindByID(ctx context.Context, id uint32) (*entity.Reservation, error)
 	Create(ctx context.Context, reservation *entity.Reservation) (*entity.Reservation, error)
 	UpdateStatus(ctx context.Context, ids []uint32, status string) error
+	ExpireDue(ctx context.Context, limit int) (int, error)
 }
 
 type reservationService struct {
@@ -24,25 +44,139 @@ func NewReservationService(props Properties) *reservationService {
 }
 
 func (s *reservationService) Find(ctx context.Context, filter *postgresrepository.FilterReservationPayload) ([]*entity.Reservation, int, error) {
-	return s.Repo.Postgres().Reservation().Find(ctx, filter)
+	reservations, total, err := s.Repo.Postgres().Reservation().Find(ctx, filter)
+	if err != nil {
+		return nil, 0, serviceerror.TranslateRepoError(err)
+	}
+
+	return reservations, total, nil
+}
+
+// Export calls fn for every reservation matching the filter, in id order,
+// streaming them from the database. Errors returned by fn are passed through.
+func (s *reservationService) Export(ctx context.Context, filter *postgresrepository.FilterReservationPayload, fn func(*entity.Reservation) error) error {
+	var fnErr error
+
+	err := s.Repo.Postgres().Reservation().Stream(ctx, filter, func(reservation *entity.Reservation) error {
+		fnErr = fn(reservation)
+		return fnErr
+	})
+	if fnErr != nil {
+		return fnErr
+	}
+
+	return serviceerror.TranslateRepoError(err)
 }
 
 func (s *reservationService) FindByID(ctx context.Context, id uint32) (*entity.Reservation, error) {
-	return s.Repo.Postgres().Reservation().FindByID(ctx, id)
+	reservation, err := s.Repo.Postgres().Reservation().FindByID(ctx, id)
+	if err != nil {
+		return nil, serviceerror.TranslateRepoError(err)
+	}
+
+	components, _, err := s.Repo.Postgres().Reservation().Find(ctx, &postgresrepository.FilterReservationPayload{
+		ParentIDs: []uint32{id},
+	})
+	if err != nil {
+		return nil, serviceerror.TranslateRepoError(err)
+	}
+
+	if len(components) > 0 {
+		reservation.Components = components
+	}
+
+	lots, err := s.Repo.Postgres().Lot().FindAllocations(ctx, []uint32{id})
+	if err != nil {
+		return nil, serviceerror.TranslateRepoError(err)
+	}
+
+	if len(lots) > 0 {
+		reservation.Lots = lots
+	}
+
+	serials, _, err := s.Repo.Postgres().Serial().Find(ctx, &postgresrepository.FilterSerialPayload{
+		ReservationIDs: []uint32{id},
+	})
+	if err != nil {
+		return nil, serviceerror.TranslateRepoError(err)
+	}
+
+	if len(serials) > 0 {
+		reservation.Serials = serials
+	}
+
+	return reservation, nil
 }
 
 func (s *reservationService) Create(ctx context.Context, reservation *entity.Reservation) (*entity.Reservation, error) {
 	var createdReservation *entity.Reservation
 
+	if reservation != nil {
+		if reservation.Unit == "" {
+			reservation.Unit = constant.UnitEach
+		}
+
+		if reservation.UnitQuantity == 0 {
+			reservation.UnitQuantity = reservation.Quantity
+		}
+
+		if reservation.UnitQuantity <= 0 {
+			return nil, invalidReservationQuantityError("Quantity must be greater than zero")
+		}
+	}
+
 	atomic := func(txRepo postgresrepository.PostgresRepository) error {
-		var err error
-		createdReservation, err = txRepo.Reservation().Create(ctx, reservation)
-		return err
+		product, err := validateReservationTarget(ctx, txRepo, reservation)
+		if err != nil {
+			return err
+		}
+
+		factor, err := unitFactor(ctx, txRepo, reservation.ProductID, reservation.Unit)
+		if err != nil {
+			return err
+		}
+
+		reservation.Quantity = reservation.UnitQuantity * factor
+
+		if err := s.validateReservationQuantity(reservation.Quantity); err != nil {
+			return err
+		}
+
+		if reservation.Status == "" {
+			reservation.Status = constant.ReservationStatusPending
+		}
+
+		components, err := txRepo.KitComponent().FindByKitIDs(ctx, []uint32{reservation.ProductID})
+		if err != nil {
+			return err
+		}
+
+		if len(components) == 0 {
+			createdReservation, err = txRepo.Reservation().Create(ctx, reservation)
+			if err != nil {
+				return err
+			}
+
+			if err := holdStock(ctx, txRepo, product, createdReservation); err != nil {
+				return err
+			}
+		} else {
+			createdReservation, err = reserveKit(ctx, txRepo, reservation, components)
+			if err != nil {
+				return err
+			}
+		}
+
+		if err := s.recordEvents(ctx, txRepo, reservationCreatedEvents(createdReservation)...); err != nil {
+			return err
+		}
+
+		return s.recordAudit(ctx, txRepo, reservationAuditEvents(ctx, createdReservation)...)
 	}
 
 	err := s.Repo.Postgres().Atomic(ctx, s.Config, atomic)
 	if err != nil {
-		return nil, err
+		return nil, serviceerror.TranslateRepoError(err)
 	}
 
 	return createdReservation, nil
@@ -50,13 +184,479 @@ func (s *reservationService) Create(ctx context.Context, reservation *entity.Res
 
 func (s *reservationService) UpdateStatus(ctx context.Context, ids []uint32, status string) error {
 	atomic := func(txRepo postgresrepository.PostgresRepository) error {
-		return txRepo.Reservation().UpdateStatus(ctx, ids, status)
+		return s.updateReservationStatus(ctx, txRepo, ids, status)
+	}
+
+	err := s.Repo.Postgres().Atomic(ctx, s.Config, atomic)
+	if err != nil {
+		return serviceerror.TranslateRepoError(err)
+	}
+
+	return nil
+}
+
+// ExpireDue cancels pending reservations older than the configured
+// reservation TTL, handling at most limit of them, and returns how many
+// expired. Their stock is released and an expired event is recorded in the
+// same transaction. Nothing expires when no TTL is configured.
+func (s *reservationService) ExpireDue(ctx context.Context, limit int) (int, error) {
+	if s.Config == nil || s.Config.App == nil || s.Config.App.ReservationTTL <= 0 {
+		return 0, nil
+	}
+
+	ttl := time.Duration(s.Config.App.ReservationTTL) * time.Second
+
+	var expired int
+
+	atomic := func(txRepo postgresrepository.PostgresRepository) error {
+		due, err := txRepo.Reservation().FindExpired(ctx, time.Now().Add(-ttl), limit)
+		if err != nil {
+			return err
+		}
+
+		if len(due) == 0 {
+			return nil
+		}
+
+		ids := make([]uint32, len(due))
+		for i, reservation := range due {
+			ids[i] = reservation.ID
+		}
+
+		if err := s.changeReservationStatus(ctx, txRepo, ids, constant.ReservationStatusCancelled, constant.EventReservationExpired); err != nil {
+			return err
+		}
+
+		expired = len(due)
+
+		return nil
 	}
 
 	err := s.Repo.Postgres().Atomic(ctx, s.Config, atomic)
+	if err != nil {
+		return 0, serviceerror.TranslateRepoError(err)
+	}
+
+	return expired, nil
+}
+
+// updateReservationStatus moves reservations, and the components of kit
+// reservations, to status within txRepo's transaction. Cancelling releases
+// the stock they hold; confirming assigns their serials to the order.
+func (p Properties) updateReservationStatus(ctx context.Context, txRepo postgresrepository.PostgresRepository, ids []uint32, status string) error {
+	var eventType string
+
+	switch status {
+	case constant.ReservationStatusConfirmed:
+		eventType = constant.EventReservationConfirmed
+	case constant.ReservationStatusCancelled:
+		eventType = constant.EventReservationCancelled
+	}
+
+	return p.changeReservationStatus(ctx, txRepo, ids, status, eventType)
+}
+
+// changeReservationStatus is updateReservationStatus recording eventType for
+// the requested reservations that change status; no event is recorded for
+// an empty eventType.
+func (p Properties) changeReservationStatus(
+	ctx context.Context,
+	txRepo postgresrepository.PostgresRepository,
+	ids []uint32,
+	status, eventType string,
+) error {
+	if _, ok := reservationStatusTransitions[status]; !ok {
+		return invalidStatusError("Status must be one of PENDING, CONFIRMED or CANCELLED")
+	}
+
+	reservations, _, err := txRepo.Reservation().Find(ctx, &postgresrepository.FilterReservationPayload{IDs: ids})
+	if err != nil {
+		return err
+	}
+
+	components, _, err := txRepo.Reservation().Find(ctx, &postgresrepository.FilterReservationPayload{ParentIDs: ids})
 	if err != nil {
 		return err
 	}
 
+	// Kit reservations hold no stock themselves; their components do.
+	kits := make(map[uint32]bool, len(components))
+	allIDs := slices.Clone(ids)
+
+	// A component may also be among ids; it is changed once, not twice.
+	affected := slices.Clone(reservations)
+	seen := make(map[uint32]bool, len(reservations)+len(components))
+
+	for _, reservation := range reservations {
+		seen[reservation.ID] = true
+	}
+
+	for _, component := range components {
+		kits[component.ParentID] = true
+
+		if seen[component.ID] {
+			continue
+		}
+
+		seen[component.ID] = true
+		affected = append(affected, component)
+		allIDs = append(allIDs, component.ID)
+	}
+
+	var releasable []*entity.Reservation
+	var confirmable []uint32
+	var audits []*entity.AuditEvent
+
+	for _, reservation := range affected {
+		if reservation.Status == status {
+			continue
+		}
+
+		if !slices.Contains(reservationStatusTransitions[reservation.Status], status) {
+			return invalidStatusError(fmt.Sprintf("Reservation %d cannot change from %s to %s", reservation.ID, reservation.Status, status))
+		}
+
+		after := reservationAuditState(reservation)
+		after["status"] = status
+		audits = append(audits, newAuditEvent(ctx, constant.AuditEntityReservation, reservation.ID, constant.AuditActionStatusChanged,
+			reservationAuditState(reservation), after))
+
+		if kits[reservation.ID] {
+			continue
+		}
+
+		switch status {
+		case constant.ReservationStatusCancelled:
+			releasable = append(releasable, reservation)
+		case constant.ReservationStatusConfirmed:
+			confirmable = append(confirmable, reservation.ID)
+		}
+	}
+
+	if err := releaseStock(ctx, txRepo, releasable); err != nil {
+		return err
+	}
+
+	if err := p.recordEvents(ctx, txRepo, reservationStatusEvents(reservations, releasable, status, eventType)...); err != nil {
+		return err
+	}
+
+	if err := p.recordAudit(ctx, txRepo, audits...); err != nil {
+		return err
+	}
+
+	// Confirming hands the serials held by serialized reservations to the order.
+	if len(confirmable) > 0 {
+		if err := txRepo.Serial().Assign(ctx, confirmable); err != nil {
+			return err
+		}
+	}
+
+	return txRepo.Reservation().UpdateStatus(ctx, allIDs, status)
+}
+
+// reservationCreatedEvents describes a new reservation and the stock it took,
+// which for a kit is taken from its components.
+func reservationCreatedEvents(reservation *entity.Reservation) []*entity.OutboxEvent {
+	events := []*entity.OutboxEvent{reservationEvent(constant.EventReservationCreated, reservation)}
+
+	held := reservation.Components
+	if len(held) == 0 {
+		held = []*entity.Reservation{reservation}
+	}
+
+	for _, r := range held {
+		events = append(events, stockChangedEvent(r.ProductID, -r.Quantity, stockChangeReserved))
+	}
+
+	return events
+}
+
+// reservationStatusEvents describes, as eventType, the requested reservations
+// that change status, and the stock released by cancelling them.
+func reservationStatusEvents(reservations, released []*entity.Reservation, status, eventType string) []*entity.OutboxEvent {
+	events := make([]*entity.OutboxEvent, 0, len(reservations)+len(released))
+
+	for _, reservation := range reservations {
+		if eventType != "" && reservation.Status != status {
+			events = append(events, reservationEvent(eventType, reservation))
+		}
+	}
+
+	for _, reservation := range released {
+		events = append(events, stockChangedEvent(reservation.ProductID, reservation.Quantity, stockChangeReleased))
+	}
+
+	return events
+}
+
+// validateReservationTarget loads the reserved product and rejects parent
+// products: stock is held by their variants, so the caller has to pick one.
+func validateReservationTarget(
+	ctx context.Context,
+	txRepo postgresrepository.PostgresRepository,
+	reservation *entity.Reservation,
+) (*entity.Product, error) {
+	if reservation == nil {
+		return nil, exception.ErrDataNull
+	}
+
+	product, err := txRepo.Product().FindByID(ctx, reservation.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, variants, err := txRepo.Product().Find(ctx, &postgresrepository.FilterProductPayload{
+		ParentIDs: []uint32{reservation.ProductID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if variants > 0 {
+		return nil, exception.NewWithErrors(exception.TypeValidationError, exception.CodeValidationFailed, "Reservation must target a variant", exception.FieldErrors{
+			"product_id": {"Product has variants; reserve a specific variant instead"},
+		})
+	}
+
+	if err := checkReservable(product); err != nil {
+		return nil, err
+	}
+
+	return product, nil
+}
+
+// validateReservationQuantity caps the base units a single reservation may
+// hold, whatever unit it was made in.
+func (s *reservationService) validateReservationQuantity(quantity int) error {
+	maxQuantity := constant.DefaultMaxReservationQuantity
+	if s.Config != nil && s.Config.App != nil && s.Config.App.MaxReservationQuantity > 0 {
+		maxQuantity = s.Config.App.MaxReservationQuantity
+	}
+
+	if quantity > maxQuantity {
+		return invalidReservationQuantityError(fmt.Sprintf("A reservation cannot hold more than %d units", maxQuantity))
+	}
+
+	return nil
+}
+
+func invalidReservationQuantityError(message string) error {
+	return exception.NewWithErrors(exception.TypeValidationError, exception.CodeValidationFailed, "Invalid reservation", exception.FieldErrors{
+		"quantity": {message},
+	})
+}
+
+// checkReservable rejects draft and discontinued products.
+func checkReservable(product *entity.Product) error {
+	if product == nil {
+		return nil
+	}
+
+	if product.Status == constant.ProductStatusDraft || product.Status == constant.ProductStatusDiscontinued {
+		return exception.Newf(exception.TypeFailedPrecondition, exception.CodeProductNotActive, "Product %d is %s and cannot be reserved", product.ID, strings.ToLower(product.Status))
+	}
+
 	return nil
 }
+
+// holdStock takes the reservation's quantity out of the product's stock. Lot-tracked
+// products are allocated first-expired-first-out across their unexpired lots and
+// serialized products hold that many available serials.
+func holdStock(ctx context.Context, txRepo postgresrepository.PostgresRepository, product *entity.Product, reservation *entity.Reservation) error {
+	if product != nil && product.TrackSerials {
+		serials, err := txRepo.Serial().Hold(ctx, reservation.ProductID, reservation.ID, reservation.Quantity)
+		if err != nil {
+			return err
+		}
+
+		reservation.Serials = serials
+
+		return nil
+	}
+
+	if product == nil || !product.TrackLots {
+		return txRepo.Product().ReserveStock(ctx, reservation.ProductID, reservation.Quantity)
+	}
+
+	lots, err := txRepo.Lot().FindAllocatable(ctx, reservation.ProductID)
+	if err != nil {
+		return err
+	}
+
+	allocations, ok := allocateFEFO(reservation, lots)
+	if !ok {
+		return errors.Wrapf(exception.ErrInsufficientStock, "insufficient unexpired stock for product %d", reservation.ProductID)
+	}
+
+	if err := txRepo.Lot().Allocate(ctx, allocations); err != nil {
+		return err
+	}
+
+	reservation.Lots = allocations
+
+	return nil
+}
+
+// allocateFEFO spreads the reservation over the lots that expire first; lots
+// without an expiry date are used last. It reports false when the lots cannot
+// cover the full quantity.
+func allocateFEFO(reservation *entity.Reservation, lots []*entity.Lot) ([]*entity.LotAllocation, bool) {
+	ordered := slices.Clone(lots)
+	slices.SortStableFunc(ordered, func(a, b *entity.Lot) int {
+		switch {
+		case a.ExpiresAt == nil && b.ExpiresAt == nil:
+			return 0
+		case a.ExpiresAt == nil:
+			return 1
+		case b.ExpiresAt == nil:
+			return -1
+		default:
+			return a.ExpiresAt.Compare(*b.ExpiresAt)
+		}
+	})
+
+	remaining := reservation.Quantity
+	allocations := make([]*entity.LotAllocation, 0, len(ordered))
+
+	for _, lot := range ordered {
+		if remaining == 0 {
+			break
+		}
+
+		if lot.Quantity <= 0 || (lot.ExpiresAt != nil && !lot.ExpiresAt.After(time.Now())) {
+			continue
+		}
+
+		quantity := min(lot.Quantity, remaining)
+		remaining -= quantity
+
+		allocations = append(allocations, &entity.LotAllocation{
+			ReservationID: reservation.ID,
+			LotID:         lot.ID,
+			Quantity:      quantity,
+			Lot:           lot,
+		})
+	}
+
+	return allocations, remaining == 0
+}
+
+// releaseStock returns the stock held by the given reservations: to their lots
+// where they were allocated from lots, to the pool of available serials for
+// serialized products and to the product otherwise.
+func releaseStock(ctx context.Context, txRepo postgresrepository.PostgresRepository, reservations []*entity.Reservation) error {
+	if len(reservations) == 0 {
+		return nil
+	}
+
+	ids := make([]uint32, 0, len(reservations))
+	for _, reservation := range reservations {
+		ids = append(ids, reservation.ID)
+	}
+
+	allocations, err := txRepo.Lot().FindAllocations(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	lotBacked := make(map[uint32]bool, len(allocations))
+	for _, allocation := range allocations {
+		lotBacked[allocation.ReservationID] = true
+	}
+
+	var lotBackedIDs, otherIDs []uint32
+
+	for _, reservation := range reservations {
+		if lotBacked[reservation.ID] {
+			lotBackedIDs = append(lotBackedIDs, reservation.ID)
+		} else {
+			otherIDs = append(otherIDs, reservation.ID)
+		}
+	}
+
+	serialBacked := make(map[uint32]bool)
+
+	if len(otherIDs) > 0 {
+		serials, _, err := txRepo.Serial().Find(ctx, &postgresrepository.FilterSerialPayload{ReservationIDs: otherIDs})
+		if err != nil {
+			return err
+		}
+
+		for _, serial := range serials {
+			serialBacked[serial.ReservationID] = true
+		}
+	}
+
+	var serialBackedIDs []uint32
+
+	for _, reservation := range reservations {
+		if lotBacked[reservation.ID] {
+			continue
+		}
+
+		if serialBacked[reservation.ID] {
+			serialBackedIDs = append(serialBackedIDs, reservation.ID)
+			continue
+		}
+
+		if err := txRepo.Product().ReleaseStock(ctx, reservation.ProductID, reservation.Quantity); err != nil {
+			return err
+		}
+	}
+
+	if len(serialBackedIDs) > 0 {
+		if err := txRepo.Serial().Release(ctx, serialBackedIDs); err != nil {
+			return err
+		}
+	}
+
+	if len(lotBackedIDs) == 0 {
+		return nil
+	}
+
+	return txRepo.Lot().ReleaseAllocations(ctx, lotBackedIDs)
+}
+
+// reserveKit records the kit reservation and reserves every component of its
+// bill of materials under it; any component running short fails the whole kit.
+func reserveKit(
+	ctx context.Context,
+	txRepo postgresrepository.PostgresRepository,
+	reservation *entity.Reservation,
+	components []*entity.KitComponent,
+) (*entity.Reservation, error) {
+	kitReservation, err := txRepo.Reservation().Create(ctx, reservation)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, component := range components {
+		if err := checkReservable(component.Component); err != nil {
+			return nil, err
+		}
+
+		quantity := reservation.Quantity * component.Quantity
+
+		componentReservation, err := txRepo.Reservation().Create(ctx, &entity.Reservation{
+			ParentID:     kitReservation.ID,
+			ProductID:    component.ComponentID,
+			OrderID:      reservation.OrderID,
+			Quantity:     quantity,
+			Unit:         constant.UnitEach,
+			UnitQuantity: quantity,
+			Status:       reservation.Status,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if err := holdStock(ctx, txRepo, component.Component, componentReservation); err != nil {
+			return nil, err
+		}
+
+		kitReservation.Components = append(kitReservation.Components, componentReservation)
+	}
+
+	return kitReservation, nil
+}
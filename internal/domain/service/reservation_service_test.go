@@ -3,13 +3,17 @@ package service_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"inventory-service/config"
+	"inventory-service/constant"
 	postgresrepository "inventory-service/internal/adapter/repository/postgres"
 	"inventory-service/internal/domain/entity"
 	"inventory-service/internal/domain/service"
+	"inventory-service/internal/shared/exception"
 	"inventory-service/mocks"
 
+	"github.com/cockroachdb/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -45,12 +49,23 @@ func TestReservationServiceFind(t *testing.T) {
 }
 
 func TestReservationServiceFindByID(t *testing.T) {
-	mockRepo, _, mockRes := setupReservationMocks(t)
+	mockRepo, mockPostgres, mockRes := setupReservationMocks(t)
+	mockLot := mocks.NewMockLotRepository(t)
+	mockPostgres.EXPECT().Lot().Return(mockLot)
 	ctx := context.Background()
 	id := uint32(1)
 	expected := &entity.Reservation{Base: entity.Base{ID: id}}
 
 	mockRes.EXPECT().FindByID(ctx, id).Return(expected, nil)
+	mockRes.EXPECT().
+		Find(ctx, &postgresrepository.FilterReservationPayload{ParentIDs: []uint32{id}}).
+		Return([]*entity.Reservation{}, 0, nil)
+	mockLot.EXPECT().FindAllocations(ctx, []uint32{id}).Return([]*entity.LotAllocation{}, nil)
+	mockSerial := mocks.NewMockSerialRepository(t)
+	mockPostgres.EXPECT().Serial().Return(mockSerial)
+	mockSerial.EXPECT().
+		Find(ctx, &postgresrepository.FilterSerialPayload{ReservationIDs: []uint32{id}}).
+		Return([]*entity.Serial{}, 0, nil)
 
 	resService := service.NewReservationService(service.Properties{Repo: mockRepo})
 	result, err := resService.FindByID(ctx, id)
@@ -62,8 +77,8 @@ func TestReservationServiceFindByID(t *testing.T) {
 func TestReservationServiceCreateAtomic(t *testing.T) {
 	mockRepo, mockPostgres, mockRes := setupReservationMocks(t)
 	ctx := context.Background()
-	input := &entity.Reservation{ProductID: 10}
-	expected := &entity.Reservation{Base: entity.Base{ID: 1}, ProductID: 10}
+	input := &entity.Reservation{ProductID: 10, Quantity: 2}
+	expected := &entity.Reservation{Base: entity.Base{ID: 1}, ProductID: 10, Quantity: 2}
 
 	// 1. Mock the Atomic call
 	// We use Run to execute the callback passed to Atomic
@@ -75,7 +90,17 @@ func TestReservationServiceCreateAtomic(t *testing.T) {
 		}).
 		Return(nil)
 
-	// 2. Mock the Create call inside the atomic block
+	// 2. Mock the variant and kit checks, the stock reservation and the Create call inside the atomic block
+	mockProduct := mocks.NewMockProductRepository(t)
+	mockKitComponent := mocks.NewMockKitComponentRepository(t)
+	mockPostgres.EXPECT().Product().Return(mockProduct)
+	mockPostgres.EXPECT().KitComponent().Return(mockKitComponent)
+	mockProduct.EXPECT().FindByID(ctx, uint32(10)).Return(&entity.Product{Base: entity.Base{ID: 10}}, nil)
+	mockProduct.EXPECT().
+		Find(ctx, &postgresrepository.FilterProductPayload{ParentIDs: []uint32{10}}).
+		Return([]*entity.Product{}, 0, nil)
+	mockKitComponent.EXPECT().FindByKitIDs(ctx, []uint32{10}).Return([]*entity.KitComponent{}, nil)
+	mockProduct.EXPECT().ReserveStock(ctx, uint32(10), 2).Return(nil)
 	mockRes.EXPECT().Create(ctx, input).Return(expected, nil)
 
 	resService := service.NewReservationService(service.Properties{
@@ -90,11 +115,46 @@ func TestReservationServiceCreateAtomic(t *testing.T) {
 	assert.Equal(t, uint32(1), result.ID)
 }
 
+func TestReservationServiceCreateOnParentProduct(t *testing.T) {
+	mockRepo, mockPostgres, mockRes := setupReservationMocks(t)
+	ctx := context.Background()
+	input := &entity.Reservation{ProductID: 10, OrderID: 1, Quantity: 1}
+
+	mockPostgres.EXPECT().
+		Atomic(ctx, mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, cfg *config.Config, fn postgresrepository.RepositoryAtomicCallback) error {
+			return fn(mockPostgres)
+		})
+
+	mockProduct := mocks.NewMockProductRepository(t)
+	mockPostgres.EXPECT().Product().Return(mockProduct)
+	mockProduct.EXPECT().FindByID(ctx, uint32(10)).Return(&entity.Product{Base: entity.Base{ID: 10}}, nil)
+	mockProduct.EXPECT().
+		Find(ctx, &postgresrepository.FilterProductPayload{ParentIDs: []uint32{10}}).
+		Return([]*entity.Product{{Base: entity.Base{ID: 11}, ParentID: 10}}, 1, nil)
+
+	resService := service.NewReservationService(service.Properties{
+		Repo:   mockRepo,
+		Config: &config.Config{},
+	})
+
+	_, err := resService.Create(ctx, input)
+
+	ex, ok := exception.GetException(err)
+	assert.True(t, ok)
+	assert.Equal(t, exception.TypeValidationError, ex.Type)
+	assert.Contains(t, ex.Errors, "product_id")
+	mockRes.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
 func TestReservationServiceUpdateStatusAtomic(t *testing.T) {
 	mockRepo, mockPostgres, mockRes := setupReservationMocks(t)
 	ctx := context.Background()
 	ids := []uint32{1, 2}
-	status := "COMPLETED"
+	status := constant.ReservationStatusConfirmed
+
+	mockSerial := mocks.NewMockSerialRepository(t)
+	mockPostgres.EXPECT().Serial().Return(mockSerial)
 
 	// Mock Atomic transaction
 	mockPostgres.EXPECT().
@@ -104,7 +164,17 @@ func TestReservationServiceUpdateStatusAtomic(t *testing.T) {
 		}).
 		Return(nil)
 
-	// Mock UpdateStatus inside the transaction
+	// Mock the lookups and UpdateStatus inside the transaction
+	mockRes.EXPECT().
+		Find(ctx, &postgresrepository.FilterReservationPayload{IDs: ids}).
+		Return([]*entity.Reservation{
+			{Base: entity.Base{ID: 1}, Status: constant.ReservationStatusPending},
+			{Base: entity.Base{ID: 2}, Status: constant.ReservationStatusPending},
+		}, 2, nil)
+	mockRes.EXPECT().
+		Find(ctx, &postgresrepository.FilterReservationPayload{ParentIDs: ids}).
+		Return([]*entity.Reservation{}, 0, nil)
+	mockSerial.EXPECT().Assign(ctx, ids).Return(nil)
 	mockRes.EXPECT().UpdateStatus(ctx, ids, status).Return(nil)
 
 	resService := service.NewReservationService(service.Properties{
@@ -116,3 +186,670 @@ func TestReservationServiceUpdateStatusAtomic(t *testing.T) {
 
 	assert.NoError(t, err)
 }
+
+func TestReservationServiceStatusTransitions(t *testing.T) {
+	tests := []struct {
+		name string
+		from string
+		to   string
+	}{
+		{"confirmed back to pending", constant.ReservationStatusConfirmed, constant.ReservationStatusPending},
+		{"cancelled back to pending", constant.ReservationStatusCancelled, constant.ReservationStatusPending},
+		{"cancelled to confirmed", constant.ReservationStatusCancelled, constant.ReservationStatusConfirmed},
+		{"pending to unknown status", constant.ReservationStatusPending, "COMPLETED"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo, mockPostgres, mockRes := setupReservationMocks(t)
+			expectReservationAtomic(mockPostgres)
+
+			ctx := context.Background()
+			ids := []uint32{1}
+
+			mockRes.EXPECT().
+				Find(ctx, &postgresrepository.FilterReservationPayload{IDs: ids}).
+				Return([]*entity.Reservation{{Base: entity.Base{ID: 1}, ProductID: 10, Quantity: 2, Status: tt.from}}, 1, nil).
+				Maybe()
+			mockRes.EXPECT().
+				Find(ctx, &postgresrepository.FilterReservationPayload{ParentIDs: ids}).
+				Return([]*entity.Reservation{}, 0, nil).
+				Maybe()
+
+			resService := service.NewReservationService(service.Properties{Repo: mockRepo, Config: &config.Config{}})
+			err := resService.UpdateStatus(ctx, ids, tt.to)
+
+			ex, ok := exception.GetException(err)
+			if assert.True(t, ok) {
+				assert.Equal(t, exception.TypeValidationError, ex.Type)
+				assert.Contains(t, ex.Errors, "status")
+			}
+			mockRes.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+// Helper to run the Atomic callback against the mocked postgres repository
+func expectReservationAtomic(mPostgres *mocks.MockPostgresRepository) {
+	mPostgres.EXPECT().
+		Atomic(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, cfg *config.Config, fn postgresrepository.RepositoryAtomicCallback) error {
+			return fn(mPostgres)
+		})
+}
+
+func TestReservationServiceCreateKit(t *testing.T) {
+	mockRepo, mockPostgres, mockRes := setupReservationMocks(t)
+	expectReservationAtomic(mockPostgres)
+
+	mockProduct := mocks.NewMockProductRepository(t)
+	mockKitComponent := mocks.NewMockKitComponentRepository(t)
+	mockPostgres.EXPECT().Product().Return(mockProduct)
+	mockPostgres.EXPECT().KitComponent().Return(mockKitComponent)
+
+	ctx := context.Background()
+	input := &entity.Reservation{ProductID: 10, OrderID: 5, Quantity: 3}
+	components := []*entity.KitComponent{
+		{KitID: 10, ComponentID: 20, Quantity: 2},
+		{KitID: 10, ComponentID: 30, Quantity: 1},
+	}
+
+	mockProduct.EXPECT().FindByID(ctx, uint32(10)).Return(&entity.Product{Base: entity.Base{ID: 10}}, nil)
+	mockProduct.EXPECT().
+		Find(ctx, &postgresrepository.FilterProductPayload{ParentIDs: []uint32{10}}).
+		Return([]*entity.Product{}, 0, nil)
+	mockKitComponent.EXPECT().FindByKitIDs(ctx, []uint32{10}).Return(components, nil)
+	mockRes.EXPECT().Create(ctx, input).Return(&entity.Reservation{Base: entity.Base{ID: 1}, ProductID: 10, OrderID: 5, Quantity: 3}, nil)
+	mockProduct.EXPECT().ReserveStock(ctx, uint32(20), 6).Return(nil)
+	mockProduct.EXPECT().ReserveStock(ctx, uint32(30), 3).Return(nil)
+	mockRes.EXPECT().
+		Create(ctx, mock.MatchedBy(func(r *entity.Reservation) bool { return r.ParentID == 1 })).
+		RunAndReturn(func(ctx context.Context, r *entity.Reservation) (*entity.Reservation, error) {
+			return r, nil
+		}).Twice()
+
+	resService := service.NewReservationService(service.Properties{Repo: mockRepo, Config: &config.Config{}})
+	result, err := resService.Create(ctx, input)
+
+	assert.NoError(t, err)
+	assert.Len(t, result.Components, 2)
+	assert.Equal(t, 6, result.Components[0].Quantity)
+	assert.Equal(t, constant.ReservationStatusPending, result.Components[1].Status)
+}
+
+func TestReservationServiceCreateKitInsufficientComponent(t *testing.T) {
+	mockRepo, mockPostgres, mockRes := setupReservationMocks(t)
+	expectReservationAtomic(mockPostgres)
+
+	mockProduct := mocks.NewMockProductRepository(t)
+	mockKitComponent := mocks.NewMockKitComponentRepository(t)
+	mockPostgres.EXPECT().Product().Return(mockProduct)
+	mockPostgres.EXPECT().KitComponent().Return(mockKitComponent)
+
+	ctx := context.Background()
+	input := &entity.Reservation{ProductID: 10, OrderID: 5, Quantity: 1}
+
+	mockProduct.EXPECT().FindByID(ctx, uint32(10)).Return(&entity.Product{Base: entity.Base{ID: 10}}, nil)
+	mockProduct.EXPECT().
+		Find(ctx, &postgresrepository.FilterProductPayload{ParentIDs: []uint32{10}}).
+		Return([]*entity.Product{}, 0, nil)
+	mockKitComponent.EXPECT().
+		FindByKitIDs(ctx, []uint32{10}).
+		Return([]*entity.KitComponent{{KitID: 10, ComponentID: 20, Quantity: 2}}, nil)
+	mockRes.EXPECT().Create(ctx, input).Return(&entity.Reservation{Base: entity.Base{ID: 1}, ProductID: 10}, nil)
+	mockRes.EXPECT().
+		Create(ctx, mock.MatchedBy(func(r *entity.Reservation) bool { return r.ParentID == 1 })).
+		Return(&entity.Reservation{Base: entity.Base{ID: 2}, ParentID: 1, ProductID: 20, Quantity: 2}, nil)
+	mockProduct.EXPECT().
+		ReserveStock(ctx, uint32(20), 2).
+		Return(errors.Wrap(exception.ErrInsufficientStock, "insufficient stock for product 20"))
+
+	resService := service.NewReservationService(service.Properties{Repo: mockRepo, Config: &config.Config{}})
+	_, err := resService.Create(ctx, input)
+
+	ex, ok := exception.GetException(err)
+	assert.True(t, ok)
+	assert.Equal(t, exception.TypeConflict, ex.Type)
+	assert.Equal(t, exception.CodeInsufficientStock, ex.Code)
+}
+
+func TestReservationServiceCancelKitReleasesComponents(t *testing.T) {
+	mockRepo, mockPostgres, mockRes := setupReservationMocks(t)
+	expectReservationAtomic(mockPostgres)
+
+	mockProduct := mocks.NewMockProductRepository(t)
+	mockPostgres.EXPECT().Product().Return(mockProduct)
+
+	ctx := context.Background()
+	ids := []uint32{1}
+
+	mockRes.EXPECT().
+		Find(ctx, &postgresrepository.FilterReservationPayload{IDs: ids}).
+		Return([]*entity.Reservation{{Base: entity.Base{ID: 1}, ProductID: 10, Quantity: 3, Status: constant.ReservationStatusPending}}, 1, nil)
+	mockRes.EXPECT().
+		Find(ctx, &postgresrepository.FilterReservationPayload{ParentIDs: ids}).
+		Return([]*entity.Reservation{
+			{Base: entity.Base{ID: 2}, ParentID: 1, ProductID: 20, Quantity: 6, Status: constant.ReservationStatusPending},
+			{Base: entity.Base{ID: 3}, ParentID: 1, ProductID: 30, Quantity: 3, Status: constant.ReservationStatusPending},
+		}, 2, nil)
+	mockLot := mocks.NewMockLotRepository(t)
+	mockPostgres.EXPECT().Lot().Return(mockLot)
+	mockLot.EXPECT().FindAllocations(ctx, []uint32{2, 3}).Return([]*entity.LotAllocation{}, nil)
+	mockSerial := mocks.NewMockSerialRepository(t)
+	mockPostgres.EXPECT().Serial().Return(mockSerial)
+	mockSerial.EXPECT().
+		Find(ctx, &postgresrepository.FilterSerialPayload{ReservationIDs: []uint32{2, 3}}).
+		Return([]*entity.Serial{}, 0, nil)
+	mockProduct.EXPECT().ReleaseStock(ctx, uint32(20), 6).Return(nil)
+	mockProduct.EXPECT().ReleaseStock(ctx, uint32(30), 3).Return(nil)
+	mockRes.EXPECT().UpdateStatus(ctx, []uint32{1, 2, 3}, constant.ReservationStatusCancelled).Return(nil)
+
+	resService := service.NewReservationService(service.Properties{Repo: mockRepo, Config: &config.Config{}})
+	err := resService.UpdateStatus(ctx, ids, constant.ReservationStatusCancelled)
+
+	assert.NoError(t, err)
+	mockProduct.AssertNotCalled(t, "ReleaseStock", ctx, uint32(10), 3)
+}
+
+func TestReservationServiceCancelKitWithItsComponent(t *testing.T) {
+	mockRepo, mockPostgres, mockRes := setupReservationMocks(t)
+	expectReservationAtomic(mockPostgres)
+
+	mockProduct := mocks.NewMockProductRepository(t)
+	mockLot := mocks.NewMockLotRepository(t)
+	mockSerial := mocks.NewMockSerialRepository(t)
+	mockPostgres.EXPECT().Product().Return(mockProduct)
+	mockPostgres.EXPECT().Lot().Return(mockLot)
+	mockPostgres.EXPECT().Serial().Return(mockSerial)
+
+	ctx := context.Background()
+	ids := []uint32{1, 2}
+	component := &entity.Reservation{Base: entity.Base{ID: 2}, ParentID: 1, ProductID: 20, Quantity: 6, Status: constant.ReservationStatusPending}
+
+	mockRes.EXPECT().
+		Find(ctx, &postgresrepository.FilterReservationPayload{IDs: ids}).
+		Return([]*entity.Reservation{
+			{Base: entity.Base{ID: 1}, ProductID: 10, Quantity: 3, Status: constant.ReservationStatusPending},
+			component,
+		}, 2, nil)
+	mockRes.EXPECT().
+		Find(ctx, &postgresrepository.FilterReservationPayload{ParentIDs: ids}).
+		Return([]*entity.Reservation{component}, 1, nil)
+	mockLot.EXPECT().FindAllocations(ctx, []uint32{2}).Return([]*entity.LotAllocation{}, nil)
+	mockSerial.EXPECT().
+		Find(ctx, &postgresrepository.FilterSerialPayload{ReservationIDs: []uint32{2}}).
+		Return([]*entity.Serial{}, 0, nil)
+	mockProduct.EXPECT().ReleaseStock(ctx, uint32(20), 6).Return(nil).Once()
+	mockRes.EXPECT().UpdateStatus(ctx, ids, constant.ReservationStatusCancelled).Return(nil)
+
+	resService := service.NewReservationService(service.Properties{Repo: mockRepo, Config: &config.Config{}})
+	err := resService.UpdateStatus(ctx, ids, constant.ReservationStatusCancelled)
+
+	assert.NoError(t, err)
+	mockProduct.AssertNumberOfCalls(t, "ReleaseStock", 1)
+}
+
+func TestReservationServiceCreateAllocatesLotsFEFO(t *testing.T) {
+	mockRepo, mockPostgres, mockRes := setupReservationMocks(t)
+	expectReservationAtomic(mockPostgres)
+
+	mockProduct := mocks.NewMockProductRepository(t)
+	mockKitComponent := mocks.NewMockKitComponentRepository(t)
+	mockLot := mocks.NewMockLotRepository(t)
+	mockPostgres.EXPECT().Product().Return(mockProduct)
+	mockPostgres.EXPECT().KitComponent().Return(mockKitComponent)
+	mockPostgres.EXPECT().Lot().Return(mockLot)
+
+	ctx := context.Background()
+	now := time.Now()
+	nextWeek := now.AddDate(0, 0, 7)
+	nextMonth := now.AddDate(0, 1, 0)
+	yesterday := now.AddDate(0, 0, -1)
+	input := &entity.Reservation{ProductID: 10, OrderID: 5, Quantity: 8}
+	created := &entity.Reservation{Base: entity.Base{ID: 1}, ProductID: 10, OrderID: 5, Quantity: 8}
+
+	mockProduct.EXPECT().FindByID(ctx, uint32(10)).Return(&entity.Product{Base: entity.Base{ID: 10}, TrackLots: true}, nil)
+	mockProduct.EXPECT().
+		Find(ctx, &postgresrepository.FilterProductPayload{ParentIDs: []uint32{10}}).
+		Return([]*entity.Product{}, 0, nil)
+	mockKitComponent.EXPECT().FindByKitIDs(ctx, []uint32{10}).Return([]*entity.KitComponent{}, nil)
+	mockRes.EXPECT().Create(ctx, input).Return(created, nil)
+	mockLot.EXPECT().FindAllocatable(ctx, uint32(10)).Return([]*entity.Lot{
+		{Base: entity.Base{ID: 100}, LotNumber: "NO-EXPIRY", Quantity: 50},
+		{Base: entity.Base{ID: 101}, LotNumber: "LATE", ExpiresAt: &nextMonth, Quantity: 5},
+		{Base: entity.Base{ID: 102}, LotNumber: "EXPIRED", ExpiresAt: &yesterday, Quantity: 50},
+		{Base: entity.Base{ID: 103}, LotNumber: "EARLY", ExpiresAt: &nextWeek, Quantity: 3},
+	}, nil)
+	mockLot.EXPECT().
+		Allocate(ctx, mock.MatchedBy(func(allocations []*entity.LotAllocation) bool {
+			return len(allocations) == 2 &&
+				allocations[0].LotID == 103 && allocations[0].Quantity == 3 &&
+				allocations[1].LotID == 101 && allocations[1].Quantity == 5
+		})).
+		Return(nil)
+
+	resService := service.NewReservationService(service.Properties{Repo: mockRepo, Config: &config.Config{}})
+	result, err := resService.Create(ctx, input)
+
+	assert.NoError(t, err)
+	assert.Len(t, result.Lots, 2)
+	mockProduct.AssertNotCalled(t, "ReserveStock", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestReservationServiceCreateLotsExhausted(t *testing.T) {
+	mockRepo, mockPostgres, mockRes := setupReservationMocks(t)
+	expectReservationAtomic(mockPostgres)
+
+	mockProduct := mocks.NewMockProductRepository(t)
+	mockKitComponent := mocks.NewMockKitComponentRepository(t)
+	mockLot := mocks.NewMockLotRepository(t)
+	mockPostgres.EXPECT().Product().Return(mockProduct)
+	mockPostgres.EXPECT().KitComponent().Return(mockKitComponent)
+	mockPostgres.EXPECT().Lot().Return(mockLot)
+
+	ctx := context.Background()
+	nextWeek := time.Now().AddDate(0, 0, 7)
+	input := &entity.Reservation{ProductID: 10, OrderID: 5, Quantity: 4}
+
+	mockProduct.EXPECT().FindByID(ctx, uint32(10)).Return(&entity.Product{Base: entity.Base{ID: 10}, TrackLots: true}, nil)
+	mockProduct.EXPECT().
+		Find(ctx, &postgresrepository.FilterProductPayload{ParentIDs: []uint32{10}}).
+		Return([]*entity.Product{}, 0, nil)
+	mockKitComponent.EXPECT().FindByKitIDs(ctx, []uint32{10}).Return([]*entity.KitComponent{}, nil)
+	mockRes.EXPECT().Create(ctx, input).Return(&entity.Reservation{Base: entity.Base{ID: 1}, ProductID: 10, Quantity: 4}, nil)
+	mockLot.EXPECT().FindAllocatable(ctx, uint32(10)).Return([]*entity.Lot{
+		{Base: entity.Base{ID: 101}, ExpiresAt: &nextWeek, Quantity: 3},
+	}, nil)
+
+	resService := service.NewReservationService(service.Properties{Repo: mockRepo, Config: &config.Config{}})
+	_, err := resService.Create(ctx, input)
+
+	ex, ok := exception.GetException(err)
+	assert.True(t, ok)
+	assert.Equal(t, exception.CodeInsufficientStock, ex.Code)
+	mockLot.AssertNotCalled(t, "Allocate", mock.Anything, mock.Anything)
+}
+
+func TestReservationServiceCancelReleasesLots(t *testing.T) {
+	mockRepo, mockPostgres, mockRes := setupReservationMocks(t)
+	expectReservationAtomic(mockPostgres)
+
+	mockProduct := mocks.NewMockProductRepository(t)
+	mockLot := mocks.NewMockLotRepository(t)
+	mockPostgres.EXPECT().Product().Return(mockProduct).Maybe()
+	mockPostgres.EXPECT().Lot().Return(mockLot)
+
+	ctx := context.Background()
+	ids := []uint32{1}
+
+	mockRes.EXPECT().
+		Find(ctx, &postgresrepository.FilterReservationPayload{IDs: ids}).
+		Return([]*entity.Reservation{{Base: entity.Base{ID: 1}, ProductID: 10, Quantity: 8, Status: constant.ReservationStatusPending}}, 1, nil)
+	mockRes.EXPECT().
+		Find(ctx, &postgresrepository.FilterReservationPayload{ParentIDs: ids}).
+		Return([]*entity.Reservation{}, 0, nil)
+	mockLot.EXPECT().FindAllocations(ctx, ids).Return([]*entity.LotAllocation{
+		{ReservationID: 1, LotID: 103, Quantity: 3},
+		{ReservationID: 1, LotID: 101, Quantity: 5},
+	}, nil)
+	mockLot.EXPECT().ReleaseAllocations(ctx, ids).Return(nil)
+	mockRes.EXPECT().UpdateStatus(ctx, ids, constant.ReservationStatusCancelled).Return(nil)
+
+	resService := service.NewReservationService(service.Properties{Repo: mockRepo, Config: &config.Config{}})
+	err := resService.UpdateStatus(ctx, ids, constant.ReservationStatusCancelled)
+
+	assert.NoError(t, err)
+	mockProduct.AssertNotCalled(t, "ReleaseStock", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestReservationServiceExpireDue(t *testing.T) {
+	mockRepo, mockPostgres, mockRes := setupReservationMocks(t)
+	expectReservationAtomic(mockPostgres)
+
+	mockLot := mocks.NewMockLotRepository(t)
+	mockOutbox := mocks.NewMockOutboxRepository(t)
+	mockPostgres.EXPECT().Lot().Return(mockLot)
+	mockPostgres.EXPECT().Outbox().Return(mockOutbox)
+
+	ctx := context.Background()
+	ids := []uint32{1}
+	pending := []*entity.Reservation{{Base: entity.Base{ID: 1}, ProductID: 10, Quantity: 8, Status: constant.ReservationStatusPending}}
+
+	mockRes.EXPECT().
+		FindExpired(ctx, mock.MatchedBy(func(before time.Time) bool {
+			return time.Since(before) >= 15*time.Minute && time.Since(before) < 16*time.Minute
+		}), 10).
+		Return(pending, nil)
+	mockRes.EXPECT().
+		Find(ctx, &postgresrepository.FilterReservationPayload{IDs: ids}).
+		Return(pending, 1, nil)
+	mockRes.EXPECT().
+		Find(ctx, &postgresrepository.FilterReservationPayload{ParentIDs: ids}).
+		Return([]*entity.Reservation{}, 0, nil)
+	mockLot.EXPECT().FindAllocations(ctx, ids).Return([]*entity.LotAllocation{{ReservationID: 1, LotID: 101, Quantity: 8}}, nil)
+	mockLot.EXPECT().ReleaseAllocations(ctx, ids).Return(nil)
+	mockOutbox.EXPECT().
+		Create(ctx, mock.MatchedBy(func(events []*entity.OutboxEvent) bool {
+			return len(events) == 2 &&
+				events[0].Type == constant.EventReservationExpired &&
+				events[1].Type == constant.EventStockChanged &&
+				events[1].Payload["quantity"] == 8
+		})).
+		Return(nil)
+	mockRes.EXPECT().UpdateStatus(ctx, ids, constant.ReservationStatusCancelled).Return(nil)
+
+	resService := service.NewReservationService(service.Properties{
+		Repo:   mockRepo,
+		Config: &config.Config{App: &config.AppConfig{UsePubsub: true, ReservationTTL: 900}},
+	})
+	expired, err := resService.ExpireDue(ctx, 10)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, expired)
+}
+
+func TestReservationServiceExpireDueWithoutTTL(t *testing.T) {
+	mockRepo, mockPostgres, _ := setupReservationMocks(t)
+
+	resService := service.NewReservationService(service.Properties{Repo: mockRepo, Config: &config.Config{App: &config.AppConfig{}}})
+	expired, err := resService.ExpireDue(context.Background(), 10)
+
+	assert.NoError(t, err)
+	assert.Zero(t, expired)
+	mockPostgres.AssertNotCalled(t, "Atomic", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestReservationServiceCreateHoldsSerials(t *testing.T) {
+	mockRepo, mockPostgres, mockRes := setupReservationMocks(t)
+	expectReservationAtomic(mockPostgres)
+
+	mockProduct := mocks.NewMockProductRepository(t)
+	mockKitComponent := mocks.NewMockKitComponentRepository(t)
+	mockSerial := mocks.NewMockSerialRepository(t)
+	mockPostgres.EXPECT().Product().Return(mockProduct)
+	mockPostgres.EXPECT().KitComponent().Return(mockKitComponent)
+	mockPostgres.EXPECT().Serial().Return(mockSerial)
+
+	ctx := context.Background()
+	input := &entity.Reservation{ProductID: 10, OrderID: 5, Quantity: 2}
+	created := &entity.Reservation{Base: entity.Base{ID: 1}, ProductID: 10, OrderID: 5, Quantity: 2}
+
+	mockProduct.EXPECT().FindByID(ctx, uint32(10)).Return(&entity.Product{Base: entity.Base{ID: 10}, TrackSerials: true}, nil)
+	mockProduct.EXPECT().
+		Find(ctx, &postgresrepository.FilterProductPayload{ParentIDs: []uint32{10}}).
+		Return([]*entity.Product{}, 0, nil)
+	mockKitComponent.EXPECT().FindByKitIDs(ctx, []uint32{10}).Return([]*entity.KitComponent{}, nil)
+	mockRes.EXPECT().Create(ctx, input).Return(created, nil)
+	mockSerial.EXPECT().Hold(ctx, uint32(10), uint32(1), 2).Return([]*entity.Serial{
+		{Base: entity.Base{ID: 7}, SerialNumber: "SN-7", Status: constant.SerialStatusReserved, ReservationID: 1},
+		{Base: entity.Base{ID: 8}, SerialNumber: "SN-8", Status: constant.SerialStatusReserved, ReservationID: 1},
+	}, nil)
+
+	resService := service.NewReservationService(service.Properties{Repo: mockRepo, Config: &config.Config{}})
+	result, err := resService.Create(ctx, input)
+
+	assert.NoError(t, err)
+	assert.Len(t, result.Serials, 2)
+	mockProduct.AssertNotCalled(t, "ReserveStock", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestReservationServiceCreateSerialsExhausted(t *testing.T) {
+	mockRepo, mockPostgres, mockRes := setupReservationMocks(t)
+	expectReservationAtomic(mockPostgres)
+
+	mockProduct := mocks.NewMockProductRepository(t)
+	mockKitComponent := mocks.NewMockKitComponentRepository(t)
+	mockSerial := mocks.NewMockSerialRepository(t)
+	mockPostgres.EXPECT().Product().Return(mockProduct)
+	mockPostgres.EXPECT().KitComponent().Return(mockKitComponent)
+	mockPostgres.EXPECT().Serial().Return(mockSerial)
+
+	ctx := context.Background()
+	input := &entity.Reservation{ProductID: 10, OrderID: 5, Quantity: 3}
+
+	mockProduct.EXPECT().FindByID(ctx, uint32(10)).Return(&entity.Product{Base: entity.Base{ID: 10}, TrackSerials: true}, nil)
+	mockProduct.EXPECT().
+		Find(ctx, &postgresrepository.FilterProductPayload{ParentIDs: []uint32{10}}).
+		Return([]*entity.Product{}, 0, nil)
+	mockKitComponent.EXPECT().FindByKitIDs(ctx, []uint32{10}).Return([]*entity.KitComponent{}, nil)
+	mockRes.EXPECT().Create(ctx, input).Return(&entity.Reservation{Base: entity.Base{ID: 1}, ProductID: 10, Quantity: 3}, nil)
+	mockSerial.EXPECT().Hold(ctx, uint32(10), uint32(1), 3).
+		Return(nil, errors.Wrap(exception.ErrInsufficientStock, "insufficient serials for product 10"))
+
+	resService := service.NewReservationService(service.Properties{Repo: mockRepo, Config: &config.Config{}})
+	_, err := resService.Create(ctx, input)
+
+	ex, ok := exception.GetException(err)
+	assert.True(t, ok)
+	assert.Equal(t, exception.CodeInsufficientStock, ex.Code)
+}
+
+func TestReservationServiceConfirmAssignsSerials(t *testing.T) {
+	mockRepo, mockPostgres, mockRes := setupReservationMocks(t)
+	expectReservationAtomic(mockPostgres)
+
+	mockSerial := mocks.NewMockSerialRepository(t)
+	mockPostgres.EXPECT().Serial().Return(mockSerial)
+
+	ctx := context.Background()
+	ids := []uint32{1}
+
+	mockRes.EXPECT().
+		Find(ctx, &postgresrepository.FilterReservationPayload{IDs: ids}).
+		Return([]*entity.Reservation{{Base: entity.Base{ID: 1}, ProductID: 10, Quantity: 2, Status: constant.ReservationStatusPending}}, 1, nil)
+	mockRes.EXPECT().
+		Find(ctx, &postgresrepository.FilterReservationPayload{ParentIDs: ids}).
+		Return([]*entity.Reservation{}, 0, nil)
+	mockSerial.EXPECT().Assign(ctx, ids).Return(nil)
+	mockRes.EXPECT().UpdateStatus(ctx, ids, constant.ReservationStatusConfirmed).Return(nil)
+
+	resService := service.NewReservationService(service.Properties{Repo: mockRepo, Config: &config.Config{}})
+	err := resService.UpdateStatus(ctx, ids, constant.ReservationStatusConfirmed)
+
+	assert.NoError(t, err)
+}
+
+func TestReservationServiceCancelFreesSerials(t *testing.T) {
+	mockRepo, mockPostgres, mockRes := setupReservationMocks(t)
+	expectReservationAtomic(mockPostgres)
+
+	mockProduct := mocks.NewMockProductRepository(t)
+	mockLot := mocks.NewMockLotRepository(t)
+	mockSerial := mocks.NewMockSerialRepository(t)
+	mockPostgres.EXPECT().Product().Return(mockProduct).Maybe()
+	mockPostgres.EXPECT().Lot().Return(mockLot)
+	mockPostgres.EXPECT().Serial().Return(mockSerial)
+
+	ctx := context.Background()
+	ids := []uint32{1}
+
+	mockRes.EXPECT().
+		Find(ctx, &postgresrepository.FilterReservationPayload{IDs: ids}).
+		Return([]*entity.Reservation{{Base: entity.Base{ID: 1}, ProductID: 10, Quantity: 2, Status: constant.ReservationStatusConfirmed}}, 1, nil)
+	mockRes.EXPECT().
+		Find(ctx, &postgresrepository.FilterReservationPayload{ParentIDs: ids}).
+		Return([]*entity.Reservation{}, 0, nil)
+	mockLot.EXPECT().FindAllocations(ctx, ids).Return([]*entity.LotAllocation{}, nil)
+	mockSerial.EXPECT().
+		Find(ctx, &postgresrepository.FilterSerialPayload{ReservationIDs: ids}).
+		Return([]*entity.Serial{
+			{Base: entity.Base{ID: 7}, ReservationID: 1, Status: constant.SerialStatusAssigned},
+			{Base: entity.Base{ID: 8}, ReservationID: 1, Status: constant.SerialStatusAssigned},
+		}, 2, nil)
+	mockSerial.EXPECT().Release(ctx, ids).Return(nil)
+	mockRes.EXPECT().UpdateStatus(ctx, ids, constant.ReservationStatusCancelled).Return(nil)
+
+	resService := service.NewReservationService(service.Properties{Repo: mockRepo, Config: &config.Config{}})
+	err := resService.UpdateStatus(ctx, ids, constant.ReservationStatusCancelled)
+
+	assert.NoError(t, err)
+	mockProduct.AssertNotCalled(t, "ReleaseStock", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestReservationServiceCreateConvertsUnit(t *testing.T) {
+	mockRepo, mockPostgres, mockRes := setupReservationMocks(t)
+	expectReservationAtomic(mockPostgres)
+
+	mockProduct := mocks.NewMockProductRepository(t)
+	mockKitComponent := mocks.NewMockKitComponentRepository(t)
+	mockProductUnit := mocks.NewMockProductUnitRepository(t)
+	mockPostgres.EXPECT().Product().Return(mockProduct)
+	mockPostgres.EXPECT().KitComponent().Return(mockKitComponent)
+	mockPostgres.EXPECT().ProductUnit().Return(mockProductUnit)
+
+	ctx := context.Background()
+	input := &entity.Reservation{ProductID: 10, OrderID: 5, Unit: constant.UnitCase, UnitQuantity: 2}
+
+	mockProduct.EXPECT().FindByID(ctx, uint32(10)).Return(&entity.Product{Base: entity.Base{ID: 10}}, nil)
+	mockProduct.EXPECT().
+		Find(ctx, &postgresrepository.FilterProductPayload{ParentIDs: []uint32{10}}).
+		Return([]*entity.Product{}, 0, nil)
+	mockProductUnit.EXPECT().FindByProductIDs(ctx, []uint32{10}).Return([]*entity.ProductUnit{
+		{ProductID: 10, Unit: constant.UnitPack, Factor: 6},
+		{ProductID: 10, Unit: constant.UnitCase, Factor: 24},
+	}, nil)
+	mockKitComponent.EXPECT().FindByKitIDs(ctx, []uint32{10}).Return([]*entity.KitComponent{}, nil)
+	mockRes.EXPECT().
+		Create(ctx, mock.MatchedBy(func(r *entity.Reservation) bool {
+			return r.Quantity == 48 && r.Unit == constant.UnitCase && r.UnitQuantity == 2
+		})).
+		Return(&entity.Reservation{Base: entity.Base{ID: 1}, ProductID: 10, Quantity: 48, Unit: constant.UnitCase, UnitQuantity: 2}, nil)
+	mockProduct.EXPECT().ReserveStock(ctx, uint32(10), 48).Return(nil)
+
+	resService := service.NewReservationService(service.Properties{Repo: mockRepo, Config: &config.Config{}})
+	result, err := resService.Create(ctx, input)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 48, result.Quantity)
+	assert.Equal(t, 2, result.UnitQuantity)
+}
+
+func TestReservationServiceRejectsBrokenQuantities(t *testing.T) {
+	tests := []struct {
+		name         string
+		maxQuantity  int
+		unit         string
+		unitQuantity int
+		// checked is whether the quantity is only known to be too large once
+		// the unit is converted.
+		checked bool
+	}{
+		{name: "zero", unit: constant.UnitEach, unitQuantity: 0},
+		{name: "negative", unit: constant.UnitEach, unitQuantity: -2},
+		{name: "above the default limit", unit: constant.UnitCase, unitQuantity: 500, checked: true},
+		{name: "above the configured limit", maxQuantity: 5, unit: constant.UnitEach, unitQuantity: 6, checked: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo, mockPostgres, mockRes := setupReservationMocks(t)
+			ctx := context.Background()
+
+			if tt.checked {
+				expectReservationAtomic(mockPostgres)
+
+				mockProduct := mocks.NewMockProductRepository(t)
+				mockProductUnit := mocks.NewMockProductUnitRepository(t)
+				mockPostgres.EXPECT().Product().Return(mockProduct)
+				mockPostgres.EXPECT().ProductUnit().Return(mockProductUnit).Maybe()
+
+				mockProduct.EXPECT().FindByID(ctx, uint32(10)).Return(&entity.Product{Base: entity.Base{ID: 10}}, nil)
+				mockProduct.EXPECT().
+					Find(ctx, &postgresrepository.FilterProductPayload{ParentIDs: []uint32{10}}).
+					Return([]*entity.Product{}, 0, nil)
+				mockProductUnit.EXPECT().FindByProductIDs(ctx, []uint32{10}).Return([]*entity.ProductUnit{
+					{ProductID: 10, Unit: constant.UnitCase, Factor: 24},
+				}, nil).Maybe()
+			}
+
+			cfg := &config.Config{App: &config.AppConfig{MaxReservationQuantity: tt.maxQuantity}}
+			input := &entity.Reservation{ProductID: 10, OrderID: 1, Unit: tt.unit, UnitQuantity: tt.unitQuantity}
+
+			resService := service.NewReservationService(service.Properties{Repo: mockRepo, Config: cfg})
+			_, err := resService.Create(ctx, input)
+
+			ex, ok := exception.GetException(err)
+			if assert.True(t, ok, "expected an exception, got %v", err) {
+				assert.Equal(t, exception.TypeValidationError, ex.Type)
+				assert.Contains(t, ex.Errors, "quantity")
+			}
+			mockRes.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestReservationServiceCreateInactiveProduct(t *testing.T) {
+	for _, status := range []string{constant.ProductStatusDraft, constant.ProductStatusDiscontinued} {
+		t.Run(status, func(t *testing.T) {
+			mockRepo, mockPostgres, mockRes := setupReservationMocks(t)
+			ctx := context.Background()
+			input := &entity.Reservation{ProductID: 10, OrderID: 1, Quantity: 1}
+
+			mockPostgres.EXPECT().
+				Atomic(ctx, mock.Anything, mock.Anything).
+				RunAndReturn(func(ctx context.Context, cfg *config.Config, fn postgresrepository.RepositoryAtomicCallback) error {
+					return fn(mockPostgres)
+				})
+
+			mockProduct := mocks.NewMockProductRepository(t)
+			mockPostgres.EXPECT().Product().Return(mockProduct)
+			mockProduct.EXPECT().FindByID(ctx, uint32(10)).Return(&entity.Product{Base: entity.Base{ID: 10}, Status: status}, nil)
+			mockProduct.EXPECT().
+				Find(ctx, &postgresrepository.FilterProductPayload{ParentIDs: []uint32{10}}).
+				Return([]*entity.Product{}, 0, nil)
+
+			resService := service.NewReservationService(service.Properties{Repo: mockRepo, Config: &config.Config{}})
+			_, err := resService.Create(ctx, input)
+
+			ex, ok := exception.GetException(err)
+			assert.True(t, ok)
+			assert.Equal(t, exception.TypeFailedPrecondition, ex.Type)
+			assert.Equal(t, exception.CodeProductNotActive, ex.Code)
+			mockRes.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestReservationServiceExport(t *testing.T) {
+	mockRepo, _, mockRes := setupReservationMocks(t)
+	ctx := context.Background()
+	filter := &postgresrepository.FilterReservationPayload{OrderIDs: []uint32{7}}
+
+	mockRes.EXPECT().Stream(ctx, filter, mock.Anything).
+		RunAndReturn(func(ctx context.Context, filter *postgresrepository.FilterReservationPayload, fn func(*entity.Reservation) error) error {
+			for _, id := range []uint32{1, 2} {
+				if err := fn(&entity.Reservation{Base: entity.Base{ID: id}, OrderID: 7}); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+
+	var exported []uint32
+
+	resService := service.NewReservationService(service.Properties{Repo: mockRepo})
+	err := resService.Export(ctx, filter, func(reservation *entity.Reservation) error {
+		exported = append(exported, reservation.ID)
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []uint32{1, 2}, exported)
+}
+
+func TestReservationServiceExportStreamError(t *testing.T) {
+	mockRepo, _, mockRes := setupReservationMocks(t)
+	ctx := context.Background()
+
+	mockRes.EXPECT().Stream(ctx, mock.Anything, mock.Anything).Return(context.DeadlineExceeded)
+
+	resService := service.NewReservationService(service.Properties{Repo: mockRepo})
+	err := resService.Export(ctx, &postgresrepository.FilterReservationPayload{}, func(*entity.Reservation) error {
+		return nil
+	})
+
+	ex, ok := exception.GetException(err)
+	assert.True(t, ok)
+	assert.Equal(t, exception.TypeTimeout, ex.Type)
+}
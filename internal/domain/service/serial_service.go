@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"inventory-service/constant"
+	postgresrepository "inventory-service/internal/adapter/repository/postgres"
+	"inventory-service/internal/domain/entity"
+	serviceerror "inventory-service/internal/domain/service/error"
+	"inventory-service/internal/shared/exception"
+	"strings"
+)
+
+var _ SerialService = (*serialService)(nil)
+
+type SerialService interface {
+	Register(ctx context.Context, productID uint32, serialNumbers []string) ([]*entity.Serial, error)
+	Find(ctx context.Context, filter *postgresrepository.FilterSerialPayload) ([]*entity.Serial, int, error)
+	FindBySerialNumber(ctx context.Context, serialNumber string) (*entity.Serial, error)
+}
+
+type serialService struct {
+	Properties
+}
+
+func NewSerialService(props Properties) *serialService {
+	return &serialService{Properties: props}
+}
+
+func (s *serialService) Find(ctx context.Context, filter *postgresrepository.FilterSerialPayload) ([]*entity.Serial, int, error) {
+	serials, total, err := s.Repo.Postgres().Serial().Find(ctx, filter)
+	if err != nil {
+		return nil, 0, serviceerror.TranslateRepoError(err)
+	}
+
+	return serials, total, nil
+}
+
+// FindBySerialNumber returns the serial with its product; assigned serials
+// carry the order they went to.
+func (s *serialService) FindBySerialNumber(ctx context.Context, serialNumber string) (*entity.Serial, error) {
+	serial, err := s.Repo.Postgres().Serial().FindBySerialNumber(ctx, strings.TrimSpace(serialNumber))
+	if err != nil {
+		return nil, serviceerror.TranslateRepoError(err)
+	}
+
+	return serial, nil
+}
+
+// Register receives stock of a serialized product, one available unit per
+// serial number.
+func (s *serialService) Register(ctx context.Context, productID uint32, serialNumbers []string) ([]*entity.Serial, error) {
+	serials, err := newSerials(productID, serialNumbers)
+	if err != nil {
+		return nil, err
+	}
+
+	var createdSerials []*entity.Serial
+
+	atomic := func(r postgresrepository.PostgresRepository) error {
+		product, err := r.Product().FindByID(ctx, productID)
+		if err != nil {
+			return err
+		}
+
+		if !product.TrackSerials {
+			return exception.NewWithErrors(exception.TypeValidationError, exception.CodeValidationFailed, "Invalid serials", exception.FieldErrors{
+				"product_id": {"Product does not track serial numbers"},
+			})
+		}
+
+		createdSerials, err = r.Serial().CreateMany(ctx, serials)
+		if err != nil {
+			return err
+		}
+
+		if err := s.recordEvents(ctx, r, stockChangedEvent(productID, len(createdSerials), stockChangeSerialsRegistered)); err != nil {
+			return err
+		}
+
+		return s.recordAudit(ctx, r, newAuditEvent(ctx, constant.AuditEntityProduct, productID, constant.AuditActionStockAdjusted,
+			nil, serialsAuditState(createdSerials)))
+	}
+
+	err = s.Repo.Postgres().Atomic(ctx, s.Config, atomic)
+	if err != nil {
+		return nil, serviceerror.TranslateRepoError(err)
+	}
+
+	return createdSerials, nil
+}
+
+func newSerials(productID uint32, serialNumbers []string) ([]*entity.Serial, error) {
+	errs := exception.FieldErrors{}
+
+	if len(serialNumbers) == 0 {
+		errs["serial_numbers"] = append(errs["serial_numbers"], "At least one serial number is required")
+	}
+
+	seen := make(map[string]bool, len(serialNumbers))
+	serials := make([]*entity.Serial, 0, len(serialNumbers))
+
+	for _, serialNumber := range serialNumbers {
+		serialNumber = strings.TrimSpace(serialNumber)
+
+		switch {
+		case serialNumber == "":
+			errs["serial_numbers"] = append(errs["serial_numbers"], "Serial numbers cannot be empty")
+			continue
+		case seen[serialNumber]:
+			errs["serial_numbers"] = append(errs["serial_numbers"], "Serial number "+serialNumber+" is listed more than once")
+			continue
+		}
+
+		seen[serialNumber] = true
+		serials = append(serials, &entity.Serial{
+			ProductID:    productID,
+			SerialNumber: serialNumber,
+			Status:       constant.SerialStatusAvailable,
+		})
+	}
+
+	if len(errs) > 0 {
+		return nil, exception.NewWithErrors(exception.TypeValidationError, exception.CodeValidationFailed, "Invalid serials", errs)
+	}
+
+	return serials, nil
+}
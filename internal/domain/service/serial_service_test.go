@@ -0,0 +1,116 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"inventory-service/config"
+	"inventory-service/constant"
+	postgresrepository "inventory-service/internal/adapter/repository/postgres"
+	"inventory-service/internal/domain/entity"
+	"inventory-service/internal/domain/service"
+	"inventory-service/internal/shared/exception"
+	"inventory-service/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Helper function to initialize the mock chain
+func setupSerialMocks(t *testing.T) (*mocks.MockRepository, *mocks.MockPostgresRepository, *mocks.MockSerialRepository) {
+	mRepo := mocks.NewMockRepository(t)
+	mPostgres := mocks.NewMockPostgresRepository(t)
+	mSerial := mocks.NewMockSerialRepository(t)
+
+	mRepo.EXPECT().Postgres().Return(mPostgres).Maybe()
+	mPostgres.EXPECT().Serial().Return(mSerial).Maybe()
+	mPostgres.EXPECT().
+		Atomic(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, cfg *config.Config, fn postgresrepository.RepositoryAtomicCallback) error {
+			return fn(mPostgres)
+		}).Maybe()
+
+	return mRepo, mPostgres, mSerial
+}
+
+func TestSerialServiceRegister(t *testing.T) {
+	mockRepo, mockPostgres, mockSerial := setupSerialMocks(t)
+	mockProduct := mocks.NewMockProductRepository(t)
+	mockPostgres.EXPECT().Product().Return(mockProduct)
+
+	ctx := context.Background()
+
+	mockProduct.EXPECT().FindByID(ctx, uint32(10)).Return(&entity.Product{Base: entity.Base{ID: 10}, TrackSerials: true}, nil)
+	mockSerial.EXPECT().
+		CreateMany(ctx, mock.MatchedBy(func(serials []*entity.Serial) bool {
+			return len(serials) == 2 &&
+				serials[0].SerialNumber == "SN-1" && serials[0].ProductID == 10 &&
+				serials[0].Status == constant.SerialStatusAvailable &&
+				serials[1].SerialNumber == "SN-2"
+		})).
+		Return([]*entity.Serial{
+			{Base: entity.Base{ID: 1}, ProductID: 10, SerialNumber: "SN-1", Status: constant.SerialStatusAvailable},
+			{Base: entity.Base{ID: 2}, ProductID: 10, SerialNumber: "SN-2", Status: constant.SerialStatusAvailable},
+		}, nil)
+
+	serialService := service.NewSerialService(service.Properties{Repo: mockRepo})
+	result, err := serialService.Register(ctx, 10, []string{" SN-1 ", "SN-2"})
+
+	assert.NoError(t, err)
+	assert.Len(t, result, 2)
+}
+
+func TestSerialServiceRegisterDuplicateInput(t *testing.T) {
+	mockRepo, _, mockSerial := setupSerialMocks(t)
+	ctx := context.Background()
+
+	serialService := service.NewSerialService(service.Properties{Repo: mockRepo})
+	_, err := serialService.Register(ctx, 10, []string{"SN-1", "SN-1", ""})
+
+	ex, ok := exception.GetException(err)
+	assert.True(t, ok)
+	assert.Equal(t, exception.CodeValidationFailed, ex.Code)
+	assert.Len(t, ex.Errors["serial_numbers"], 2)
+	mockSerial.AssertNotCalled(t, "CreateMany", mock.Anything, mock.Anything)
+}
+
+func TestSerialServiceRegisterUntrackedProduct(t *testing.T) {
+	mockRepo, mockPostgres, mockSerial := setupSerialMocks(t)
+	mockProduct := mocks.NewMockProductRepository(t)
+	mockPostgres.EXPECT().Product().Return(mockProduct)
+
+	ctx := context.Background()
+
+	mockProduct.EXPECT().FindByID(ctx, uint32(10)).Return(&entity.Product{Base: entity.Base{ID: 10}}, nil)
+
+	serialService := service.NewSerialService(service.Properties{Repo: mockRepo})
+	_, err := serialService.Register(ctx, 10, []string{"SN-1"})
+
+	ex, ok := exception.GetException(err)
+	assert.True(t, ok)
+	assert.Equal(t, exception.CodeValidationFailed, ex.Code)
+	assert.Contains(t, ex.Errors, "product_id")
+	mockSerial.AssertNotCalled(t, "CreateMany", mock.Anything, mock.Anything)
+}
+
+func TestSerialServiceFindBySerialNumber(t *testing.T) {
+	mockRepo, _, mockSerial := setupSerialMocks(t)
+	ctx := context.Background()
+
+	mockSerial.EXPECT().FindBySerialNumber(ctx, "SN-1").Return(&entity.Serial{
+		Base:          entity.Base{ID: 1},
+		ProductID:     10,
+		SerialNumber:  "SN-1",
+		Status:        constant.SerialStatusAssigned,
+		ReservationID: 3,
+		OrderID:       42,
+		Product:       &entity.Product{Base: entity.Base{ID: 10}, Name: "Laptop"},
+	}, nil)
+
+	serialService := service.NewSerialService(service.Properties{Repo: mockRepo})
+	result, err := serialService.FindBySerialNumber(ctx, "SN-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(42), result.OrderID)
+	assert.Equal(t, "Laptop", result.Product.Name)
+}
@@ -12,6 +12,15 @@ var _ Service = (*service)(nil)
 type Service interface {
 	Product() ProductService
 	Reservation() ReservationService
+	Category() CategoryService
+	Lot() LotService
+	Serial() SerialService
+	PriceChange() PriceChangeService
+	StockWatch() StockWatchService
+	Outbox() OutboxService
+	Webhook() WebhookService
+	OrderEvent() OrderEventService
+	Audit() AuditService
 }
 
 type Properties struct {
@@ -25,6 +34,15 @@ type service struct {
 	Properties
 	productService     ProductService
 	reservationService ReservationService
+	categoryService    CategoryService
+	lotService         LotService
+	serialService      SerialService
+	priceChangeService PriceChangeService
+	stockWatchService  StockWatchService
+	outboxService      OutboxService
+	webhookService     WebhookService
+	orderEventService  OrderEventService
+	auditService       AuditService
 }
 
 func NewService(
@@ -44,6 +62,15 @@ func NewService(
 		Properties:         props,
 		productService:     NewProductService(props),
 		reservationService: NewReservationService(props),
+		categoryService:    NewCategoryService(props),
+		lotService:         NewLotService(props),
+		serialService:      NewSerialService(props),
+		priceChangeService: NewPriceChangeService(props),
+		stockWatchService:  NewStockWatchService(props),
+		outboxService:      NewOutboxService(props),
+		webhookService:     NewWebhookService(props),
+		orderEventService:  NewOrderEventService(props),
+		auditService:       NewAuditService(props),
 	}, nil
 }
 
@@ -54,3 +81,39 @@ func (s *service) Product() ProductService {
 func (s *service) Reservation() ReservationService {
 	return s.reservationService
 }
+
+func (s *service) Category() CategoryService {
+	return s.categoryService
+}
+
+func (s *service) Lot() LotService {
+	return s.lotService
+}
+
+func (s *service) Serial() SerialService {
+	return s.serialService
+}
+
+func (s *service) PriceChange() PriceChangeService {
+	return s.priceChangeService
+}
+
+func (s *service) StockWatch() StockWatchService {
+	return s.stockWatchService
+}
+
+func (s *service) Outbox() OutboxService {
+	return s.outboxService
+}
+
+func (s *service) Webhook() WebhookService {
+	return s.webhookService
+}
+
+func (s *service) OrderEvent() OrderEventService {
+	return s.orderEventService
+}
+
+func (s *service) Audit() AuditService {
+	return s.auditService
+}
@@ -0,0 +1,339 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"inventory-service/constant"
+	"inventory-service/internal/domain/entity"
+	serviceerror "inventory-service/internal/domain/service/error"
+	"inventory-service/internal/shared/exception"
+	"sync"
+	"time"
+)
+
+// stockEventPageSize is the number of events read per query when replaying
+// history to a watcher or catching up after a missed notification.
+const stockEventPageSize = 500
+
+var _ StockWatchService = (*stockWatchService)(nil)
+
+// StockWatchService fans stock events out to watchers. Events are published
+// once by the database listener and delivered to every watcher of the
+// product from memory, so watchers cost no queries after they start.
+type StockWatchService interface {
+	Watch(ctx context.Context, productIDs []uint32, afterSequence uint64, fn func(*entity.StockEvent) error) error
+	Publish(event *entity.StockEvent)
+	CatchUp(ctx context.Context) error
+	Prune(ctx context.Context) (int, error)
+	Close()
+}
+
+type stockSubscriber struct {
+	products map[uint32]struct{}
+	events   chan *entity.StockEvent
+	// dropped is closed when the subscriber fell behind and was removed.
+	dropped chan struct{}
+}
+
+type stockWatchService struct {
+	Properties
+	bufferSize  int
+	maxProducts int
+	retention   time.Duration
+
+	mu           sync.Mutex
+	subscribers  map[*stockSubscriber]struct{}
+	lastSequence uint64
+	// closed ends every watch, so that servers shutting down are not kept
+	// waiting by streams that only end when the client leaves.
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+func NewStockWatchService(props Properties) *stockWatchService {
+	s := &stockWatchService{
+		Properties:  props,
+		bufferSize:  constant.DefaultStockWatchBufferSize,
+		maxProducts: constant.DefaultStockWatchMaxProducts,
+		retention:   constant.DefaultStockWatchRetention,
+		subscribers: make(map[*stockSubscriber]struct{}),
+		closed:      make(chan struct{}),
+	}
+
+	if props.Config != nil && props.Config.StockWatch != nil {
+		if props.Config.StockWatch.BufferSize > 0 {
+			s.bufferSize = props.Config.StockWatch.BufferSize
+		}
+
+		if props.Config.StockWatch.MaxProducts > 0 {
+			s.maxProducts = props.Config.StockWatch.MaxProducts
+		}
+
+		if props.Config.StockWatch.Retention > 0 {
+			s.retention = time.Duration(props.Config.StockWatch.Retention) * time.Hour
+		}
+	}
+
+	return s
+}
+
+// Watch calls fn with the stock events of the given products until ctx is
+// done or fn fails. It starts with the events after afterSequence when one is
+// given, and otherwise with a snapshot of each product's current totals. A
+// watcher too slow to keep up is disconnected with a service unavailable
+// error naming the sequence to resume after.
+func (s *stockWatchService) Watch(ctx context.Context, productIDs []uint32, afterSequence uint64, fn func(*entity.StockEvent) error) error {
+	productIDs, err := s.validateProductIDs(productIDs)
+	if err != nil {
+		return err
+	}
+
+	// Subscribe before reading the history so no event is missed in between;
+	// events seen twice are skipped by sequence.
+	sub := s.subscribe(productIDs)
+	defer s.unsubscribe(sub)
+
+	var fnErr error
+
+	seen := make(map[uint32]uint64, len(productIDs))
+	last := afterSequence
+	send := func(event *entity.StockEvent) error {
+		if event.Sequence <= seen[event.ProductID] {
+			return nil
+		}
+
+		seen[event.ProductID] = event.Sequence
+		last = max(last, event.Sequence)
+
+		if err := fn(event); err != nil {
+			fnErr = err
+			return err
+		}
+
+		return nil
+	}
+
+	if afterSequence > 0 {
+		err = s.replay(ctx, productIDs, afterSequence, send)
+	} else {
+		err = s.snapshot(ctx, productIDs, send)
+	}
+
+	if err != nil {
+		if fnErr != nil {
+			return fnErr
+		}
+
+		return serviceerror.TranslateRepoError(err)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-s.closed:
+			return exception.New(
+				exception.TypeServiceUnavailable,
+				exception.CodeServiceUnavailable,
+				fmt.Sprintf("Stock watch is shutting down, resume after sequence %d", last),
+			)
+		case <-sub.dropped:
+			return exception.New(
+				exception.TypeServiceUnavailable,
+				exception.CodeServiceUnavailable,
+				fmt.Sprintf("Stock watch fell behind, resume after sequence %d", last),
+			)
+		case event := <-sub.events:
+			if err := send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// Publish delivers an event to the watchers of its product without waiting
+// on any of them. A watcher whose buffer is full is dropped.
+func (s *stockWatchService) Publish(event *entity.StockEvent) {
+	if event == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.lastSequence = max(s.lastSequence, event.Sequence)
+
+	for sub := range s.subscribers {
+		if _, ok := sub.products[event.ProductID]; !ok {
+			continue
+		}
+
+		select {
+		case sub.events <- event:
+		default:
+			delete(s.subscribers, sub)
+			close(sub.dropped)
+		}
+	}
+}
+
+// CatchUp publishes the events stored since the last published one. The
+// listener calls it on every (re)connect, since notifications sent while it
+// was disconnected are lost.
+func (s *stockWatchService) CatchUp(ctx context.Context) error {
+	s.mu.Lock()
+	after := s.lastSequence
+	s.mu.Unlock()
+
+	repo := s.Repo.Postgres().StockEvent()
+
+	if after == 0 {
+		sequence, err := repo.LastSequence(ctx)
+		if err != nil {
+			return serviceerror.TranslateRepoError(err)
+		}
+
+		s.mu.Lock()
+		s.lastSequence = max(s.lastSequence, sequence)
+		s.mu.Unlock()
+
+		return nil
+	}
+
+	for {
+		events, err := repo.FindAfter(ctx, after, nil, stockEventPageSize)
+		if err != nil {
+			return serviceerror.TranslateRepoError(err)
+		}
+
+		for _, event := range events {
+			s.Publish(event)
+			after = event.Sequence
+		}
+
+		if len(events) < stockEventPageSize {
+			return nil
+		}
+	}
+}
+
+// Prune removes events older than the retention period.
+func (s *stockWatchService) Prune(ctx context.Context) (int, error) {
+	deleted, err := s.Repo.Postgres().StockEvent().DeleteBefore(ctx, time.Now().Add(-s.retention))
+	if err != nil {
+		return 0, serviceerror.TranslateRepoError(err)
+	}
+
+	return deleted, nil
+}
+
+// Close ends every running and future watch.
+func (s *stockWatchService) Close() {
+	s.closeOnce.Do(func() {
+		close(s.closed)
+	})
+}
+
+func (s *stockWatchService) validateProductIDs(productIDs []uint32) ([]uint32, error) {
+	unique := make([]uint32, 0, len(productIDs))
+	seen := make(map[uint32]struct{}, len(productIDs))
+
+	for _, id := range productIDs {
+		if id == 0 {
+			return nil, exception.NewWithErrors(
+				exception.TypeValidationError,
+				exception.CodeValidationFailed,
+				"Invalid product id",
+				exception.FieldErrors{"product_ids": {"product ids must be positive"}},
+			)
+		}
+
+		if _, ok := seen[id]; ok {
+			continue
+		}
+
+		seen[id] = struct{}{}
+		unique = append(unique, id)
+	}
+
+	if len(unique) == 0 || len(unique) > s.maxProducts {
+		return nil, exception.NewWithErrors(
+			exception.TypeValidationError,
+			exception.CodeValidationFailed,
+			"Invalid number of products",
+			exception.FieldErrors{"product_ids": {fmt.Sprintf("between 1 and %d products can be watched", s.maxProducts)}},
+		)
+	}
+
+	return unique, nil
+}
+
+func (s *stockWatchService) subscribe(productIDs []uint32) *stockSubscriber {
+	sub := &stockSubscriber{
+		products: make(map[uint32]struct{}, len(productIDs)),
+		events:   make(chan *entity.StockEvent, s.bufferSize),
+		dropped:  make(chan struct{}),
+	}
+
+	for _, id := range productIDs {
+		sub.products[id] = struct{}{}
+	}
+
+	s.mu.Lock()
+	s.subscribers[sub] = struct{}{}
+	s.mu.Unlock()
+
+	return sub
+}
+
+func (s *stockWatchService) unsubscribe(sub *stockSubscriber) {
+	s.mu.Lock()
+	delete(s.subscribers, sub)
+	s.mu.Unlock()
+}
+
+// snapshot sends the latest event of each product, which holds its current
+// totals.
+func (s *stockWatchService) snapshot(ctx context.Context, productIDs []uint32, send func(*entity.StockEvent) error) error {
+	events, err := s.Repo.Postgres().StockEvent().FindLatest(ctx, productIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, event := range events {
+		snapshot := *event
+		snapshot.Snapshot = true
+
+		if err := send(&snapshot); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// replay sends the stored events after the given sequence. Pruning keeps the
+// latest event of every product, so a watcher resuming from a pruned sequence
+// still ends up with the current totals.
+func (s *stockWatchService) replay(ctx context.Context, productIDs []uint32, after uint64, send func(*entity.StockEvent) error) error {
+	repo := s.Repo.Postgres().StockEvent()
+
+	for {
+		events, err := repo.FindAfter(ctx, after, productIDs, stockEventPageSize)
+		if err != nil {
+			return err
+		}
+
+		for _, event := range events {
+			if err := send(event); err != nil {
+				return err
+			}
+
+			after = event.Sequence
+		}
+
+		if len(events) < stockEventPageSize {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,182 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"inventory-service/config"
+	"inventory-service/internal/domain/entity"
+	"inventory-service/internal/domain/service"
+	"inventory-service/internal/shared/exception"
+	"inventory-service/mocks"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var errStopWatch = errors.New("stop watching")
+
+// Helper to initialize the mock chain for stock events
+func setupStockEventMocks(t *testing.T) (*mocks.MockRepository, *mocks.MockStockEventRepository) {
+	mRepo := mocks.NewMockRepository(t)
+	mPostgres := mocks.NewMockPostgresRepository(t)
+	mStockEvent := mocks.NewMockStockEventRepository(t)
+
+	mRepo.EXPECT().Postgres().Return(mPostgres).Maybe()
+	mPostgres.EXPECT().StockEvent().Return(mStockEvent).Maybe()
+
+	return mRepo, mStockEvent
+}
+
+func TestStockWatchServiceWatchStartsWithSnapshot(t *testing.T) {
+	mockRepo, mockStockEvent := setupStockEventMocks(t)
+	ctx := context.Background()
+
+	mockStockEvent.EXPECT().FindLatest(mock.Anything, []uint32{1, 2}).Return([]*entity.StockEvent{
+		{Sequence: 3, ProductID: 1, Stock: 10, Reserved: 2},
+		{Sequence: 5, ProductID: 2, Stock: 4},
+	}, nil)
+
+	watchService := service.NewStockWatchService(service.Properties{Repo: mockRepo})
+
+	var received []*entity.StockEvent
+	err := watchService.Watch(ctx, []uint32{1, 2, 1}, 0, func(event *entity.StockEvent) error {
+		received = append(received, event)
+		if len(received) == 2 {
+			return errStopWatch
+		}
+
+		return nil
+	})
+
+	assert.ErrorIs(t, err, errStopWatch)
+	assert.Len(t, received, 2)
+	assert.True(t, received[0].Snapshot)
+	assert.Equal(t, 8, received[0].AvailableStock())
+	assert.Equal(t, uint64(5), received[1].Sequence)
+}
+
+func TestStockWatchServiceWatchResumesAfterSequence(t *testing.T) {
+	mockRepo, mockStockEvent := setupStockEventMocks(t)
+	ctx := context.Background()
+
+	mockStockEvent.EXPECT().FindAfter(mock.Anything, uint64(10), []uint32{1}, mock.Anything).Return([]*entity.StockEvent{
+		{Sequence: 12, ProductID: 1, Stock: 9},
+	}, nil)
+
+	watchService := service.NewStockWatchService(service.Properties{Repo: mockRepo})
+
+	var received []*entity.StockEvent
+	err := watchService.Watch(ctx, []uint32{1}, 10, func(event *entity.StockEvent) error {
+		received = append(received, event)
+		return errStopWatch
+	})
+
+	assert.ErrorIs(t, err, errStopWatch)
+	assert.Len(t, received, 1)
+	assert.False(t, received[0].Snapshot)
+	assert.Equal(t, uint64(12), received[0].Sequence)
+}
+
+func TestStockWatchServicePublishFansOutWithoutQueries(t *testing.T) {
+	mockRepo, mockStockEvent := setupStockEventMocks(t)
+	ctx := context.Background()
+
+	mockStockEvent.EXPECT().FindLatest(mock.Anything, []uint32{1}).Return([]*entity.StockEvent{
+		{Sequence: 3, ProductID: 1, Stock: 10},
+	}, nil).Times(2)
+
+	watchService := service.NewStockWatchService(service.Properties{Repo: mockRepo})
+
+	ready := make(chan struct{}, 2)
+	results := make(chan []uint64, 2)
+
+	for range 2 {
+		go func() {
+			var sequences []uint64
+			err := watchService.Watch(ctx, []uint32{1}, 0, func(event *entity.StockEvent) error {
+				sequences = append(sequences, event.Sequence)
+				if event.Snapshot {
+					ready <- struct{}{}
+					return nil
+				}
+
+				return errStopWatch
+			})
+			assert.ErrorIs(t, err, errStopWatch)
+			results <- sequences
+		}()
+	}
+
+	<-ready
+	<-ready
+
+	// Older and unwatched events are skipped.
+	watchService.Publish(&entity.StockEvent{Sequence: 2, ProductID: 1, Stock: 11})
+	watchService.Publish(&entity.StockEvent{Sequence: 4, ProductID: 2, Stock: 1})
+	watchService.Publish(&entity.StockEvent{Sequence: 6, ProductID: 1, Stock: 7})
+
+	assert.Equal(t, []uint64{3, 6}, <-results)
+	assert.Equal(t, []uint64{3, 6}, <-results)
+}
+
+func TestStockWatchServiceDropsLaggingWatcher(t *testing.T) {
+	mockRepo, mockStockEvent := setupStockEventMocks(t)
+	ctx := context.Background()
+
+	mockStockEvent.EXPECT().FindLatest(mock.Anything, []uint32{1}).Return([]*entity.StockEvent{
+		{Sequence: 3, ProductID: 1, Stock: 10},
+	}, nil)
+
+	watchService := service.NewStockWatchService(service.Properties{
+		Config: &config.Config{StockWatch: &config.StockWatchConfig{BufferSize: 1}},
+		Repo:   mockRepo,
+	})
+
+	err := watchService.Watch(ctx, []uint32{1}, 0, func(event *entity.StockEvent) error {
+		if event.Snapshot {
+			// The second event overflows the buffer of one.
+			watchService.Publish(&entity.StockEvent{Sequence: 4, ProductID: 1, Stock: 9})
+			watchService.Publish(&entity.StockEvent{Sequence: 5, ProductID: 1, Stock: 8})
+		}
+
+		return nil
+	})
+
+	ex, ok := exception.GetException(err)
+	assert.True(t, ok)
+	assert.Equal(t, exception.TypeServiceUnavailable, ex.Type)
+}
+
+func TestStockWatchServiceWatchValidatesProducts(t *testing.T) {
+	watchService := service.NewStockWatchService(service.Properties{
+		Config: &config.Config{StockWatch: &config.StockWatchConfig{MaxProducts: 2}},
+	})
+
+	for _, productIDs := range [][]uint32{nil, {0}, {1, 2, 3}} {
+		err := watchService.Watch(context.Background(), productIDs, 0, func(*entity.StockEvent) error {
+			return nil
+		})
+
+		ex, ok := exception.GetException(err)
+		assert.True(t, ok)
+		assert.Equal(t, exception.TypeValidationError, ex.Type)
+		assert.Contains(t, ex.Errors, "product_ids")
+	}
+}
+
+func TestStockWatchServiceCatchUp(t *testing.T) {
+	mockRepo, mockStockEvent := setupStockEventMocks(t)
+	ctx := context.Background()
+
+	mockStockEvent.EXPECT().LastSequence(ctx).Return(uint64(7), nil).Once()
+	mockStockEvent.EXPECT().FindAfter(ctx, uint64(7), []uint32(nil), mock.Anything).Return([]*entity.StockEvent{
+		{Sequence: 8, ProductID: 1, Stock: 3},
+	}, nil).Once()
+
+	watchService := service.NewStockWatchService(service.Properties{Repo: mockRepo})
+
+	assert.NoError(t, watchService.CatchUp(ctx))
+	assert.NoError(t, watchService.CatchUp(ctx))
+}
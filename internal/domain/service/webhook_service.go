@@ -0,0 +1,419 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"inventory-service/constant"
+	postgresrepository "inventory-service/internal/adapter/repository/postgres"
+	"inventory-service/internal/domain/entity"
+	serviceerror "inventory-service/internal/domain/service/error"
+	"inventory-service/internal/shared/exception"
+	"inventory-service/pkg/webhook"
+	"net/url"
+	"slices"
+	"strconv"
+	"time"
+)
+
+const (
+	webhookMinRetryDelay = 10 * time.Second
+	webhookMaxRetryDelay = time.Hour
+	webhookMinSecretLen  = 16
+	// webhookLeaseMargin is added to the time a claimed batch may take to
+	// send before its deliveries can be claimed again.
+	webhookLeaseMargin = time.Minute
+)
+
+// webhookEventTypes are the event types a webhook can subscribe to.
+var webhookEventTypes = []string{
+	constant.EventProductCreated,
+	constant.EventStockChanged,
+	constant.EventReservationCreated,
+	constant.EventReservationConfirmed,
+	constant.EventReservationCancelled,
+	constant.EventReservationExpired,
+}
+
+var _ WebhookService = (*webhookService)(nil)
+
+type WebhookService interface {
+	Find(ctx context.Context, filter *postgresrepository.FilterWebhookPayload) ([]*entity.Webhook, int, error)
+	FindByID(ctx context.Context, id uint32) (*entity.Webhook, error)
+	Create(ctx context.Context, webhook *entity.Webhook) (*entity.Webhook, error)
+	Update(ctx context.Context, webhook *entity.Webhook) (*entity.Webhook, error)
+	Delete(ctx context.Context, id uint32) error
+	FindDeliveries(ctx context.Context, filter *postgresrepository.FilterWebhookDeliveryPayload) ([]*entity.WebhookDelivery, int, error)
+	Dispatch(ctx context.Context, limit int) (*WebhookDispatch, error)
+}
+
+// WebhookDispatch counts the outcome of one Dispatch call. Disabled counts
+// the webhooks that were disabled by its failures.
+type WebhookDispatch struct {
+	Succeeded int
+	Retried   int
+	Dead      int
+	Disabled  int
+}
+
+// Total is the number of deliveries Dispatch attempted.
+func (d *WebhookDispatch) Total() int {
+	return d.Succeeded + d.Retried + d.Dead
+}
+
+type webhookService struct {
+	Properties
+	sender       webhook.Sender
+	timeout      time.Duration
+	maxAttempts  int
+	disableAfter int
+}
+
+func NewWebhookService(props Properties) *webhookService {
+	s := &webhookService{
+		Properties:   props,
+		maxAttempts:  constant.DefaultWebhookMaxAttempts,
+		disableAfter: constant.DefaultWebhookDisableAfter,
+	}
+
+	timeout := constant.DefaultWebhookTimeout
+	allowPrivateNetworks := false
+
+	if props.Config != nil && props.Config.Webhook != nil {
+		if props.Config.Webhook.Timeout > 0 {
+			timeout = time.Duration(props.Config.Webhook.Timeout) * time.Second
+		}
+
+		if props.Config.Webhook.MaxAttempts > 0 {
+			s.maxAttempts = props.Config.Webhook.MaxAttempts
+		}
+
+		if props.Config.Webhook.DisableAfter > 0 {
+			s.disableAfter = props.Config.Webhook.DisableAfter
+		}
+
+		allowPrivateNetworks = props.Config.Webhook.AllowPrivateNetworks
+	}
+
+	s.timeout = timeout
+	s.sender = webhook.NewSender(timeout, allowPrivateNetworks)
+
+	return s
+}
+
+func (s *webhookService) Find(ctx context.Context, filter *postgresrepository.FilterWebhookPayload) ([]*entity.Webhook, int, error) {
+	webhooks, total, err := s.Repo.Postgres().Webhook().Find(ctx, filter)
+	if err != nil {
+		return nil, 0, serviceerror.TranslateRepoError(err)
+	}
+
+	return webhooks, total, nil
+}
+
+func (s *webhookService) FindByID(ctx context.Context, id uint32) (*entity.Webhook, error) {
+	webhook, err := s.Repo.Postgres().Webhook().FindByID(ctx, id)
+	if err != nil {
+		return nil, serviceerror.TranslateRepoError(err)
+	}
+
+	return webhook, nil
+}
+
+// Create subscribes a new, active webhook. A secret is generated when none is
+// given; it is only ever returned by Create.
+func (s *webhookService) Create(ctx context.Context, webhook *entity.Webhook) (*entity.Webhook, error) {
+	if err := validateWebhook(webhook); err != nil {
+		return nil, err
+	}
+
+	if webhook.Secret == "" {
+		secret, err := newWebhookSecret()
+		if err != nil {
+			return nil, serviceerror.TranslateRepoError(err)
+		}
+
+		webhook.Secret = secret
+	}
+
+	webhook.Active = true
+	webhook.FailureCount = 0
+	webhook.DisabledAt = nil
+
+	createdWebhook, err := s.Repo.Postgres().Webhook().Create(ctx, webhook)
+	if err != nil {
+		return nil, serviceerror.TranslateRepoError(err)
+	}
+
+	return createdWebhook, nil
+}
+
+// Update replaces the URL, event types and active flag of a webhook, and its
+// secret when a new one is given. Enabling a webhook clears its failures, so
+// its pending deliveries are attempted again.
+func (s *webhookService) Update(ctx context.Context, webhook *entity.Webhook) (*entity.Webhook, error) {
+	if err := validateWebhook(webhook); err != nil {
+		return nil, err
+	}
+
+	var updatedWebhook *entity.Webhook
+
+	atomic := func(r postgresrepository.PostgresRepository) error {
+		current, err := r.Webhook().FindByID(ctx, webhook.ID)
+		if err != nil {
+			return err
+		}
+
+		if webhook.Secret == "" {
+			webhook.Secret = current.Secret
+		}
+
+		switch {
+		case webhook.Active:
+			webhook.FailureCount = 0
+			webhook.DisabledAt = nil
+		case current.Active:
+			now := time.Now()
+			webhook.FailureCount = current.FailureCount
+			webhook.DisabledAt = &now
+		default:
+			webhook.FailureCount = current.FailureCount
+			webhook.DisabledAt = current.DisabledAt
+		}
+
+		updatedWebhook, err = r.Webhook().Update(ctx, webhook)
+		return err
+	}
+
+	err := s.Repo.Postgres().Atomic(ctx, s.Config, atomic)
+	if err != nil {
+		return nil, serviceerror.TranslateRepoError(err)
+	}
+
+	return updatedWebhook, nil
+}
+
+func (s *webhookService) Delete(ctx context.Context, id uint32) error {
+	if err := s.Repo.Postgres().Webhook().Delete(ctx, id); err != nil {
+		return serviceerror.TranslateRepoError(err)
+	}
+
+	return nil
+}
+
+func (s *webhookService) FindDeliveries(ctx context.Context, filter *postgresrepository.FilterWebhookDeliveryPayload) ([]*entity.WebhookDelivery, int, error) {
+	deliveries, total, err := s.Repo.Postgres().WebhookDelivery().Find(ctx, filter)
+	if err != nil {
+		return nil, 0, serviceerror.TranslateRepoError(err)
+	}
+
+	return deliveries, total, nil
+}
+
+// Dispatch attempts up to limit due deliveries. A failed delivery is retried
+// with exponential backoff until it runs out of attempts, and every failed
+// attempt counts towards disabling its webhook. The deliveries are claimed in
+// a short transaction and sent outside of it, so a slow receiver holds no
+// locks, and the outcome of each is stored in a transaction of its own.
+func (s *webhookService) Dispatch(ctx context.Context, limit int) (*WebhookDispatch, error) {
+	deliveries, webhooks, err := s.claim(ctx, limit)
+	if err != nil {
+		return nil, serviceerror.TranslateRepoError(err)
+	}
+
+	dispatch := &WebhookDispatch{}
+
+	for _, delivery := range deliveries {
+		// The webhook may have been disabled by an earlier delivery of this
+		// batch.
+		webhook := webhooks[delivery.WebhookID]
+		if !webhook.Active {
+			continue
+		}
+
+		if err := s.attempt(ctx, webhook, delivery, dispatch); err != nil {
+			return nil, serviceerror.TranslateRepoError(err)
+		}
+	}
+
+	return dispatch, nil
+}
+
+// claim leases up to limit due deliveries to this dispatcher for long enough
+// to send them all, and returns them with their webhooks by ID. Another
+// dispatcher picks a delivery up again only once its lease has run out.
+func (s *webhookService) claim(ctx context.Context, limit int) ([]*entity.WebhookDelivery, map[uint32]*entity.Webhook, error) {
+	var (
+		claimed []*entity.WebhookDelivery
+		byID    map[uint32]*entity.Webhook
+	)
+
+	atomic := func(r postgresrepository.PostgresRepository) error {
+		claimed = nil
+		byID = map[uint32]*entity.Webhook{}
+
+		deliveries, err := r.WebhookDelivery().FindDue(ctx, time.Now(), limit)
+		if err != nil || len(deliveries) == 0 {
+			return err
+		}
+
+		webhookIDs := make([]uint32, 0, len(deliveries))
+		for _, delivery := range deliveries {
+			if !slices.Contains(webhookIDs, delivery.WebhookID) {
+				webhookIDs = append(webhookIDs, delivery.WebhookID)
+			}
+		}
+
+		webhooks, _, err := r.Webhook().Find(ctx, &postgresrepository.FilterWebhookPayload{IDs: webhookIDs})
+		if err != nil {
+			return err
+		}
+
+		for _, webhook := range webhooks {
+			byID[webhook.ID] = webhook
+		}
+
+		ids := make([]uint64, 0, len(deliveries))
+		for _, delivery := range deliveries {
+			if webhook := byID[delivery.WebhookID]; webhook != nil && webhook.Active {
+				claimed = append(claimed, delivery)
+				ids = append(ids, delivery.ID)
+			}
+		}
+
+		until := time.Now().Add(time.Duration(len(ids))*s.timeout + webhookLeaseMargin)
+
+		return r.WebhookDelivery().Lease(ctx, ids, until)
+	}
+
+	if err := s.Repo.Postgres().Atomic(ctx, s.Config, atomic); err != nil {
+		return nil, nil, err
+	}
+
+	return claimed, byID, nil
+}
+
+// attempt sends one delivery and stores its outcome.
+func (s *webhookService) attempt(
+	ctx context.Context,
+	wh *entity.Webhook,
+	delivery *entity.WebhookDelivery,
+	dispatch *WebhookDispatch,
+) error {
+	body, err := json.Marshal(map[string]any{
+		"id":         delivery.EventID,
+		"type":       delivery.EventType,
+		"created_at": delivery.CreatedAt,
+		"data":       delivery.Payload,
+	})
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	delivery.Attempts++
+
+	res, err := s.sender.Send(ctx, &webhook.Request{
+		URL:      wh.URL,
+		Secret:   wh.Secret,
+		Event:    delivery.EventType,
+		Delivery: strconv.FormatUint(delivery.ID, 10),
+		Body:     body,
+	})
+
+	delivery.ResponseStatus = 0
+	delivery.ResponseBody = ""
+
+	switch {
+	case err != nil:
+		delivery.LastError = err.Error()
+	case res.StatusCode < 200 || res.StatusCode > 299:
+		delivery.ResponseStatus = res.StatusCode
+		delivery.ResponseBody = res.Body
+		delivery.LastError = fmt.Sprintf("receiver responded with status %d", res.StatusCode)
+	default:
+		delivery.ResponseStatus = res.StatusCode
+		delivery.ResponseBody = res.Body
+		delivery.LastError = ""
+	}
+
+	succeeded := delivery.LastError == ""
+
+	switch {
+	case succeeded:
+		delivery.Status = constant.WebhookDeliveryStatusSucceeded
+		delivery.DeliveredAt = &now
+		dispatch.Succeeded++
+	case delivery.Attempts >= s.maxAttempts:
+		delivery.Status = constant.WebhookDeliveryStatusDead
+		dispatch.Dead++
+	default:
+		delivery.NextAttemptAt = now.Add(retryDelay(delivery.Attempts, webhookMinRetryDelay, webhookMaxRetryDelay))
+		dispatch.Retried++
+	}
+
+	var disabled bool
+
+	atomic := func(r postgresrepository.PostgresRepository) error {
+		if err := r.WebhookDelivery().Update(ctx, delivery); err != nil {
+			return err
+		}
+
+		disabled, err = r.Webhook().RecordAttempt(ctx, wh.ID, succeeded, s.disableAfter)
+
+		return err
+	}
+
+	if err := s.Repo.Postgres().Atomic(ctx, s.Config, atomic); err != nil {
+		return err
+	}
+
+	if disabled {
+		wh.Active = false
+		dispatch.Disabled++
+	}
+
+	return nil
+}
+
+func validateWebhook(webhook *entity.Webhook) error {
+	if webhook == nil {
+		return exception.New(exception.TypeBadRequest, exception.CodeBadRequest, "Input data cannot be null")
+	}
+
+	errs := exception.FieldErrors{}
+
+	if parsed, err := url.Parse(webhook.URL); err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		errs["url"] = append(errs["url"], "URL must be an absolute http or https URL")
+	}
+
+	if len(webhook.EventTypes) == 0 {
+		errs["event_types"] = append(errs["event_types"], "At least one event type is required")
+	}
+
+	for _, eventType := range webhook.EventTypes {
+		if !slices.Contains(webhookEventTypes, eventType) {
+			errs["event_types"] = append(errs["event_types"], fmt.Sprintf("Unknown event type %q", eventType))
+		}
+	}
+
+	if webhook.Secret != "" && len(webhook.Secret) < webhookMinSecretLen {
+		errs["secret"] = append(errs["secret"], fmt.Sprintf("Secret must be at least %d characters", webhookMinSecretLen))
+	}
+
+	if len(errs) > 0 {
+		return exception.NewWithErrors(exception.TypeValidationError, exception.CodeValidationFailed, "Invalid webhook", errs)
+	}
+
+	return nil
+}
+
+func newWebhookSecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(secret), nil
+}
@@ -0,0 +1,437 @@
+package service_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"inventory-service/config"
+	"inventory-service/constant"
+	postgresrepository "inventory-service/internal/adapter/repository/postgres"
+	"inventory-service/internal/domain/entity"
+	"inventory-service/internal/domain/service"
+	"inventory-service/internal/shared/exception"
+	"inventory-service/mocks"
+	"inventory-service/pkg/webhook"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const webhookSecret = "0123456789abcdef0123456789abcdef"
+
+// localReceivers lets webhooks reach the test receivers on the loopback
+// address.
+var localReceivers = &config.Config{Webhook: &config.WebhookConfig{AllowPrivateNetworks: true}}
+
+// Helper to initialize the mock chain for webhooks
+func setupWebhookMocks(t *testing.T) (*mocks.MockRepository, *mocks.MockPostgresRepository, *mocks.MockWebhookRepository, *mocks.MockWebhookDeliveryRepository) {
+	mRepo := mocks.NewMockRepository(t)
+	mPostgres := mocks.NewMockPostgresRepository(t)
+	mWebhook := mocks.NewMockWebhookRepository(t)
+	mDelivery := mocks.NewMockWebhookDeliveryRepository(t)
+
+	mRepo.EXPECT().Postgres().Return(mPostgres).Maybe()
+	mPostgres.EXPECT().Webhook().Return(mWebhook).Maybe()
+	mPostgres.EXPECT().WebhookDelivery().Return(mDelivery).Maybe()
+	mPostgres.EXPECT().
+		Atomic(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, cfg *config.Config, fn postgresrepository.RepositoryAtomicCallback) error {
+			return fn(mPostgres)
+		}).
+		Maybe()
+
+	return mRepo, mPostgres, mWebhook, mDelivery
+}
+
+// receivedRequest is what the test receiver saw of one webhook request.
+type receivedRequest struct {
+	header http.Header
+	body   []byte
+}
+
+// newReceiver starts a local receiver answering every request with status.
+func newReceiver(t *testing.T, status int) (*httptest.Server, chan receivedRequest) {
+	received := make(chan receivedRequest, 10)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received <- receivedRequest{header: r.Header.Clone(), body: body}
+
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte("ack"))
+	}))
+	t.Cleanup(server.Close)
+
+	return server, received
+}
+
+func TestWebhookServiceDispatchSignsAndRecordsDelivery(t *testing.T) {
+	mockRepo, _, mockWebhook, mockDelivery := setupWebhookMocks(t)
+	receiver, received := newReceiver(t, http.StatusOK)
+	ctx := context.Background()
+
+	mockDelivery.EXPECT().FindDue(ctx, mock.Anything, 10).Return([]*entity.WebhookDelivery{
+		{ID: 7, WebhookID: 1, EventID: 3, EventType: constant.EventStockChanged, Payload: map[string]any{"product_id": 5}, Status: constant.WebhookDeliveryStatusPending},
+	}, nil)
+	mockWebhook.EXPECT().
+		Find(ctx, &postgresrepository.FilterWebhookPayload{IDs: []uint32{1}}).
+		Return([]*entity.Webhook{{Base: entity.Base{ID: 1}, URL: receiver.URL, Secret: webhookSecret, Active: true}}, 1, nil)
+	// The delivery is leased for at least as long as sending it may take.
+	mockDelivery.EXPECT().
+		Lease(ctx, []uint64{7}, mock.MatchedBy(func(until time.Time) bool {
+			return until.After(time.Now().Add(constant.DefaultWebhookTimeout))
+		})).
+		Return(nil)
+	mockDelivery.EXPECT().
+		Update(ctx, mock.MatchedBy(func(delivery *entity.WebhookDelivery) bool {
+			return delivery.Status == constant.WebhookDeliveryStatusSucceeded &&
+				delivery.Attempts == 1 &&
+				delivery.ResponseStatus == http.StatusOK &&
+				delivery.ResponseBody == "ack" &&
+				delivery.DeliveredAt != nil
+		})).
+		Return(nil)
+	mockWebhook.EXPECT().RecordAttempt(ctx, uint32(1), true, constant.DefaultWebhookDisableAfter).Return(false, nil)
+
+	webhookService := service.NewWebhookService(service.Properties{Config: localReceivers, Repo: mockRepo})
+	dispatch, err := webhookService.Dispatch(ctx, 10)
+
+	assert.NoError(t, err)
+	assert.Equal(t, &service.WebhookDispatch{Succeeded: 1}, dispatch)
+
+	req := <-received
+	timestamp := req.header.Get(webhook.HeaderTimestamp)
+
+	assert.True(t, webhook.Verify(webhookSecret, timestamp, req.body, req.header.Get(webhook.HeaderSignature)))
+	assert.False(t, webhook.Verify("another-secret-of-some-length", timestamp, req.body, req.header.Get(webhook.HeaderSignature)))
+	assert.Equal(t, constant.EventStockChanged, req.header.Get(webhook.HeaderEvent))
+	assert.Equal(t, "7", req.header.Get(webhook.HeaderDelivery))
+
+	var body map[string]any
+	assert.NoError(t, json.Unmarshal(req.body, &body))
+	assert.Equal(t, float64(3), body["id"])
+	assert.Equal(t, map[string]any{"product_id": float64(5)}, body["data"])
+}
+
+func TestWebhookServiceDispatchRetriesWithBackoff(t *testing.T) {
+	mockRepo, _, mockWebhook, mockDelivery := setupWebhookMocks(t)
+	receiver, _ := newReceiver(t, http.StatusServiceUnavailable)
+	ctx := context.Background()
+	start := time.Now()
+
+	mockDelivery.EXPECT().FindDue(ctx, mock.Anything, 10).Return([]*entity.WebhookDelivery{
+		{ID: 7, WebhookID: 1, Status: constant.WebhookDeliveryStatusPending, Attempts: 2},
+	}, nil)
+	mockWebhook.EXPECT().
+		Find(ctx, mock.Anything).
+		Return([]*entity.Webhook{{Base: entity.Base{ID: 1}, URL: receiver.URL, Secret: webhookSecret, Active: true}}, 1, nil)
+	mockDelivery.EXPECT().Lease(ctx, []uint64{7}, mock.Anything).Return(nil)
+	mockDelivery.EXPECT().
+		Update(ctx, mock.MatchedBy(func(delivery *entity.WebhookDelivery) bool {
+			// The third failure waits 40s before the next attempt.
+			return delivery.Status == constant.WebhookDeliveryStatusPending &&
+				delivery.Attempts == 3 &&
+				delivery.ResponseStatus == http.StatusServiceUnavailable &&
+				delivery.ResponseBody == "ack" &&
+				delivery.LastError != "" &&
+				!delivery.NextAttemptAt.Before(start.Add(40*time.Second))
+		})).
+		Return(nil)
+	mockWebhook.EXPECT().RecordAttempt(ctx, uint32(1), false, constant.DefaultWebhookDisableAfter).Return(false, nil)
+
+	webhookService := service.NewWebhookService(service.Properties{Config: localReceivers, Repo: mockRepo})
+	dispatch, err := webhookService.Dispatch(ctx, 10)
+
+	assert.NoError(t, err)
+	assert.Equal(t, &service.WebhookDispatch{Retried: 1}, dispatch)
+}
+
+func TestWebhookServiceDispatchDisablesFailingWebhook(t *testing.T) {
+	mockRepo, _, mockWebhook, mockDelivery := setupWebhookMocks(t)
+	receiver, received := newReceiver(t, http.StatusInternalServerError)
+	ctx := context.Background()
+
+	mockDelivery.EXPECT().FindDue(ctx, mock.Anything, 10).Return([]*entity.WebhookDelivery{
+		{ID: 7, WebhookID: 1, Status: constant.WebhookDeliveryStatusPending, Attempts: 1},
+		{ID: 8, WebhookID: 1, Status: constant.WebhookDeliveryStatusPending},
+	}, nil)
+	mockWebhook.EXPECT().
+		Find(ctx, mock.Anything).
+		Return([]*entity.Webhook{{Base: entity.Base{ID: 1}, URL: receiver.URL, Secret: webhookSecret, Active: true, FailureCount: 2}}, 1, nil)
+	mockDelivery.EXPECT().Lease(ctx, []uint64{7, 8}, mock.Anything).Return(nil)
+	mockDelivery.EXPECT().
+		Update(ctx, mock.MatchedBy(func(delivery *entity.WebhookDelivery) bool {
+			return delivery.ID == 7 && delivery.Status == constant.WebhookDeliveryStatusDead
+		})).
+		Return(nil)
+	// The failure disables the webhook, so its second delivery waits.
+	mockWebhook.EXPECT().RecordAttempt(ctx, uint32(1), false, 3).Return(true, nil).Once()
+
+	webhookService := service.NewWebhookService(service.Properties{
+		Config: &config.Config{Webhook: &config.WebhookConfig{MaxAttempts: 2, DisableAfter: 3, AllowPrivateNetworks: true}},
+		Repo:   mockRepo,
+	})
+	dispatch, err := webhookService.Dispatch(ctx, 10)
+
+	assert.NoError(t, err)
+	assert.Equal(t, &service.WebhookDispatch{Dead: 1, Disabled: 1}, dispatch)
+	assert.Len(t, received, 1)
+}
+
+func TestWebhookServiceDispatchRecordsUnreachableReceiver(t *testing.T) {
+	mockRepo, _, mockWebhook, mockDelivery := setupWebhookMocks(t)
+	receiver, _ := newReceiver(t, http.StatusOK)
+	receiver.Close()
+	ctx := context.Background()
+
+	mockDelivery.EXPECT().FindDue(ctx, mock.Anything, 10).Return([]*entity.WebhookDelivery{
+		{ID: 7, WebhookID: 1, Status: constant.WebhookDeliveryStatusPending},
+	}, nil)
+	mockWebhook.EXPECT().
+		Find(ctx, mock.Anything).
+		Return([]*entity.Webhook{{Base: entity.Base{ID: 1}, URL: receiver.URL, Secret: webhookSecret, Active: true}}, 1, nil)
+	mockDelivery.EXPECT().Lease(ctx, []uint64{7}, mock.Anything).Return(nil)
+	mockDelivery.EXPECT().
+		Update(ctx, mock.MatchedBy(func(delivery *entity.WebhookDelivery) bool {
+			return delivery.Status == constant.WebhookDeliveryStatusPending &&
+				delivery.ResponseStatus == 0 &&
+				delivery.LastError != ""
+		})).
+		Return(nil)
+	mockWebhook.EXPECT().RecordAttempt(ctx, uint32(1), false, constant.DefaultWebhookDisableAfter).Return(false, nil)
+
+	webhookService := service.NewWebhookService(service.Properties{Config: localReceivers, Repo: mockRepo})
+	dispatch, err := webhookService.Dispatch(ctx, 10)
+
+	assert.NoError(t, err)
+	assert.Equal(t, &service.WebhookDispatch{Retried: 1}, dispatch)
+}
+
+func TestWebhookServiceDispatchSendsOutsideTransactions(t *testing.T) {
+	mockRepo := mocks.NewMockRepository(t)
+	mockPostgres := mocks.NewMockPostgresRepository(t)
+	mockWebhook := mocks.NewMockWebhookRepository(t)
+	mockDelivery := mocks.NewMockWebhookDeliveryRepository(t)
+	ctx := context.Background()
+
+	var inTransaction, sentInTransaction bool
+	transactions := 0
+
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sentInTransaction = sentInTransaction || inTransaction
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(receiver.Close)
+
+	mockRepo.EXPECT().Postgres().Return(mockPostgres)
+	mockPostgres.EXPECT().Webhook().Return(mockWebhook)
+	mockPostgres.EXPECT().WebhookDelivery().Return(mockDelivery)
+	mockPostgres.EXPECT().
+		Atomic(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, cfg *config.Config, fn postgresrepository.RepositoryAtomicCallback) error {
+			inTransaction = true
+			defer func() { inTransaction = false }()
+			transactions++
+
+			return fn(mockPostgres)
+		})
+
+	mockDelivery.EXPECT().FindDue(ctx, mock.Anything, 10).Return([]*entity.WebhookDelivery{
+		{ID: 7, WebhookID: 1, Status: constant.WebhookDeliveryStatusPending},
+		{ID: 8, WebhookID: 1, Status: constant.WebhookDeliveryStatusPending},
+	}, nil)
+	mockWebhook.EXPECT().
+		Find(ctx, mock.Anything).
+		Return([]*entity.Webhook{{Base: entity.Base{ID: 1}, URL: receiver.URL, Secret: webhookSecret, Active: true}}, 1, nil)
+	mockDelivery.EXPECT().Lease(ctx, []uint64{7, 8}, mock.Anything).Return(nil)
+	mockDelivery.EXPECT().Update(ctx, mock.Anything).Return(nil).Times(2)
+	mockWebhook.EXPECT().RecordAttempt(ctx, uint32(1), true, constant.DefaultWebhookDisableAfter).Return(false, nil).Times(2)
+
+	webhookService := service.NewWebhookService(service.Properties{Config: localReceivers, Repo: mockRepo})
+	dispatch, err := webhookService.Dispatch(ctx, 10)
+
+	assert.NoError(t, err)
+	assert.Equal(t, &service.WebhookDispatch{Succeeded: 2}, dispatch)
+	assert.False(t, sentInTransaction)
+	// One transaction claims the batch and one stores each outcome.
+	assert.Equal(t, 3, transactions)
+}
+
+func TestWebhookServiceDispatchRefusesPrivateReceivers(t *testing.T) {
+	mockRepo, _, mockWebhook, mockDelivery := setupWebhookMocks(t)
+	receiver, received := newReceiver(t, http.StatusOK)
+	ctx := context.Background()
+
+	mockDelivery.EXPECT().FindDue(ctx, mock.Anything, 10).Return([]*entity.WebhookDelivery{
+		{ID: 7, WebhookID: 1, Status: constant.WebhookDeliveryStatusPending},
+	}, nil)
+	mockWebhook.EXPECT().
+		Find(ctx, mock.Anything).
+		Return([]*entity.Webhook{{Base: entity.Base{ID: 1}, URL: receiver.URL, Secret: webhookSecret, Active: true}}, 1, nil)
+	mockDelivery.EXPECT().Lease(ctx, []uint64{7}, mock.Anything).Return(nil)
+	mockDelivery.EXPECT().
+		Update(ctx, mock.MatchedBy(func(delivery *entity.WebhookDelivery) bool {
+			return delivery.Status == constant.WebhookDeliveryStatusPending &&
+				strings.Contains(delivery.LastError, webhook.ErrForbiddenAddress.Error())
+		})).
+		Return(nil)
+	mockWebhook.EXPECT().RecordAttempt(ctx, uint32(1), false, constant.DefaultWebhookDisableAfter).Return(false, nil)
+
+	webhookService := service.NewWebhookService(service.Properties{Repo: mockRepo})
+	dispatch, err := webhookService.Dispatch(ctx, 10)
+
+	assert.NoError(t, err)
+	assert.Equal(t, &service.WebhookDispatch{Retried: 1}, dispatch)
+	assert.Len(t, received, 0)
+}
+
+func TestWebhookServiceDispatchDoesNotFollowRedirects(t *testing.T) {
+	mockRepo, _, mockWebhook, mockDelivery := setupWebhookMocks(t)
+	target, received := newReceiver(t, http.StatusOK)
+	ctx := context.Background()
+
+	redirect := httptest.NewServer(http.RedirectHandler(target.URL, http.StatusFound))
+	t.Cleanup(redirect.Close)
+
+	mockDelivery.EXPECT().FindDue(ctx, mock.Anything, 10).Return([]*entity.WebhookDelivery{
+		{ID: 7, WebhookID: 1, Status: constant.WebhookDeliveryStatusPending},
+	}, nil)
+	mockWebhook.EXPECT().
+		Find(ctx, mock.Anything).
+		Return([]*entity.Webhook{{Base: entity.Base{ID: 1}, URL: redirect.URL, Secret: webhookSecret, Active: true}}, 1, nil)
+	mockDelivery.EXPECT().Lease(ctx, []uint64{7}, mock.Anything).Return(nil)
+	mockDelivery.EXPECT().
+		Update(ctx, mock.MatchedBy(func(delivery *entity.WebhookDelivery) bool {
+			return delivery.Status == constant.WebhookDeliveryStatusPending &&
+				delivery.ResponseStatus == http.StatusFound
+		})).
+		Return(nil)
+	mockWebhook.EXPECT().RecordAttempt(ctx, uint32(1), false, constant.DefaultWebhookDisableAfter).Return(false, nil)
+
+	webhookService := service.NewWebhookService(service.Properties{Config: localReceivers, Repo: mockRepo})
+	dispatch, err := webhookService.Dispatch(ctx, 10)
+
+	assert.NoError(t, err)
+	assert.Equal(t, &service.WebhookDispatch{Retried: 1}, dispatch)
+	assert.Len(t, received, 0)
+}
+
+func TestWebhookServiceCreateGeneratesSecret(t *testing.T) {
+	mockRepo, _, mockWebhook, _ := setupWebhookMocks(t)
+	ctx := context.Background()
+
+	mockWebhook.EXPECT().
+		Create(ctx, mock.MatchedBy(func(webhook *entity.Webhook) bool {
+			return webhook.Active && len(webhook.Secret) == 64
+		})).
+		RunAndReturn(func(_ context.Context, webhook *entity.Webhook) (*entity.Webhook, error) {
+			return webhook, nil
+		})
+
+	webhookService := service.NewWebhookService(service.Properties{Repo: mockRepo})
+	created, err := webhookService.Create(ctx, &entity.Webhook{
+		URL:        "https://example.com/hooks",
+		EventTypes: []string{constant.EventStockChanged},
+	})
+
+	assert.NoError(t, err)
+	assert.NotEmpty(t, created.Secret)
+}
+
+func TestWebhookServiceCreateValidation(t *testing.T) {
+	mockRepo, _, _, _ := setupWebhookMocks(t)
+
+	webhookService := service.NewWebhookService(service.Properties{Repo: mockRepo})
+	_, err := webhookService.Create(context.Background(), &entity.Webhook{
+		URL:        "ftp://example.com",
+		EventTypes: []string{"inventory.unknown"},
+		Secret:     "short",
+	})
+
+	ex, ok := exception.GetException(err)
+	assert.True(t, ok)
+	assert.Equal(t, exception.TypeValidationError, ex.Type)
+	assert.Contains(t, ex.Errors, "url")
+	assert.Contains(t, ex.Errors, "event_types")
+	assert.Contains(t, ex.Errors, "secret")
+}
+
+func TestWebhookServiceUpdateEnablingClearsFailures(t *testing.T) {
+	mockRepo, _, mockWebhook, _ := setupWebhookMocks(t)
+	ctx := context.Background()
+	disabledAt := time.Now()
+
+	mockWebhook.EXPECT().FindByID(ctx, uint32(1)).Return(&entity.Webhook{
+		Base:         entity.Base{ID: 1},
+		Secret:       webhookSecret,
+		FailureCount: 20,
+		DisabledAt:   &disabledAt,
+	}, nil)
+	mockWebhook.EXPECT().
+		Update(ctx, mock.MatchedBy(func(webhook *entity.Webhook) bool {
+			return webhook.Active &&
+				webhook.Secret == webhookSecret &&
+				webhook.FailureCount == 0 &&
+				webhook.DisabledAt == nil
+		})).
+		RunAndReturn(func(_ context.Context, webhook *entity.Webhook) (*entity.Webhook, error) {
+			return webhook, nil
+		})
+
+	webhookService := service.NewWebhookService(service.Properties{Repo: mockRepo})
+	_, err := webhookService.Update(ctx, &entity.Webhook{
+		Base:       entity.Base{ID: 1},
+		URL:        "https://example.com/hooks",
+		EventTypes: []string{constant.EventStockChanged},
+		Active:     true,
+	})
+
+	assert.NoError(t, err)
+}
+
+func TestOutboxServiceDeliverFansOutToWebhooks(t *testing.T) {
+	mockRepo, mockPostgres, mockOutbox := setupOutboxMocks(t)
+	mockWebhook := mocks.NewMockWebhookRepository(t)
+	mockDelivery := mocks.NewMockWebhookDeliveryRepository(t)
+	mockPostgres.EXPECT().Webhook().Return(mockWebhook)
+	mockPostgres.EXPECT().WebhookDelivery().Return(mockDelivery)
+	ctx := context.Background()
+
+	mockOutbox.EXPECT().FindDeliverable(ctx, mock.Anything, 10).Return([]*entity.OutboxEvent{
+		{ID: 1, Type: constant.EventProductCreated},
+		{ID: 2, Type: constant.EventStockChanged},
+	}, nil)
+	mockWebhook.EXPECT().
+		FindSubscribed(ctx, []string{constant.EventProductCreated, constant.EventStockChanged}).
+		Return([]*entity.Webhook{
+			{Base: entity.Base{ID: 1}, EventTypes: []string{constant.EventStockChanged}},
+			{Base: entity.Base{ID: 2}, EventTypes: []string{constant.EventProductCreated, constant.EventStockChanged}},
+		}, nil)
+	mockDelivery.EXPECT().
+		CreateMany(ctx, mock.MatchedBy(func(deliveries []*entity.WebhookDelivery) bool {
+			return len(deliveries) == 3 &&
+				deliveries[0].WebhookID == 2 && deliveries[0].EventID == 1 &&
+				deliveries[1].WebhookID == 1 && deliveries[1].EventID == 2 &&
+				deliveries[2].WebhookID == 2 && deliveries[2].EventID == 2 &&
+				deliveries[2].Status == constant.WebhookDeliveryStatusPending
+		})).
+		Return(nil)
+	mockOutbox.EXPECT().MarkDelivered(ctx, []uint64{1, 2}, mock.Anything).Return(nil)
+
+	outboxService := service.NewOutboxService(service.Properties{
+		Config: &config.Config{Webhook: &config.WebhookConfig{Enabled: true}},
+		Repo:   mockRepo,
+	})
+	delivery, err := outboxService.Deliver(ctx, 10, func(context.Context, *entity.OutboxEvent) error {
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, &service.OutboxDelivery{Delivered: 2}, delivery)
+}
@@ -0,0 +1,68 @@
+package auth
+
+import (
+	"inventory-service/config"
+	"inventory-service/internal/shared/exception"
+	"inventory-service/pkg/jwtauth"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+const bearerScheme = "Bearer"
+
+type Authenticator interface {
+	// Authenticate resolves the caller from an Authorization header value. It
+	// fails with the exception package's auth errors.
+	Authenticate(header string) (*Principal, error)
+}
+
+type authenticator struct {
+	verifier jwtauth.Verifier
+}
+
+func NewAuthenticator(cfg *config.AuthConfig) (Authenticator, error) {
+	verifier, err := jwtauth.NewVerifier(jwtauth.Options{
+		Algorithm: cfg.Algorithm,
+		Secret:    cfg.Secret,
+		JWKSFile:  cfg.JWKSFile,
+		Issuer:    cfg.Issuer,
+		Audience:  cfg.Audience,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to setup jwt verifier")
+	}
+
+	return &authenticator{verifier: verifier}, nil
+}
+
+func (a *authenticator) Authenticate(header string) (*Principal, error) {
+	if header == "" {
+		return nil, exception.ErrAuthHeaderMissing
+	}
+
+	scheme, token, ok := strings.Cut(strings.TrimSpace(header), " ")
+	if !ok {
+		return nil, exception.ErrAuthHeaderInvalid
+	}
+
+	if !strings.EqualFold(scheme, bearerScheme) {
+		return nil, exception.ErrAuthUnsupported
+	}
+
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return nil, exception.ErrAuthHeaderInvalid
+	}
+
+	claims, err := a.verifier.Verify(token)
+	if err != nil {
+		if errors.Is(err, jwtauth.ErrTokenExpired) {
+			return nil, exception.ErrAuthTokenExpired
+		}
+
+		return nil, exception.ErrAuthTokenInvalid
+	}
+
+	return &Principal{Subject: claims.Subject, Roles: claims.Roles}, nil
+}
@@ -0,0 +1,165 @@
+package auth_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"inventory-service/config"
+	"inventory-service/internal/shared/auth"
+	"inventory-service/internal/shared/exception"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+const testSecret = "test-secret"
+
+func signHS256(t *testing.T, claims jwt.MapClaims) string {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(testSecret))
+	assert.NoError(t, err)
+
+	return token
+}
+
+func writeJWKS(t *testing.T, kid string, key *rsa.PublicKey) string {
+	set := map[string]any{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": kid,
+			"use": "sig",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}},
+	}
+
+	data, err := json.Marshal(set)
+	assert.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	assert.NoError(t, os.WriteFile(path, data, 0o600))
+
+	return path
+}
+
+func assertAuthError(t *testing.T, err error, errType exception.ErrorType, code string) {
+	ex, ok := exception.GetException(err)
+	if assert.True(t, ok) {
+		assert.Equal(t, errType, ex.Type)
+		assert.Equal(t, code, ex.Code)
+	}
+}
+
+func TestAuthenticatorHS256(t *testing.T) {
+	authenticator, err := auth.NewAuthenticator(&config.AuthConfig{Algorithm: "HS256", Secret: testSecret, Issuer: "auth-service"})
+	assert.NoError(t, err)
+
+	token := signHS256(t, jwt.MapClaims{
+		"sub":   "user-1",
+		"iss":   "auth-service",
+		"roles": []string{"operator"},
+		"exp":   time.Now().Add(time.Hour).Unix(),
+	})
+
+	principal, err := authenticator.Authenticate("Bearer " + token)
+	assert.NoError(t, err)
+	assert.Equal(t, &auth.Principal{Subject: "user-1", Roles: []string{"operator"}}, principal)
+
+	ctx := auth.WithPrincipal(context.Background(), principal)
+	fromCtx, ok := auth.PrincipalFromContext(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, principal, fromCtx)
+}
+
+func TestAuthenticatorRS256WithJWKS(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+
+	authenticator, err := auth.NewAuthenticator(&config.AuthConfig{Algorithm: "RS256", JWKSFile: writeJWKS(t, "key-1", &key.PublicKey)})
+	assert.NoError(t, err)
+
+	sign := func(kid string) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{"sub": "svc-orders", "exp": time.Now().Add(time.Hour).Unix()})
+		token.Header["kid"] = kid
+
+		signed, err := token.SignedString(key)
+		assert.NoError(t, err)
+
+		return signed
+	}
+
+	principal, err := authenticator.Authenticate("bearer " + sign("key-1"))
+	if assert.NoError(t, err) {
+		assert.Equal(t, "svc-orders", principal.Subject)
+	}
+
+	_, err = authenticator.Authenticate("Bearer " + sign("key-2"))
+	assertAuthError(t, err, exception.TypeTokenInvalid, exception.CodeTokenInvalid)
+
+	// An HS256 token is refused even when signed with something it can verify.
+	_, err = authenticator.Authenticate("Bearer " + signHS256(t, jwt.MapClaims{"sub": "user-1"}))
+	assertAuthError(t, err, exception.TypeTokenInvalid, exception.CodeTokenInvalid)
+}
+
+func TestAuthenticatorFailures(t *testing.T) {
+	authenticator, err := auth.NewAuthenticator(&config.AuthConfig{Algorithm: "HS256", Secret: testSecret, Audience: "inventory"})
+	assert.NoError(t, err)
+
+	exp := time.Now().Add(time.Hour).Unix()
+	expired := signHS256(t, jwt.MapClaims{"sub": "user-1", "aud": "inventory", "exp": time.Now().Add(-time.Minute).Unix()})
+	wrongAudience := signHS256(t, jwt.MapClaims{"sub": "user-1", "aud": "billing", "exp": exp})
+	noSubject := signHS256(t, jwt.MapClaims{"aud": "inventory", "exp": exp})
+	noExpiry := signHS256(t, jwt.MapClaims{"sub": "user-1", "aud": "inventory"})
+
+	// An expired token with a forged signature is reported as invalid, not
+	// expired.
+	forgedExpired, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"sub": "user-1",
+		"aud": "inventory",
+		"exp": time.Now().Add(-time.Minute).Unix(),
+	}).SignedString([]byte("another-secret"))
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		header  string
+		errType exception.ErrorType
+		code    string
+	}{
+		{"missing header", "", exception.TypeUnauthorized, exception.CodeAuthHeaderMissing},
+		{"no token", "Bearer ", exception.TypeUnauthorized, exception.CodeAuthHeaderInvalid},
+		{"malformed header", "token", exception.TypeUnauthorized, exception.CodeAuthHeaderInvalid},
+		{"basic auth", "Basic dXNlcjpwYXNz", exception.TypeUnauthorized, exception.CodeAuthUnsupported},
+		{"garbage token", "Bearer not-a-jwt", exception.TypeTokenInvalid, exception.CodeTokenInvalid},
+		{"expired", "Bearer " + expired, exception.TypeTokenExpired, exception.CodeTokenExpired},
+		{"wrong audience", "Bearer " + wrongAudience, exception.TypeTokenInvalid, exception.CodeTokenInvalid},
+		{"no subject", "Bearer " + noSubject, exception.TypeTokenInvalid, exception.CodeTokenInvalid},
+		{"no expiry", "Bearer " + noExpiry, exception.TypeTokenInvalid, exception.CodeTokenInvalid},
+		{"expired with forged signature", "Bearer " + forgedExpired, exception.TypeTokenInvalid, exception.CodeTokenInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := authenticator.Authenticate(tt.header)
+			assertAuthError(t, err, tt.errType, tt.code)
+		})
+	}
+}
+
+func TestNewAuthenticatorRejectsMissingKeys(t *testing.T) {
+	_, err := auth.NewAuthenticator(&config.AuthConfig{Algorithm: "HS256"})
+	assert.Error(t, err)
+
+	_, err = auth.NewAuthenticator(&config.AuthConfig{Algorithm: "RS256", JWKSFile: filepath.Join(t.TempDir(), "missing.json")})
+	assert.Error(t, err)
+
+	_, err = auth.NewAuthenticator(&config.AuthConfig{Algorithm: "none"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,27 @@
+package auth
+
+import "context"
+
+// How a client proved its identity.
+const (
+	ClientAuthMTLS   = "mtls"
+	ClientAuthAPIKey = "api_key"
+)
+
+// Client is the service calling the gRPC server, identified by its
+// certificate or API key.
+type Client struct {
+	Name   string
+	Method string
+}
+
+type clientKey struct{}
+
+func WithClient(ctx context.Context, client *Client) context.Context {
+	return context.WithValue(ctx, clientKey{}, client)
+}
+
+func ClientFromContext(ctx context.Context) (*Client, bool) {
+	client, ok := ctx.Value(clientKey{}).(*Client)
+	return client, ok && client != nil
+}
@@ -0,0 +1,53 @@
+package auth
+
+import "context"
+
+// Where a call came in.
+const (
+	SourceREST     = "rest"
+	SourceGRPC     = "grpc"
+	SourceConsumer = "consumer"
+	SourceSystem   = "system"
+)
+
+// Actors of calls that carry no identity.
+const (
+	ActorAnonymous = "anonymous"
+	ActorSystem    = "system"
+)
+
+// Origin is the transport a call came in through and the ID it is traced by.
+type Origin struct {
+	Source    string
+	RequestID string
+}
+
+type originKey struct{}
+
+func WithOrigin(ctx context.Context, origin *Origin) context.Context {
+	return context.WithValue(ctx, originKey{}, origin)
+}
+
+func OriginFromContext(ctx context.Context) (*Origin, bool) {
+	origin, ok := ctx.Value(originKey{}).(*Origin)
+	return origin, ok && origin != nil
+}
+
+// Actor names who made a call: the authenticated user, else the calling
+// service. Unidentified REST and gRPC callers are anonymous; work the service
+// starts itself, such as consuming events, is done by the system.
+func Actor(ctx context.Context) string {
+	if principal, ok := PrincipalFromContext(ctx); ok && principal.Subject != "" {
+		return principal.Subject
+	}
+
+	if client, ok := ClientFromContext(ctx); ok && client.Name != "" {
+		return client.Name
+	}
+
+	if origin, ok := OriginFromContext(ctx); ok && (origin.Source == SourceREST || origin.Source == SourceGRPC) {
+		return ActorAnonymous
+	}
+
+	return ActorSystem
+}
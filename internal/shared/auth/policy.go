@@ -0,0 +1,123 @@
+package auth
+
+import (
+	"inventory-service/config"
+	"inventory-service/constant"
+	"inventory-service/internal/shared/exception"
+	"os"
+	"slices"
+
+	"github.com/cockroachdb/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// Transports an endpoint can be reached through.
+const (
+	TransportREST = "rest"
+	TransportGRPC = "grpc"
+)
+
+type policyFile struct {
+	Roles       map[string][]string `yaml:"roles"`
+	Permissions map[string]struct {
+		REST []string `yaml:"rest"`
+		GRPC []string `yaml:"grpc"`
+	} `yaml:"permissions"`
+}
+
+// Policy maps every endpoint to the permission it requires and every role to
+// the permissions it grants.
+type Policy struct {
+	roles       map[string][]string
+	permissions map[string]bool
+	endpoints   map[string]map[string]string
+}
+
+// LoadPolicy reads the policy file. An endpoint may require only one
+// permission, and roles may only grant permissions the file defines.
+func LoadPolicy(path string) (*Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read policy file")
+	}
+
+	var file policyFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, errors.Wrap(err, "failed to parse policy file")
+	}
+
+	policy := &Policy{
+		roles:       file.Roles,
+		permissions: make(map[string]bool, len(file.Permissions)),
+		endpoints:   map[string]map[string]string{TransportREST: {}, TransportGRPC: {}},
+	}
+
+	for permission, endpoints := range file.Permissions {
+		policy.permissions[permission] = true
+
+		for transport, names := range map[string][]string{TransportREST: endpoints.REST, TransportGRPC: endpoints.GRPC} {
+			for _, name := range names {
+				if other, ok := policy.endpoints[transport][name]; ok {
+					return nil, errors.Newf("%s endpoint %q requires both %q and %q", transport, name, other, permission)
+				}
+
+				policy.endpoints[transport][name] = permission
+			}
+		}
+	}
+
+	for role, permissions := range file.Roles {
+		for _, permission := range permissions {
+			if _, ok := file.Permissions[permission]; !ok {
+				return nil, errors.Newf("role %q grants unknown permission %q", role, permission)
+			}
+		}
+	}
+
+	return policy, nil
+}
+
+// LoadConfiguredPolicy reads the policy file named by the auth config.
+func LoadConfiguredPolicy(cfg *config.AuthConfig) (*Policy, error) {
+	path := cfg.PolicyFile
+	if path == "" {
+		path = constant.DefaultPolicyFile
+	}
+
+	return LoadPolicy(path)
+}
+
+// RESTEndpoint names a REST route the way the policy file lists it.
+func RESTEndpoint(method, path string) string {
+	return method + " " + path
+}
+
+// HasPermission reports whether the policy defines the permission.
+func (p *Policy) HasPermission(permission string) bool {
+	return p.permissions[permission]
+}
+
+// Permission returns the permission an endpoint requires.
+func (p *Policy) Permission(transport, endpoint string) (string, bool) {
+	permission, ok := p.endpoints[transport][endpoint]
+	return permission, ok
+}
+
+// Authorize checks that one of the principal's roles grants the permission
+// the endpoint requires. Endpoints the policy does not list are denied.
+func (p *Policy) Authorize(principal *Principal, transport, endpoint string) error {
+	permission, ok := p.Permission(transport, endpoint)
+	if !ok {
+		return exception.New(exception.TypePermissionDenied, exception.CodeForbidden, "No permission is defined for this endpoint")
+	}
+
+	if principal != nil {
+		for _, role := range principal.Roles {
+			if slices.Contains(p.roles[role], permission) {
+				return nil
+			}
+		}
+	}
+
+	return exception.Newf(exception.TypePermissionDenied, exception.CodeForbidden, "Permission %q is required", permission)
+}
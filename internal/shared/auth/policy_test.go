@@ -0,0 +1,82 @@
+package auth_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"inventory-service/internal/shared/auth"
+	"inventory-service/internal/shared/exception"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func writePolicy(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "policy.yaml")
+	assert.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	return path
+}
+
+func TestPolicyAuthorize(t *testing.T) {
+	policy, err := auth.LoadPolicy(writePolicy(t, `
+roles:
+  viewer: [inventory.read]
+  admin: [inventory.read, product.manage]
+permissions:
+  inventory.read:
+    rest: [GET /api/v1/products]
+    grpc: [ListProducts]
+  product.manage:
+    grpc: [DeleteProduct]
+`))
+	assert.NoError(t, err)
+
+	viewer := &auth.Principal{Subject: "user-1", Roles: []string{"viewer"}}
+	admin := &auth.Principal{Subject: "user-2", Roles: []string{"unknown", "admin"}}
+
+	assert.NoError(t, policy.Authorize(viewer, auth.TransportREST, auth.RESTEndpoint("GET", "/api/v1/products")))
+	assert.NoError(t, policy.Authorize(viewer, auth.TransportGRPC, "ListProducts"))
+	assert.NoError(t, policy.Authorize(admin, auth.TransportGRPC, "DeleteProduct"))
+
+	tests := []struct {
+		name      string
+		principal *auth.Principal
+		transport string
+		endpoint  string
+	}{
+		{"missing permission", viewer, auth.TransportGRPC, "DeleteProduct"},
+		{"no roles", &auth.Principal{Subject: "user-3"}, auth.TransportGRPC, "ListProducts"},
+		{"unlisted endpoint", admin, auth.TransportGRPC, "CreateProduct"},
+		{"other transport", admin, auth.TransportREST, "DeleteProduct"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := policy.Authorize(tt.principal, tt.transport, tt.endpoint)
+			assertAuthError(t, err, exception.TypePermissionDenied, exception.CodeForbidden)
+		})
+	}
+}
+
+func TestLoadPolicyRejectsInconsistentFiles(t *testing.T) {
+	_, err := auth.LoadPolicy(writePolicy(t, `
+roles:
+  viewer: [inventory.read]
+permissions:
+  inventory.read:
+    grpc: [ListProducts]
+  product.manage:
+    grpc: [ListProducts]
+`))
+	assert.ErrorContains(t, err, "ListProducts")
+
+	_, err = auth.LoadPolicy(writePolicy(t, `
+roles:
+  viewer: [inventory.write]
+permissions:
+  inventory.read:
+    grpc: [ListProducts]
+`))
+	assert.ErrorContains(t, err, "inventory.write")
+}
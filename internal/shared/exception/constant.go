@@ -18,6 +18,7 @@ const (
 	TypeNotFound             ErrorType = "Not Found"
 	TypeMethodNotAllowed     ErrorType = "Method Not Allowed"
 	TypeConflict             ErrorType = "Conflict"
+	TypeFailedPrecondition   ErrorType = "Failed Precondition"
 	TypeUnsupportedMediaType ErrorType = "Unsupported Media Type"
 	TypeRateLimitExceeded    ErrorType = "Rate Limit Exceeded"
 	TypeQueryError           ErrorType = "Query Error"
@@ -48,7 +49,18 @@ const (
 	CodeAuthHeaderMissing     = "AUTH_HEADER_MISSING"
 	CodeAuthHeaderInvalid     = "AUTH_HEADER_INVALID"
 	CodeAuthUnsupported       = "AUTH_UNSUPPORTED"
+	CodeAPIKeyInvalid         = "API_KEY_INVALID"
+	CodeRateLimitExceeded     = "RATE_LIMIT_EXCEEDED"
 	CodeDBConstraintViolation = "DB_CONSTRAINT_VIOLATION"
+	CodeInsufficientStock     = "INSUFFICIENT_STOCK"
+	CodeProductNotActive      = "PRODUCT_NOT_ACTIVE"
+)
+
+const (
+	pgCodeUniqueViolation           = "23505"
+	pgCodeForeignKeyViolation       = "23503"
+	pgCodeNotNullViolation          = "23502"
+	pgCodeStringDataRightTruncation = "22001"
 )
 
 var (
@@ -64,12 +76,18 @@ var (
 	ErrTimeout        = errors.New("operation timed out")
 	ErrConnection     = errors.New("connection error")
 	ErrTxFailed       = errors.New("transaction failed")
+
+	ErrInsufficientStock = errors.New("insufficient stock")
 )
 
 var (
-	ErrAuthHeaderMissing    = New(TypePermissionDenied, CodeAuthHeaderMissing, "Authorization header not provided")
-	ErrAuthHeaderInvalid    = New(TypePermissionDenied, CodeAuthHeaderInvalid, "Invalid authorization header format")
-	ErrAuthUnsupported      = New(TypePermissionDenied, CodeAuthUnsupported, "Unsupported authorization type")
-	ErrAuthTokenInvalid     = New(TypeBadRequest, CodeTokenInvalid, "Invalid or expired token")
+	ErrAuthHeaderMissing    = New(TypeUnauthorized, CodeAuthHeaderMissing, "Authorization header not provided")
+	ErrAuthHeaderInvalid    = New(TypeUnauthorized, CodeAuthHeaderInvalid, "Invalid authorization header format")
+	ErrAuthUnsupported      = New(TypeUnauthorized, CodeAuthUnsupported, "Unsupported authorization type")
+	ErrAuthTokenInvalid     = New(TypeTokenInvalid, CodeTokenInvalid, "Invalid token")
+	ErrAuthTokenExpired     = New(TypeTokenExpired, CodeTokenExpired, "Token has expired")
 	ErrAuthTokenBlacklisted = New(TypePermissionDenied, CodeTokenBlacklisted, "Token has been logged out")
+	ErrClientUnidentified   = New(TypeUnauthorized, CodeUnauthorized, "Client certificate or API key required")
+	ErrAPIKeyInvalid        = New(TypeUnauthorized, CodeAPIKeyInvalid, "Invalid API key")
+	ErrAPIKeyInsecure       = New(TypeUnauthorized, CodeAPIKeyInvalid, "API keys are only accepted over TLS")
 )
@@ -1,12 +1,15 @@
 package exception
 
 import (
+	"database/sql"
 	"fmt"
 	"reflect"
+	"regexp"
 	"strings"
 
 	"github.com/cockroachdb/errors"
 	validator "github.com/go-playground/validator/v10"
+	"github.com/jackc/pgx/v5/pgconn"
 )
 
 type ErrorType string
@@ -243,8 +246,34 @@ func handleDBError(err error, tableName, operation string) error {
 	return Wrap(err, TypeQueryError, "DB_ERROR", fmt.Sprintf("Error during %s on table %s: %v", operation, tableName, err))
 }
 
-// NewDBError creates a database-specific error.
+var pgKeyDetailRegex = regexp.MustCompile(`Key \((.+?)\)=\((.*?)\)`)
+
+// NewDBError creates a database-specific error. Well-known driver failures are
+// wrapped around their sentinel errors so that callers can translate them.
 func NewDBError(err error, table string, operation string) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return errors.Wrapf(ErrNotFound, "record not found on table '%s' during '%s'", table, operation)
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case pgCodeUniqueViolation:
+			field, value := pgErr.ConstraintName, ""
+			if matches := pgKeyDetailRegex.FindStringSubmatch(pgErr.Detail); len(matches) > 2 {
+				field, value = matches[1], matches[2]
+			}
+
+			return errors.Wrapf(ErrDuplicateEntry, "duplicate value '%s' for field '%s'", value, field)
+		case pgCodeForeignKeyViolation:
+			return errors.Wrapf(ErrForeignKey, "%s", pgErr.Detail)
+		case pgCodeNotNullViolation:
+			return errors.Wrapf(ErrNotNull, "field '%s' cannot be null", pgErr.ColumnName)
+		case pgCodeStringDataRightTruncation:
+			return errors.Wrapf(ErrDataTooLong, "value too long on table '%s'", table)
+		}
+	}
+
 	message := fmt.Sprintf("Database error on table '%s' during '%s': %v", table, operation, err)
 	return Wrap(err, "DB_ERROR", "DB001", message)
 }
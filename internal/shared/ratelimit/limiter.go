@@ -0,0 +1,153 @@
+package ratelimit
+
+import (
+	"context"
+	"inventory-service/config"
+	"inventory-service/constant"
+	"inventory-service/internal/shared/auth"
+	"inventory-service/internal/shared/exception"
+	"inventory-service/pkg/logger"
+	"inventory-service/pkg/tokenbucket"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// metaRetryAfter is the exception metadata key holding how long a limited
+// caller should wait.
+const metaRetryAfter = "retry_after"
+
+// ipBucket prefixes the keys of the per IP buckets. Group names are
+// permissions, which never contain a space.
+const ipBucket = "remote ip"
+
+// Limiter gives every caller a token bucket per endpoint group. Endpoints are
+// grouped by the permission the policy file assigns them; those of groups
+// without a limit of their own share the default bucket. Every IP address
+// also has a bucket of its own, taken from before the caller is known.
+type Limiter struct {
+	store   tokenbucket.Store
+	policy  *auth.Policy
+	limit   tokenbucket.Limit
+	ipLimit tokenbucket.Limit
+	groups  map[string]tokenbucket.Limit
+	logger  logger.Logger
+}
+
+// NewLimiter groups endpoints by the policy file the auth config names, and
+// checks that every configured group is one of its permissions.
+func NewLimiter(cfg *config.Config, store tokenbucket.Store, appLogger logger.Logger) (*Limiter, error) {
+	authConfig := cfg.Auth
+	if authConfig == nil {
+		authConfig = &config.AuthConfig{}
+	}
+
+	policy, err := auth.LoadConfiguredPolicy(authConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	l := &Limiter{
+		store:   store,
+		policy:  policy,
+		limit:   tokenbucket.Limit{Rate: constant.DefaultRateLimitRate, Burst: constant.DefaultRateLimitBurst},
+		ipLimit: tokenbucket.Limit{Rate: constant.DefaultRateLimitIPRate, Burst: constant.DefaultRateLimitIPBurst},
+		groups:  make(map[string]tokenbucket.Limit, len(cfg.RateLimit.Groups)),
+		logger:  appLogger,
+	}
+
+	if cfg.RateLimit.Rate > 0 {
+		l.limit.Rate = cfg.RateLimit.Rate
+	}
+
+	if cfg.RateLimit.Burst > 0 {
+		l.limit.Burst = cfg.RateLimit.Burst
+	}
+
+	if cfg.RateLimit.IPRate > 0 {
+		l.ipLimit.Rate = cfg.RateLimit.IPRate
+	}
+
+	if cfg.RateLimit.IPBurst > 0 {
+		l.ipLimit.Burst = cfg.RateLimit.IPBurst
+	}
+
+	for group, limit := range cfg.RateLimit.Groups {
+		if !policy.HasPermission(group) {
+			return nil, errors.Newf("rate limit group %q is not a permission of the policy", group)
+		}
+
+		if limit.Rate <= 0 || limit.Burst <= 0 {
+			return nil, errors.Newf("rate limit group %q needs a positive rate and burst", group)
+		}
+
+		l.groups[group] = tokenbucket.Limit{Rate: limit.Rate, Burst: limit.Burst}
+	}
+
+	return l, nil
+}
+
+// Allow takes a token for the caller from the bucket of the endpoint's group,
+// failing with a rate limit exception when the bucket is empty. Calls are let
+// through when the store cannot be reached.
+func (l *Limiter) Allow(ctx context.Context, caller, transport, endpoint string) error {
+	limit := l.limit
+
+	group, _ := l.policy.Permission(transport, endpoint)
+	if groupLimit, ok := l.groups[group]; ok {
+		limit = groupLimit
+	} else {
+		group = ""
+	}
+
+	return l.take(ctx, group+"|"+caller, limit)
+}
+
+// AllowIP takes a token from the bucket of the IP address a call came from,
+// before it is authenticated, so callers without valid credentials are
+// limited too. Calls are let through when the store cannot be reached.
+func (l *Limiter) AllowIP(ctx context.Context, ip string) error {
+	return l.take(ctx, ipBucket+"|"+ip, l.ipLimit)
+}
+
+func (l *Limiter) take(ctx context.Context, key string, limit tokenbucket.Limit) error {
+	allowed, retryAfter, err := l.store.Take(ctx, key, limit)
+	if err != nil {
+		l.logger.Error().Err(err).Msg("Failed to check rate limit, allowing the call")
+		return nil
+	}
+
+	if allowed {
+		return nil
+	}
+
+	return exception.WithMeta(
+		exception.New(exception.TypeRateLimitExceeded, exception.CodeRateLimitExceeded, "Too many requests, retry later"),
+		metaRetryAfter, retryAfter,
+	)
+}
+
+// Caller identifies who a call is counted against: the JWT subject, else the
+// calling service, else the remote IP.
+func Caller(ctx context.Context, ip string) string {
+	if principal, ok := auth.PrincipalFromContext(ctx); ok && principal.Subject != "" {
+		return "user:" + principal.Subject
+	}
+
+	if client, ok := auth.ClientFromContext(ctx); ok && client.Name != "" {
+		return "client:" + client.Name
+	}
+
+	return "ip:" + ip
+}
+
+// RetryAfter returns how long the caller of a rate limited call should wait.
+func RetryAfter(err error) (time.Duration, bool) {
+	ex, ok := exception.GetException(err)
+	if !ok || ex.Type != exception.TypeRateLimitExceeded {
+		return 0, false
+	}
+
+	retryAfter, ok := ex.Metadata[metaRetryAfter].(time.Duration)
+	return retryAfter, ok
+}
@@ -0,0 +1,131 @@
+package ratelimit_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"inventory-service/config"
+	"inventory-service/internal/shared/auth"
+	"inventory-service/internal/shared/exception"
+	"inventory-service/internal/shared/ratelimit"
+	"inventory-service/pkg/logger"
+	"inventory-service/pkg/tokenbucket"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+// policyFile is the policy the service ships with.
+const policyFile = "../../../config/policy.yaml"
+
+func newLimiter(t *testing.T, rateLimit *config.RateLimitConfig, store tokenbucket.Store) *ratelimit.Limiter {
+	limiter, err := ratelimit.NewLimiter(&config.Config{
+		Auth:      &config.AuthConfig{PolicyFile: policyFile},
+		RateLimit: rateLimit,
+	}, store, logger.NewZerologLogger(false))
+	assert.NoError(t, err)
+
+	return limiter
+}
+
+func TestLimiterAllowsBurstThenRejects(t *testing.T) {
+	limiter := newLimiter(t, &config.RateLimitConfig{Rate: 1, Burst: 2}, tokenbucket.NewMemoryStore())
+	ctx := context.Background()
+
+	assert.NoError(t, limiter.Allow(ctx, "ip:10.0.0.1", auth.TransportGRPC, "ListProducts"))
+	assert.NoError(t, limiter.Allow(ctx, "ip:10.0.0.1", auth.TransportGRPC, "ListProducts"))
+
+	err := limiter.Allow(ctx, "ip:10.0.0.1", auth.TransportGRPC, "ListProducts")
+	ex, ok := exception.GetException(err)
+	if assert.True(t, ok) {
+		assert.Equal(t, exception.TypeRateLimitExceeded, ex.Type)
+		assert.Equal(t, exception.CodeRateLimitExceeded, ex.Code)
+	}
+
+	retryAfter, ok := ratelimit.RetryAfter(err)
+	assert.True(t, ok)
+	assert.Greater(t, retryAfter, time.Duration(0))
+	assert.LessOrEqual(t, retryAfter, time.Second)
+
+	// Other callers have buckets of their own.
+	assert.NoError(t, limiter.Allow(ctx, "ip:10.0.0.2", auth.TransportGRPC, "ListProducts"))
+}
+
+func TestLimiterGroupsEndpointsByPermission(t *testing.T) {
+	limiter := newLimiter(t, &config.RateLimitConfig{
+		Rate:   100,
+		Burst:  100,
+		Groups: map[string]config.RateLimit{"stock.adjust": {Rate: 1, Burst: 1}},
+	}, tokenbucket.NewMemoryStore())
+	ctx := context.Background()
+
+	// AdjustStock and the REST adjustment route share the stock.adjust bucket.
+	assert.NoError(t, limiter.Allow(ctx, "user:user-1", auth.TransportGRPC, "AdjustStock"))
+
+	err := limiter.Allow(ctx, "user:user-1", auth.TransportREST, auth.RESTEndpoint("POST", "/api/v1/products/:id/adjustments"))
+	_, ok := ratelimit.RetryAfter(err)
+	assert.True(t, ok)
+
+	// Endpoints of other groups use the default limit.
+	assert.NoError(t, limiter.Allow(ctx, "user:user-1", auth.TransportGRPC, "ListProducts"))
+}
+
+func TestLimiterLimitsIPsSeparately(t *testing.T) {
+	limiter := newLimiter(t, &config.RateLimitConfig{Rate: 1, Burst: 1, IPRate: 1, IPBurst: 2}, tokenbucket.NewMemoryStore())
+	ctx := context.Background()
+
+	assert.NoError(t, limiter.AllowIP(ctx, "10.0.0.1"))
+	assert.NoError(t, limiter.AllowIP(ctx, "10.0.0.1"))
+
+	_, ok := ratelimit.RetryAfter(limiter.AllowIP(ctx, "10.0.0.1"))
+	assert.True(t, ok)
+
+	// The IP bucket is not the bucket of an unauthenticated caller.
+	assert.NoError(t, limiter.Allow(ctx, "ip:10.0.0.1", auth.TransportGRPC, "ListProducts"))
+	assert.NoError(t, limiter.AllowIP(ctx, "10.0.0.2"))
+}
+
+func TestNewLimiterRejectsInvalidGroups(t *testing.T) {
+	tests := []struct {
+		name   string
+		groups map[string]config.RateLimit
+	}{
+		{"unknown permission", map[string]config.RateLimit{"stock.read": {Rate: 1, Burst: 1}}},
+		{"zero burst", map[string]config.RateLimit{"stock.adjust": {Rate: 1}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ratelimit.NewLimiter(&config.Config{
+				Auth:      &config.AuthConfig{PolicyFile: policyFile},
+				RateLimit: &config.RateLimitConfig{Groups: tt.groups},
+			}, tokenbucket.NewMemoryStore(), logger.NewZerologLogger(false))
+			assert.Error(t, err)
+		})
+	}
+}
+
+// failingStore cannot be reached.
+type failingStore struct{}
+
+func (failingStore) Take(context.Context, string, tokenbucket.Limit) (bool, time.Duration, error) {
+	return false, 0, errors.New("store unavailable")
+}
+
+func TestLimiterAllowsCallsWhenStoreFails(t *testing.T) {
+	limiter := newLimiter(t, &config.RateLimitConfig{}, failingStore{})
+
+	assert.NoError(t, limiter.Allow(context.Background(), "ip:10.0.0.1", auth.TransportGRPC, "ListProducts"))
+}
+
+func TestCaller(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, "ip:10.0.0.1", ratelimit.Caller(ctx, "10.0.0.1"))
+
+	ctx = auth.WithClient(ctx, &auth.Client{Name: "order-service", Method: auth.ClientAuthAPIKey})
+	assert.Equal(t, "client:order-service", ratelimit.Caller(ctx, "10.0.0.1"))
+
+	ctx = auth.WithPrincipal(ctx, &auth.Principal{Subject: "user-1"})
+	assert.Equal(t, "user:user-1", ratelimit.Caller(ctx, "10.0.0.1"))
+}
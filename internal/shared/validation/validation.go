@@ -0,0 +1,149 @@
+package validation
+
+import (
+	"fmt"
+	"inventory-service/constant"
+	"inventory-service/internal/shared/exception"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Validator collects the field errors of a request, keyed by the path of the
+// field such as products.0.name. The rules are shared by the REST and gRPC
+// APIs, so both reject the same requests.
+type Validator struct {
+	errs exception.FieldErrors
+}
+
+func New() *Validator {
+	return &Validator{errs: exception.FieldErrors{}}
+}
+
+// Field joins the parts of a nested field path.
+func Field(parts ...any) string {
+	path := make([]string, len(parts))
+	for i, part := range parts {
+		path[i] = fmt.Sprint(part)
+	}
+
+	return strings.Join(path, ".")
+}
+
+// Check records message against field unless ok.
+func (v *Validator) Check(ok bool, field, message string) {
+	if !ok {
+		v.errs[field] = append(v.errs[field], message)
+	}
+}
+
+func (v *Validator) Required(field, value string) {
+	v.Check(value != "", field, "This field is required")
+}
+
+func (v *Validator) RequiredID(field string, id uint32) {
+	v.Check(id > 0, field, "This field is required")
+}
+
+func (v *Validator) MaxLength(field, value string, max int) {
+	v.Check(utf8.RuneCountInString(value) <= max, field, fmt.Sprintf("This field must be at most %d characters long", max))
+}
+
+func (v *Validator) Min(field string, value, min int) {
+	v.Check(value >= min, field, fmt.Sprintf("This field must be greater than or equal to %d", min))
+}
+
+func (v *Validator) Max(field string, value, max int) {
+	v.Check(value <= max, field, fmt.Sprintf("This field must be less than or equal to %d", max))
+}
+
+// OneOf accepts an empty value; combine it with Required otherwise.
+func (v *Validator) OneOf(field, value string, allowed ...string) {
+	v.Check(value == "" || slices.Contains(allowed, value), field, "This field must be one of "+strings.Join(allowed, ", "))
+}
+
+// Pagination checks the page and per_page parameters of a list call.
+func (v *Validator) Pagination(page, perPage int) {
+	v.Min("page", page, 0)
+	v.Min("per_page", perPage, 0)
+	v.Max("per_page", perPage, constant.MaxPerPage)
+}
+
+// Name is the required name of a product or category.
+func (v *Validator) Name(field, name string) {
+	v.Required(field, name)
+	v.MaxLength(field, name, constant.MaxNameLength)
+}
+
+func (v *Validator) SKU(field, sku string) {
+	v.Required(field, sku)
+	v.MaxLength(field, sku, constant.MaxSKULength)
+}
+
+// Barcode is optional; a given one is digits only.
+func (v *Validator) Barcode(field, barcode string) {
+	if barcode == "" {
+		return
+	}
+
+	_, err := strconv.ParseUint(barcode, 10, 64)
+	v.Check(err == nil, field, "This field must be numeric")
+	v.MaxLength(field, barcode, constant.MaxBarcodeLength)
+}
+
+// Currency is optional; a given one is a three letter upper case ISO 4217 code.
+func (v *Validator) Currency(field, currency string) {
+	if currency == "" {
+		return
+	}
+
+	v.Check(len(currency) == 3 && strings.ToUpper(currency) == currency, field, "This field must be a three letter upper case currency code")
+}
+
+// Unit is optional and defaults to the base unit.
+func (v *Validator) Unit(field, unit string) {
+	v.OneOf(field, unit, constant.UnitEach, constant.UnitPack, constant.UnitCase, constant.UnitPallet)
+}
+
+// PackagingUnit is a unit a product can be packed in, so not the base unit.
+func (v *Validator) PackagingUnit(field, unit string) {
+	v.Required(field, unit)
+	v.OneOf(field, unit, constant.UnitPack, constant.UnitCase, constant.UnitPallet)
+}
+
+func (v *Validator) LotNumber(field, lotNumber string) {
+	v.Required(field, lotNumber)
+	v.MaxLength(field, lotNumber, constant.MaxLotNumberLength)
+}
+
+// SerialNumbers are the serial numbers registered at once, at least one.
+func (v *Validator) SerialNumbers(field string, serialNumbers []string) {
+	v.Check(len(serialNumbers) > 0, field, "This field is required")
+	for i, serialNumber := range serialNumbers {
+		field := Field(field, i)
+		v.Required(field, serialNumber)
+		v.MaxLength(field, serialNumber, constant.MaxSerialNumberLength)
+	}
+}
+
+// Webhook checks the fields a webhook is created and updated with.
+func (v *Validator) Webhook(url string, eventTypes []string, secret string) {
+	v.Required("url", url)
+	v.Check(len(eventTypes) > 0, "event_types", "This field is required")
+	v.MaxLength("secret", secret, constant.MaxWebhookSecretLength)
+}
+
+func (v *Validator) Valid() bool {
+	return len(v.errs) == 0
+}
+
+// Err is a validation exception holding the collected field errors, or nil
+// when there are none.
+func (v *Validator) Err(message string) error {
+	if v.Valid() {
+		return nil
+	}
+
+	return exception.NewWithErrors(exception.TypeValidationError, exception.CodeValidationFailed, message, v.errs)
+}
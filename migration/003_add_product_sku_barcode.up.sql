@@ -0,0 +1,13 @@
+START TRANSACTION;
+
+ALTER TABLE "products" ADD COLUMN IF NOT EXISTS "sku" VARCHAR(64);
+ALTER TABLE "products" ADD COLUMN IF NOT EXISTS "barcode" VARCHAR(14);
+
+UPDATE "products" SET "sku" = 'SKU-' || "id" WHERE "sku" IS NULL;
+
+ALTER TABLE "products" ALTER COLUMN "sku" SET NOT NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS "uq_products_sku" ON "products" ("sku");
+CREATE UNIQUE INDEX IF NOT EXISTS "uq_products_barcode" ON "products" ("barcode") WHERE "barcode" IS NOT NULL;
+
+COMMIT;
@@ -0,0 +1,21 @@
+START TRANSACTION;
+
+CREATE TABLE IF NOT EXISTS "categories" (
+    "id" SERIAL PRIMARY KEY,
+    "parent_id" INT,
+    "name" VARCHAR(255) NOT NULL,
+    "description" TEXT NOT NULL DEFAULT '',
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "deleted_at" TIMESTAMPTZ,
+    CONSTRAINT "fk_categories_parent_id_categories" FOREIGN KEY ("parent_id") REFERENCES "categories"("id") ON DELETE RESTRICT
+);
+
+CREATE INDEX IF NOT EXISTS "idx_categories_parent_id" ON "categories" ("parent_id");
+
+ALTER TABLE "products" ADD COLUMN IF NOT EXISTS "category_id" INT;
+ALTER TABLE "products" ADD CONSTRAINT "fk_products_category_id_categories" FOREIGN KEY ("category_id") REFERENCES "categories"("id") ON DELETE RESTRICT;
+
+CREATE INDEX IF NOT EXISTS "idx_products_category_id" ON "products" ("category_id");
+
+COMMIT;
@@ -0,0 +1,10 @@
+START TRANSACTION;
+
+ALTER TABLE "products" ADD COLUMN IF NOT EXISTS "parent_id" INT;
+ALTER TABLE "products" ADD COLUMN IF NOT EXISTS "options" JSONB NOT NULL DEFAULT '{}'::jsonb;
+ALTER TABLE "products" ADD CONSTRAINT "fk_products_parent_id_products" FOREIGN KEY ("parent_id") REFERENCES "products"("id") ON DELETE RESTRICT;
+
+CREATE INDEX IF NOT EXISTS "idx_products_parent_id" ON "products" ("parent_id");
+CREATE UNIQUE INDEX IF NOT EXISTS "uq_products_parent_id_options" ON "products" ("parent_id", "options") WHERE "parent_id" IS NOT NULL;
+
+COMMIT;
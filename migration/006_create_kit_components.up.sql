@@ -0,0 +1,20 @@
+START TRANSACTION;
+
+CREATE TABLE IF NOT EXISTS "kit_components" (
+    "kit_id" INT NOT NULL,
+    "component_id" INT NOT NULL,
+    "quantity" INT NOT NULL,
+    PRIMARY KEY ("kit_id", "component_id"),
+    CONSTRAINT "fk_kit_components_kit_id_products" FOREIGN KEY ("kit_id") REFERENCES "products"("id") ON DELETE CASCADE,
+    CONSTRAINT "fk_kit_components_component_id_products" FOREIGN KEY ("component_id") REFERENCES "products"("id") ON DELETE RESTRICT,
+    CONSTRAINT "chk_kit_components_quantity" CHECK ("quantity" > 0)
+);
+
+CREATE INDEX IF NOT EXISTS "idx_kit_components_component_id" ON "kit_components" ("component_id");
+
+ALTER TABLE "reservations" ADD COLUMN IF NOT EXISTS "parent_id" INT;
+ALTER TABLE "reservations" ADD CONSTRAINT "fk_reservations_parent_id_reservations" FOREIGN KEY ("parent_id") REFERENCES "reservations"("id") ON DELETE RESTRICT;
+
+CREATE INDEX IF NOT EXISTS "idx_reservations_parent_id" ON "reservations" ("parent_id");
+
+COMMIT;
@@ -0,0 +1,32 @@
+START TRANSACTION;
+
+ALTER TABLE "products" ADD COLUMN IF NOT EXISTS "track_lots" BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE IF NOT EXISTS "lots" (
+    "id" SERIAL PRIMARY KEY,
+    "product_id" INT NOT NULL,
+    "lot_number" VARCHAR(64) NOT NULL,
+    "expires_at" TIMESTAMPTZ,
+    "quantity" INT NOT NULL,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "deleted_at" TIMESTAMPTZ,
+    CONSTRAINT "fk_lots_product_id_products" FOREIGN KEY ("product_id") REFERENCES "products"("id") ON DELETE RESTRICT,
+    CONSTRAINT "chk_lots_quantity" CHECK ("quantity" >= 0)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS "uq_lots_product_id_lot_number" ON "lots" ("product_id", "lot_number");
+CREATE INDEX IF NOT EXISTS "idx_lots_product_id_expires_at" ON "lots" ("product_id", "expires_at");
+
+CREATE TABLE IF NOT EXISTS "reservation_lots" (
+    "reservation_id" INT NOT NULL,
+    "lot_id" INT NOT NULL,
+    "quantity" INT NOT NULL,
+    PRIMARY KEY ("reservation_id", "lot_id"),
+    CONSTRAINT "fk_reservation_lots_reservation_id_reservations" FOREIGN KEY ("reservation_id") REFERENCES "reservations"("id") ON DELETE CASCADE,
+    CONSTRAINT "fk_reservation_lots_lot_id_lots" FOREIGN KEY ("lot_id") REFERENCES "lots"("id") ON DELETE RESTRICT
+);
+
+CREATE INDEX IF NOT EXISTS "idx_reservation_lots_lot_id" ON "reservation_lots" ("lot_id");
+
+COMMIT;
@@ -0,0 +1,24 @@
+START TRANSACTION;
+
+ALTER TABLE "products" ADD COLUMN IF NOT EXISTS "track_serials" BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE IF NOT EXISTS "serials" (
+    "id" SERIAL PRIMARY KEY,
+    "product_id" INT NOT NULL,
+    "serial_number" VARCHAR(128) NOT NULL,
+    "status" VARCHAR(16) NOT NULL DEFAULT 'AVAILABLE',
+    "reservation_id" INT,
+    "order_id" INT,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "deleted_at" TIMESTAMPTZ,
+    CONSTRAINT "fk_serials_product_id_products" FOREIGN KEY ("product_id") REFERENCES "products"("id") ON DELETE RESTRICT,
+    CONSTRAINT "fk_serials_reservation_id_reservations" FOREIGN KEY ("reservation_id") REFERENCES "reservations"("id") ON DELETE SET NULL,
+    CONSTRAINT "chk_serials_status" CHECK ("status" IN ('AVAILABLE', 'RESERVED', 'ASSIGNED'))
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS "uq_serials_serial_number" ON "serials" ("serial_number");
+CREATE INDEX IF NOT EXISTS "idx_serials_product_id_status" ON "serials" ("product_id", "status");
+CREATE INDEX IF NOT EXISTS "idx_serials_reservation_id" ON "serials" ("reservation_id");
+
+COMMIT;
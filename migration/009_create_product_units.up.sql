@@ -0,0 +1,17 @@
+START TRANSACTION;
+
+CREATE TABLE IF NOT EXISTS "product_units" (
+    "product_id" INT NOT NULL,
+    "unit" VARCHAR(16) NOT NULL,
+    "factor" INT NOT NULL,
+    PRIMARY KEY ("product_id", "unit"),
+    CONSTRAINT "fk_product_units_product_id_products" FOREIGN KEY ("product_id") REFERENCES "products"("id") ON DELETE CASCADE,
+    CONSTRAINT "chk_product_units_factor" CHECK ("factor" > 0)
+);
+
+ALTER TABLE "reservations" ADD COLUMN IF NOT EXISTS "unit" VARCHAR(16) NOT NULL DEFAULT 'each';
+ALTER TABLE "reservations" ADD COLUMN IF NOT EXISTS "unit_quantity" INT;
+UPDATE "reservations" SET "unit_quantity" = "quantity" WHERE "unit_quantity" IS NULL;
+ALTER TABLE "reservations" ALTER COLUMN "unit_quantity" SET NOT NULL;
+
+COMMIT;
@@ -0,0 +1,6 @@
+START TRANSACTION;
+
+ALTER TABLE "products" ADD COLUMN IF NOT EXISTS "currency" CHAR(3) NOT NULL DEFAULT 'USD';
+ALTER TABLE "products" ADD CONSTRAINT "chk_products_price" CHECK ("price" >= 0);
+
+COMMIT;
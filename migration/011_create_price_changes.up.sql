@@ -0,0 +1,23 @@
+START TRANSACTION;
+
+CREATE TABLE IF NOT EXISTS "price_changes" (
+    "id" SERIAL PRIMARY KEY,
+    "product_id" INT NOT NULL,
+    "price" DECIMAL(10,2) NOT NULL,
+    "currency" CHAR(3) NOT NULL,
+    "effective_from" TIMESTAMPTZ NOT NULL,
+    "applied_at" TIMESTAMPTZ,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "deleted_at" TIMESTAMPTZ,
+    CONSTRAINT "fk_price_changes_product_id_products" FOREIGN KEY ("product_id") REFERENCES "products"("id") ON DELETE CASCADE,
+    CONSTRAINT "chk_price_changes_price" CHECK ("price" >= 0)
+);
+
+CREATE INDEX IF NOT EXISTS "idx_price_changes_product_id_effective_from" ON "price_changes" ("product_id", "effective_from");
+CREATE INDEX IF NOT EXISTS "idx_price_changes_pending" ON "price_changes" ("effective_from") WHERE "applied_at" IS NULL AND "deleted_at" IS NULL;
+
+INSERT INTO "price_changes" ("product_id", "price", "currency", "effective_from", "applied_at")
+SELECT "id", "price", "currency", "created_at", "created_at" FROM "products";
+
+COMMIT;
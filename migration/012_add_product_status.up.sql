@@ -0,0 +1,8 @@
+START TRANSACTION;
+
+ALTER TABLE "products" ADD COLUMN IF NOT EXISTS "status" VARCHAR(16) NOT NULL DEFAULT 'ACTIVE';
+ALTER TABLE "products" ADD CONSTRAINT "chk_products_status" CHECK ("status" IN ('DRAFT', 'ACTIVE', 'DISCONTINUED'));
+
+CREATE INDEX IF NOT EXISTS "idx_products_status" ON "products" ("status");
+
+COMMIT;
@@ -0,0 +1,7 @@
+START TRANSACTION;
+
+ALTER TABLE "products" ADD COLUMN IF NOT EXISTS "attributes" JSONB NOT NULL DEFAULT '{}';
+
+CREATE INDEX IF NOT EXISTS "idx_products_attributes" ON "products" USING GIN ("attributes");
+
+COMMIT;
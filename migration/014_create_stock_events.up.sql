@@ -0,0 +1,107 @@
+START TRANSACTION;
+
+CREATE TABLE IF NOT EXISTS "stock_events" (
+    "id" BIGSERIAL PRIMARY KEY,
+    "product_id" INT NOT NULL,
+    "stock" INT NOT NULL,
+    "reserved" INT NOT NULL,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT "fk_stock_events_product_id_products" FOREIGN KEY ("product_id") REFERENCES "products"("id") ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS "idx_stock_events_product_id_id" ON "stock_events" ("product_id", "id");
+CREATE INDEX IF NOT EXISTS "idx_stock_events_created_at" ON "stock_events" ("created_at");
+
+-- record_stock_event stores the current stock and pending reservation total of
+-- a product, unless they equal its latest event, and notifies listeners with
+-- the event as JSON.
+CREATE OR REPLACE FUNCTION "record_stock_event"("target_id" INT) RETURNS VOID AS $$
+DECLARE
+    "event" "stock_events"%ROWTYPE;
+BEGIN
+    INSERT INTO "stock_events" ("product_id", "stock", "reserved")
+    SELECT "current"."product_id", "current"."stock", "current"."reserved"
+    FROM (
+        SELECT
+            "p"."id" AS "product_id",
+            "p"."stock",
+            COALESCE((
+                SELECT SUM("r"."quantity")
+                FROM "reservations" AS "r"
+                WHERE "r"."product_id" = "p"."id" AND "r"."status" = 'PENDING'
+            ), 0)::INT AS "reserved"
+        FROM "products" AS "p"
+        WHERE "p"."id" = "target_id"
+    ) AS "current"
+    WHERE NOT EXISTS (
+        SELECT 1
+        FROM (
+            SELECT "e"."stock", "e"."reserved"
+            FROM "stock_events" AS "e"
+            WHERE "e"."product_id" = "target_id"
+            ORDER BY "e"."id" DESC
+            LIMIT 1
+        ) AS "latest"
+        WHERE "latest"."stock" = "current"."stock" AND "latest"."reserved" = "current"."reserved"
+    )
+    RETURNING * INTO "event";
+
+    IF "event"."id" IS NOT NULL THEN
+        PERFORM pg_notify('stock_events', json_build_object(
+            'sequence', "event"."id",
+            'product_id', "event"."product_id",
+            'stock', "event"."stock",
+            'reserved', "event"."reserved",
+            'created_at', "event"."created_at"
+        )::TEXT);
+    END IF;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION "products_stock_event"() RETURNS TRIGGER AS $$
+BEGIN
+    PERFORM "record_stock_event"(NEW."id");
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION "reservations_stock_event"() RETURNS TRIGGER AS $$
+BEGIN
+    PERFORM "record_stock_event"(NEW."product_id");
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+-- The triggers are deferred to commit so that a reservation, which updates both
+-- the product stock and the reservations, yields one event with final totals.
+DROP TRIGGER IF EXISTS "trg_products_stock_event_insert" ON "products";
+CREATE CONSTRAINT TRIGGER "trg_products_stock_event_insert"
+    AFTER INSERT ON "products"
+    DEFERRABLE INITIALLY DEFERRED
+    FOR EACH ROW EXECUTE FUNCTION "products_stock_event"();
+
+DROP TRIGGER IF EXISTS "trg_products_stock_event_update" ON "products";
+CREATE CONSTRAINT TRIGGER "trg_products_stock_event_update"
+    AFTER UPDATE OF "stock" ON "products"
+    DEFERRABLE INITIALLY DEFERRED
+    FOR EACH ROW WHEN (OLD."stock" IS DISTINCT FROM NEW."stock")
+    EXECUTE FUNCTION "products_stock_event"();
+
+DROP TRIGGER IF EXISTS "trg_reservations_stock_event" ON "reservations";
+CREATE CONSTRAINT TRIGGER "trg_reservations_stock_event"
+    AFTER INSERT OR UPDATE OF "status", "quantity" ON "reservations"
+    DEFERRABLE INITIALLY DEFERRED
+    FOR EACH ROW EXECUTE FUNCTION "reservations_stock_event"();
+
+INSERT INTO "stock_events" ("product_id", "stock", "reserved")
+SELECT
+    "p"."id",
+    "p"."stock",
+    COALESCE((
+        SELECT SUM("r"."quantity")
+        FROM "reservations" AS "r"
+        WHERE "r"."product_id" = "p"."id" AND "r"."status" = 'PENDING'
+    ), 0)::INT
+FROM "products" AS "p";
+
+COMMIT;
@@ -0,0 +1,21 @@
+START TRANSACTION;
+
+CREATE TABLE IF NOT EXISTS "outbox_events" (
+    "id" BIGSERIAL PRIMARY KEY,
+    "event_type" VARCHAR(255) NOT NULL,
+    "partition_key" VARCHAR(255) NOT NULL,
+    "payload" JSONB NOT NULL DEFAULT '{}'::JSONB,
+    "status" VARCHAR(32) NOT NULL DEFAULT 'PENDING',
+    "attempts" INT NOT NULL DEFAULT 0,
+    "next_attempt_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "last_error" TEXT NOT NULL DEFAULT '',
+    "delivered_at" TIMESTAMPTZ,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- The relay looks for the oldest pending event of each partition key.
+CREATE INDEX IF NOT EXISTS "idx_outbox_events_pending" ON "outbox_events" ("partition_key", "id") WHERE "status" = 'PENDING';
+CREATE INDEX IF NOT EXISTS "idx_outbox_events_status" ON "outbox_events" ("status", "next_attempt_at");
+
+COMMIT;
@@ -0,0 +1,40 @@
+START TRANSACTION;
+
+CREATE TABLE IF NOT EXISTS "webhooks" (
+    "id" SERIAL PRIMARY KEY,
+    "url" TEXT NOT NULL,
+    "event_types" TEXT[] NOT NULL DEFAULT '{}',
+    "secret" VARCHAR(255) NOT NULL,
+    "active" BOOLEAN NOT NULL DEFAULT TRUE,
+    "failure_count" INT NOT NULL DEFAULT 0,
+    "disabled_at" TIMESTAMPTZ,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "deleted_at" TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS "idx_webhooks_event_types" ON "webhooks" USING GIN ("event_types");
+
+CREATE TABLE IF NOT EXISTS "webhook_deliveries" (
+    "id" BIGSERIAL PRIMARY KEY,
+    "webhook_id" INT NOT NULL,
+    "event_id" BIGINT NOT NULL,
+    "event_type" VARCHAR(255) NOT NULL,
+    "payload" JSONB NOT NULL DEFAULT '{}'::JSONB,
+    "status" VARCHAR(32) NOT NULL DEFAULT 'PENDING',
+    "attempts" INT NOT NULL DEFAULT 0,
+    "next_attempt_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "response_status" INT NOT NULL DEFAULT 0,
+    "response_body" TEXT NOT NULL DEFAULT '',
+    "last_error" TEXT NOT NULL DEFAULT '',
+    "delivered_at" TIMESTAMPTZ,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    "updated_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT "fk_webhook_deliveries_webhook_id_webhooks" FOREIGN KEY ("webhook_id") REFERENCES "webhooks"("id") ON DELETE CASCADE,
+    CONSTRAINT "uq_webhook_deliveries_webhook_id_event_id" UNIQUE ("webhook_id", "event_id")
+);
+
+CREATE INDEX IF NOT EXISTS "idx_webhook_deliveries_pending" ON "webhook_deliveries" ("next_attempt_at") WHERE "status" = 'PENDING';
+CREATE INDEX IF NOT EXISTS "idx_webhook_deliveries_webhook_id_id" ON "webhook_deliveries" ("webhook_id", "id");
+
+COMMIT;
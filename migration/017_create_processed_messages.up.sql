@@ -0,0 +1,10 @@
+START TRANSACTION;
+
+-- Inbound messages already handled, so redeliveries are ignored.
+CREATE TABLE IF NOT EXISTS "processed_messages" (
+    "message_id" VARCHAR(255) PRIMARY KEY,
+    "topic" VARCHAR(255) NOT NULL,
+    "processed_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+COMMIT;
@@ -0,0 +1,22 @@
+START TRANSACTION;
+
+-- Who changed what: one row per mutating call, written in the transaction of
+-- the change it records.
+CREATE TABLE IF NOT EXISTS "audit_events" (
+    "id" BIGSERIAL PRIMARY KEY,
+    "entity_type" VARCHAR(64) NOT NULL,
+    "entity_id" INT NOT NULL,
+    "action" VARCHAR(64) NOT NULL,
+    "actor" VARCHAR(255) NOT NULL,
+    "source" VARCHAR(32) NOT NULL,
+    "request_id" VARCHAR(255) NOT NULL DEFAULT '',
+    "before" JSONB,
+    "after" JSONB,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS "idx_audit_events_entity" ON "audit_events" ("entity_type", "entity_id", "created_at");
+CREATE INDEX IF NOT EXISTS "idx_audit_events_actor" ON "audit_events" ("actor", "created_at");
+CREATE INDEX IF NOT EXISTS "idx_audit_events_created_at" ON "audit_events" ("created_at");
+
+COMMIT;
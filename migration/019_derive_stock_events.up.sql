@@ -0,0 +1,137 @@
+START TRANSACTION;
+
+-- product_available_stock returns the stock a product reads with: the unexpired
+-- lot stock of a lot-tracked product, the number of available serials of a
+-- serialized one and the stock column otherwise.
+CREATE OR REPLACE FUNCTION "product_available_stock"("target_id" INT) RETURNS INT AS $$
+    SELECT CASE
+        WHEN "p"."track_serials" THEN (
+            SELECT COUNT(*)
+            FROM "serials" AS "s"
+            WHERE "s"."product_id" = "p"."id" AND "s"."status" = 'AVAILABLE' AND "s"."deleted_at" IS NULL
+        )::INT
+        WHEN "p"."track_lots" THEN (
+            SELECT COALESCE(SUM("l"."quantity"), 0)
+            FROM "lots" AS "l"
+            WHERE "l"."product_id" = "p"."id"
+                AND ("l"."expires_at" IS NULL OR "l"."expires_at" > CURRENT_TIMESTAMP)
+                AND "l"."deleted_at" IS NULL
+        )::INT
+        ELSE "p"."stock"
+    END
+    FROM "products" AS "p"
+    WHERE "p"."id" = "target_id";
+$$ LANGUAGE sql STABLE;
+
+-- record_stock_event stores the available stock and pending reservation total
+-- of a product, unless they equal its latest event, and notifies listeners with
+-- the event as JSON.
+CREATE OR REPLACE FUNCTION "record_stock_event"("target_id" INT) RETURNS VOID AS $$
+DECLARE
+    "event" "stock_events"%ROWTYPE;
+BEGIN
+    INSERT INTO "stock_events" ("product_id", "stock", "reserved")
+    SELECT "current"."product_id", "current"."stock", "current"."reserved"
+    FROM (
+        SELECT
+            "p"."id" AS "product_id",
+            "product_available_stock"("p"."id") AS "stock",
+            COALESCE((
+                SELECT SUM("r"."quantity")
+                FROM "reservations" AS "r"
+                WHERE "r"."product_id" = "p"."id" AND "r"."status" = 'PENDING'
+            ), 0)::INT AS "reserved"
+        FROM "products" AS "p"
+        WHERE "p"."id" = "target_id"
+    ) AS "current"
+    WHERE NOT EXISTS (
+        SELECT 1
+        FROM (
+            SELECT "e"."stock", "e"."reserved"
+            FROM "stock_events" AS "e"
+            WHERE "e"."product_id" = "target_id"
+            ORDER BY "e"."id" DESC
+            LIMIT 1
+        ) AS "latest"
+        WHERE "latest"."stock" = "current"."stock" AND "latest"."reserved" = "current"."reserved"
+    )
+    RETURNING * INTO "event";
+
+    IF "event"."id" IS NOT NULL THEN
+        PERFORM pg_notify('stock_events', json_build_object(
+            'sequence', "event"."id",
+            'product_id', "event"."product_id",
+            'stock', "event"."stock",
+            'reserved', "event"."reserved",
+            'created_at', "event"."created_at"
+        )::TEXT);
+    END IF;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Lots and serials rows name their product; a deleted row is recorded through
+-- OLD, and a row moved to another product records both.
+CREATE OR REPLACE FUNCTION "product_rows_stock_event"() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP <> 'INSERT' THEN
+        PERFORM "record_stock_event"(OLD."product_id");
+    END IF;
+    IF TG_OP <> 'DELETE' AND (TG_OP = 'INSERT' OR OLD."product_id" <> NEW."product_id") THEN
+        PERFORM "record_stock_event"(NEW."product_id");
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION "reservation_lots_stock_event"() RETURNS TRIGGER AS $$
+DECLARE
+    "target_lot_id" INT;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        "target_lot_id" := OLD."lot_id";
+    ELSE
+        "target_lot_id" := NEW."lot_id";
+    END IF;
+    PERFORM "record_stock_event"("l"."product_id") FROM "lots" AS "l" WHERE "l"."id" = "target_lot_id";
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Switching lot or serial tracking on or off changes which stock a product
+-- reads with.
+DROP TRIGGER IF EXISTS "trg_products_stock_event_update" ON "products";
+CREATE CONSTRAINT TRIGGER "trg_products_stock_event_update"
+    AFTER UPDATE OF "stock", "track_lots", "track_serials" ON "products"
+    DEFERRABLE INITIALLY DEFERRED
+    FOR EACH ROW WHEN (
+        OLD."stock" IS DISTINCT FROM NEW."stock"
+        OR OLD."track_lots" IS DISTINCT FROM NEW."track_lots"
+        OR OLD."track_serials" IS DISTINCT FROM NEW."track_serials"
+    )
+    EXECUTE FUNCTION "products_stock_event"();
+
+DROP TRIGGER IF EXISTS "trg_lots_stock_event" ON "lots";
+CREATE CONSTRAINT TRIGGER "trg_lots_stock_event"
+    AFTER INSERT OR UPDATE OF "product_id", "quantity", "expires_at", "deleted_at" OR DELETE ON "lots"
+    DEFERRABLE INITIALLY DEFERRED
+    FOR EACH ROW EXECUTE FUNCTION "product_rows_stock_event"();
+
+DROP TRIGGER IF EXISTS "trg_reservation_lots_stock_event" ON "reservation_lots";
+CREATE CONSTRAINT TRIGGER "trg_reservation_lots_stock_event"
+    AFTER INSERT OR UPDATE OR DELETE ON "reservation_lots"
+    DEFERRABLE INITIALLY DEFERRED
+    FOR EACH ROW EXECUTE FUNCTION "reservation_lots_stock_event"();
+
+DROP TRIGGER IF EXISTS "trg_serials_stock_event" ON "serials";
+CREATE CONSTRAINT TRIGGER "trg_serials_stock_event"
+    AFTER INSERT OR UPDATE OF "product_id", "status", "deleted_at" OR DELETE ON "serials"
+    DEFERRABLE INITIALLY DEFERRED
+    FOR EACH ROW EXECUTE FUNCTION "product_rows_stock_event"();
+
+-- Tracked products were recorded with their stock column; record what they
+-- actually hold.
+SELECT "record_stock_event"("p"."id")
+FROM "products" AS "p"
+WHERE "p"."track_lots" OR "p"."track_serials";
+
+COMMIT;
@@ -0,0 +1,13 @@
+START TRANSACTION;
+
+-- Variants created without a price of their own follow their parent's price.
+ALTER TABLE "products" ADD COLUMN IF NOT EXISTS "inherit_price" BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Variants used to be given a copy of their parent's price; those still
+-- matching it are taken to inherit it.
+UPDATE "products" AS "v"
+SET "inherit_price" = TRUE
+FROM "products" AS "p"
+WHERE "v"."parent_id" = "p"."id" AND "v"."price" = "p"."price" AND "v"."currency" = "p"."currency";
+
+COMMIT;
@@ -0,0 +1,7 @@
+START TRANSACTION;
+
+-- The reservation expirer looks up pending top-level reservations by age.
+CREATE INDEX IF NOT EXISTS "idx_reservations_pending_created_at" ON "reservations" ("created_at")
+    WHERE "status" = 'PENDING' AND "parent_id" IS NULL AND "deleted_at" IS NULL;
+
+COMMIT;
@@ -103,6 +103,52 @@ func (_c *MockPostgresRepository_Atomic_Call) RunAndReturn(run func(ctx context.
 	return _c
 }
 
+// Category provides a mock function for the type MockPostgresRepository
+func (_mock *MockPostgresRepository) Category() postgresrepository.CategoryRepository {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Category")
+	}
+
+	var r0 postgresrepository.CategoryRepository
+	if returnFunc, ok := ret.Get(0).(func() postgresrepository.CategoryRepository); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(postgresrepository.CategoryRepository)
+		}
+	}
+	return r0
+}
+
+// MockPostgresRepository_Category_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Category'
+type MockPostgresRepository_Category_Call struct {
+	*mock.Call
+}
+
+// Category is a helper method to define mock.On call
+func (_e *MockPostgresRepository_Expecter) Category() *MockPostgresRepository_Category_Call {
+	return &MockPostgresRepository_Category_Call{Call: _e.mock.On("Category")}
+}
+
+func (_c *MockPostgresRepository_Category_Call) Run(run func()) *MockPostgresRepository_Category_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockPostgresRepository_Category_Call) Return(categoryRepository postgresrepository.CategoryRepository) *MockPostgresRepository_Category_Call {
+	_c.Call.Return(categoryRepository)
+	return _c
+}
+
+func (_c *MockPostgresRepository_Category_Call) RunAndReturn(run func() postgresrepository.CategoryRepository) *MockPostgresRepository_Category_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Close provides a mock function for the type MockPostgresRepository
 func (_mock *MockPostgresRepository) Close() error {
 	ret := _mock.Called()
@@ -193,6 +239,190 @@ func (_c *MockPostgresRepository_DB_Call) RunAndReturn(run func() *bun.DB) *Mock
 	return _c
 }
 
+// KitComponent provides a mock function for the type MockPostgresRepository
+func (_mock *MockPostgresRepository) KitComponent() postgresrepository.KitComponentRepository {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for KitComponent")
+	}
+
+	var r0 postgresrepository.KitComponentRepository
+	if returnFunc, ok := ret.Get(0).(func() postgresrepository.KitComponentRepository); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(postgresrepository.KitComponentRepository)
+		}
+	}
+	return r0
+}
+
+// MockPostgresRepository_KitComponent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'KitComponent'
+type MockPostgresRepository_KitComponent_Call struct {
+	*mock.Call
+}
+
+// KitComponent is a helper method to define mock.On call
+func (_e *MockPostgresRepository_Expecter) KitComponent() *MockPostgresRepository_KitComponent_Call {
+	return &MockPostgresRepository_KitComponent_Call{Call: _e.mock.On("KitComponent")}
+}
+
+func (_c *MockPostgresRepository_KitComponent_Call) Run(run func()) *MockPostgresRepository_KitComponent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockPostgresRepository_KitComponent_Call) Return(kitComponentRepository postgresrepository.KitComponentRepository) *MockPostgresRepository_KitComponent_Call {
+	_c.Call.Return(kitComponentRepository)
+	return _c
+}
+
+func (_c *MockPostgresRepository_KitComponent_Call) RunAndReturn(run func() postgresrepository.KitComponentRepository) *MockPostgresRepository_KitComponent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Lot provides a mock function for the type MockPostgresRepository
+func (_mock *MockPostgresRepository) Lot() postgresrepository.LotRepository {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Lot")
+	}
+
+	var r0 postgresrepository.LotRepository
+	if returnFunc, ok := ret.Get(0).(func() postgresrepository.LotRepository); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(postgresrepository.LotRepository)
+		}
+	}
+	return r0
+}
+
+// MockPostgresRepository_Lot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Lot'
+type MockPostgresRepository_Lot_Call struct {
+	*mock.Call
+}
+
+// Lot is a helper method to define mock.On call
+func (_e *MockPostgresRepository_Expecter) Lot() *MockPostgresRepository_Lot_Call {
+	return &MockPostgresRepository_Lot_Call{Call: _e.mock.On("Lot")}
+}
+
+func (_c *MockPostgresRepository_Lot_Call) Run(run func()) *MockPostgresRepository_Lot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockPostgresRepository_Lot_Call) Return(lotRepository postgresrepository.LotRepository) *MockPostgresRepository_Lot_Call {
+	_c.Call.Return(lotRepository)
+	return _c
+}
+
+func (_c *MockPostgresRepository_Lot_Call) RunAndReturn(run func() postgresrepository.LotRepository) *MockPostgresRepository_Lot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Outbox provides a mock function for the type MockPostgresRepository
+func (_mock *MockPostgresRepository) Outbox() postgresrepository.OutboxRepository {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Outbox")
+	}
+
+	var r0 postgresrepository.OutboxRepository
+	if returnFunc, ok := ret.Get(0).(func() postgresrepository.OutboxRepository); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(postgresrepository.OutboxRepository)
+		}
+	}
+	return r0
+}
+
+// MockPostgresRepository_Outbox_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Outbox'
+type MockPostgresRepository_Outbox_Call struct {
+	*mock.Call
+}
+
+// Outbox is a helper method to define mock.On call
+func (_e *MockPostgresRepository_Expecter) Outbox() *MockPostgresRepository_Outbox_Call {
+	return &MockPostgresRepository_Outbox_Call{Call: _e.mock.On("Outbox")}
+}
+
+func (_c *MockPostgresRepository_Outbox_Call) Run(run func()) *MockPostgresRepository_Outbox_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockPostgresRepository_Outbox_Call) Return(outboxRepository postgresrepository.OutboxRepository) *MockPostgresRepository_Outbox_Call {
+	_c.Call.Return(outboxRepository)
+	return _c
+}
+
+func (_c *MockPostgresRepository_Outbox_Call) RunAndReturn(run func() postgresrepository.OutboxRepository) *MockPostgresRepository_Outbox_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PriceChange provides a mock function for the type MockPostgresRepository
+func (_mock *MockPostgresRepository) PriceChange() postgresrepository.PriceChangeRepository {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for PriceChange")
+	}
+
+	var r0 postgresrepository.PriceChangeRepository
+	if returnFunc, ok := ret.Get(0).(func() postgresrepository.PriceChangeRepository); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(postgresrepository.PriceChangeRepository)
+		}
+	}
+	return r0
+}
+
+// MockPostgresRepository_PriceChange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PriceChange'
+type MockPostgresRepository_PriceChange_Call struct {
+	*mock.Call
+}
+
+// PriceChange is a helper method to define mock.On call
+func (_e *MockPostgresRepository_Expecter) PriceChange() *MockPostgresRepository_PriceChange_Call {
+	return &MockPostgresRepository_PriceChange_Call{Call: _e.mock.On("PriceChange")}
+}
+
+func (_c *MockPostgresRepository_PriceChange_Call) Run(run func()) *MockPostgresRepository_PriceChange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockPostgresRepository_PriceChange_Call) Return(priceChangeRepository postgresrepository.PriceChangeRepository) *MockPostgresRepository_PriceChange_Call {
+	_c.Call.Return(priceChangeRepository)
+	return _c
+}
+
+func (_c *MockPostgresRepository_PriceChange_Call) RunAndReturn(run func() postgresrepository.PriceChangeRepository) *MockPostgresRepository_PriceChange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Product provides a mock function for the type MockPostgresRepository
 func (_mock *MockPostgresRepository) Product() postgresrepository.ProductRepository {
 	ret := _mock.Called()
@@ -239,6 +469,52 @@ func (_c *MockPostgresRepository_Product_Call) RunAndReturn(run func() postgresr
 	return _c
 }
 
+// ProductUnit provides a mock function for the type MockPostgresRepository
+func (_mock *MockPostgresRepository) ProductUnit() postgresrepository.ProductUnitRepository {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProductUnit")
+	}
+
+	var r0 postgresrepository.ProductUnitRepository
+	if returnFunc, ok := ret.Get(0).(func() postgresrepository.ProductUnitRepository); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(postgresrepository.ProductUnitRepository)
+		}
+	}
+	return r0
+}
+
+// MockPostgresRepository_ProductUnit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProductUnit'
+type MockPostgresRepository_ProductUnit_Call struct {
+	*mock.Call
+}
+
+// ProductUnit is a helper method to define mock.On call
+func (_e *MockPostgresRepository_Expecter) ProductUnit() *MockPostgresRepository_ProductUnit_Call {
+	return &MockPostgresRepository_ProductUnit_Call{Call: _e.mock.On("ProductUnit")}
+}
+
+func (_c *MockPostgresRepository_ProductUnit_Call) Run(run func()) *MockPostgresRepository_ProductUnit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockPostgresRepository_ProductUnit_Call) Return(productUnitRepository postgresrepository.ProductUnitRepository) *MockPostgresRepository_ProductUnit_Call {
+	_c.Call.Return(productUnitRepository)
+	return _c
+}
+
+func (_c *MockPostgresRepository_ProductUnit_Call) RunAndReturn(run func() postgresrepository.ProductUnitRepository) *MockPostgresRepository_ProductUnit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Reservation provides a mock function for the type MockPostgresRepository
 func (_mock *MockPostgresRepository) Reservation() postgresrepository.ReservationRepository {
 	ret := _mock.Called()
@@ -284,3 +560,279 @@ func (_c *MockPostgresRepository_Reservation_Call) RunAndReturn(run func() postg
 	_c.Call.Return(run)
 	return _c
 }
+
+// Serial provides a mock function for the type MockPostgresRepository
+func (_mock *MockPostgresRepository) Serial() postgresrepository.SerialRepository {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Serial")
+	}
+
+	var r0 postgresrepository.SerialRepository
+	if returnFunc, ok := ret.Get(0).(func() postgresrepository.SerialRepository); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(postgresrepository.SerialRepository)
+		}
+	}
+	return r0
+}
+
+// MockPostgresRepository_Serial_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Serial'
+type MockPostgresRepository_Serial_Call struct {
+	*mock.Call
+}
+
+// Serial is a helper method to define mock.On call
+func (_e *MockPostgresRepository_Expecter) Serial() *MockPostgresRepository_Serial_Call {
+	return &MockPostgresRepository_Serial_Call{Call: _e.mock.On("Serial")}
+}
+
+func (_c *MockPostgresRepository_Serial_Call) Run(run func()) *MockPostgresRepository_Serial_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockPostgresRepository_Serial_Call) Return(serialRepository postgresrepository.SerialRepository) *MockPostgresRepository_Serial_Call {
+	_c.Call.Return(serialRepository)
+	return _c
+}
+
+func (_c *MockPostgresRepository_Serial_Call) RunAndReturn(run func() postgresrepository.SerialRepository) *MockPostgresRepository_Serial_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StockEvent provides a mock function for the type MockPostgresRepository
+func (_mock *MockPostgresRepository) StockEvent() postgresrepository.StockEventRepository {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for StockEvent")
+	}
+
+	var r0 postgresrepository.StockEventRepository
+	if returnFunc, ok := ret.Get(0).(func() postgresrepository.StockEventRepository); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(postgresrepository.StockEventRepository)
+		}
+	}
+	return r0
+}
+
+// MockPostgresRepository_StockEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StockEvent'
+type MockPostgresRepository_StockEvent_Call struct {
+	*mock.Call
+}
+
+// StockEvent is a helper method to define mock.On call
+func (_e *MockPostgresRepository_Expecter) StockEvent() *MockPostgresRepository_StockEvent_Call {
+	return &MockPostgresRepository_StockEvent_Call{Call: _e.mock.On("StockEvent")}
+}
+
+func (_c *MockPostgresRepository_StockEvent_Call) Run(run func()) *MockPostgresRepository_StockEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockPostgresRepository_StockEvent_Call) Return(stockEventRepository postgresrepository.StockEventRepository) *MockPostgresRepository_StockEvent_Call {
+	_c.Call.Return(stockEventRepository)
+	return _c
+}
+
+func (_c *MockPostgresRepository_StockEvent_Call) RunAndReturn(run func() postgresrepository.StockEventRepository) *MockPostgresRepository_StockEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Webhook provides a mock function for the type MockPostgresRepository
+func (_mock *MockPostgresRepository) Webhook() postgresrepository.WebhookRepository {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Webhook")
+	}
+
+	var r0 postgresrepository.WebhookRepository
+	if returnFunc, ok := ret.Get(0).(func() postgresrepository.WebhookRepository); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(postgresrepository.WebhookRepository)
+		}
+	}
+	return r0
+}
+
+// MockPostgresRepository_Webhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Webhook'
+type MockPostgresRepository_Webhook_Call struct {
+	*mock.Call
+}
+
+// Webhook is a helper method to define mock.On call
+func (_e *MockPostgresRepository_Expecter) Webhook() *MockPostgresRepository_Webhook_Call {
+	return &MockPostgresRepository_Webhook_Call{Call: _e.mock.On("Webhook")}
+}
+
+func (_c *MockPostgresRepository_Webhook_Call) Run(run func()) *MockPostgresRepository_Webhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockPostgresRepository_Webhook_Call) Return(webhookRepository postgresrepository.WebhookRepository) *MockPostgresRepository_Webhook_Call {
+	_c.Call.Return(webhookRepository)
+	return _c
+}
+
+func (_c *MockPostgresRepository_Webhook_Call) RunAndReturn(run func() postgresrepository.WebhookRepository) *MockPostgresRepository_Webhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WebhookDelivery provides a mock function for the type MockPostgresRepository
+func (_mock *MockPostgresRepository) WebhookDelivery() postgresrepository.WebhookDeliveryRepository {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for WebhookDelivery")
+	}
+
+	var r0 postgresrepository.WebhookDeliveryRepository
+	if returnFunc, ok := ret.Get(0).(func() postgresrepository.WebhookDeliveryRepository); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(postgresrepository.WebhookDeliveryRepository)
+		}
+	}
+	return r0
+}
+
+// MockPostgresRepository_WebhookDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WebhookDelivery'
+type MockPostgresRepository_WebhookDelivery_Call struct {
+	*mock.Call
+}
+
+// WebhookDelivery is a helper method to define mock.On call
+func (_e *MockPostgresRepository_Expecter) WebhookDelivery() *MockPostgresRepository_WebhookDelivery_Call {
+	return &MockPostgresRepository_WebhookDelivery_Call{Call: _e.mock.On("WebhookDelivery")}
+}
+
+func (_c *MockPostgresRepository_WebhookDelivery_Call) Run(run func()) *MockPostgresRepository_WebhookDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockPostgresRepository_WebhookDelivery_Call) Return(webhookDeliveryRepository postgresrepository.WebhookDeliveryRepository) *MockPostgresRepository_WebhookDelivery_Call {
+	_c.Call.Return(webhookDeliveryRepository)
+	return _c
+}
+
+func (_c *MockPostgresRepository_WebhookDelivery_Call) RunAndReturn(run func() postgresrepository.WebhookDeliveryRepository) *MockPostgresRepository_WebhookDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProcessedMessage provides a mock function for the type MockPostgresRepository
+func (_mock *MockPostgresRepository) ProcessedMessage() postgresrepository.ProcessedMessageRepository {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProcessedMessage")
+	}
+
+	var r0 postgresrepository.ProcessedMessageRepository
+	if returnFunc, ok := ret.Get(0).(func() postgresrepository.ProcessedMessageRepository); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(postgresrepository.ProcessedMessageRepository)
+		}
+	}
+	return r0
+}
+
+// MockPostgresRepository_ProcessedMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProcessedMessage'
+type MockPostgresRepository_ProcessedMessage_Call struct {
+	*mock.Call
+}
+
+// ProcessedMessage is a helper method to define mock.On call
+func (_e *MockPostgresRepository_Expecter) ProcessedMessage() *MockPostgresRepository_ProcessedMessage_Call {
+	return &MockPostgresRepository_ProcessedMessage_Call{Call: _e.mock.On("ProcessedMessage")}
+}
+
+func (_c *MockPostgresRepository_ProcessedMessage_Call) Run(run func()) *MockPostgresRepository_ProcessedMessage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockPostgresRepository_ProcessedMessage_Call) Return(processedMessageRepository postgresrepository.ProcessedMessageRepository) *MockPostgresRepository_ProcessedMessage_Call {
+	_c.Call.Return(processedMessageRepository)
+	return _c
+}
+
+func (_c *MockPostgresRepository_ProcessedMessage_Call) RunAndReturn(run func() postgresrepository.ProcessedMessageRepository) *MockPostgresRepository_ProcessedMessage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AuditEvent provides a mock function for the type MockPostgresRepository
+func (_mock *MockPostgresRepository) AuditEvent() postgresrepository.AuditEventRepository {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for AuditEvent")
+	}
+
+	var r0 postgresrepository.AuditEventRepository
+	if returnFunc, ok := ret.Get(0).(func() postgresrepository.AuditEventRepository); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(postgresrepository.AuditEventRepository)
+		}
+	}
+	return r0
+}
+
+// MockPostgresRepository_AuditEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AuditEvent'
+type MockPostgresRepository_AuditEvent_Call struct {
+	*mock.Call
+}
+
+// AuditEvent is a helper method to define mock.On call
+func (_e *MockPostgresRepository_Expecter) AuditEvent() *MockPostgresRepository_AuditEvent_Call {
+	return &MockPostgresRepository_AuditEvent_Call{Call: _e.mock.On("AuditEvent")}
+}
+
+func (_c *MockPostgresRepository_AuditEvent_Call) Run(run func()) *MockPostgresRepository_AuditEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockPostgresRepository_AuditEvent_Call) Return(auditEventRepository postgresrepository.AuditEventRepository) *MockPostgresRepository_AuditEvent_Call {
+	_c.Call.Return(auditEventRepository)
+	return _c
+}
+
+func (_c *MockPostgresRepository_AuditEvent_Call) RunAndReturn(run func() postgresrepository.AuditEventRepository) *MockPostgresRepository_AuditEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -107,6 +107,74 @@ func (_c *MockProductRepository_Create_Call) RunAndReturn(run func(ctx context.C
 	return _c
 }
 
+// CreateMany provides a mock function for the type MockProductRepository
+func (_mock *MockProductRepository) CreateMany(ctx context.Context, products []*entity.Product) ([]*entity.Product, error) {
+	ret := _mock.Called(ctx, products)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateMany")
+	}
+
+	var r0 []*entity.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []*entity.Product) ([]*entity.Product, error)); ok {
+		return returnFunc(ctx, products)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []*entity.Product) []*entity.Product); ok {
+		r0 = returnFunc(ctx, products)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []*entity.Product) error); ok {
+		r1 = returnFunc(ctx, products)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProductRepository_CreateMany_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMany'
+type MockProductRepository_CreateMany_Call struct {
+	*mock.Call
+}
+
+// CreateMany is a helper method to define mock.On call
+//   - ctx context.Context
+//   - products []*entity.Product
+func (_e *MockProductRepository_Expecter) CreateMany(ctx interface{}, products interface{}) *MockProductRepository_CreateMany_Call {
+	return &MockProductRepository_CreateMany_Call{Call: _e.mock.On("CreateMany", ctx, products)}
+}
+
+func (_c *MockProductRepository_CreateMany_Call) Run(run func(ctx context.Context, products []*entity.Product)) *MockProductRepository_CreateMany_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []*entity.Product
+		if args[1] != nil {
+			arg1 = args[1].([]*entity.Product)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProductRepository_CreateMany_Call) Return(products1 []*entity.Product, err error) *MockProductRepository_CreateMany_Call {
+	_c.Call.Return(products1, err)
+	return _c
+}
+
+func (_c *MockProductRepository_CreateMany_Call) RunAndReturn(run func(ctx context.Context, products []*entity.Product) ([]*entity.Product, error)) *MockProductRepository_CreateMany_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Delete provides a mock function for the type MockProductRepository
 func (_mock *MockProductRepository) Delete(ctx context.Context, id uint32) error {
 	ret := _mock.Called(ctx, id)
@@ -238,6 +306,74 @@ func (_c *MockProductRepository_Find_Call) RunAndReturn(run func(ctx context.Con
 	return _c
 }
 
+// FindByBarcode provides a mock function for the type MockProductRepository
+func (_mock *MockProductRepository) FindByBarcode(ctx context.Context, barcode string) (*entity.Product, error) {
+	ret := _mock.Called(ctx, barcode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByBarcode")
+	}
+
+	var r0 *entity.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*entity.Product, error)); ok {
+		return returnFunc(ctx, barcode)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *entity.Product); ok {
+		r0 = returnFunc(ctx, barcode)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, barcode)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProductRepository_FindByBarcode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByBarcode'
+type MockProductRepository_FindByBarcode_Call struct {
+	*mock.Call
+}
+
+// FindByBarcode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - barcode string
+func (_e *MockProductRepository_Expecter) FindByBarcode(ctx interface{}, barcode interface{}) *MockProductRepository_FindByBarcode_Call {
+	return &MockProductRepository_FindByBarcode_Call{Call: _e.mock.On("FindByBarcode", ctx, barcode)}
+}
+
+func (_c *MockProductRepository_FindByBarcode_Call) Run(run func(ctx context.Context, barcode string)) *MockProductRepository_FindByBarcode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProductRepository_FindByBarcode_Call) Return(product *entity.Product, err error) *MockProductRepository_FindByBarcode_Call {
+	_c.Call.Return(product, err)
+	return _c
+}
+
+func (_c *MockProductRepository_FindByBarcode_Call) RunAndReturn(run func(ctx context.Context, barcode string) (*entity.Product, error)) *MockProductRepository_FindByBarcode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindByID provides a mock function for the type MockProductRepository
 func (_mock *MockProductRepository) FindByID(ctx context.Context, id uint32) (*entity.Product, error) {
 	ret := _mock.Called(ctx, id)
@@ -306,6 +442,331 @@ func (_c *MockProductRepository_FindByID_Call) RunAndReturn(run func(ctx context
 	return _c
 }
 
+// FindBySKU provides a mock function for the type MockProductRepository
+func (_mock *MockProductRepository) FindBySKU(ctx context.Context, sku string) (*entity.Product, error) {
+	ret := _mock.Called(ctx, sku)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindBySKU")
+	}
+
+	var r0 *entity.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*entity.Product, error)); ok {
+		return returnFunc(ctx, sku)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *entity.Product); ok {
+		r0 = returnFunc(ctx, sku)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, sku)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProductRepository_FindBySKU_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindBySKU'
+type MockProductRepository_FindBySKU_Call struct {
+	*mock.Call
+}
+
+// FindBySKU is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sku string
+func (_e *MockProductRepository_Expecter) FindBySKU(ctx interface{}, sku interface{}) *MockProductRepository_FindBySKU_Call {
+	return &MockProductRepository_FindBySKU_Call{Call: _e.mock.On("FindBySKU", ctx, sku)}
+}
+
+func (_c *MockProductRepository_FindBySKU_Call) Run(run func(ctx context.Context, sku string)) *MockProductRepository_FindBySKU_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProductRepository_FindBySKU_Call) Return(product *entity.Product, err error) *MockProductRepository_FindBySKU_Call {
+	_c.Call.Return(product, err)
+	return _c
+}
+
+func (_c *MockProductRepository_FindBySKU_Call) RunAndReturn(run func(ctx context.Context, sku string) (*entity.Product, error)) *MockProductRepository_FindBySKU_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockByID provides a mock function for the type MockProductRepository
+func (_mock *MockProductRepository) LockByID(ctx context.Context, id uint32) (*entity.Product, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockByID")
+	}
+
+	var r0 *entity.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uint32) (*entity.Product, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uint32) *entity.Product); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uint32) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProductRepository_LockByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockByID'
+type MockProductRepository_LockByID_Call struct {
+	*mock.Call
+}
+
+// LockByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint32
+func (_e *MockProductRepository_Expecter) LockByID(ctx interface{}, id interface{}) *MockProductRepository_LockByID_Call {
+	return &MockProductRepository_LockByID_Call{Call: _e.mock.On("LockByID", ctx, id)}
+}
+
+func (_c *MockProductRepository_LockByID_Call) Run(run func(ctx context.Context, id uint32)) *MockProductRepository_LockByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uint32
+		if args[1] != nil {
+			arg1 = args[1].(uint32)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProductRepository_LockByID_Call) Return(product *entity.Product, err error) *MockProductRepository_LockByID_Call {
+	_c.Call.Return(product, err)
+	return _c
+}
+
+func (_c *MockProductRepository_LockByID_Call) RunAndReturn(run func(ctx context.Context, id uint32) (*entity.Product, error)) *MockProductRepository_LockByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseStock provides a mock function for the type MockProductRepository
+func (_mock *MockProductRepository) ReleaseStock(ctx context.Context, id uint32, quantity int) error {
+	ret := _mock.Called(ctx, id, quantity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseStock")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uint32, int) error); ok {
+		r0 = returnFunc(ctx, id, quantity)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProductRepository_ReleaseStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseStock'
+type MockProductRepository_ReleaseStock_Call struct {
+	*mock.Call
+}
+
+// ReleaseStock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint32
+//   - quantity int
+func (_e *MockProductRepository_Expecter) ReleaseStock(ctx interface{}, id interface{}, quantity interface{}) *MockProductRepository_ReleaseStock_Call {
+	return &MockProductRepository_ReleaseStock_Call{Call: _e.mock.On("ReleaseStock", ctx, id, quantity)}
+}
+
+func (_c *MockProductRepository_ReleaseStock_Call) Run(run func(ctx context.Context, id uint32, quantity int)) *MockProductRepository_ReleaseStock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uint32
+		if args[1] != nil {
+			arg1 = args[1].(uint32)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProductRepository_ReleaseStock_Call) Return(err error) *MockProductRepository_ReleaseStock_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProductRepository_ReleaseStock_Call) RunAndReturn(run func(ctx context.Context, id uint32, quantity int) error) *MockProductRepository_ReleaseStock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReserveStock provides a mock function for the type MockProductRepository
+func (_mock *MockProductRepository) ReserveStock(ctx context.Context, id uint32, quantity int) error {
+	ret := _mock.Called(ctx, id, quantity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReserveStock")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uint32, int) error); ok {
+		r0 = returnFunc(ctx, id, quantity)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProductRepository_ReserveStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReserveStock'
+type MockProductRepository_ReserveStock_Call struct {
+	*mock.Call
+}
+
+// ReserveStock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint32
+//   - quantity int
+func (_e *MockProductRepository_Expecter) ReserveStock(ctx interface{}, id interface{}, quantity interface{}) *MockProductRepository_ReserveStock_Call {
+	return &MockProductRepository_ReserveStock_Call{Call: _e.mock.On("ReserveStock", ctx, id, quantity)}
+}
+
+func (_c *MockProductRepository_ReserveStock_Call) Run(run func(ctx context.Context, id uint32, quantity int)) *MockProductRepository_ReserveStock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uint32
+		if args[1] != nil {
+			arg1 = args[1].(uint32)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProductRepository_ReserveStock_Call) Return(err error) *MockProductRepository_ReserveStock_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProductRepository_ReserveStock_Call) RunAndReturn(run func(ctx context.Context, id uint32, quantity int) error) *MockProductRepository_ReserveStock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stream provides a mock function for the type MockProductRepository
+func (_mock *MockProductRepository) Stream(ctx context.Context, filter *postgresrepository.FilterProductPayload, fn func(*entity.Product) error) error {
+	ret := _mock.Called(ctx, filter, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stream")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *postgresrepository.FilterProductPayload, func(*entity.Product) error) error); ok {
+		r0 = returnFunc(ctx, filter, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProductRepository_Stream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stream'
+type MockProductRepository_Stream_Call struct {
+	*mock.Call
+}
+
+// Stream is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter *postgresrepository.FilterProductPayload
+//   - fn func(*entity.Product) error
+func (_e *MockProductRepository_Expecter) Stream(ctx interface{}, filter interface{}, fn interface{}) *MockProductRepository_Stream_Call {
+	return &MockProductRepository_Stream_Call{Call: _e.mock.On("Stream", ctx, filter, fn)}
+}
+
+func (_c *MockProductRepository_Stream_Call) Run(run func(ctx context.Context, filter *postgresrepository.FilterProductPayload, fn func(*entity.Product) error)) *MockProductRepository_Stream_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *postgresrepository.FilterProductPayload
+		if args[1] != nil {
+			arg1 = args[1].(*postgresrepository.FilterProductPayload)
+		}
+		var arg2 func(*entity.Product) error
+		if args[2] != nil {
+			arg2 = args[2].(func(*entity.Product) error)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProductRepository_Stream_Call) Return(err error) *MockProductRepository_Stream_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProductRepository_Stream_Call) RunAndReturn(run func(ctx context.Context, filter *postgresrepository.FilterProductPayload, fn func(*entity.Product) error) error) *MockProductRepository_Stream_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function for the type MockProductRepository
 func (_mock *MockProductRepository) Update(ctx context.Context, product *entity.Product) (*entity.Product, error) {
 	ret := _mock.Called(ctx, product)
@@ -373,3 +834,197 @@ func (_c *MockProductRepository_Update_Call) RunAndReturn(run func(ctx context.C
 	_c.Call.Return(run)
 	return _c
 }
+
+// UpdateMany provides a mock function for the type MockProductRepository
+func (_mock *MockProductRepository) UpdateMany(ctx context.Context, products []*entity.Product) ([]*entity.Product, error) {
+	ret := _mock.Called(ctx, products)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateMany")
+	}
+
+	var r0 []*entity.Product
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []*entity.Product) ([]*entity.Product, error)); ok {
+		return returnFunc(ctx, products)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []*entity.Product) []*entity.Product); ok {
+		r0 = returnFunc(ctx, products)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Product)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []*entity.Product) error); ok {
+		r1 = returnFunc(ctx, products)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProductRepository_UpdateMany_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateMany'
+type MockProductRepository_UpdateMany_Call struct {
+	*mock.Call
+}
+
+// UpdateMany is a helper method to define mock.On call
+//   - ctx context.Context
+//   - products []*entity.Product
+func (_e *MockProductRepository_Expecter) UpdateMany(ctx interface{}, products interface{}) *MockProductRepository_UpdateMany_Call {
+	return &MockProductRepository_UpdateMany_Call{Call: _e.mock.On("UpdateMany", ctx, products)}
+}
+
+func (_c *MockProductRepository_UpdateMany_Call) Run(run func(ctx context.Context, products []*entity.Product)) *MockProductRepository_UpdateMany_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []*entity.Product
+		if args[1] != nil {
+			arg1 = args[1].([]*entity.Product)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProductRepository_UpdateMany_Call) Return(products1 []*entity.Product, err error) *MockProductRepository_UpdateMany_Call {
+	_c.Call.Return(products1, err)
+	return _c
+}
+
+func (_c *MockProductRepository_UpdateMany_Call) RunAndReturn(run func(ctx context.Context, products []*entity.Product) ([]*entity.Product, error)) *MockProductRepository_UpdateMany_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePrice provides a mock function for the type MockProductRepository
+func (_mock *MockProductRepository) UpdatePrice(ctx context.Context, id uint32, price entity.Money) error {
+	ret := _mock.Called(ctx, id, price)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePrice")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uint32, entity.Money) error); ok {
+		r0 = returnFunc(ctx, id, price)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProductRepository_UpdatePrice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePrice'
+type MockProductRepository_UpdatePrice_Call struct {
+	*mock.Call
+}
+
+// UpdatePrice is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint32
+//   - price entity.Money
+func (_e *MockProductRepository_Expecter) UpdatePrice(ctx interface{}, id interface{}, price interface{}) *MockProductRepository_UpdatePrice_Call {
+	return &MockProductRepository_UpdatePrice_Call{Call: _e.mock.On("UpdatePrice", ctx, id, price)}
+}
+
+func (_c *MockProductRepository_UpdatePrice_Call) Run(run func(ctx context.Context, id uint32, price entity.Money)) *MockProductRepository_UpdatePrice_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uint32
+		if args[1] != nil {
+			arg1 = args[1].(uint32)
+		}
+		var arg2 entity.Money
+		if args[2] != nil {
+			arg2 = args[2].(entity.Money)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProductRepository_UpdatePrice_Call) Return(err error) *MockProductRepository_UpdatePrice_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProductRepository_UpdatePrice_Call) RunAndReturn(run func(ctx context.Context, id uint32, price entity.Money) error) *MockProductRepository_UpdatePrice_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateStatus provides a mock function for the type MockProductRepository
+func (_mock *MockProductRepository) UpdateStatus(ctx context.Context, id uint32, status string) error {
+	ret := _mock.Called(ctx, id, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateStatus")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uint32, string) error); ok {
+		r0 = returnFunc(ctx, id, status)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProductRepository_UpdateStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStatus'
+type MockProductRepository_UpdateStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint32
+//   - status string
+func (_e *MockProductRepository_Expecter) UpdateStatus(ctx interface{}, id interface{}, status interface{}) *MockProductRepository_UpdateStatus_Call {
+	return &MockProductRepository_UpdateStatus_Call{Call: _e.mock.On("UpdateStatus", ctx, id, status)}
+}
+
+func (_c *MockProductRepository_UpdateStatus_Call) Run(run func(ctx context.Context, id uint32, status string)) *MockProductRepository_UpdateStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uint32
+		if args[1] != nil {
+			arg1 = args[1].(uint32)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProductRepository_UpdateStatus_Call) Return(err error) *MockProductRepository_UpdateStatus_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProductRepository_UpdateStatus_Call) RunAndReturn(run func(ctx context.Context, id uint32, status string) error) *MockProductRepository_UpdateStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"inventory-service/internal/adapter/repository/postgres"
 	"inventory-service/internal/domain/entity"
+	"time"
 
 	mock "github.com/stretchr/testify/mock"
 )
@@ -249,6 +250,143 @@ func (_c *MockReservationRepository_FindByID_Call) RunAndReturn(run func(ctx con
 	return _c
 }
 
+// FindExpired provides a mock function for the type MockReservationRepository
+func (_mock *MockReservationRepository) FindExpired(ctx context.Context, before time.Time, limit int) ([]*entity.Reservation, error) {
+	ret := _mock.Called(ctx, before, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindExpired")
+	}
+
+	var r0 []*entity.Reservation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, int) ([]*entity.Reservation, error)); ok {
+		return returnFunc(ctx, before, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, int) []*entity.Reservation); ok {
+		r0 = returnFunc(ctx, before, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Reservation)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = returnFunc(ctx, before, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockReservationRepository_FindExpired_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindExpired'
+type MockReservationRepository_FindExpired_Call struct {
+	*mock.Call
+}
+
+// FindExpired is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before time.Time
+//   - limit int
+func (_e *MockReservationRepository_Expecter) FindExpired(ctx interface{}, before interface{}, limit interface{}) *MockReservationRepository_FindExpired_Call {
+	return &MockReservationRepository_FindExpired_Call{Call: _e.mock.On("FindExpired", ctx, before, limit)}
+}
+
+func (_c *MockReservationRepository_FindExpired_Call) Run(run func(ctx context.Context, before time.Time, limit int)) *MockReservationRepository_FindExpired_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReservationRepository_FindExpired_Call) Return(reservations []*entity.Reservation, err error) *MockReservationRepository_FindExpired_Call {
+	_c.Call.Return(reservations, err)
+	return _c
+}
+
+func (_c *MockReservationRepository_FindExpired_Call) RunAndReturn(run func(ctx context.Context, before time.Time, limit int) ([]*entity.Reservation, error)) *MockReservationRepository_FindExpired_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stream provides a mock function for the type MockReservationRepository
+func (_mock *MockReservationRepository) Stream(ctx context.Context, filter *postgresrepository.FilterReservationPayload, fn func(*entity.Reservation) error) error {
+	ret := _mock.Called(ctx, filter, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stream")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *postgresrepository.FilterReservationPayload, func(*entity.Reservation) error) error); ok {
+		r0 = returnFunc(ctx, filter, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockReservationRepository_Stream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stream'
+type MockReservationRepository_Stream_Call struct {
+	*mock.Call
+}
+
+// Stream is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter *postgresrepository.FilterReservationPayload
+//   - fn func(*entity.Reservation) error
+func (_e *MockReservationRepository_Expecter) Stream(ctx interface{}, filter interface{}, fn interface{}) *MockReservationRepository_Stream_Call {
+	return &MockReservationRepository_Stream_Call{Call: _e.mock.On("Stream", ctx, filter, fn)}
+}
+
+func (_c *MockReservationRepository_Stream_Call) Run(run func(ctx context.Context, filter *postgresrepository.FilterReservationPayload, fn func(*entity.Reservation) error)) *MockReservationRepository_Stream_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *postgresrepository.FilterReservationPayload
+		if args[1] != nil {
+			arg1 = args[1].(*postgresrepository.FilterReservationPayload)
+		}
+		var arg2 func(*entity.Reservation) error
+		if args[2] != nil {
+			arg2 = args[2].(func(*entity.Reservation) error)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReservationRepository_Stream_Call) Return(err error) *MockReservationRepository_Stream_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockReservationRepository_Stream_Call) RunAndReturn(run func(ctx context.Context, filter *postgresrepository.FilterReservationPayload, fn func(*entity.Reservation) error) error) *MockReservationRepository_Stream_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateStatus provides a mock function for the type MockReservationRepository
 func (_mock *MockReservationRepository) UpdateStatus(ctx context.Context, ids []uint32, status string) error {
 	ret := _mock.Called(ctx, ids, status)
@@ -0,0 +1,163 @@
+package certreload
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// Reloader serves a certificate, and optionally a CA pool for verifying
+// client certificates, re-reading the files once they change on disk. Files
+// are checked during handshakes, at most once per interval.
+type Reloader struct {
+	certFile string
+	keyFile  string
+	caFile   string
+	interval time.Duration
+	// onError is called when changed files cannot be loaded; the previous
+	// certificate keeps being served.
+	onError func(error)
+
+	mu      sync.Mutex
+	checked time.Time
+	stamps  []fileStamp
+	cert    *tls.Certificate
+	pool    *x509.CertPool
+}
+
+type fileStamp struct {
+	modTime time.Time
+	size    int64
+}
+
+// New loads the certificate and key, and the CA file when one is given.
+func New(certFile, keyFile, caFile string, interval time.Duration, onError func(error)) (*Reloader, error) {
+	r := &Reloader{
+		certFile: certFile,
+		keyFile:  keyFile,
+		caFile:   caFile,
+		interval: interval,
+		onError:  onError,
+	}
+
+	stamps, err := r.stat()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+
+	r.stamps = stamps
+	r.checked = time.Now()
+
+	return r, nil
+}
+
+// Config returns a copy of base that hands every handshake the current
+// certificate and CA pool.
+func (r *Reloader) Config(base *tls.Config) *tls.Config {
+	cfg := base.Clone()
+	cfg.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		cert, pool := r.current()
+
+		handshake := base.Clone()
+		handshake.Certificates = []tls.Certificate{*cert}
+		handshake.ClientCAs = pool
+
+		return handshake, nil
+	}
+
+	return cfg
+}
+
+func (r *Reloader) current() (*tls.Certificate, *x509.CertPool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if time.Since(r.checked) >= r.interval {
+		r.checked = time.Now()
+		r.reloadIfChanged()
+	}
+
+	return r.cert, r.pool
+}
+
+// reloadIfChanged must be called with mu held.
+func (r *Reloader) reloadIfChanged() {
+	stamps, err := r.stat()
+	if err != nil {
+		r.report(err)
+		return
+	}
+
+	if slices.Equal(stamps, r.stamps) {
+		return
+	}
+
+	// The new stamps are kept even when loading fails, so a half-written
+	// pair is retried on the next change rather than on every handshake.
+	r.stamps = stamps
+
+	if err := r.load(); err != nil {
+		r.report(err)
+	}
+}
+
+func (r *Reloader) load() error {
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return errors.Wrap(err, "failed to load certificate")
+	}
+
+	var pool *x509.CertPool
+
+	if r.caFile != "" {
+		data, err := os.ReadFile(r.caFile)
+		if err != nil {
+			return errors.Wrap(err, "failed to read client ca file")
+		}
+
+		pool = x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(data) {
+			return errors.New("client ca file holds no certificates")
+		}
+	}
+
+	r.cert = &cert
+	r.pool = pool
+
+	return nil
+}
+
+func (r *Reloader) stat() ([]fileStamp, error) {
+	files := []string{r.certFile, r.keyFile}
+	if r.caFile != "" {
+		files = append(files, r.caFile)
+	}
+
+	stamps := make([]fileStamp, 0, len(files))
+
+	for _, file := range files {
+		info, err := os.Stat(file)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to stat %s", file)
+		}
+
+		stamps = append(stamps, fileStamp{modTime: info.ModTime(), size: info.Size()})
+	}
+
+	return stamps, nil
+}
+
+func (r *Reloader) report(err error) {
+	if r.onError != nil {
+		r.onError(err)
+	}
+}
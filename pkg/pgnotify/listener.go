@@ -0,0 +1,55 @@
+package pgnotify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type Listener interface {
+	// Wait blocks until a notification arrives or ctx is done, and returns
+	// its payload.
+	Wait(ctx context.Context) (string, error)
+	Close(ctx context.Context) error
+}
+
+type listener struct {
+	conn    *pgx.Conn
+	channel string
+}
+
+// Listen opens a dedicated connection and subscribes it to the channel.
+// Notifications are only received while the connection is open, so callers
+// reconnect with a new Listen when Wait fails.
+func Listen(ctx context.Context, dsn string, channel string) (Listener, error) {
+	if channel == "" {
+		return nil, errors.New("channel is required")
+	}
+
+	conn, err := pgx.Connect(ctx, dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect: %w", err)
+	}
+
+	if _, err := conn.Exec(ctx, "LISTEN "+pgx.Identifier{channel}.Sanitize()); err != nil {
+		_ = conn.Close(ctx)
+		return nil, fmt.Errorf("failed to listen on %s: %w", channel, err)
+	}
+
+	return &listener{conn: conn, channel: channel}, nil
+}
+
+func (l *listener) Wait(ctx context.Context) (string, error) {
+	notification, err := l.conn.WaitForNotification(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return notification.Payload, nil
+}
+
+func (l *listener) Close(ctx context.Context) error {
+	return l.conn.Close(ctx)
+}
@@ -0,0 +1,54 @@
+package pubsub
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+)
+
+type filePublisher struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+// NewFilePublisher returns a publisher that appends each message as a JSON
+// line to the file at path, for inspecting events locally.
+func NewFilePublisher(path string) (Publisher, error) {
+	if path == "" {
+		return nil, errors.New("file path is required")
+	}
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", path, err)
+	}
+
+	return &filePublisher{file: file}, nil
+}
+
+func (p *filePublisher) Publish(ctx context.Context, msg *Message) error {
+	line, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("failed to encode message: %w", err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, err := p.file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write message: %w", err)
+	}
+
+	// Sync so that a message reported as published survives a crash.
+	return p.file.Sync()
+}
+
+func (p *filePublisher) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.file.Close()
+}
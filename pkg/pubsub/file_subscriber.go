@@ -0,0 +1,95 @@
+package pubsub
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"sync"
+	"time"
+)
+
+type fileSubscriber struct {
+	path         string
+	pollInterval time.Duration
+	done         chan struct{}
+	closing      sync.Once
+}
+
+// NewFileSubscriber returns a subscriber that reads messages from the JSON
+// lines of the file at path, as written by the file publisher, and waits for
+// more to be appended. Every subscription starts from the top of the file, so
+// handlers see the messages handled before again and drop them by ID. Lines
+// that are not messages are skipped.
+func NewFileSubscriber(path string, pollInterval time.Duration) (Subscriber, error) {
+	if path == "" {
+		return nil, errors.New("file path is required")
+	}
+
+	if pollInterval <= 0 {
+		pollInterval = time.Second
+	}
+
+	return &fileSubscriber{
+		path:         path,
+		pollInterval: pollInterval,
+		done:         make(chan struct{}),
+	}, nil
+}
+
+func (s *fileSubscriber) Subscribe(ctx context.Context, topics []string, handler Handler) error {
+	file, err := os.OpenFile(s.path, os.O_CREATE|os.O_RDONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", s.path, err)
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+
+	// partial holds the start of a line still being written.
+	var partial []byte
+
+	for {
+		line, err := reader.ReadBytes('\n')
+		partial = append(partial, line...)
+
+		if errors.Is(err, io.EOF) {
+			if !wait(ctx, s.done, s.pollInterval) {
+				return stopReason(ctx)
+			}
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", s.path, err)
+		}
+
+		var msg Message
+		if err := json.Unmarshal(partial, &msg); err != nil || msg.ID == "" {
+			partial = nil
+			continue
+		}
+
+		partial = nil
+
+		if !slices.Contains(topics, msg.Topic) {
+			continue
+		}
+
+		for handler(ctx, &msg) != nil {
+			if !wait(ctx, s.done, redeliveryDelay) {
+				return stopReason(ctx)
+			}
+		}
+	}
+}
+
+func (s *fileSubscriber) Close() error {
+	s.closing.Do(func() { close(s.done) })
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package pubsub
+
+import (
+	"context"
+	"inventory-service/pkg/logger"
+)
+
+type logPublisher struct {
+	logger logger.Logger
+}
+
+// NewLogPublisher returns a publisher that only logs the messages, for
+// running the service without a broker.
+func NewLogPublisher(logger logger.Logger) Publisher {
+	return &logPublisher{logger: logger}
+}
+
+func (p *logPublisher) Publish(ctx context.Context, msg *Message) error {
+	p.logger.Info().
+		Field("id", msg.ID).
+		Field("topic", msg.Topic).
+		Field("key", msg.Key).
+		Field("payload", string(msg.Payload)).
+		Msg("Published message")
+
+	return nil
+}
+
+func (p *logPublisher) Close() error {
+	return nil
+}
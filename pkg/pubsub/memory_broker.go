@@ -0,0 +1,102 @@
+package pubsub
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+)
+
+// ErrClosed is returned by a closed publisher or subscriber.
+var ErrClosed = errors.New("pubsub: closed")
+
+// MemoryBroker is a publisher and subscriber keeping messages in memory, for
+// tests and local development. Each message goes to one subscriber of its
+// topic; messages published while nobody subscribes wait for the next
+// subscription.
+type MemoryBroker struct {
+	mu    sync.Mutex
+	queue []*Message
+	// published is closed, and replaced, whenever a message is queued.
+	published chan struct{}
+	done      chan struct{}
+	closing   sync.Once
+}
+
+func NewMemoryBroker() *MemoryBroker {
+	return &MemoryBroker{
+		published: make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+}
+
+func (b *MemoryBroker) Publish(ctx context.Context, msg *Message) error {
+	select {
+	case <-b.done:
+		return ErrClosed
+	default:
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.queue = append(b.queue, msg)
+	close(b.published)
+	b.published = make(chan struct{})
+
+	return nil
+}
+
+func (b *MemoryBroker) Subscribe(ctx context.Context, topics []string, handler Handler) error {
+	for {
+		msg, published := b.next(topics)
+
+		if msg == nil {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-b.done:
+				return ErrClosed
+			case <-published:
+				continue
+			}
+		}
+
+		for handler(ctx, msg) != nil {
+			if !wait(ctx, b.done, redeliveryDelay) {
+				b.requeue(msg)
+				return stopReason(ctx)
+			}
+		}
+	}
+}
+
+func (b *MemoryBroker) Close() error {
+	b.closing.Do(func() { close(b.done) })
+
+	return nil
+}
+
+// next takes the oldest queued message of one of topics. When there is none
+// it returns the channel closed by the next publish.
+func (b *MemoryBroker) next(topics []string) (*Message, <-chan struct{}) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for i, msg := range b.queue {
+		if slices.Contains(topics, msg.Topic) {
+			b.queue = slices.Delete(b.queue, i, i+1)
+			return msg, nil
+		}
+	}
+
+	return nil, b.published
+}
+
+// requeue puts an unacknowledged message back at the front of the queue.
+func (b *MemoryBroker) requeue(msg *Message) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.queue = slices.Insert(b.queue, 0, msg)
+}
@@ -0,0 +1,26 @@
+package pubsub
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// Message is one event handed to a publisher, with a JSON payload. ID is stable across
+// redeliveries, so consumers can drop the duplicates that at-least-once
+// delivery may produce. Messages sharing a key must be consumed in order.
+type Message struct {
+	ID         string            `json:"id"`
+	Topic      string            `json:"topic"`
+	Key        string            `json:"key"`
+	Payload    json.RawMessage   `json:"payload"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+	OccurredAt time.Time         `json:"occurred_at"`
+}
+
+type Publisher interface {
+	// Publish returns once the message has been accepted; an error means it
+	// may or may not have been, and the caller should retry.
+	Publish(ctx context.Context, msg *Message) error
+	Close() error
+}
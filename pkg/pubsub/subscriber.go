@@ -0,0 +1,47 @@
+package pubsub
+
+import (
+	"context"
+	"time"
+)
+
+// redeliveryDelay is how long a subscriber waits before handing a message
+// whose handler failed to it again.
+const redeliveryDelay = time.Second
+
+// Handler processes one message. An error leaves the message unacknowledged,
+// so it is delivered again; a message that can never be processed should be
+// acknowledged, by returning nil, rather than block the ones after it.
+type Handler func(ctx context.Context, msg *Message) error
+
+type Subscriber interface {
+	// Subscribe hands the messages of the given topics to handler, one at a
+	// time and in order, until ctx is cancelled or the subscriber is closed.
+	// Delivery is at least once, so handlers must drop duplicates by ID.
+	Subscribe(ctx context.Context, topics []string, handler Handler) error
+	Close() error
+}
+
+// wait sleeps for d, and reports false if ctx or done ended it first.
+func wait(ctx context.Context, done <-chan struct{}, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-done:
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// stopReason is the error a subscription stopped by ctx or by Close ends with.
+func stopReason(ctx context.Context) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	return ErrClosed
+}
@@ -0,0 +1,104 @@
+package tokenbucket
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// sweepInterval is how often the memory store drops buckets that have
+// refilled completely.
+const sweepInterval = time.Minute
+
+// Limit refills a bucket with Rate tokens per second, holding at most Burst.
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+// Store keeps the token buckets of all callers. Take removes one token from
+// the bucket at key, which starts full, and reports how long until the next
+// token when none is left. A store shared between instances makes them
+// enforce one limit together.
+type Store interface {
+	Take(ctx context.Context, key string, limit Limit) (bool, time.Duration, error)
+}
+
+// MemoryStore keeps buckets in memory, so every instance limits on its own.
+type MemoryStore struct {
+	mu      sync.Mutex
+	buckets map[string]*bucket
+	swept   time.Time
+	now     func() time.Time
+}
+
+type bucket struct {
+	tokens  float64
+	updated time.Time
+	// full is when the bucket will have refilled, after which it is no
+	// different from a new one.
+	full time.Time
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		buckets: make(map[string]*bucket),
+		swept:   time.Now(),
+		now:     time.Now,
+	}
+}
+
+func (s *MemoryStore) Take(_ context.Context, key string, limit Limit) (bool, time.Duration, error) {
+	if limit.Rate <= 0 || limit.Burst <= 0 {
+		return true, 0, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.sweep(now)
+
+	burst := float64(limit.Burst)
+
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &bucket{tokens: burst, updated: now}
+		s.buckets[key] = b
+	}
+
+	b.tokens = min(burst, b.tokens+now.Sub(b.updated).Seconds()*limit.Rate)
+	b.updated = now
+
+	allowed := b.tokens >= 1
+	if allowed {
+		b.tokens--
+	}
+
+	b.full = now.Add(seconds((burst - b.tokens) / limit.Rate))
+
+	if !allowed {
+		return false, seconds((1 - b.tokens) / limit.Rate), nil
+	}
+
+	return true, 0, nil
+}
+
+// sweep must be called with mu held.
+func (s *MemoryStore) sweep(now time.Time) {
+	if now.Sub(s.swept) < sweepInterval {
+		return
+	}
+
+	s.swept = now
+
+	for key, b := range s.buckets {
+		if !now.Before(b.full) {
+			delete(s.buckets, key)
+		}
+	}
+}
+
+func seconds(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second))
+}
@@ -0,0 +1,158 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// Headers set on every webhook request. The signature is
+// "sha256=" + hex(HMAC-SHA256(secret, timestamp + "." + body)), so receivers
+// can check both the sender and the age of a request.
+const (
+	HeaderSignature = "X-Webhook-Signature"
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderDelivery  = "X-Webhook-Delivery"
+)
+
+// maxResponseBody caps how much of a receiver's response is kept.
+const maxResponseBody = 1024
+
+type Request struct {
+	URL      string
+	Secret   string
+	Event    string
+	Delivery string
+	Body     []byte
+}
+
+type Response struct {
+	StatusCode int
+	Body       string
+}
+
+type Sender interface {
+	// Send posts the request and returns the receiver's response, whatever
+	// its status. An error means no response was received.
+	Send(ctx context.Context, req *Request) (*Response, error)
+}
+
+type sender struct {
+	client *http.Client
+}
+
+// ErrForbiddenAddress is returned for a receiver that resolves to an address
+// webhooks may not be sent to.
+var ErrForbiddenAddress = errors.New("webhook receiver address is not allowed")
+
+// NewSender returns a sender that gives receivers timeout to answer. Unless
+// allowPrivateNetworks is set it refuses to connect to loopback, private,
+// link-local and other non-public addresses, checked after DNS resolution so
+// a public name pointing inside the network is refused too. Redirects are
+// never followed; a redirect response counts as a failed delivery.
+func NewSender(timeout time.Duration, allowPrivateNetworks bool) Sender {
+	dialer := &net.Dialer{Timeout: timeout}
+	if !allowPrivateNetworks {
+		dialer.Control = refusePrivateAddresses
+	}
+
+	transport := &http.Transport{
+		// A proxy would be dialled instead of the receiver, which defeats the
+		// address check.
+		Proxy:               nil,
+		DialContext:         dialer.DialContext,
+		TLSHandshakeTimeout: timeout,
+		MaxIdleConns:        100,
+		IdleConnTimeout:     90 * time.Second,
+	}
+
+	return &sender{client: &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}}
+}
+
+// sharedAddressSpace is the carrier-grade NAT range of RFC 6598.
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// refusePrivateAddresses is a dialer control hook rejecting connections to
+// addresses that are not publicly routable.
+func refusePrivateAddresses(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return err
+	}
+
+	// Loopback, link-local, multicast and unspecified addresses are not
+	// global unicast.
+	addr = addr.Unmap()
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() || sharedAddressSpace.Contains(addr) {
+		return fmt.Errorf("%w: %s", ErrForbiddenAddress, addr)
+	}
+
+	return nil
+}
+
+func (s *sender) Send(ctx context.Context, req *Request) (*Response, error) {
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, req.URL, bytes.NewReader(req.Body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set(HeaderEvent, req.Event)
+	httpReq.Header.Set(HeaderDelivery, req.Delivery)
+	httpReq.Header.Set(HeaderTimestamp, timestamp)
+	httpReq.Header.Set(HeaderSignature, Sign(req.Secret, timestamp, req.Body))
+
+	res, err := s.client.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(res.Body, maxResponseBody))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	return &Response{StatusCode: res.StatusCode, Body: string(body)}, nil
+}
+
+// Sign returns the signature header value of a body sent at timestamp.
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify reports whether signature is the valid signature of body sent at
+// timestamp.
+func Verify(secret, timestamp string, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(signature))
+}
@@ -10,6 +10,8 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -75,33 +77,3996 @@ func (ReservationStatus) EnumDescriptor() ([]byte, []int) {
 	return file_proto_inventory_proto_rawDescGZIP(), []int{0}
 }
 
+type ProductStatus int32
+
+const (
+	ProductStatus_PRODUCT_STATUS_UNSPECIFIED  ProductStatus = 0
+	ProductStatus_PRODUCT_STATUS_DRAFT        ProductStatus = 1
+	ProductStatus_PRODUCT_STATUS_ACTIVE       ProductStatus = 2
+	ProductStatus_PRODUCT_STATUS_DISCONTINUED ProductStatus = 3
+)
+
+// Enum value maps for ProductStatus.
+var (
+	ProductStatus_name = map[int32]string{
+		0: "PRODUCT_STATUS_UNSPECIFIED",
+		1: "PRODUCT_STATUS_DRAFT",
+		2: "PRODUCT_STATUS_ACTIVE",
+		3: "PRODUCT_STATUS_DISCONTINUED",
+	}
+	ProductStatus_value = map[string]int32{
+		"PRODUCT_STATUS_UNSPECIFIED":  0,
+		"PRODUCT_STATUS_DRAFT":        1,
+		"PRODUCT_STATUS_ACTIVE":       2,
+		"PRODUCT_STATUS_DISCONTINUED": 3,
+	}
+)
+
+func (x ProductStatus) Enum() *ProductStatus {
+	p := new(ProductStatus)
+	*p = x
+	return p
+}
+
+func (x ProductStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProductStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_inventory_proto_enumTypes[1].Descriptor()
+}
+
+func (ProductStatus) Type() protoreflect.EnumType {
+	return &file_proto_inventory_proto_enumTypes[1]
+}
+
+func (x ProductStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProductStatus.Descriptor instead.
+func (ProductStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{1}
+}
+
+type BatchItemStatus int32
+
+const (
+	BatchItemStatus_BATCH_ITEM_STATUS_UNSPECIFIED BatchItemStatus = 0
+	BatchItemStatus_BATCH_ITEM_STATUS_SUCCEEDED   BatchItemStatus = 1
+	BatchItemStatus_BATCH_ITEM_STATUS_FAILED      BatchItemStatus = 2
+	// aborted items were valid but not written because another item of an
+	// atomic batch failed.
+	BatchItemStatus_BATCH_ITEM_STATUS_ABORTED BatchItemStatus = 3
+)
+
+// Enum value maps for BatchItemStatus.
+var (
+	BatchItemStatus_name = map[int32]string{
+		0: "BATCH_ITEM_STATUS_UNSPECIFIED",
+		1: "BATCH_ITEM_STATUS_SUCCEEDED",
+		2: "BATCH_ITEM_STATUS_FAILED",
+		3: "BATCH_ITEM_STATUS_ABORTED",
+	}
+	BatchItemStatus_value = map[string]int32{
+		"BATCH_ITEM_STATUS_UNSPECIFIED": 0,
+		"BATCH_ITEM_STATUS_SUCCEEDED":   1,
+		"BATCH_ITEM_STATUS_FAILED":      2,
+		"BATCH_ITEM_STATUS_ABORTED":     3,
+	}
+)
+
+func (x BatchItemStatus) Enum() *BatchItemStatus {
+	p := new(BatchItemStatus)
+	*p = x
+	return p
+}
+
+func (x BatchItemStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BatchItemStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_inventory_proto_enumTypes[2].Descriptor()
+}
+
+func (BatchItemStatus) Type() protoreflect.EnumType {
+	return &file_proto_inventory_proto_enumTypes[2]
+}
+
+func (x BatchItemStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BatchItemStatus.Descriptor instead.
+func (BatchItemStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{2}
+}
+
+type SerialStatus int32
+
+const (
+	SerialStatus_SERIAL_STATUS_UNSPECIFIED SerialStatus = 0
+	SerialStatus_SERIAL_STATUS_AVAILABLE   SerialStatus = 1
+	SerialStatus_SERIAL_STATUS_RESERVED    SerialStatus = 2
+	SerialStatus_SERIAL_STATUS_ASSIGNED    SerialStatus = 3
+)
+
+// Enum value maps for SerialStatus.
+var (
+	SerialStatus_name = map[int32]string{
+		0: "SERIAL_STATUS_UNSPECIFIED",
+		1: "SERIAL_STATUS_AVAILABLE",
+		2: "SERIAL_STATUS_RESERVED",
+		3: "SERIAL_STATUS_ASSIGNED",
+	}
+	SerialStatus_value = map[string]int32{
+		"SERIAL_STATUS_UNSPECIFIED": 0,
+		"SERIAL_STATUS_AVAILABLE":   1,
+		"SERIAL_STATUS_RESERVED":    2,
+		"SERIAL_STATUS_ASSIGNED":    3,
+	}
+)
+
+func (x SerialStatus) Enum() *SerialStatus {
+	p := new(SerialStatus)
+	*p = x
+	return p
+}
+
+func (x SerialStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SerialStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_inventory_proto_enumTypes[3].Descriptor()
+}
+
+func (SerialStatus) Type() protoreflect.EnumType {
+	return &file_proto_inventory_proto_enumTypes[3]
+}
+
+func (x SerialStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SerialStatus.Descriptor instead.
+func (SerialStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{3}
+}
+
+type WebhookDeliveryStatus int32
+
+const (
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED WebhookDeliveryStatus = 0
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING     WebhookDeliveryStatus = 1
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_SUCCEEDED   WebhookDeliveryStatus = 2
+	// dead deliveries ran out of attempts.
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DEAD WebhookDeliveryStatus = 3
+)
+
+// Enum value maps for WebhookDeliveryStatus.
+var (
+	WebhookDeliveryStatus_name = map[int32]string{
+		0: "WEBHOOK_DELIVERY_STATUS_UNSPECIFIED",
+		1: "WEBHOOK_DELIVERY_STATUS_PENDING",
+		2: "WEBHOOK_DELIVERY_STATUS_SUCCEEDED",
+		3: "WEBHOOK_DELIVERY_STATUS_DEAD",
+	}
+	WebhookDeliveryStatus_value = map[string]int32{
+		"WEBHOOK_DELIVERY_STATUS_UNSPECIFIED": 0,
+		"WEBHOOK_DELIVERY_STATUS_PENDING":     1,
+		"WEBHOOK_DELIVERY_STATUS_SUCCEEDED":   2,
+		"WEBHOOK_DELIVERY_STATUS_DEAD":        3,
+	}
+)
+
+func (x WebhookDeliveryStatus) Enum() *WebhookDeliveryStatus {
+	p := new(WebhookDeliveryStatus)
+	*p = x
+	return p
+}
+
+func (x WebhookDeliveryStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WebhookDeliveryStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_inventory_proto_enumTypes[4].Descriptor()
+}
+
+func (WebhookDeliveryStatus) Type() protoreflect.EnumType {
+	return &file_proto_inventory_proto_enumTypes[4]
+}
+
+func (x WebhookDeliveryStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WebhookDeliveryStatus.Descriptor instead.
+func (WebhookDeliveryStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{4}
+}
+
 type Product struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name       string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Stock      int32                  `protobuf:"varint,3,opt,name=stock,proto3" json:"stock,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Sku        string                 `protobuf:"bytes,7,opt,name=sku,proto3" json:"sku,omitempty"`
+	Barcode    string                 `protobuf:"bytes,8,opt,name=barcode,proto3" json:"barcode,omitempty"`
+	CategoryId uint32                 `protobuf:"varint,9,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	// parent_id is set on variants; options holds their option values.
+	ParentId uint32            `protobuf:"varint,10,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Options  map[string]string `protobuf:"bytes,11,rep,name=options,proto3" json:"options,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Variants []*Product        `protobuf:"bytes,12,rep,name=variants,proto3" json:"variants,omitempty"`
+	// available_stock sums the stock of all variants on parent products.
+	AvailableStock int32 `protobuf:"varint,13,opt,name=available_stock,json=availableStock,proto3" json:"available_stock,omitempty"`
+	// components is the bill of materials of a kit.
+	Components []*KitComponent `protobuf:"bytes,14,rep,name=components,proto3" json:"components,omitempty"`
+	// track_lots marks products whose stock is held in lots with expiry dates.
+	TrackLots bool `protobuf:"varint,15,opt,name=track_lots,json=trackLots,proto3" json:"track_lots,omitempty"`
+	// track_serials marks products whose units are individually serial-numbered.
+	TrackSerials bool `protobuf:"varint,16,opt,name=track_serials,json=trackSerials,proto3" json:"track_serials,omitempty"`
+	// units lists the product's pack sizes; quantities are kept in the base unit "each".
+	Units []*ProductUnit `protobuf:"bytes,17,rep,name=units,proto3" json:"units,omitempty"`
+	Price *Money         `protobuf:"bytes,18,opt,name=price,proto3" json:"price,omitempty"`
+	// status is the lifecycle status; only active products can be reserved.
+	Status ProductStatus `protobuf:"varint,19,opt,name=status,proto3,enum=inventory.ProductStatus" json:"status,omitempty"`
+	// attributes holds line-specific properties; "weight" is a number and
+	// "dimensions" an object with numeric "length", "width" and "height".
+	Attributes    *structpb.Struct `protobuf:"bytes,20,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Product) Reset() {
+	*x = Product{}
+	mi := &file_proto_inventory_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Product) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Product) ProtoMessage() {}
+
+func (x *Product) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Product.ProtoReflect.Descriptor instead.
+func (*Product) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Product) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Product) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Product) GetStock() int32 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *Product) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Product) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Product) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *Product) GetBarcode() string {
+	if x != nil {
+		return x.Barcode
+	}
+	return ""
+}
+
+func (x *Product) GetCategoryId() uint32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *Product) GetParentId() uint32 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *Product) GetOptions() map[string]string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *Product) GetVariants() []*Product {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+func (x *Product) GetAvailableStock() int32 {
+	if x != nil {
+		return x.AvailableStock
+	}
+	return 0
+}
+
+func (x *Product) GetComponents() []*KitComponent {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+func (x *Product) GetTrackLots() bool {
+	if x != nil {
+		return x.TrackLots
+	}
+	return false
+}
+
+func (x *Product) GetTrackSerials() bool {
+	if x != nil {
+		return x.TrackSerials
+	}
+	return false
+}
+
+func (x *Product) GetUnits() []*ProductUnit {
+	if x != nil {
+		return x.Units
+	}
+	return nil
+}
+
+func (x *Product) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *Product) GetStatus() ProductStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ProductStatus_PRODUCT_STATUS_UNSPECIFIED
+}
+
+func (x *Product) GetAttributes() *structpb.Struct {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+// AttributeFilter matches products on one attribute. key is a dotted path into
+// nested objects such as "dimensions.length"; min and max bound numeric values
+// inclusively.
+type AttributeFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Equals        *structpb.Value        `protobuf:"bytes,2,opt,name=equals,proto3" json:"equals,omitempty"`
+	Min           *float64               `protobuf:"fixed64,3,opt,name=min,proto3,oneof" json:"min,omitempty"`
+	Max           *float64               `protobuf:"fixed64,4,opt,name=max,proto3,oneof" json:"max,omitempty"`
+	Exists        bool                   `protobuf:"varint,5,opt,name=exists,proto3" json:"exists,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttributeFilter) Reset() {
+	*x = AttributeFilter{}
+	mi := &file_proto_inventory_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttributeFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeFilter) ProtoMessage() {}
+
+func (x *AttributeFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeFilter.ProtoReflect.Descriptor instead.
+func (*AttributeFilter) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AttributeFilter) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *AttributeFilter) GetEquals() *structpb.Value {
+	if x != nil {
+		return x.Equals
+	}
+	return nil
+}
+
+func (x *AttributeFilter) GetMin() float64 {
+	if x != nil && x.Min != nil {
+		return *x.Min
+	}
+	return 0
+}
+
+func (x *AttributeFilter) GetMax() float64 {
+	if x != nil && x.Max != nil {
+		return *x.Max
+	}
+	return 0
+}
+
+func (x *AttributeFilter) GetExists() bool {
+	if x != nil {
+		return x.Exists
+	}
+	return false
+}
+
+// Money mirrors google.type.Money: an exact amount of units plus nanos
+// (10^-9 units) in an ISO 4217 currency; units and nanos share the same sign.
+type Money struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CurrencyCode  string                 `protobuf:"bytes,1,opt,name=currency_code,json=currencyCode,proto3" json:"currency_code,omitempty"`
+	Units         int64                  `protobuf:"varint,2,opt,name=units,proto3" json:"units,omitempty"`
+	Nanos         int32                  `protobuf:"varint,3,opt,name=nanos,proto3" json:"nanos,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	mi := &file_proto_inventory_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Money) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *Money) GetUnits() int64 {
+	if x != nil {
+		return x.Units
+	}
+	return 0
+}
+
+func (x *Money) GetNanos() int32 {
+	if x != nil {
+		return x.Nanos
+	}
+	return 0
+}
+
+type ProductUnit struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Unit  string                 `protobuf:"bytes,1,opt,name=unit,proto3" json:"unit,omitempty"`
+	// factor is the number of base units one unit holds.
+	Factor        int32 `protobuf:"varint,2,opt,name=factor,proto3" json:"factor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductUnit) Reset() {
+	*x = ProductUnit{}
+	mi := &file_proto_inventory_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductUnit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductUnit) ProtoMessage() {}
+
+func (x *ProductUnit) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductUnit.ProtoReflect.Descriptor instead.
+func (*ProductUnit) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ProductUnit) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *ProductUnit) GetFactor() int32 {
+	if x != nil {
+		return x.Factor
+	}
+	return 0
+}
+
+type StockAdjustment struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId uint32                 `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity  int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Unit      string                 `protobuf:"bytes,3,opt,name=unit,proto3" json:"unit,omitempty"`
+	// base_quantity is the change in the product's base unit.
+	BaseQuantity  int32    `protobuf:"varint,4,opt,name=base_quantity,json=baseQuantity,proto3" json:"base_quantity,omitempty"`
+	Product       *Product `protobuf:"bytes,5,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockAdjustment) Reset() {
+	*x = StockAdjustment{}
+	mi := &file_proto_inventory_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockAdjustment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockAdjustment) ProtoMessage() {}
+
+func (x *StockAdjustment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockAdjustment.ProtoReflect.Descriptor instead.
+func (*StockAdjustment) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StockAdjustment) GetProductId() uint32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *StockAdjustment) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *StockAdjustment) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *StockAdjustment) GetBaseQuantity() int32 {
+	if x != nil {
+		return x.BaseQuantity
+	}
+	return 0
+}
+
+func (x *StockAdjustment) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type KitComponent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ComponentId   uint32                 `protobuf:"varint,1,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Component     *Product               `protobuf:"bytes,3,opt,name=component,proto3" json:"component,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KitComponent) Reset() {
+	*x = KitComponent{}
+	mi := &file_proto_inventory_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KitComponent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KitComponent) ProtoMessage() {}
+
+func (x *KitComponent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KitComponent.ProtoReflect.Descriptor instead.
+func (*KitComponent) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *KitComponent) GetComponentId() uint32 {
+	if x != nil {
+		return x.ComponentId
+	}
+	return 0
+}
+
+func (x *KitComponent) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *KitComponent) GetComponent() *Product {
+	if x != nil {
+		return x.Component
+	}
+	return nil
+}
+
+type Category struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ParentId    uint32                 `protobuf:"varint,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Name        string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// product_count and total_stock include every descendant category.
+	ProductCount  int32                  `protobuf:"varint,5,opt,name=product_count,json=productCount,proto3" json:"product_count,omitempty"`
+	TotalStock    int64                  `protobuf:"varint,6,opt,name=total_stock,json=totalStock,proto3" json:"total_stock,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Children      []*Category            `protobuf:"bytes,9,rep,name=children,proto3" json:"children,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Category) Reset() {
+	*x = Category{}
+	mi := &file_proto_inventory_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Category) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Category) ProtoMessage() {}
+
+func (x *Category) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Category.ProtoReflect.Descriptor instead.
+func (*Category) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Category) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Category) GetParentId() uint32 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *Category) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Category) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Category) GetProductCount() int32 {
+	if x != nil {
+		return x.ProductCount
+	}
+	return 0
+}
+
+func (x *Category) GetTotalStock() int64 {
+	if x != nil {
+		return x.TotalStock
+	}
+	return 0
+}
+
+func (x *Category) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Category) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Category) GetChildren() []*Category {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+type Reservation struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId uint32                 `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	OrderId   uint32                 `protobuf:"varint,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Quantity  int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Status    ReservationStatus      `protobuf:"varint,5,opt,name=status,proto3,enum=inventory.ReservationStatus" json:"status,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// parent_id links a component reservation to its kit reservation.
+	ParentId   uint32           `protobuf:"varint,7,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Components []*Reservation   `protobuf:"bytes,8,rep,name=components,proto3" json:"components,omitempty"`
+	Lots       []*LotAllocation `protobuf:"bytes,9,rep,name=lots,proto3" json:"lots,omitempty"`
+	Serials    []*Serial        `protobuf:"bytes,10,rep,name=serials,proto3" json:"serials,omitempty"`
+	// quantity is in the base unit; unit_quantity is the amount requested in unit.
+	Unit          string `protobuf:"bytes,11,opt,name=unit,proto3" json:"unit,omitempty"`
+	UnitQuantity  int32  `protobuf:"varint,12,opt,name=unit_quantity,json=unitQuantity,proto3" json:"unit_quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reservation) Reset() {
+	*x = Reservation{}
+	mi := &file_proto_inventory_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reservation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reservation) ProtoMessage() {}
+
+func (x *Reservation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reservation.ProtoReflect.Descriptor instead.
+func (*Reservation) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Reservation) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Reservation) GetProductId() uint32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *Reservation) GetOrderId() uint32 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *Reservation) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *Reservation) GetStatus() ReservationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReservationStatus_RESERVATION_STATUS_UNSPECIFIED
+}
+
+func (x *Reservation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Reservation) GetParentId() uint32 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *Reservation) GetComponents() []*Reservation {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+func (x *Reservation) GetLots() []*LotAllocation {
+	if x != nil {
+		return x.Lots
+	}
+	return nil
+}
+
+func (x *Reservation) GetSerials() []*Serial {
+	if x != nil {
+		return x.Serials
+	}
+	return nil
+}
+
+func (x *Reservation) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *Reservation) GetUnitQuantity() int32 {
+	if x != nil {
+		return x.UnitQuantity
+	}
+	return 0
+}
+
+type Lot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId     uint32                 `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	LotNumber     string                 `protobuf:"bytes,3,opt,name=lot_number,json=lotNumber,proto3" json:"lot_number,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Quantity      int32                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Lot) Reset() {
+	*x = Lot{}
+	mi := &file_proto_inventory_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Lot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Lot) ProtoMessage() {}
+
+func (x *Lot) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Lot.ProtoReflect.Descriptor instead.
+func (*Lot) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Lot) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Lot) GetProductId() uint32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *Lot) GetLotNumber() string {
+	if x != nil {
+		return x.LotNumber
+	}
+	return ""
+}
+
+func (x *Lot) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Lot) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *Lot) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Lot) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type LotAllocation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LotId         uint32                 `protobuf:"varint,1,opt,name=lot_id,json=lotId,proto3" json:"lot_id,omitempty"`
+	LotNumber     string                 `protobuf:"bytes,2,opt,name=lot_number,json=lotNumber,proto3" json:"lot_number,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LotAllocation) Reset() {
+	*x = LotAllocation{}
+	mi := &file_proto_inventory_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LotAllocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LotAllocation) ProtoMessage() {}
+
+func (x *LotAllocation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LotAllocation.ProtoReflect.Descriptor instead.
+func (*LotAllocation) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *LotAllocation) GetLotId() uint32 {
+	if x != nil {
+		return x.LotId
+	}
+	return 0
+}
+
+func (x *LotAllocation) GetLotNumber() string {
+	if x != nil {
+		return x.LotNumber
+	}
+	return ""
+}
+
+func (x *LotAllocation) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *LotAllocation) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// PriceChange is an entry of a product's price history. applied_at stays
+// unset while a scheduled change waits for its effective time.
+type PriceChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId     uint32                 `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Price         *Money                 `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"`
+	EffectiveFrom *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+	AppliedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=applied_at,json=appliedAt,proto3" json:"applied_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceChange) Reset() {
+	*x = PriceChange{}
+	mi := &file_proto_inventory_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceChange) ProtoMessage() {}
+
+func (x *PriceChange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceChange.ProtoReflect.Descriptor instead.
+func (*PriceChange) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PriceChange) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PriceChange) GetProductId() uint32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *PriceChange) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *PriceChange) GetEffectiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return nil
+}
+
+func (x *PriceChange) GetAppliedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AppliedAt
+	}
+	return nil
+}
+
+func (x *PriceChange) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type Serial struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId     uint32                 `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	SerialNumber  string                 `protobuf:"bytes,3,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
+	Status        SerialStatus           `protobuf:"varint,4,opt,name=status,proto3,enum=inventory.SerialStatus" json:"status,omitempty"`
+	ReservationId uint32                 `protobuf:"varint,5,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	// order_id is set once the serial has been assigned to a confirmed reservation.
+	OrderId       uint32                 `protobuf:"varint,6,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Product       *Product               `protobuf:"bytes,9,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Serial) Reset() {
+	*x = Serial{}
+	mi := &file_proto_inventory_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Serial) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Serial) ProtoMessage() {}
+
+func (x *Serial) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Serial.ProtoReflect.Descriptor instead.
+func (*Serial) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Serial) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Serial) GetProductId() uint32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *Serial) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+func (x *Serial) GetStatus() SerialStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SerialStatus_SERIAL_STATUS_UNSPECIFIED
+}
+
+func (x *Serial) GetReservationId() uint32 {
+	if x != nil {
+		return x.ReservationId
+	}
+	return 0
+}
+
+func (x *Serial) GetOrderId() uint32 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *Serial) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Serial) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Serial) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+// Webhook subscribes a URL to domain events. secret is only returned when the
+// webhook is created. A webhook is disabled, and disabled_at set, after too
+// many consecutive failed deliveries.
+type Webhook struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	EventTypes    []string               `protobuf:"bytes,3,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	Secret        string                 `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"`
+	Active        bool                   `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`
+	FailureCount  int32                  `protobuf:"varint,6,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
+	DisabledAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=disabled_at,json=disabledAt,proto3" json:"disabled_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Webhook) Reset() {
+	*x = Webhook{}
+	mi := &file_proto_inventory_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Webhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Webhook) ProtoMessage() {}
+
+func (x *Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Webhook) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Webhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Webhook) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *Webhook) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *Webhook) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *Webhook) GetFailureCount() int32 {
+	if x != nil {
+		return x.FailureCount
+	}
+	return 0
+}
+
+func (x *Webhook) GetDisabledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DisabledAt
+	}
+	return nil
+}
+
+func (x *Webhook) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Webhook) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// WebhookDelivery is one event sent to one webhook with the outcome of its
+// latest attempt.
+type WebhookDelivery struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	WebhookId      uint32                 `protobuf:"varint,2,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	EventId        uint64                 `protobuf:"varint,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventType      string                 `protobuf:"bytes,4,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	Status         WebhookDeliveryStatus  `protobuf:"varint,5,opt,name=status,proto3,enum=inventory.WebhookDeliveryStatus" json:"status,omitempty"`
+	Attempts       int32                  `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	NextAttemptAt  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+	ResponseStatus int32                  `protobuf:"varint,8,opt,name=response_status,json=responseStatus,proto3" json:"response_status,omitempty"`
+	ResponseBody   string                 `protobuf:"bytes,9,opt,name=response_body,json=responseBody,proto3" json:"response_body,omitempty"`
+	LastError      string                 `protobuf:"bytes,10,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	DeliveredAt    *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=delivered_at,json=deliveredAt,proto3" json:"delivered_at,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	mi := &file_proto_inventory_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *WebhookDelivery) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetWebhookId() uint32 {
+	if x != nil {
+		return x.WebhookId
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetEventId() uint64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetStatus() WebhookDeliveryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED
+}
+
+func (x *WebhookDelivery) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetNextAttemptAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetResponseStatus() int32 {
+	if x != nil {
+		return x.ResponseStatus
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetResponseBody() string {
+	if x != nil {
+		return x.ResponseBody
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetDeliveredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeliveredAt
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// AuditEvent records one change to a product or reservation. before is unset
+// for creations and after for deletions. source is "rest", "grpc",
+// "consumer" or "system".
+type AuditEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	EntityType    string                 `protobuf:"bytes,2,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	EntityId      uint32                 `protobuf:"varint,3,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	Action        string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	Actor         string                 `protobuf:"bytes,5,opt,name=actor,proto3" json:"actor,omitempty"`
+	Source        string                 `protobuf:"bytes,6,opt,name=source,proto3" json:"source,omitempty"`
+	RequestId     string                 `protobuf:"bytes,7,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Before        *structpb.Struct       `protobuf:"bytes,8,opt,name=before,proto3" json:"before,omitempty"`
+	After         *structpb.Struct       `protobuf:"bytes,9,opt,name=after,proto3" json:"after,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	mi := &file_proto_inventory_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEvent) ProtoMessage() {}
+
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AuditEvent) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuditEvent) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetEntityId() uint32 {
+	if x != nil {
+		return x.EntityId
+	}
+	return 0
+}
+
+func (x *AuditEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetBefore() *structpb.Struct {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetAfter() *structpb.Struct {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListProductsRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Page               uint32                 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage            uint32                 `protobuf:"varint,2,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	Search             string                 `protobuf:"bytes,3,opt,name=search,proto3" json:"search,omitempty"`
+	Ids                []uint32               `protobuf:"varint,4,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	Names              []string               `protobuf:"bytes,5,rep,name=names,proto3" json:"names,omitempty"`
+	Skus               []string               `protobuf:"bytes,6,rep,name=skus,proto3" json:"skus,omitempty"`
+	CategoryId         uint32                 `protobuf:"varint,7,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	IncludeDescendants bool                   `protobuf:"varint,8,opt,name=include_descendants,json=includeDescendants,proto3" json:"include_descendants,omitempty"`
+	ParentId           uint32                 `protobuf:"varint,9,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	ExcludeVariants    bool                   `protobuf:"varint,10,opt,name=exclude_variants,json=excludeVariants,proto3" json:"exclude_variants,omitempty"`
+	Statuses           []ProductStatus        `protobuf:"varint,11,rep,packed,name=statuses,proto3,enum=inventory.ProductStatus" json:"statuses,omitempty"`
+	Attributes         []*AttributeFilter     `protobuf:"bytes,12,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ListProductsRequest) Reset() {
+	*x = ListProductsRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsRequest) ProtoMessage() {}
+
+func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsRequest.ProtoReflect.Descriptor instead.
+func (*ListProductsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListProductsRequest) GetPage() uint32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListProductsRequest) GetPerPage() uint32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+func (x *ListProductsRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetIds() []uint32 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *ListProductsRequest) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *ListProductsRequest) GetSkus() []string {
+	if x != nil {
+		return x.Skus
+	}
+	return nil
+}
+
+func (x *ListProductsRequest) GetCategoryId() uint32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *ListProductsRequest) GetIncludeDescendants() bool {
+	if x != nil {
+		return x.IncludeDescendants
+	}
+	return false
+}
+
+func (x *ListProductsRequest) GetParentId() uint32 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *ListProductsRequest) GetExcludeVariants() bool {
+	if x != nil {
+		return x.ExcludeVariants
+	}
+	return false
+}
+
+func (x *ListProductsRequest) GetStatuses() []ProductStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ListProductsRequest) GetAttributes() []*AttributeFilter {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type ListProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductsResponse) Reset() {
+	*x = ListProductsResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsResponse) ProtoMessage() {}
+
+func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsResponse.ProtoReflect.Descriptor instead.
+func (*ListProductsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListProductsResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *ListProductsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetProductRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// price_at, when set, returns the price the product had at that time
+	// instead of its current price.
+	PriceAt       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=price_at,json=priceAt,proto3" json:"price_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductRequest) Reset() {
+	*x = GetProductRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductRequest) ProtoMessage() {}
+
+func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductRequest.ProtoReflect.Descriptor instead.
+func (*GetProductRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetProductRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetProductRequest) GetPriceAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PriceAt
+	}
+	return nil
+}
+
+type GetProductBySKURequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductBySKURequest) Reset() {
+	*x = GetProductBySKURequest{}
+	mi := &file_proto_inventory_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductBySKURequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductBySKURequest) ProtoMessage() {}
+
+func (x *GetProductBySKURequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductBySKURequest.ProtoReflect.Descriptor instead.
+func (*GetProductBySKURequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetProductBySKURequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+type GetProductByBarcodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Barcode       string                 `protobuf:"bytes,1,opt,name=barcode,proto3" json:"barcode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductByBarcodeRequest) Reset() {
+	*x = GetProductByBarcodeRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductByBarcodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductByBarcodeRequest) ProtoMessage() {}
+
+func (x *GetProductByBarcodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductByBarcodeRequest.ProtoReflect.Descriptor instead.
+func (*GetProductByBarcodeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetProductByBarcodeRequest) GetBarcode() string {
+	if x != nil {
+		return x.Barcode
+	}
+	return ""
+}
+
+type CreateProductRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Name         string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Stock        int32                  `protobuf:"varint,2,opt,name=stock,proto3" json:"stock,omitempty"`
+	Sku          string                 `protobuf:"bytes,4,opt,name=sku,proto3" json:"sku,omitempty"`
+	Barcode      string                 `protobuf:"bytes,5,opt,name=barcode,proto3" json:"barcode,omitempty"`
+	CategoryId   uint32                 `protobuf:"varint,6,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	ParentId     uint32                 `protobuf:"varint,7,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Options      map[string]string      `protobuf:"bytes,8,rep,name=options,proto3" json:"options,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Components   []*KitComponent        `protobuf:"bytes,9,rep,name=components,proto3" json:"components,omitempty"`
+	TrackLots    bool                   `protobuf:"varint,10,opt,name=track_lots,json=trackLots,proto3" json:"track_lots,omitempty"`
+	TrackSerials bool                   `protobuf:"varint,11,opt,name=track_serials,json=trackSerials,proto3" json:"track_serials,omitempty"`
+	Units        []*ProductUnit         `protobuf:"bytes,12,rep,name=units,proto3" json:"units,omitempty"`
+	Price        *Money                 `protobuf:"bytes,13,opt,name=price,proto3" json:"price,omitempty"`
+	// status defaults to active; new products may also start as drafts.
+	Status        ProductStatus    `protobuf:"varint,14,opt,name=status,proto3,enum=inventory.ProductStatus" json:"status,omitempty"`
+	Attributes    *structpb.Struct `protobuf:"bytes,15,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProductRequest) Reset() {
+	*x = CreateProductRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductRequest) ProtoMessage() {}
+
+func (x *CreateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductRequest.ProtoReflect.Descriptor instead.
+func (*CreateProductRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CreateProductRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetStock() int32 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *CreateProductRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetBarcode() string {
+	if x != nil {
+		return x.Barcode
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetCategoryId() uint32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *CreateProductRequest) GetParentId() uint32 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *CreateProductRequest) GetOptions() map[string]string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *CreateProductRequest) GetComponents() []*KitComponent {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+func (x *CreateProductRequest) GetTrackLots() bool {
+	if x != nil {
+		return x.TrackLots
+	}
+	return false
+}
+
+func (x *CreateProductRequest) GetTrackSerials() bool {
+	if x != nil {
+		return x.TrackSerials
+	}
+	return false
+}
+
+func (x *CreateProductRequest) GetUnits() []*ProductUnit {
+	if x != nil {
+		return x.Units
+	}
+	return nil
+}
+
+func (x *CreateProductRequest) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *CreateProductRequest) GetStatus() ProductStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ProductStatus_PRODUCT_STATUS_UNSPECIFIED
+}
+
+func (x *CreateProductRequest) GetAttributes() *structpb.Struct {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+// UpdateProductRequest changes the fields named in update_mask, or without a
+// mask the fields set to a non-default value; the others keep their stored
+// values. In a batch, every item replaces the whole product.
+type UpdateProductRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name         string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Stock        int32                  `protobuf:"varint,3,opt,name=stock,proto3" json:"stock,omitempty"`
+	Sku          string                 `protobuf:"bytes,5,opt,name=sku,proto3" json:"sku,omitempty"`
+	Barcode      string                 `protobuf:"bytes,6,opt,name=barcode,proto3" json:"barcode,omitempty"`
+	CategoryId   uint32                 `protobuf:"varint,7,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	ParentId     uint32                 `protobuf:"varint,8,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Options      map[string]string      `protobuf:"bytes,9,rep,name=options,proto3" json:"options,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Components   []*KitComponent        `protobuf:"bytes,10,rep,name=components,proto3" json:"components,omitempty"`
+	TrackLots    bool                   `protobuf:"varint,11,opt,name=track_lots,json=trackLots,proto3" json:"track_lots,omitempty"`
+	TrackSerials bool                   `protobuf:"varint,12,opt,name=track_serials,json=trackSerials,proto3" json:"track_serials,omitempty"`
+	Units        []*ProductUnit         `protobuf:"bytes,13,rep,name=units,proto3" json:"units,omitempty"`
+	Price        *Money                 `protobuf:"bytes,14,opt,name=price,proto3" json:"price,omitempty"`
+	Attributes   *structpb.Struct       `protobuf:"bytes,15,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	// update_mask lists the fields to change by their proto names, e.g. "sku"
+	// or "units"; a listed field left unset is cleared.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,16,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductRequest) Reset() {
+	*x = UpdateProductRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductRequest) ProtoMessage() {}
+
+func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProductRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UpdateProductRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateProductRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetStock() int32 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *UpdateProductRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetBarcode() string {
+	if x != nil {
+		return x.Barcode
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetCategoryId() uint32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *UpdateProductRequest) GetParentId() uint32 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *UpdateProductRequest) GetOptions() map[string]string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *UpdateProductRequest) GetComponents() []*KitComponent {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+func (x *UpdateProductRequest) GetTrackLots() bool {
+	if x != nil {
+		return x.TrackLots
+	}
+	return false
+}
+
+func (x *UpdateProductRequest) GetTrackSerials() bool {
+	if x != nil {
+		return x.TrackSerials
+	}
+	return false
+}
+
+func (x *UpdateProductRequest) GetUnits() []*ProductUnit {
+	if x != nil {
+		return x.Units
+	}
+	return nil
+}
+
+func (x *UpdateProductRequest) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *UpdateProductRequest) GetAttributes() *structpb.Struct {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *UpdateProductRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type BatchCreateProductsRequest struct {
+	state    protoimpl.MessageState  `protogen:"open.v1"`
+	Products []*CreateProductRequest `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	// atomic writes either every product or none of them.
+	Atomic        bool `protobuf:"varint,2,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchCreateProductsRequest) Reset() {
+	*x = BatchCreateProductsRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCreateProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateProductsRequest) ProtoMessage() {}
+
+func (x *BatchCreateProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateProductsRequest.ProtoReflect.Descriptor instead.
+func (*BatchCreateProductsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *BatchCreateProductsRequest) GetProducts() []*CreateProductRequest {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *BatchCreateProductsRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+type BatchUpdateProductsRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Products      []*UpdateProductRequest `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	Atomic        bool                    `protobuf:"varint,2,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdateProductsRequest) Reset() {
+	*x = BatchUpdateProductsRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdateProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateProductsRequest) ProtoMessage() {}
+
+func (x *BatchUpdateProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateProductsRequest.ProtoReflect.Descriptor instead.
+func (*BatchUpdateProductsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *BatchUpdateProductsRequest) GetProducts() []*UpdateProductRequest {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *BatchUpdateProductsRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+type BatchItemResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Status        BatchItemStatus        `protobuf:"varint,2,opt,name=status,proto3,enum=inventory.BatchItemStatus" json:"status,omitempty"`
+	Product       *Product               `protobuf:"bytes,3,opt,name=product,proto3" json:"product,omitempty"`
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCode     string                 `protobuf:"bytes,5,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	FieldErrors   map[string]string      `protobuf:"bytes,6,rep,name=field_errors,json=fieldErrors,proto3" json:"field_errors,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchItemResult) Reset() {
+	*x = BatchItemResult{}
+	mi := &file_proto_inventory_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchItemResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchItemResult) ProtoMessage() {}
+
+func (x *BatchItemResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchItemResult.ProtoReflect.Descriptor instead.
+func (*BatchItemResult) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *BatchItemResult) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *BatchItemResult) GetStatus() BatchItemStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BatchItemStatus_BATCH_ITEM_STATUS_UNSPECIFIED
+}
+
+func (x *BatchItemResult) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *BatchItemResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *BatchItemResult) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *BatchItemResult) GetFieldErrors() map[string]string {
+	if x != nil {
+		return x.FieldErrors
+	}
+	return nil
+}
+
+type BatchProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*BatchItemResult     `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Succeeded     int32                  `protobuf:"varint,2,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed        int32                  `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchProductsResponse) Reset() {
+	*x = BatchProductsResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchProductsResponse) ProtoMessage() {}
+
+func (x *BatchProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchProductsResponse.ProtoReflect.Descriptor instead.
+func (*BatchProductsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *BatchProductsResponse) GetResults() []*BatchItemResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BatchProductsResponse) GetSucceeded() int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *BatchProductsResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+type UpdateProductStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        ProductStatus          `protobuf:"varint,2,opt,name=status,proto3,enum=inventory.ProductStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductStatusRequest) Reset() {
+	*x = UpdateProductStatusRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductStatusRequest) ProtoMessage() {}
+
+func (x *UpdateProductStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProductStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UpdateProductStatusRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateProductStatusRequest) GetStatus() ProductStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ProductStatus_PRODUCT_STATUS_UNSPECIFIED
+}
+
+type AdjustStockRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId uint32                 `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// quantity is added to the stock, or removed when negative.
+	Quantity int32 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// unit defaults to the base unit "each".
+	Unit          string `protobuf:"bytes,3,opt,name=unit,proto3" json:"unit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdjustStockRequest) Reset() {
+	*x = AdjustStockRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustStockRequest) ProtoMessage() {}
+
+func (x *AdjustStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustStockRequest.ProtoReflect.Descriptor instead.
+func (*AdjustStockRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AdjustStockRequest) GetProductId() uint32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *AdjustStockRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *AdjustStockRequest) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+type DeleteProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductRequest) Reset() {
+	*x = DeleteProductRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductRequest) ProtoMessage() {}
+
+func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProductRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DeleteProductRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListCategoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          uint32                 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage       uint32                 `protobuf:"varint,2,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	Search        string                 `protobuf:"bytes,3,opt,name=search,proto3" json:"search,omitempty"`
+	ParentId      uint32                 `protobuf:"varint,4,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	RootsOnly     bool                   `protobuf:"varint,5,opt,name=roots_only,json=rootsOnly,proto3" json:"roots_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesRequest) Reset() {
+	*x = ListCategoriesRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesRequest) ProtoMessage() {}
+
+func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*ListCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListCategoriesRequest) GetPage() uint32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListCategoriesRequest) GetPerPage() uint32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+func (x *ListCategoriesRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *ListCategoriesRequest) GetParentId() uint32 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *ListCategoriesRequest) GetRootsOnly() bool {
+	if x != nil {
+		return x.RootsOnly
+	}
+	return false
+}
+
+type ListCategoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*Category            `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesResponse) Reset() {
+	*x = ListCategoriesResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesResponse) ProtoMessage() {}
+
+func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListCategoriesResponse) GetCategories() []*Category {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *ListCategoriesResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryRequest) Reset() {
+	*x = GetCategoryRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryRequest) ProtoMessage() {}
+
+func (x *GetCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryRequest.ProtoReflect.Descriptor instead.
+func (*GetCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetCategoryRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type CreateCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ParentId      uint32                 `protobuf:"varint,1,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryRequest) Reset() {
+	*x = CreateCategoryRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryRequest) ProtoMessage() {}
+
+func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CreateCategoryRequest) GetParentId() uint32 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *CreateCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type UpdateCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ParentId      uint32                 `protobuf:"varint,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCategoryRequest) Reset() {
+	*x = UpdateCategoryRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCategoryRequest) ProtoMessage() {}
+
+func (x *UpdateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UpdateCategoryRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateCategoryRequest) GetParentId() uint32 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *UpdateCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type DeleteCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryRequest) Reset() {
+	*x = DeleteCategoryRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryRequest) ProtoMessage() {}
+
+func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DeleteCategoryRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type CreateLotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     uint32                 `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	LotNumber     string                 `protobuf:"bytes,2,opt,name=lot_number,json=lotNumber,proto3" json:"lot_number,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateLotRequest) Reset() {
+	*x = CreateLotRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateLotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLotRequest) ProtoMessage() {}
+
+func (x *CreateLotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLotRequest.ProtoReflect.Descriptor instead.
+func (*CreateLotRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CreateLotRequest) GetProductId() uint32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *CreateLotRequest) GetLotNumber() string {
+	if x != nil {
+		return x.LotNumber
+	}
+	return ""
+}
+
+func (x *CreateLotRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *CreateLotRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type ListLotsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Page           uint32                 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage        uint32                 `protobuf:"varint,2,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	ProductIds     []uint32               `protobuf:"varint,3,rep,packed,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`
+	ExcludeExpired bool                   `protobuf:"varint,4,opt,name=exclude_expired,json=excludeExpired,proto3" json:"exclude_expired,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListLotsRequest) Reset() {
+	*x = ListLotsRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLotsRequest) ProtoMessage() {}
+
+func (x *ListLotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLotsRequest.ProtoReflect.Descriptor instead.
+func (*ListLotsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListLotsRequest) GetPage() uint32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListLotsRequest) GetPerPage() uint32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+func (x *ListLotsRequest) GetProductIds() []uint32 {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+func (x *ListLotsRequest) GetExcludeExpired() bool {
+	if x != nil {
+		return x.ExcludeExpired
+	}
+	return false
+}
+
+type ListExpiringLotsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          uint32                 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage       uint32                 `protobuf:"varint,2,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	ProductIds    []uint32               `protobuf:"varint,3,rep,packed,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`
+	WithinDays    uint32                 `protobuf:"varint,4,opt,name=within_days,json=withinDays,proto3" json:"within_days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExpiringLotsRequest) Reset() {
+	*x = ListExpiringLotsRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExpiringLotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExpiringLotsRequest) ProtoMessage() {}
+
+func (x *ListExpiringLotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExpiringLotsRequest.ProtoReflect.Descriptor instead.
+func (*ListExpiringLotsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListExpiringLotsRequest) GetPage() uint32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListExpiringLotsRequest) GetPerPage() uint32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+func (x *ListExpiringLotsRequest) GetProductIds() []uint32 {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+func (x *ListExpiringLotsRequest) GetWithinDays() uint32 {
+	if x != nil {
+		return x.WithinDays
+	}
+	return 0
+}
+
+type ListLotsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lots          []*Lot                 `protobuf:"bytes,1,rep,name=lots,proto3" json:"lots,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLotsResponse) Reset() {
+	*x = ListLotsResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLotsResponse) ProtoMessage() {}
+
+func (x *ListLotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLotsResponse.ProtoReflect.Descriptor instead.
+func (*ListLotsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListLotsResponse) GetLots() []*Lot {
+	if x != nil {
+		return x.Lots
+	}
+	return nil
+}
+
+func (x *ListLotsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type RegisterSerialsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     uint32                 `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	SerialNumbers []string               `protobuf:"bytes,2,rep,name=serial_numbers,json=serialNumbers,proto3" json:"serial_numbers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterSerialsRequest) Reset() {
+	*x = RegisterSerialsRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterSerialsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterSerialsRequest) ProtoMessage() {}
+
+func (x *RegisterSerialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterSerialsRequest.ProtoReflect.Descriptor instead.
+func (*RegisterSerialsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RegisterSerialsRequest) GetProductId() uint32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *RegisterSerialsRequest) GetSerialNumbers() []string {
+	if x != nil {
+		return x.SerialNumbers
+	}
+	return nil
+}
+
+type ListSerialsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          uint32                 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage       uint32                 `protobuf:"varint,2,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	ProductIds    []uint32               `protobuf:"varint,3,rep,packed,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`
+	Statuses      []SerialStatus         `protobuf:"varint,4,rep,packed,name=statuses,proto3,enum=inventory.SerialStatus" json:"statuses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSerialsRequest) Reset() {
+	*x = ListSerialsRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSerialsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSerialsRequest) ProtoMessage() {}
+
+func (x *ListSerialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSerialsRequest.ProtoReflect.Descriptor instead.
+func (*ListSerialsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListSerialsRequest) GetPage() uint32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListSerialsRequest) GetPerPage() uint32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+func (x *ListSerialsRequest) GetProductIds() []uint32 {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+func (x *ListSerialsRequest) GetStatuses() []SerialStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+type ListSerialsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Serials       []*Serial              `protobuf:"bytes,1,rep,name=serials,proto3" json:"serials,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSerialsResponse) Reset() {
+	*x = ListSerialsResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSerialsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSerialsResponse) ProtoMessage() {}
+
+func (x *ListSerialsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSerialsResponse.ProtoReflect.Descriptor instead.
+func (*ListSerialsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListSerialsResponse) GetSerials() []*Serial {
+	if x != nil {
+		return x.Serials
+	}
+	return nil
+}
+
+func (x *ListSerialsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetSerialRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SerialNumber  string                 `protobuf:"bytes,1,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSerialRequest) Reset() {
+	*x = GetSerialRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSerialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSerialRequest) ProtoMessage() {}
+
+func (x *GetSerialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSerialRequest.ProtoReflect.Descriptor instead.
+func (*GetSerialRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetSerialRequest) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+type SchedulePriceChangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     uint32                 `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Price         *Money                 `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+	EffectiveFrom *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchedulePriceChangeRequest) Reset() {
+	*x = SchedulePriceChangeRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchedulePriceChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulePriceChangeRequest) ProtoMessage() {}
+
+func (x *SchedulePriceChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulePriceChangeRequest.ProtoReflect.Descriptor instead.
+func (*SchedulePriceChangeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SchedulePriceChangeRequest) GetProductId() uint32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *SchedulePriceChangeRequest) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *SchedulePriceChangeRequest) GetEffectiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return nil
+}
+
+type CancelPriceChangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     uint32                 `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Id            uint32                 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelPriceChangeRequest) Reset() {
+	*x = CancelPriceChangeRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelPriceChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPriceChangeRequest) ProtoMessage() {}
+
+func (x *CancelPriceChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelPriceChangeRequest.ProtoReflect.Descriptor instead.
+func (*CancelPriceChangeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CancelPriceChangeRequest) GetProductId() uint32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *CancelPriceChangeRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListPriceHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          uint32                 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage       uint32                 `protobuf:"varint,2,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	ProductId     uint32                 `protobuf:"varint,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	PendingOnly   bool                   `protobuf:"varint,4,opt,name=pending_only,json=pendingOnly,proto3" json:"pending_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPriceHistoryRequest) Reset() {
+	*x = ListPriceHistoryRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPriceHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPriceHistoryRequest) ProtoMessage() {}
+
+func (x *ListPriceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPriceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListPriceHistoryRequest) GetPage() uint32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListPriceHistoryRequest) GetPerPage() uint32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+func (x *ListPriceHistoryRequest) GetProductId() uint32 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ListPriceHistoryRequest) GetPendingOnly() bool {
+	if x != nil {
+		return x.PendingOnly
+	}
+	return false
+}
+
+type ListPriceHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PriceChanges  []*PriceChange         `protobuf:"bytes,1,rep,name=price_changes,json=priceChanges,proto3" json:"price_changes,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPriceHistoryResponse) Reset() {
+	*x = ListPriceHistoryResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPriceHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPriceHistoryResponse) ProtoMessage() {}
+
+func (x *ListPriceHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPriceHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListPriceHistoryResponse) GetPriceChanges() []*PriceChange {
+	if x != nil {
+		return x.PriceChanges
+	}
+	return nil
+}
+
+func (x *ListPriceHistoryResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type ListReservationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          uint32                 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage       uint32                 `protobuf:"varint,2,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	ProductIds    []uint32               `protobuf:"varint,3,rep,packed,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`
+	OrderIds      []uint32               `protobuf:"varint,4,rep,packed,name=order_ids,json=orderIds,proto3" json:"order_ids,omitempty"`
+	Statuses      []ReservationStatus    `protobuf:"varint,5,rep,packed,name=statuses,proto3,enum=inventory.ReservationStatus" json:"statuses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReservationsRequest) Reset() {
+	*x = ListReservationsRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReservationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReservationsRequest) ProtoMessage() {}
+
+func (x *ListReservationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReservationsRequest.ProtoReflect.Descriptor instead.
+func (*ListReservationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListReservationsRequest) GetPage() uint32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListReservationsRequest) GetPerPage() uint32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+func (x *ListReservationsRequest) GetProductIds() []uint32 {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+func (x *ListReservationsRequest) GetOrderIds() []uint32 {
+	if x != nil {
+		return x.OrderIds
+	}
+	return nil
+}
+
+func (x *ListReservationsRequest) GetStatuses() []ReservationStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+type ListReservationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reservations  []*Reservation         `protobuf:"bytes,1,rep,name=reservations,proto3" json:"reservations,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReservationsResponse) Reset() {
+	*x = ListReservationsResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReservationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReservationsResponse) ProtoMessage() {}
+
+func (x *ListReservationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReservationsResponse.ProtoReflect.Descriptor instead.
+func (*ListReservationsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListReservationsResponse) GetReservations() []*Reservation {
+	if x != nil {
+		return x.Reservations
+	}
+	return nil
+}
+
+func (x *ListReservationsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetReservationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Stock         int32                  `protobuf:"varint,3,opt,name=stock,proto3" json:"stock,omitempty"`
-	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Product) Reset() {
-	*x = Product{}
-	mi := &file_proto_inventory_proto_msgTypes[0]
+func (x *GetReservationRequest) Reset() {
+	*x = GetReservationRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReservationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReservationRequest) ProtoMessage() {}
+
+func (x *GetReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReservationRequest.ProtoReflect.Descriptor instead.
+func (*GetReservationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetReservationRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type CreateReservationRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId uint32                 `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	OrderId   uint32                 `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// quantity is given in unit, which defaults to the base unit "each".
+	Quantity      int32  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Unit          string `protobuf:"bytes,4,opt,name=unit,proto3" json:"unit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReservationRequest) Reset() {
+	*x = CreateReservationRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Product) String() string {
+func (x *CreateReservationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Product) ProtoMessage() {}
+func (*CreateReservationRequest) ProtoMessage() {}
 
-func (x *Product) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_proto_msgTypes[0]
+func (x *CreateReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -112,80 +4077,174 @@ func (x *Product) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Product.ProtoReflect.Descriptor instead.
-func (*Product) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use CreateReservationRequest.ProtoReflect.Descriptor instead.
+func (*CreateReservationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *Product) GetId() uint32 {
+func (x *CreateReservationRequest) GetProductId() uint32 {
 	if x != nil {
-		return x.Id
+		return x.ProductId
 	}
 	return 0
 }
 
-func (x *Product) GetName() string {
+func (x *CreateReservationRequest) GetOrderId() uint32 {
 	if x != nil {
-		return x.Name
+		return x.OrderId
 	}
-	return ""
+	return 0
 }
 
-func (x *Product) GetStock() int32 {
+func (x *CreateReservationRequest) GetQuantity() int32 {
 	if x != nil {
-		return x.Stock
+		return x.Quantity
 	}
 	return 0
 }
 
-func (x *Product) GetPrice() float64 {
+func (x *CreateReservationRequest) GetUnit() string {
 	if x != nil {
-		return x.Price
+		return x.Unit
 	}
-	return 0
+	return ""
 }
 
-func (x *Product) GetCreatedAt() *timestamppb.Timestamp {
+type UpdateReservationStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []uint32               `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	Status        ReservationStatus      `protobuf:"varint,2,opt,name=status,proto3,enum=inventory.ReservationStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateReservationStatusRequest) Reset() {
+	*x = UpdateReservationStatusRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateReservationStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateReservationStatusRequest) ProtoMessage() {}
+
+func (x *UpdateReservationStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[51]
 	if x != nil {
-		return x.CreatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateReservationStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateReservationStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *UpdateReservationStatusRequest) GetIds() []uint32 {
+	if x != nil {
+		return x.Ids
 	}
 	return nil
 }
 
-func (x *Product) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *UpdateReservationStatusRequest) GetStatus() ReservationStatus {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Status
+	}
+	return ReservationStatus_RESERVATION_STATUS_UNSPECIFIED
+}
+
+type WatchStockRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ProductIds []uint32               `protobuf:"varint,1,rep,packed,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`
+	// after_sequence resumes a watch after the last event received; when unset
+	// the stream starts with a snapshot of each product.
+	AfterSequence uint64 `protobuf:"varint,2,opt,name=after_sequence,json=afterSequence,proto3" json:"after_sequence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchStockRequest) Reset() {
+	*x = WatchStockRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchStockRequest) ProtoMessage() {}
+
+func (x *WatchStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchStockRequest.ProtoReflect.Descriptor instead.
+func (*WatchStockRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *WatchStockRequest) GetProductIds() []uint32 {
+	if x != nil {
+		return x.ProductIds
 	}
 	return nil
 }
 
-type Reservation struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	ProductId     uint32                 `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	OrderId       uint32                 `protobuf:"varint,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	Status        ReservationStatus      `protobuf:"varint,5,opt,name=status,proto3,enum=inventory.ReservationStatus" json:"status,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+func (x *WatchStockRequest) GetAfterSequence() uint64 {
+	if x != nil {
+		return x.AfterSequence
+	}
+	return 0
+}
+
+type StockEvent struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Sequence       uint64                 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	ProductId      uint32                 `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Stock          int32                  `protobuf:"varint,3,opt,name=stock,proto3" json:"stock,omitempty"`
+	Reserved       int32                  `protobuf:"varint,4,opt,name=reserved,proto3" json:"reserved,omitempty"`
+	AvailableStock int32                  `protobuf:"varint,5,opt,name=available_stock,json=availableStock,proto3" json:"available_stock,omitempty"`
+	ChangedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	// snapshot is set on the events that open a watch with current totals.
+	Snapshot      bool `protobuf:"varint,7,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Reservation) Reset() {
-	*x = Reservation{}
-	mi := &file_proto_inventory_proto_msgTypes[1]
+func (x *StockEvent) Reset() {
+	*x = StockEvent{}
+	mi := &file_proto_inventory_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Reservation) String() string {
+func (x *StockEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Reservation) ProtoMessage() {}
+func (*StockEvent) ProtoMessage() {}
 
-func (x *Reservation) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_proto_msgTypes[1]
+func (x *StockEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -196,79 +4255,84 @@ func (x *Reservation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Reservation.ProtoReflect.Descriptor instead.
-func (*Reservation) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use StockEvent.ProtoReflect.Descriptor instead.
+func (*StockEvent) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *Reservation) GetId() uint32 {
+func (x *StockEvent) GetSequence() uint64 {
 	if x != nil {
-		return x.Id
+		return x.Sequence
 	}
 	return 0
 }
 
-func (x *Reservation) GetProductId() uint32 {
+func (x *StockEvent) GetProductId() uint32 {
 	if x != nil {
 		return x.ProductId
 	}
 	return 0
 }
 
-func (x *Reservation) GetOrderId() uint32 {
+func (x *StockEvent) GetStock() int32 {
 	if x != nil {
-		return x.OrderId
+		return x.Stock
 	}
 	return 0
 }
 
-func (x *Reservation) GetQuantity() int32 {
+func (x *StockEvent) GetReserved() int32 {
 	if x != nil {
-		return x.Quantity
+		return x.Reserved
 	}
 	return 0
 }
 
-func (x *Reservation) GetStatus() ReservationStatus {
+func (x *StockEvent) GetAvailableStock() int32 {
 	if x != nil {
-		return x.Status
+		return x.AvailableStock
 	}
-	return ReservationStatus_RESERVATION_STATUS_UNSPECIFIED
+	return 0
 }
 
-func (x *Reservation) GetCreatedAt() *timestamppb.Timestamp {
+func (x *StockEvent) GetChangedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.CreatedAt
+		return x.ChangedAt
 	}
 	return nil
 }
 
-type ListProductsRequest struct {
+func (x *StockEvent) GetSnapshot() bool {
+	if x != nil {
+		return x.Snapshot
+	}
+	return false
+}
+
+type ListWebhooksRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Page          uint32                 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
 	PerPage       uint32                 `protobuf:"varint,2,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
-	Search        string                 `protobuf:"bytes,3,opt,name=search,proto3" json:"search,omitempty"`
-	Ids           []uint32               `protobuf:"varint,4,rep,packed,name=ids,proto3" json:"ids,omitempty"`
-	Names         []string               `protobuf:"bytes,5,rep,name=names,proto3" json:"names,omitempty"`
+	ActiveOnly    bool                   `protobuf:"varint,3,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProductsRequest) Reset() {
-	*x = ListProductsRequest{}
-	mi := &file_proto_inventory_proto_msgTypes[2]
+func (x *ListWebhooksRequest) Reset() {
+	*x = ListWebhooksRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProductsRequest) String() string {
+func (x *ListWebhooksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProductsRequest) ProtoMessage() {}
+func (*ListWebhooksRequest) ProtoMessage() {}
 
-func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_proto_msgTypes[2]
+func (x *ListWebhooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -279,69 +4343,55 @@ func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProductsRequest.ProtoReflect.Descriptor instead.
-func (*ListProductsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use ListWebhooksRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhooksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *ListProductsRequest) GetPage() uint32 {
+func (x *ListWebhooksRequest) GetPage() uint32 {
 	if x != nil {
 		return x.Page
 	}
 	return 0
 }
 
-func (x *ListProductsRequest) GetPerPage() uint32 {
+func (x *ListWebhooksRequest) GetPerPage() uint32 {
 	if x != nil {
 		return x.PerPage
 	}
 	return 0
 }
 
-func (x *ListProductsRequest) GetSearch() string {
-	if x != nil {
-		return x.Search
-	}
-	return ""
-}
-
-func (x *ListProductsRequest) GetIds() []uint32 {
-	if x != nil {
-		return x.Ids
-	}
-	return nil
-}
-
-func (x *ListProductsRequest) GetNames() []string {
+func (x *ListWebhooksRequest) GetActiveOnly() bool {
 	if x != nil {
-		return x.Names
+		return x.ActiveOnly
 	}
-	return nil
+	return false
 }
 
-type ListProductsResponse struct {
+type ListWebhooksResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	Webhooks      []*Webhook             `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
 	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProductsResponse) Reset() {
-	*x = ListProductsResponse{}
-	mi := &file_proto_inventory_proto_msgTypes[3]
+func (x *ListWebhooksResponse) Reset() {
+	*x = ListWebhooksResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProductsResponse) String() string {
+func (x *ListWebhooksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProductsResponse) ProtoMessage() {}
+func (*ListWebhooksResponse) ProtoMessage() {}
 
-func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_proto_msgTypes[3]
+func (x *ListWebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -352,47 +4402,47 @@ func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProductsResponse.ProtoReflect.Descriptor instead.
-func (*ListProductsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ListWebhooksResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *ListProductsResponse) GetProducts() []*Product {
+func (x *ListWebhooksResponse) GetWebhooks() []*Webhook {
 	if x != nil {
-		return x.Products
+		return x.Webhooks
 	}
 	return nil
 }
 
-func (x *ListProductsResponse) GetTotal() int32 {
+func (x *ListWebhooksResponse) GetTotal() int32 {
 	if x != nil {
 		return x.Total
 	}
 	return 0
 }
 
-type GetProductRequest struct {
+type GetWebhookRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProductRequest) Reset() {
-	*x = GetProductRequest{}
-	mi := &file_proto_inventory_proto_msgTypes[4]
+func (x *GetWebhookRequest) Reset() {
+	*x = GetWebhookRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProductRequest) String() string {
+func (x *GetWebhookRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProductRequest) ProtoMessage() {}
+func (*GetWebhookRequest) ProtoMessage() {}
 
-func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_proto_msgTypes[4]
+func (x *GetWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -403,42 +4453,43 @@ func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProductRequest.ProtoReflect.Descriptor instead.
-func (*GetProductRequest) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use GetWebhookRequest.ProtoReflect.Descriptor instead.
+func (*GetWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *GetProductRequest) GetId() uint32 {
+func (x *GetWebhookRequest) GetId() uint32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type CreateProductRequest struct {
+// CreateWebhookRequest generates a secret when none is given.
+type CreateWebhookRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Stock         int32                  `protobuf:"varint,2,opt,name=stock,proto3" json:"stock,omitempty"`
-	Price         float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	EventTypes    []string               `protobuf:"bytes,2,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	Secret        string                 `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateProductRequest) Reset() {
-	*x = CreateProductRequest{}
-	mi := &file_proto_inventory_proto_msgTypes[5]
+func (x *CreateWebhookRequest) Reset() {
+	*x = CreateWebhookRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateProductRequest) String() string {
+func (x *CreateWebhookRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateProductRequest) ProtoMessage() {}
+func (*CreateWebhookRequest) ProtoMessage() {}
 
-func (x *CreateProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_proto_msgTypes[5]
+func (x *CreateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -449,57 +4500,60 @@ func (x *CreateProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateProductRequest.ProtoReflect.Descriptor instead.
-func (*CreateProductRequest) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use CreateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *CreateProductRequest) GetName() string {
+func (x *CreateWebhookRequest) GetUrl() string {
 	if x != nil {
-		return x.Name
+		return x.Url
 	}
 	return ""
 }
 
-func (x *CreateProductRequest) GetStock() int32 {
+func (x *CreateWebhookRequest) GetEventTypes() []string {
 	if x != nil {
-		return x.Stock
+		return x.EventTypes
 	}
-	return 0
+	return nil
 }
 
-func (x *CreateProductRequest) GetPrice() float64 {
+func (x *CreateWebhookRequest) GetSecret() string {
 	if x != nil {
-		return x.Price
+		return x.Secret
 	}
-	return 0
+	return ""
 }
 
-type UpdateProductRequest struct {
+// UpdateWebhookRequest keeps the current secret when none is given. Enabling a
+// webhook clears its failures.
+type UpdateWebhookRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Stock         int32                  `protobuf:"varint,3,opt,name=stock,proto3" json:"stock,omitempty"`
-	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	EventTypes    []string               `protobuf:"bytes,3,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	Secret        string                 `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"`
+	Active        bool                   `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateProductRequest) Reset() {
-	*x = UpdateProductRequest{}
-	mi := &file_proto_inventory_proto_msgTypes[6]
+func (x *UpdateWebhookRequest) Reset() {
+	*x = UpdateWebhookRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateProductRequest) String() string {
+func (x *UpdateWebhookRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProductRequest) ProtoMessage() {}
+func (*UpdateWebhookRequest) ProtoMessage() {}
 
-func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_proto_msgTypes[6]
+func (x *UpdateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -510,61 +4564,68 @@ func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProductRequest.ProtoReflect.Descriptor instead.
-func (*UpdateProductRequest) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use UpdateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *UpdateProductRequest) GetId() uint32 {
+func (x *UpdateWebhookRequest) GetId() uint32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *UpdateProductRequest) GetName() string {
+func (x *UpdateWebhookRequest) GetUrl() string {
 	if x != nil {
-		return x.Name
+		return x.Url
 	}
 	return ""
 }
 
-func (x *UpdateProductRequest) GetStock() int32 {
+func (x *UpdateWebhookRequest) GetEventTypes() []string {
 	if x != nil {
-		return x.Stock
+		return x.EventTypes
 	}
-	return 0
+	return nil
 }
 
-func (x *UpdateProductRequest) GetPrice() float64 {
+func (x *UpdateWebhookRequest) GetSecret() string {
 	if x != nil {
-		return x.Price
+		return x.Secret
 	}
-	return 0
+	return ""
 }
 
-type DeleteProductRequest struct {
+func (x *UpdateWebhookRequest) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type DeleteWebhookRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteProductRequest) Reset() {
-	*x = DeleteProductRequest{}
-	mi := &file_proto_inventory_proto_msgTypes[7]
+func (x *DeleteWebhookRequest) Reset() {
+	*x = DeleteWebhookRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteProductRequest) String() string {
+func (x *DeleteWebhookRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteProductRequest) ProtoMessage() {}
+func (*DeleteWebhookRequest) ProtoMessage() {}
 
-func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_proto_msgTypes[7]
+func (x *DeleteWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -575,44 +4636,43 @@ func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteProductRequest.ProtoReflect.Descriptor instead.
-func (*DeleteProductRequest) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use DeleteWebhookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *DeleteProductRequest) GetId() uint32 {
+func (x *DeleteWebhookRequest) GetId() uint32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type ListReservationsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          uint32                 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	PerPage       uint32                 `protobuf:"varint,2,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
-	ProductIds    []uint32               `protobuf:"varint,3,rep,packed,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`
-	OrderIds      []uint32               `protobuf:"varint,4,rep,packed,name=order_ids,json=orderIds,proto3" json:"order_ids,omitempty"`
-	Statuses      []ReservationStatus    `protobuf:"varint,5,rep,packed,name=statuses,proto3,enum=inventory.ReservationStatus" json:"statuses,omitempty"`
+type ListWebhookDeliveriesRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	WebhookId     uint32                  `protobuf:"varint,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	Statuses      []WebhookDeliveryStatus `protobuf:"varint,2,rep,packed,name=statuses,proto3,enum=inventory.WebhookDeliveryStatus" json:"statuses,omitempty"`
+	Page          uint32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage       uint32                  `protobuf:"varint,4,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListReservationsRequest) Reset() {
-	*x = ListReservationsRequest{}
-	mi := &file_proto_inventory_proto_msgTypes[8]
+func (x *ListWebhookDeliveriesRequest) Reset() {
+	*x = ListWebhookDeliveriesRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListReservationsRequest) String() string {
+func (x *ListWebhookDeliveriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListReservationsRequest) ProtoMessage() {}
+func (*ListWebhookDeliveriesRequest) ProtoMessage() {}
 
-func (x *ListReservationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_proto_msgTypes[8]
+func (x *ListWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -623,69 +4683,62 @@ func (x *ListReservationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListReservationsRequest.ProtoReflect.Descriptor instead.
-func (*ListReservationsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *ListReservationsRequest) GetPage() uint32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
+// Deprecated: Use ListWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *ListReservationsRequest) GetPerPage() uint32 {
+func (x *ListWebhookDeliveriesRequest) GetWebhookId() uint32 {
 	if x != nil {
-		return x.PerPage
+		return x.WebhookId
 	}
 	return 0
 }
 
-func (x *ListReservationsRequest) GetProductIds() []uint32 {
+func (x *ListWebhookDeliveriesRequest) GetStatuses() []WebhookDeliveryStatus {
 	if x != nil {
-		return x.ProductIds
+		return x.Statuses
 	}
 	return nil
 }
 
-func (x *ListReservationsRequest) GetOrderIds() []uint32 {
+func (x *ListWebhookDeliveriesRequest) GetPage() uint32 {
 	if x != nil {
-		return x.OrderIds
+		return x.Page
 	}
-	return nil
+	return 0
 }
 
-func (x *ListReservationsRequest) GetStatuses() []ReservationStatus {
+func (x *ListWebhookDeliveriesRequest) GetPerPage() uint32 {
 	if x != nil {
-		return x.Statuses
+		return x.PerPage
 	}
-	return nil
+	return 0
 }
 
-type ListReservationsResponse struct {
+type ListWebhookDeliveriesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Reservations  []*Reservation         `protobuf:"bytes,1,rep,name=reservations,proto3" json:"reservations,omitempty"`
+	Deliveries    []*WebhookDelivery     `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
 	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListReservationsResponse) Reset() {
-	*x = ListReservationsResponse{}
-	mi := &file_proto_inventory_proto_msgTypes[9]
+func (x *ListWebhookDeliveriesResponse) Reset() {
+	*x = ListWebhookDeliveriesResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListReservationsResponse) String() string {
+func (x *ListWebhookDeliveriesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListReservationsResponse) ProtoMessage() {}
+func (*ListWebhookDeliveriesResponse) ProtoMessage() {}
 
-func (x *ListReservationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_proto_msgTypes[9]
+func (x *ListWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -696,47 +4749,55 @@ func (x *ListReservationsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListReservationsResponse.ProtoReflect.Descriptor instead.
-func (*ListReservationsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ListWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *ListReservationsResponse) GetReservations() []*Reservation {
+func (x *ListWebhookDeliveriesResponse) GetDeliveries() []*WebhookDelivery {
 	if x != nil {
-		return x.Reservations
+		return x.Deliveries
 	}
 	return nil
 }
 
-func (x *ListReservationsResponse) GetTotal() int32 {
+func (x *ListWebhookDeliveriesResponse) GetTotal() int32 {
 	if x != nil {
 		return x.Total
 	}
 	return 0
 }
 
-type GetReservationRequest struct {
+// ListAuditEventsRequest filters by entity, actor and a time range; from is
+// inclusive and to exclusive. entity_ids require entity_type.
+type ListAuditEventsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Page          uint32                 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage       uint32                 `protobuf:"varint,2,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	EntityType    string                 `protobuf:"bytes,3,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	EntityIds     []uint32               `protobuf:"varint,4,rep,packed,name=entity_ids,json=entityIds,proto3" json:"entity_ids,omitempty"`
+	Actors        []string               `protobuf:"bytes,5,rep,name=actors,proto3" json:"actors,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=to,proto3" json:"to,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetReservationRequest) Reset() {
-	*x = GetReservationRequest{}
-	mi := &file_proto_inventory_proto_msgTypes[10]
+func (x *ListAuditEventsRequest) Reset() {
+	*x = ListAuditEventsRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetReservationRequest) String() string {
+func (x *ListAuditEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetReservationRequest) ProtoMessage() {}
+func (*ListAuditEventsRequest) ProtoMessage() {}
 
-func (x *GetReservationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_proto_msgTypes[10]
+func (x *ListAuditEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -747,101 +4808,83 @@ func (x *GetReservationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetReservationRequest.ProtoReflect.Descriptor instead.
-func (*GetReservationRequest) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ListAuditEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *GetReservationRequest) GetId() uint32 {
+func (x *ListAuditEventsRequest) GetPage() uint32 {
 	if x != nil {
-		return x.Id
+		return x.Page
 	}
 	return 0
 }
 
-type CreateReservationRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     uint32                 `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	OrderId       uint32                 `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *CreateReservationRequest) Reset() {
-	*x = CreateReservationRequest{}
-	mi := &file_proto_inventory_proto_msgTypes[11]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *CreateReservationRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ListAuditEventsRequest) GetPerPage() uint32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
 }
 
-func (*CreateReservationRequest) ProtoMessage() {}
-
-func (x *CreateReservationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_proto_msgTypes[11]
+func (x *ListAuditEventsRequest) GetEntityType() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.EntityType
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use CreateReservationRequest.ProtoReflect.Descriptor instead.
-func (*CreateReservationRequest) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_proto_rawDescGZIP(), []int{11}
+func (x *ListAuditEventsRequest) GetEntityIds() []uint32 {
+	if x != nil {
+		return x.EntityIds
+	}
+	return nil
 }
 
-func (x *CreateReservationRequest) GetProductId() uint32 {
+func (x *ListAuditEventsRequest) GetActors() []string {
 	if x != nil {
-		return x.ProductId
+		return x.Actors
 	}
-	return 0
+	return nil
 }
 
-func (x *CreateReservationRequest) GetOrderId() uint32 {
+func (x *ListAuditEventsRequest) GetFrom() *timestamppb.Timestamp {
 	if x != nil {
-		return x.OrderId
+		return x.From
 	}
-	return 0
+	return nil
 }
 
-func (x *CreateReservationRequest) GetQuantity() int32 {
+func (x *ListAuditEventsRequest) GetTo() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Quantity
+		return x.To
 	}
-	return 0
+	return nil
 }
 
-type UpdateReservationStatusRequest struct {
+type ListAuditEventsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Ids           []uint32               `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
-	Status        ReservationStatus      `protobuf:"varint,2,opt,name=status,proto3,enum=inventory.ReservationStatus" json:"status,omitempty"`
+	Events        []*AuditEvent          `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateReservationStatusRequest) Reset() {
-	*x = UpdateReservationStatusRequest{}
-	mi := &file_proto_inventory_proto_msgTypes[12]
+func (x *ListAuditEventsResponse) Reset() {
+	*x = ListAuditEventsResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateReservationStatusRequest) String() string {
+func (x *ListAuditEventsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateReservationStatusRequest) ProtoMessage() {}
+func (*ListAuditEventsResponse) ProtoMessage() {}
 
-func (x *UpdateReservationStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_proto_msgTypes[12]
+func (x *ListAuditEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -852,39 +4895,101 @@ func (x *UpdateReservationStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateReservationStatusRequest.ProtoReflect.Descriptor instead.
-func (*UpdateReservationStatusRequest) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ListAuditEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *UpdateReservationStatusRequest) GetIds() []uint32 {
+func (x *ListAuditEventsResponse) GetEvents() []*AuditEvent {
 	if x != nil {
-		return x.Ids
+		return x.Events
 	}
 	return nil
 }
 
-func (x *UpdateReservationStatusRequest) GetStatus() ReservationStatus {
+func (x *ListAuditEventsResponse) GetTotal() int32 {
 	if x != nil {
-		return x.Status
+		return x.Total
 	}
-	return ReservationStatus_RESERVATION_STATUS_UNSPECIFIED
+	return 0
 }
 
 var File_proto_inventory_proto protoreflect.FileDescriptor
 
 const file_proto_inventory_proto_rawDesc = "" +
 	"\n" +
-	"\x15proto/inventory.proto\x12\tinventory\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xcf\x01\n" +
+	"\x15proto/inventory.proto\x12\tinventory\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb7\x06\n" +
 	"\aProduct\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
-	"\x05stock\x18\x03 \x01(\x05R\x05stock\x12\x14\n" +
-	"\x05price\x18\x04 \x01(\x01R\x05price\x129\n" +
+	"\x05stock\x18\x03 \x01(\x05R\x05stock\x129\n" +
 	"\n" +
 	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xe4\x01\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x10\n" +
+	"\x03sku\x18\a \x01(\tR\x03sku\x12\x18\n" +
+	"\abarcode\x18\b \x01(\tR\abarcode\x12\x1f\n" +
+	"\vcategory_id\x18\t \x01(\rR\n" +
+	"categoryId\x12\x1b\n" +
+	"\tparent_id\x18\n" +
+	" \x01(\rR\bparentId\x129\n" +
+	"\aoptions\x18\v \x03(\v2\x1f.inventory.Product.OptionsEntryR\aoptions\x12.\n" +
+	"\bvariants\x18\f \x03(\v2\x12.inventory.ProductR\bvariants\x12'\n" +
+	"\x0favailable_stock\x18\r \x01(\x05R\x0eavailableStock\x127\n" +
+	"\n" +
+	"components\x18\x0e \x03(\v2\x17.inventory.KitComponentR\n" +
+	"components\x12\x1d\n" +
+	"\n" +
+	"track_lots\x18\x0f \x01(\bR\ttrackLots\x12#\n" +
+	"\rtrack_serials\x18\x10 \x01(\bR\ftrackSerials\x12,\n" +
+	"\x05units\x18\x11 \x03(\v2\x16.inventory.ProductUnitR\x05units\x12&\n" +
+	"\x05price\x18\x12 \x01(\v2\x10.inventory.MoneyR\x05price\x120\n" +
+	"\x06status\x18\x13 \x01(\x0e2\x18.inventory.ProductStatusR\x06status\x127\n" +
+	"\n" +
+	"attributes\x18\x14 \x01(\v2\x17.google.protobuf.StructR\n" +
+	"attributes\x1a:\n" +
+	"\fOptionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01J\x04\b\x04\x10\x05\"\xa9\x01\n" +
+	"\x0fAttributeFilter\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12.\n" +
+	"\x06equals\x18\x02 \x01(\v2\x16.google.protobuf.ValueR\x06equals\x12\x15\n" +
+	"\x03min\x18\x03 \x01(\x01H\x00R\x03min\x88\x01\x01\x12\x15\n" +
+	"\x03max\x18\x04 \x01(\x01H\x01R\x03max\x88\x01\x01\x12\x16\n" +
+	"\x06exists\x18\x05 \x01(\bR\x06existsB\x06\n" +
+	"\x04_minB\x06\n" +
+	"\x04_max\"X\n" +
+	"\x05Money\x12#\n" +
+	"\rcurrency_code\x18\x01 \x01(\tR\fcurrencyCode\x12\x14\n" +
+	"\x05units\x18\x02 \x01(\x03R\x05units\x12\x14\n" +
+	"\x05nanos\x18\x03 \x01(\x05R\x05nanos\"9\n" +
+	"\vProductUnit\x12\x12\n" +
+	"\x04unit\x18\x01 \x01(\tR\x04unit\x12\x16\n" +
+	"\x06factor\x18\x02 \x01(\x05R\x06factor\"\xb3\x01\n" +
+	"\x0fStockAdjustment\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\rR\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12\x12\n" +
+	"\x04unit\x18\x03 \x01(\tR\x04unit\x12#\n" +
+	"\rbase_quantity\x18\x04 \x01(\x05R\fbaseQuantity\x12,\n" +
+	"\aproduct\x18\x05 \x01(\v2\x12.inventory.ProductR\aproduct\"\x7f\n" +
+	"\fKitComponent\x12!\n" +
+	"\fcomponent_id\x18\x01 \x01(\rR\vcomponentId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x120\n" +
+	"\tcomponent\x18\x03 \x01(\v2\x12.inventory.ProductR\tcomponent\"\xda\x02\n" +
+	"\bCategory\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\rR\bparentId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12#\n" +
+	"\rproduct_count\x18\x05 \x01(\x05R\fproductCount\x12\x1f\n" +
+	"\vtotal_stock\x18\x06 \x01(\x03R\n" +
+	"totalStock\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12/\n" +
+	"\bchildren\x18\t \x03(\v2\x13.inventory.CategoryR\bchildren\"\xcd\x03\n" +
 	"\vReservation\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1d\n" +
 	"\n" +
@@ -893,29 +4998,299 @@ const file_proto_inventory_proto_rawDesc = "" +
 	"\bquantity\x18\x04 \x01(\x05R\bquantity\x124\n" +
 	"\x06status\x18\x05 \x01(\x0e2\x1c.inventory.ReservationStatusR\x06status\x129\n" +
 	"\n" +
-	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x84\x01\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1b\n" +
+	"\tparent_id\x18\a \x01(\rR\bparentId\x126\n" +
+	"\n" +
+	"components\x18\b \x03(\v2\x16.inventory.ReservationR\n" +
+	"components\x12,\n" +
+	"\x04lots\x18\t \x03(\v2\x18.inventory.LotAllocationR\x04lots\x12+\n" +
+	"\aserials\x18\n" +
+	" \x03(\v2\x11.inventory.SerialR\aserials\x12\x12\n" +
+	"\x04unit\x18\v \x01(\tR\x04unit\x12#\n" +
+	"\runit_quantity\x18\f \x01(\x05R\funitQuantity\"\xa0\x02\n" +
+	"\x03Lot\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\rR\tproductId\x12\x1d\n" +
+	"\n" +
+	"lot_number\x18\x03 \x01(\tR\tlotNumber\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x05R\bquantity\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x9c\x01\n" +
+	"\rLotAllocation\x12\x15\n" +
+	"\x06lot_id\x18\x01 \x01(\rR\x05lotId\x12\x1d\n" +
+	"\n" +
+	"lot_number\x18\x02 \x01(\tR\tlotNumber\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\"\x9d\x02\n" +
+	"\vPriceChange\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\rR\tproductId\x12&\n" +
+	"\x05price\x18\x03 \x01(\v2\x10.inventory.MoneyR\x05price\x12A\n" +
+	"\x0eeffective_from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\reffectiveFrom\x129\n" +
+	"\n" +
+	"applied_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tappliedAt\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xf3\x02\n" +
+	"\x06Serial\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\rR\tproductId\x12#\n" +
+	"\rserial_number\x18\x03 \x01(\tR\fserialNumber\x12/\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x17.inventory.SerialStatusR\x06status\x12%\n" +
+	"\x0ereservation_id\x18\x05 \x01(\rR\rreservationId\x12\x19\n" +
+	"\border_id\x18\x06 \x01(\rR\aorderId\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12,\n" +
+	"\aproduct\x18\t \x01(\v2\x12.inventory.ProductR\aproduct\"\xd4\x02\n" +
+	"\aWebhook\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x1f\n" +
+	"\vevent_types\x18\x03 \x03(\tR\n" +
+	"eventTypes\x12\x16\n" +
+	"\x06secret\x18\x04 \x01(\tR\x06secret\x12\x16\n" +
+	"\x06active\x18\x05 \x01(\bR\x06active\x12#\n" +
+	"\rfailure_count\x18\x06 \x01(\x05R\ffailureCount\x12;\n" +
+	"\vdisabled_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"disabledAt\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xfb\x03\n" +
+	"\x0fWebhookDelivery\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x02 \x01(\rR\twebhookId\x12\x19\n" +
+	"\bevent_id\x18\x03 \x01(\x04R\aeventId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x04 \x01(\tR\teventType\x128\n" +
+	"\x06status\x18\x05 \x01(\x0e2 .inventory.WebhookDeliveryStatusR\x06status\x12\x1a\n" +
+	"\battempts\x18\x06 \x01(\x05R\battempts\x12B\n" +
+	"\x0fnext_attempt_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\rnextAttemptAt\x12'\n" +
+	"\x0fresponse_status\x18\b \x01(\x05R\x0eresponseStatus\x12#\n" +
+	"\rresponse_body\x18\t \x01(\tR\fresponseBody\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\n" +
+	" \x01(\tR\tlastError\x12=\n" +
+	"\fdelivered_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\vdeliveredAt\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xda\x02\n" +
+	"\n" +
+	"AuditEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1f\n" +
+	"\ventity_type\x18\x02 \x01(\tR\n" +
+	"entityType\x12\x1b\n" +
+	"\tentity_id\x18\x03 \x01(\rR\bentityId\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12\x14\n" +
+	"\x05actor\x18\x05 \x01(\tR\x05actor\x12\x16\n" +
+	"\x06source\x18\x06 \x01(\tR\x06source\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\a \x01(\tR\trequestId\x12/\n" +
+	"\x06before\x18\b \x01(\v2\x17.google.protobuf.StructR\x06before\x12-\n" +
+	"\x05after\x18\t \x01(\v2\x17.google.protobuf.StructR\x05after\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xa4\x03\n" +
 	"\x13ListProductsRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\rR\x04page\x12\x19\n" +
 	"\bper_page\x18\x02 \x01(\rR\aperPage\x12\x16\n" +
 	"\x06search\x18\x03 \x01(\tR\x06search\x12\x10\n" +
 	"\x03ids\x18\x04 \x03(\rR\x03ids\x12\x14\n" +
-	"\x05names\x18\x05 \x03(\tR\x05names\"\\\n" +
+	"\x05names\x18\x05 \x03(\tR\x05names\x12\x12\n" +
+	"\x04skus\x18\x06 \x03(\tR\x04skus\x12\x1f\n" +
+	"\vcategory_id\x18\a \x01(\rR\n" +
+	"categoryId\x12/\n" +
+	"\x13include_descendants\x18\b \x01(\bR\x12includeDescendants\x12\x1b\n" +
+	"\tparent_id\x18\t \x01(\rR\bparentId\x12)\n" +
+	"\x10exclude_variants\x18\n" +
+	" \x01(\bR\x0fexcludeVariants\x124\n" +
+	"\bstatuses\x18\v \x03(\x0e2\x18.inventory.ProductStatusR\bstatuses\x12:\n" +
+	"\n" +
+	"attributes\x18\f \x03(\v2\x1a.inventory.AttributeFilterR\n" +
+	"attributes\"\\\n" +
 	"\x14ListProductsResponse\x12.\n" +
 	"\bproducts\x18\x01 \x03(\v2\x12.inventory.ProductR\bproducts\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\"#\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"Z\n" +
 	"\x11GetProductRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\rR\x02id\"V\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x125\n" +
+	"\bprice_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\apriceAt\"*\n" +
+	"\x16GetProductBySKURequest\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\"6\n" +
+	"\x1aGetProductByBarcodeRequest\x12\x18\n" +
+	"\abarcode\x18\x01 \x01(\tR\abarcode\"\xf2\x04\n" +
 	"\x14CreateProductRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
-	"\x05stock\x18\x02 \x01(\x05R\x05stock\x12\x14\n" +
-	"\x05price\x18\x03 \x01(\x01R\x05price\"f\n" +
+	"\x05stock\x18\x02 \x01(\x05R\x05stock\x12\x10\n" +
+	"\x03sku\x18\x04 \x01(\tR\x03sku\x12\x18\n" +
+	"\abarcode\x18\x05 \x01(\tR\abarcode\x12\x1f\n" +
+	"\vcategory_id\x18\x06 \x01(\rR\n" +
+	"categoryId\x12\x1b\n" +
+	"\tparent_id\x18\a \x01(\rR\bparentId\x12F\n" +
+	"\aoptions\x18\b \x03(\v2,.inventory.CreateProductRequest.OptionsEntryR\aoptions\x127\n" +
+	"\n" +
+	"components\x18\t \x03(\v2\x17.inventory.KitComponentR\n" +
+	"components\x12\x1d\n" +
+	"\n" +
+	"track_lots\x18\n" +
+	" \x01(\bR\ttrackLots\x12#\n" +
+	"\rtrack_serials\x18\v \x01(\bR\ftrackSerials\x12,\n" +
+	"\x05units\x18\f \x03(\v2\x16.inventory.ProductUnitR\x05units\x12&\n" +
+	"\x05price\x18\r \x01(\v2\x10.inventory.MoneyR\x05price\x120\n" +
+	"\x06status\x18\x0e \x01(\x0e2\x18.inventory.ProductStatusR\x06status\x127\n" +
+	"\n" +
+	"attributes\x18\x0f \x01(\v2\x17.google.protobuf.StructR\n" +
+	"attributes\x1a:\n" +
+	"\fOptionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01J\x04\b\x03\x10\x04\"\x8d\x05\n" +
 	"\x14UpdateProductRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
-	"\x05stock\x18\x03 \x01(\x05R\x05stock\x12\x14\n" +
-	"\x05price\x18\x04 \x01(\x01R\x05price\"&\n" +
+	"\x05stock\x18\x03 \x01(\x05R\x05stock\x12\x10\n" +
+	"\x03sku\x18\x05 \x01(\tR\x03sku\x12\x18\n" +
+	"\abarcode\x18\x06 \x01(\tR\abarcode\x12\x1f\n" +
+	"\vcategory_id\x18\a \x01(\rR\n" +
+	"categoryId\x12\x1b\n" +
+	"\tparent_id\x18\b \x01(\rR\bparentId\x12F\n" +
+	"\aoptions\x18\t \x03(\v2,.inventory.UpdateProductRequest.OptionsEntryR\aoptions\x127\n" +
+	"\n" +
+	"components\x18\n" +
+	" \x03(\v2\x17.inventory.KitComponentR\n" +
+	"components\x12\x1d\n" +
+	"\n" +
+	"track_lots\x18\v \x01(\bR\ttrackLots\x12#\n" +
+	"\rtrack_serials\x18\f \x01(\bR\ftrackSerials\x12,\n" +
+	"\x05units\x18\r \x03(\v2\x16.inventory.ProductUnitR\x05units\x12&\n" +
+	"\x05price\x18\x0e \x01(\v2\x10.inventory.MoneyR\x05price\x127\n" +
+	"\n" +
+	"attributes\x18\x0f \x01(\v2\x17.google.protobuf.StructR\n" +
+	"attributes\x12;\n" +
+	"\vupdate_mask\x18\x10 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x1a:\n" +
+	"\fOptionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01J\x04\b\x04\x10\x05\"q\n" +
+	"\x1aBatchCreateProductsRequest\x12;\n" +
+	"\bproducts\x18\x01 \x03(\v2\x1f.inventory.CreateProductRequestR\bproducts\x12\x16\n" +
+	"\x06atomic\x18\x02 \x01(\bR\x06atomic\"q\n" +
+	"\x1aBatchUpdateProductsRequest\x12;\n" +
+	"\bproducts\x18\x01 \x03(\v2\x1f.inventory.UpdateProductRequestR\bproducts\x12\x16\n" +
+	"\x06atomic\x18\x02 \x01(\bR\x06atomic\"\xce\x02\n" +
+	"\x0fBatchItemResult\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x122\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1a.inventory.BatchItemStatusR\x06status\x12,\n" +
+	"\aproduct\x18\x03 \x01(\v2\x12.inventory.ProductR\aproduct\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x12\x1d\n" +
+	"\n" +
+	"error_code\x18\x05 \x01(\tR\terrorCode\x12N\n" +
+	"\ffield_errors\x18\x06 \x03(\v2+.inventory.BatchItemResult.FieldErrorsEntryR\vfieldErrors\x1a>\n" +
+	"\x10FieldErrorsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x83\x01\n" +
+	"\x15BatchProductsResponse\x124\n" +
+	"\aresults\x18\x01 \x03(\v2\x1a.inventory.BatchItemResultR\aresults\x12\x1c\n" +
+	"\tsucceeded\x18\x02 \x01(\x05R\tsucceeded\x12\x16\n" +
+	"\x06failed\x18\x03 \x01(\x05R\x06failed\"^\n" +
+	"\x1aUpdateProductStatusRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x120\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x18.inventory.ProductStatusR\x06status\"c\n" +
+	"\x12AdjustStockRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\rR\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12\x12\n" +
+	"\x04unit\x18\x03 \x01(\tR\x04unit\"&\n" +
 	"\x14DeleteProductRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\rR\x02id\"\xc0\x01\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"\x9a\x01\n" +
+	"\x15ListCategoriesRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\rR\x04page\x12\x19\n" +
+	"\bper_page\x18\x02 \x01(\rR\aperPage\x12\x16\n" +
+	"\x06search\x18\x03 \x01(\tR\x06search\x12\x1b\n" +
+	"\tparent_id\x18\x04 \x01(\rR\bparentId\x12\x1d\n" +
+	"\n" +
+	"roots_only\x18\x05 \x01(\bR\trootsOnly\"c\n" +
+	"\x16ListCategoriesResponse\x123\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x13.inventory.CategoryR\n" +
+	"categories\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"$\n" +
+	"\x12GetCategoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"j\n" +
+	"\x15CreateCategoryRequest\x12\x1b\n" +
+	"\tparent_id\x18\x01 \x01(\rR\bparentId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\"z\n" +
+	"\x15UpdateCategoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\rR\bparentId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\"'\n" +
+	"\x15DeleteCategoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"\xa7\x01\n" +
+	"\x10CreateLotRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\rR\tproductId\x12\x1d\n" +
+	"\n" +
+	"lot_number\x18\x02 \x01(\tR\tlotNumber\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\"\x8a\x01\n" +
+	"\x0fListLotsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\rR\x04page\x12\x19\n" +
+	"\bper_page\x18\x02 \x01(\rR\aperPage\x12\x1f\n" +
+	"\vproduct_ids\x18\x03 \x03(\rR\n" +
+	"productIds\x12'\n" +
+	"\x0fexclude_expired\x18\x04 \x01(\bR\x0eexcludeExpired\"\x8a\x01\n" +
+	"\x17ListExpiringLotsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\rR\x04page\x12\x19\n" +
+	"\bper_page\x18\x02 \x01(\rR\aperPage\x12\x1f\n" +
+	"\vproduct_ids\x18\x03 \x03(\rR\n" +
+	"productIds\x12\x1f\n" +
+	"\vwithin_days\x18\x04 \x01(\rR\n" +
+	"withinDays\"L\n" +
+	"\x10ListLotsResponse\x12\"\n" +
+	"\x04lots\x18\x01 \x03(\v2\x0e.inventory.LotR\x04lots\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"^\n" +
+	"\x16RegisterSerialsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\rR\tproductId\x12%\n" +
+	"\x0eserial_numbers\x18\x02 \x03(\tR\rserialNumbers\"\x99\x01\n" +
+	"\x12ListSerialsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\rR\x04page\x12\x19\n" +
+	"\bper_page\x18\x02 \x01(\rR\aperPage\x12\x1f\n" +
+	"\vproduct_ids\x18\x03 \x03(\rR\n" +
+	"productIds\x123\n" +
+	"\bstatuses\x18\x04 \x03(\x0e2\x17.inventory.SerialStatusR\bstatuses\"X\n" +
+	"\x13ListSerialsResponse\x12+\n" +
+	"\aserials\x18\x01 \x03(\v2\x11.inventory.SerialR\aserials\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"7\n" +
+	"\x10GetSerialRequest\x12#\n" +
+	"\rserial_number\x18\x01 \x01(\tR\fserialNumber\"\xa6\x01\n" +
+	"\x1aSchedulePriceChangeRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\rR\tproductId\x12&\n" +
+	"\x05price\x18\x02 \x01(\v2\x10.inventory.MoneyR\x05price\x12A\n" +
+	"\x0eeffective_from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\reffectiveFrom\"I\n" +
+	"\x18CancelPriceChangeRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\rR\tproductId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\rR\x02id\"\x8a\x01\n" +
+	"\x17ListPriceHistoryRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\rR\x04page\x12\x19\n" +
+	"\bper_page\x18\x02 \x01(\rR\aperPage\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x03 \x01(\rR\tproductId\x12!\n" +
+	"\fpending_only\x18\x04 \x01(\bR\vpendingOnly\"m\n" +
+	"\x18ListPriceHistoryResponse\x12;\n" +
+	"\rprice_changes\x18\x01 \x03(\v2\x16.inventory.PriceChangeR\fpriceChanges\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\xc0\x01\n" +
 	"\x17ListReservationsRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\rR\x04page\x12\x19\n" +
 	"\bper_page\x18\x02 \x01(\rR\aperPage\x12\x1f\n" +
@@ -927,31 +5302,147 @@ const file_proto_inventory_proto_rawDesc = "" +
 	"\freservations\x18\x01 \x03(\v2\x16.inventory.ReservationR\freservations\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\"'\n" +
 	"\x15GetReservationRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\rR\x02id\"p\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"\x84\x01\n" +
 	"\x18CreateReservationRequest\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\rR\tproductId\x12\x19\n" +
 	"\border_id\x18\x02 \x01(\rR\aorderId\x12\x1a\n" +
-	"\bquantity\x18\x03 \x01(\x05R\bquantity\"h\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x12\n" +
+	"\x04unit\x18\x04 \x01(\tR\x04unit\"h\n" +
 	"\x1eUpdateReservationStatusRequest\x12\x10\n" +
 	"\x03ids\x18\x01 \x03(\rR\x03ids\x124\n" +
-	"\x06status\x18\x02 \x01(\x0e2\x1c.inventory.ReservationStatusR\x06status*\x9b\x01\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1c.inventory.ReservationStatusR\x06status\"[\n" +
+	"\x11WatchStockRequest\x12\x1f\n" +
+	"\vproduct_ids\x18\x01 \x03(\rR\n" +
+	"productIds\x12%\n" +
+	"\x0eafter_sequence\x18\x02 \x01(\x04R\rafterSequence\"\xf9\x01\n" +
+	"\n" +
+	"StockEvent\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x04R\bsequence\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\rR\tproductId\x12\x14\n" +
+	"\x05stock\x18\x03 \x01(\x05R\x05stock\x12\x1a\n" +
+	"\breserved\x18\x04 \x01(\x05R\breserved\x12'\n" +
+	"\x0favailable_stock\x18\x05 \x01(\x05R\x0eavailableStock\x129\n" +
+	"\n" +
+	"changed_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tchangedAt\x12\x1a\n" +
+	"\bsnapshot\x18\a \x01(\bR\bsnapshot\"e\n" +
+	"\x13ListWebhooksRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\rR\x04page\x12\x19\n" +
+	"\bper_page\x18\x02 \x01(\rR\aperPage\x12\x1f\n" +
+	"\vactive_only\x18\x03 \x01(\bR\n" +
+	"activeOnly\"\\\n" +
+	"\x14ListWebhooksResponse\x12.\n" +
+	"\bwebhooks\x18\x01 \x03(\v2\x12.inventory.WebhookR\bwebhooks\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"#\n" +
+	"\x11GetWebhookRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"a\n" +
+	"\x14CreateWebhookRequest\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x1f\n" +
+	"\vevent_types\x18\x02 \x03(\tR\n" +
+	"eventTypes\x12\x16\n" +
+	"\x06secret\x18\x03 \x01(\tR\x06secret\"\x89\x01\n" +
+	"\x14UpdateWebhookRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x1f\n" +
+	"\vevent_types\x18\x03 \x03(\tR\n" +
+	"eventTypes\x12\x16\n" +
+	"\x06secret\x18\x04 \x01(\tR\x06secret\x12\x16\n" +
+	"\x06active\x18\x05 \x01(\bR\x06active\"&\n" +
+	"\x14DeleteWebhookRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"\xaa\x01\n" +
+	"\x1cListWebhookDeliveriesRequest\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x01 \x01(\rR\twebhookId\x12<\n" +
+	"\bstatuses\x18\x02 \x03(\x0e2 .inventory.WebhookDeliveryStatusR\bstatuses\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\rR\x04page\x12\x19\n" +
+	"\bper_page\x18\x04 \x01(\rR\aperPage\"q\n" +
+	"\x1dListWebhookDeliveriesResponse\x12:\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2\x1a.inventory.WebhookDeliveryR\n" +
+	"deliveries\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\xfb\x01\n" +
+	"\x16ListAuditEventsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\rR\x04page\x12\x19\n" +
+	"\bper_page\x18\x02 \x01(\rR\aperPage\x12\x1f\n" +
+	"\ventity_type\x18\x03 \x01(\tR\n" +
+	"entityType\x12\x1d\n" +
+	"\n" +
+	"entity_ids\x18\x04 \x03(\rR\tentityIds\x12\x16\n" +
+	"\x06actors\x18\x05 \x03(\tR\x06actors\x12.\n" +
+	"\x04from\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"^\n" +
+	"\x17ListAuditEventsResponse\x12-\n" +
+	"\x06events\x18\x01 \x03(\v2\x15.inventory.AuditEventR\x06events\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total*\x9b\x01\n" +
 	"\x11ReservationStatus\x12\"\n" +
 	"\x1eRESERVATION_STATUS_UNSPECIFIED\x10\x00\x12\x1e\n" +
 	"\x1aRESERVATION_STATUS_PENDING\x10\x01\x12 \n" +
 	"\x1cRESERVATION_STATUS_CONFIRMED\x10\x02\x12 \n" +
-	"\x1cRESERVATION_STATUS_CANCELLED\x10\x032\xd2\x05\n" +
+	"\x1cRESERVATION_STATUS_CANCELLED\x10\x03*\x85\x01\n" +
+	"\rProductStatus\x12\x1e\n" +
+	"\x1aPRODUCT_STATUS_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14PRODUCT_STATUS_DRAFT\x10\x01\x12\x19\n" +
+	"\x15PRODUCT_STATUS_ACTIVE\x10\x02\x12\x1f\n" +
+	"\x1bPRODUCT_STATUS_DISCONTINUED\x10\x03*\x92\x01\n" +
+	"\x0fBatchItemStatus\x12!\n" +
+	"\x1dBATCH_ITEM_STATUS_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bBATCH_ITEM_STATUS_SUCCEEDED\x10\x01\x12\x1c\n" +
+	"\x18BATCH_ITEM_STATUS_FAILED\x10\x02\x12\x1d\n" +
+	"\x19BATCH_ITEM_STATUS_ABORTED\x10\x03*\x82\x01\n" +
+	"\fSerialStatus\x12\x1d\n" +
+	"\x19SERIAL_STATUS_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17SERIAL_STATUS_AVAILABLE\x10\x01\x12\x1a\n" +
+	"\x16SERIAL_STATUS_RESERVED\x10\x02\x12\x1a\n" +
+	"\x16SERIAL_STATUS_ASSIGNED\x10\x03*\xae\x01\n" +
+	"\x15WebhookDeliveryStatus\x12'\n" +
+	"#WEBHOOK_DELIVERY_STATUS_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fWEBHOOK_DELIVERY_STATUS_PENDING\x10\x01\x12%\n" +
+	"!WEBHOOK_DELIVERY_STATUS_SUCCEEDED\x10\x02\x12 \n" +
+	"\x1cWEBHOOK_DELIVERY_STATUS_DEAD\x10\x032\x8a\x18\n" +
 	"\x10InventoryService\x12O\n" +
 	"\fListProducts\x12\x1e.inventory.ListProductsRequest\x1a\x1f.inventory.ListProductsResponse\x12>\n" +
 	"\n" +
-	"GetProduct\x12\x1c.inventory.GetProductRequest\x1a\x12.inventory.Product\x12D\n" +
+	"GetProduct\x12\x1c.inventory.GetProductRequest\x1a\x12.inventory.Product\x12H\n" +
+	"\x0fGetProductBySKU\x12!.inventory.GetProductBySKURequest\x1a\x12.inventory.Product\x12P\n" +
+	"\x13GetProductByBarcode\x12%.inventory.GetProductByBarcodeRequest\x1a\x12.inventory.Product\x12D\n" +
 	"\rCreateProduct\x12\x1f.inventory.CreateProductRequest\x1a\x12.inventory.Product\x12D\n" +
 	"\rUpdateProduct\x12\x1f.inventory.UpdateProductRequest\x1a\x12.inventory.Product\x12H\n" +
-	"\rDeleteProduct\x12\x1f.inventory.DeleteProductRequest\x1a\x16.google.protobuf.Empty\x12[\n" +
+	"\rDeleteProduct\x12\x1f.inventory.DeleteProductRequest\x1a\x16.google.protobuf.Empty\x12H\n" +
+	"\vAdjustStock\x12\x1d.inventory.AdjustStockRequest\x1a\x1a.inventory.StockAdjustment\x12P\n" +
+	"\x13UpdateProductStatus\x12%.inventory.UpdateProductStatusRequest\x1a\x12.inventory.Product\x12^\n" +
+	"\x13BatchCreateProducts\x12%.inventory.BatchCreateProductsRequest\x1a .inventory.BatchProductsResponse\x12^\n" +
+	"\x13BatchUpdateProducts\x12%.inventory.BatchUpdateProductsRequest\x1a .inventory.BatchProductsResponse\x12F\n" +
+	"\x0eExportProducts\x12\x1e.inventory.ListProductsRequest\x1a\x12.inventory.Product0\x01\x12C\n" +
+	"\n" +
+	"WatchStock\x12\x1c.inventory.WatchStockRequest\x1a\x15.inventory.StockEvent0\x01\x12U\n" +
+	"\x0eListCategories\x12 .inventory.ListCategoriesRequest\x1a!.inventory.ListCategoriesResponse\x12A\n" +
+	"\vGetCategory\x12\x1d.inventory.GetCategoryRequest\x1a\x13.inventory.Category\x12G\n" +
+	"\x0eCreateCategory\x12 .inventory.CreateCategoryRequest\x1a\x13.inventory.Category\x12G\n" +
+	"\x0eUpdateCategory\x12 .inventory.UpdateCategoryRequest\x1a\x13.inventory.Category\x12J\n" +
+	"\x0eDeleteCategory\x12 .inventory.DeleteCategoryRequest\x1a\x16.google.protobuf.Empty\x128\n" +
+	"\tCreateLot\x12\x1b.inventory.CreateLotRequest\x1a\x0e.inventory.Lot\x12C\n" +
+	"\bListLots\x12\x1a.inventory.ListLotsRequest\x1a\x1b.inventory.ListLotsResponse\x12S\n" +
+	"\x10ListExpiringLots\x12\".inventory.ListExpiringLotsRequest\x1a\x1b.inventory.ListLotsResponse\x12T\n" +
+	"\x0fRegisterSerials\x12!.inventory.RegisterSerialsRequest\x1a\x1e.inventory.ListSerialsResponse\x12L\n" +
+	"\vListSerials\x12\x1d.inventory.ListSerialsRequest\x1a\x1e.inventory.ListSerialsResponse\x12;\n" +
+	"\tGetSerial\x12\x1b.inventory.GetSerialRequest\x1a\x11.inventory.Serial\x12T\n" +
+	"\x13SchedulePriceChange\x12%.inventory.SchedulePriceChangeRequest\x1a\x16.inventory.PriceChange\x12P\n" +
+	"\x11CancelPriceChange\x12#.inventory.CancelPriceChangeRequest\x1a\x16.google.protobuf.Empty\x12[\n" +
+	"\x10ListPriceHistory\x12\".inventory.ListPriceHistoryRequest\x1a#.inventory.ListPriceHistoryResponse\x12[\n" +
 	"\x10ListReservations\x12\".inventory.ListReservationsRequest\x1a#.inventory.ListReservationsResponse\x12J\n" +
 	"\x0eGetReservation\x12 .inventory.GetReservationRequest\x1a\x16.inventory.Reservation\x12P\n" +
 	"\x11CreateReservation\x12#.inventory.CreateReservationRequest\x1a\x16.inventory.Reservation\x12\\\n" +
-	"\x17UpdateReservationStatus\x12).inventory.UpdateReservationStatusRequest\x1a\x16.google.protobuf.EmptyB\rZ\vproto/pb;pbb\x06proto3"
+	"\x17UpdateReservationStatus\x12).inventory.UpdateReservationStatusRequest\x1a\x16.google.protobuf.Empty\x12R\n" +
+	"\x12ExportReservations\x12\".inventory.ListReservationsRequest\x1a\x16.inventory.Reservation0\x01\x12O\n" +
+	"\fListWebhooks\x12\x1e.inventory.ListWebhooksRequest\x1a\x1f.inventory.ListWebhooksResponse\x12>\n" +
+	"\n" +
+	"GetWebhook\x12\x1c.inventory.GetWebhookRequest\x1a\x12.inventory.Webhook\x12D\n" +
+	"\rCreateWebhook\x12\x1f.inventory.CreateWebhookRequest\x1a\x12.inventory.Webhook\x12D\n" +
+	"\rUpdateWebhook\x12\x1f.inventory.UpdateWebhookRequest\x1a\x12.inventory.Webhook\x12H\n" +
+	"\rDeleteWebhook\x12\x1f.inventory.DeleteWebhookRequest\x1a\x16.google.protobuf.Empty\x12j\n" +
+	"\x15ListWebhookDeliveries\x12'.inventory.ListWebhookDeliveriesRequest\x1a(.inventory.ListWebhookDeliveriesResponse\x12X\n" +
+	"\x0fListAuditEvents\x12!.inventory.ListAuditEventsRequest\x1a\".inventory.ListAuditEventsResponseB\rZ\vproto/pb;pbb\x06proto3"
 
 var (
 	file_proto_inventory_proto_rawDescOnce sync.Once
@@ -965,58 +5456,255 @@ func file_proto_inventory_proto_rawDescGZIP() []byte {
 	return file_proto_inventory_proto_rawDescData
 }
 
-var file_proto_inventory_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_proto_inventory_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_proto_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 68)
 var file_proto_inventory_proto_goTypes = []any{
 	(ReservationStatus)(0),                 // 0: inventory.ReservationStatus
-	(*Product)(nil),                        // 1: inventory.Product
-	(*Reservation)(nil),                    // 2: inventory.Reservation
-	(*ListProductsRequest)(nil),            // 3: inventory.ListProductsRequest
-	(*ListProductsResponse)(nil),           // 4: inventory.ListProductsResponse
-	(*GetProductRequest)(nil),              // 5: inventory.GetProductRequest
-	(*CreateProductRequest)(nil),           // 6: inventory.CreateProductRequest
-	(*UpdateProductRequest)(nil),           // 7: inventory.UpdateProductRequest
-	(*DeleteProductRequest)(nil),           // 8: inventory.DeleteProductRequest
-	(*ListReservationsRequest)(nil),        // 9: inventory.ListReservationsRequest
-	(*ListReservationsResponse)(nil),       // 10: inventory.ListReservationsResponse
-	(*GetReservationRequest)(nil),          // 11: inventory.GetReservationRequest
-	(*CreateReservationRequest)(nil),       // 12: inventory.CreateReservationRequest
-	(*UpdateReservationStatusRequest)(nil), // 13: inventory.UpdateReservationStatusRequest
-	(*timestamppb.Timestamp)(nil),          // 14: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),                  // 15: google.protobuf.Empty
+	(ProductStatus)(0),                     // 1: inventory.ProductStatus
+	(BatchItemStatus)(0),                   // 2: inventory.BatchItemStatus
+	(SerialStatus)(0),                      // 3: inventory.SerialStatus
+	(WebhookDeliveryStatus)(0),             // 4: inventory.WebhookDeliveryStatus
+	(*Product)(nil),                        // 5: inventory.Product
+	(*AttributeFilter)(nil),                // 6: inventory.AttributeFilter
+	(*Money)(nil),                          // 7: inventory.Money
+	(*ProductUnit)(nil),                    // 8: inventory.ProductUnit
+	(*StockAdjustment)(nil),                // 9: inventory.StockAdjustment
+	(*KitComponent)(nil),                   // 10: inventory.KitComponent
+	(*Category)(nil),                       // 11: inventory.Category
+	(*Reservation)(nil),                    // 12: inventory.Reservation
+	(*Lot)(nil),                            // 13: inventory.Lot
+	(*LotAllocation)(nil),                  // 14: inventory.LotAllocation
+	(*PriceChange)(nil),                    // 15: inventory.PriceChange
+	(*Serial)(nil),                         // 16: inventory.Serial
+	(*Webhook)(nil),                        // 17: inventory.Webhook
+	(*WebhookDelivery)(nil),                // 18: inventory.WebhookDelivery
+	(*AuditEvent)(nil),                     // 19: inventory.AuditEvent
+	(*ListProductsRequest)(nil),            // 20: inventory.ListProductsRequest
+	(*ListProductsResponse)(nil),           // 21: inventory.ListProductsResponse
+	(*GetProductRequest)(nil),              // 22: inventory.GetProductRequest
+	(*GetProductBySKURequest)(nil),         // 23: inventory.GetProductBySKURequest
+	(*GetProductByBarcodeRequest)(nil),     // 24: inventory.GetProductByBarcodeRequest
+	(*CreateProductRequest)(nil),           // 25: inventory.CreateProductRequest
+	(*UpdateProductRequest)(nil),           // 26: inventory.UpdateProductRequest
+	(*BatchCreateProductsRequest)(nil),     // 27: inventory.BatchCreateProductsRequest
+	(*BatchUpdateProductsRequest)(nil),     // 28: inventory.BatchUpdateProductsRequest
+	(*BatchItemResult)(nil),                // 29: inventory.BatchItemResult
+	(*BatchProductsResponse)(nil),          // 30: inventory.BatchProductsResponse
+	(*UpdateProductStatusRequest)(nil),     // 31: inventory.UpdateProductStatusRequest
+	(*AdjustStockRequest)(nil),             // 32: inventory.AdjustStockRequest
+	(*DeleteProductRequest)(nil),           // 33: inventory.DeleteProductRequest
+	(*ListCategoriesRequest)(nil),          // 34: inventory.ListCategoriesRequest
+	(*ListCategoriesResponse)(nil),         // 35: inventory.ListCategoriesResponse
+	(*GetCategoryRequest)(nil),             // 36: inventory.GetCategoryRequest
+	(*CreateCategoryRequest)(nil),          // 37: inventory.CreateCategoryRequest
+	(*UpdateCategoryRequest)(nil),          // 38: inventory.UpdateCategoryRequest
+	(*DeleteCategoryRequest)(nil),          // 39: inventory.DeleteCategoryRequest
+	(*CreateLotRequest)(nil),               // 40: inventory.CreateLotRequest
+	(*ListLotsRequest)(nil),                // 41: inventory.ListLotsRequest
+	(*ListExpiringLotsRequest)(nil),        // 42: inventory.ListExpiringLotsRequest
+	(*ListLotsResponse)(nil),               // 43: inventory.ListLotsResponse
+	(*RegisterSerialsRequest)(nil),         // 44: inventory.RegisterSerialsRequest
+	(*ListSerialsRequest)(nil),             // 45: inventory.ListSerialsRequest
+	(*ListSerialsResponse)(nil),            // 46: inventory.ListSerialsResponse
+	(*GetSerialRequest)(nil),               // 47: inventory.GetSerialRequest
+	(*SchedulePriceChangeRequest)(nil),     // 48: inventory.SchedulePriceChangeRequest
+	(*CancelPriceChangeRequest)(nil),       // 49: inventory.CancelPriceChangeRequest
+	(*ListPriceHistoryRequest)(nil),        // 50: inventory.ListPriceHistoryRequest
+	(*ListPriceHistoryResponse)(nil),       // 51: inventory.ListPriceHistoryResponse
+	(*ListReservationsRequest)(nil),        // 52: inventory.ListReservationsRequest
+	(*ListReservationsResponse)(nil),       // 53: inventory.ListReservationsResponse
+	(*GetReservationRequest)(nil),          // 54: inventory.GetReservationRequest
+	(*CreateReservationRequest)(nil),       // 55: inventory.CreateReservationRequest
+	(*UpdateReservationStatusRequest)(nil), // 56: inventory.UpdateReservationStatusRequest
+	(*WatchStockRequest)(nil),              // 57: inventory.WatchStockRequest
+	(*StockEvent)(nil),                     // 58: inventory.StockEvent
+	(*ListWebhooksRequest)(nil),            // 59: inventory.ListWebhooksRequest
+	(*ListWebhooksResponse)(nil),           // 60: inventory.ListWebhooksResponse
+	(*GetWebhookRequest)(nil),              // 61: inventory.GetWebhookRequest
+	(*CreateWebhookRequest)(nil),           // 62: inventory.CreateWebhookRequest
+	(*UpdateWebhookRequest)(nil),           // 63: inventory.UpdateWebhookRequest
+	(*DeleteWebhookRequest)(nil),           // 64: inventory.DeleteWebhookRequest
+	(*ListWebhookDeliveriesRequest)(nil),   // 65: inventory.ListWebhookDeliveriesRequest
+	(*ListWebhookDeliveriesResponse)(nil),  // 66: inventory.ListWebhookDeliveriesResponse
+	(*ListAuditEventsRequest)(nil),         // 67: inventory.ListAuditEventsRequest
+	(*ListAuditEventsResponse)(nil),        // 68: inventory.ListAuditEventsResponse
+	nil,                                    // 69: inventory.Product.OptionsEntry
+	nil,                                    // 70: inventory.CreateProductRequest.OptionsEntry
+	nil,                                    // 71: inventory.UpdateProductRequest.OptionsEntry
+	nil,                                    // 72: inventory.BatchItemResult.FieldErrorsEntry
+	(*timestamppb.Timestamp)(nil),          // 73: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),                // 74: google.protobuf.Struct
+	(*structpb.Value)(nil),                 // 75: google.protobuf.Value
+	(*fieldmaskpb.FieldMask)(nil),          // 76: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),                  // 77: google.protobuf.Empty
 }
 var file_proto_inventory_proto_depIdxs = []int32{
-	14, // 0: inventory.Product.created_at:type_name -> google.protobuf.Timestamp
-	14, // 1: inventory.Product.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 2: inventory.Reservation.status:type_name -> inventory.ReservationStatus
-	14, // 3: inventory.Reservation.created_at:type_name -> google.protobuf.Timestamp
-	1,  // 4: inventory.ListProductsResponse.products:type_name -> inventory.Product
-	0,  // 5: inventory.ListReservationsRequest.statuses:type_name -> inventory.ReservationStatus
-	2,  // 6: inventory.ListReservationsResponse.reservations:type_name -> inventory.Reservation
-	0,  // 7: inventory.UpdateReservationStatusRequest.status:type_name -> inventory.ReservationStatus
-	3,  // 8: inventory.InventoryService.ListProducts:input_type -> inventory.ListProductsRequest
-	5,  // 9: inventory.InventoryService.GetProduct:input_type -> inventory.GetProductRequest
-	6,  // 10: inventory.InventoryService.CreateProduct:input_type -> inventory.CreateProductRequest
-	7,  // 11: inventory.InventoryService.UpdateProduct:input_type -> inventory.UpdateProductRequest
-	8,  // 12: inventory.InventoryService.DeleteProduct:input_type -> inventory.DeleteProductRequest
-	9,  // 13: inventory.InventoryService.ListReservations:input_type -> inventory.ListReservationsRequest
-	11, // 14: inventory.InventoryService.GetReservation:input_type -> inventory.GetReservationRequest
-	12, // 15: inventory.InventoryService.CreateReservation:input_type -> inventory.CreateReservationRequest
-	13, // 16: inventory.InventoryService.UpdateReservationStatus:input_type -> inventory.UpdateReservationStatusRequest
-	4,  // 17: inventory.InventoryService.ListProducts:output_type -> inventory.ListProductsResponse
-	1,  // 18: inventory.InventoryService.GetProduct:output_type -> inventory.Product
-	1,  // 19: inventory.InventoryService.CreateProduct:output_type -> inventory.Product
-	1,  // 20: inventory.InventoryService.UpdateProduct:output_type -> inventory.Product
-	15, // 21: inventory.InventoryService.DeleteProduct:output_type -> google.protobuf.Empty
-	10, // 22: inventory.InventoryService.ListReservations:output_type -> inventory.ListReservationsResponse
-	2,  // 23: inventory.InventoryService.GetReservation:output_type -> inventory.Reservation
-	2,  // 24: inventory.InventoryService.CreateReservation:output_type -> inventory.Reservation
-	15, // 25: inventory.InventoryService.UpdateReservationStatus:output_type -> google.protobuf.Empty
-	17, // [17:26] is the sub-list for method output_type
-	8,  // [8:17] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	73,  // 0: inventory.Product.created_at:type_name -> google.protobuf.Timestamp
+	73,  // 1: inventory.Product.updated_at:type_name -> google.protobuf.Timestamp
+	69,  // 2: inventory.Product.options:type_name -> inventory.Product.OptionsEntry
+	5,   // 3: inventory.Product.variants:type_name -> inventory.Product
+	10,  // 4: inventory.Product.components:type_name -> inventory.KitComponent
+	8,   // 5: inventory.Product.units:type_name -> inventory.ProductUnit
+	7,   // 6: inventory.Product.price:type_name -> inventory.Money
+	1,   // 7: inventory.Product.status:type_name -> inventory.ProductStatus
+	74,  // 8: inventory.Product.attributes:type_name -> google.protobuf.Struct
+	75,  // 9: inventory.AttributeFilter.equals:type_name -> google.protobuf.Value
+	5,   // 10: inventory.StockAdjustment.product:type_name -> inventory.Product
+	5,   // 11: inventory.KitComponent.component:type_name -> inventory.Product
+	73,  // 12: inventory.Category.created_at:type_name -> google.protobuf.Timestamp
+	73,  // 13: inventory.Category.updated_at:type_name -> google.protobuf.Timestamp
+	11,  // 14: inventory.Category.children:type_name -> inventory.Category
+	0,   // 15: inventory.Reservation.status:type_name -> inventory.ReservationStatus
+	73,  // 16: inventory.Reservation.created_at:type_name -> google.protobuf.Timestamp
+	12,  // 17: inventory.Reservation.components:type_name -> inventory.Reservation
+	14,  // 18: inventory.Reservation.lots:type_name -> inventory.LotAllocation
+	16,  // 19: inventory.Reservation.serials:type_name -> inventory.Serial
+	73,  // 20: inventory.Lot.expires_at:type_name -> google.protobuf.Timestamp
+	73,  // 21: inventory.Lot.created_at:type_name -> google.protobuf.Timestamp
+	73,  // 22: inventory.Lot.updated_at:type_name -> google.protobuf.Timestamp
+	73,  // 23: inventory.LotAllocation.expires_at:type_name -> google.protobuf.Timestamp
+	7,   // 24: inventory.PriceChange.price:type_name -> inventory.Money
+	73,  // 25: inventory.PriceChange.effective_from:type_name -> google.protobuf.Timestamp
+	73,  // 26: inventory.PriceChange.applied_at:type_name -> google.protobuf.Timestamp
+	73,  // 27: inventory.PriceChange.created_at:type_name -> google.protobuf.Timestamp
+	3,   // 28: inventory.Serial.status:type_name -> inventory.SerialStatus
+	73,  // 29: inventory.Serial.created_at:type_name -> google.protobuf.Timestamp
+	73,  // 30: inventory.Serial.updated_at:type_name -> google.protobuf.Timestamp
+	5,   // 31: inventory.Serial.product:type_name -> inventory.Product
+	73,  // 32: inventory.Webhook.disabled_at:type_name -> google.protobuf.Timestamp
+	73,  // 33: inventory.Webhook.created_at:type_name -> google.protobuf.Timestamp
+	73,  // 34: inventory.Webhook.updated_at:type_name -> google.protobuf.Timestamp
+	4,   // 35: inventory.WebhookDelivery.status:type_name -> inventory.WebhookDeliveryStatus
+	73,  // 36: inventory.WebhookDelivery.next_attempt_at:type_name -> google.protobuf.Timestamp
+	73,  // 37: inventory.WebhookDelivery.delivered_at:type_name -> google.protobuf.Timestamp
+	73,  // 38: inventory.WebhookDelivery.created_at:type_name -> google.protobuf.Timestamp
+	74,  // 39: inventory.AuditEvent.before:type_name -> google.protobuf.Struct
+	74,  // 40: inventory.AuditEvent.after:type_name -> google.protobuf.Struct
+	73,  // 41: inventory.AuditEvent.created_at:type_name -> google.protobuf.Timestamp
+	1,   // 42: inventory.ListProductsRequest.statuses:type_name -> inventory.ProductStatus
+	6,   // 43: inventory.ListProductsRequest.attributes:type_name -> inventory.AttributeFilter
+	5,   // 44: inventory.ListProductsResponse.products:type_name -> inventory.Product
+	73,  // 45: inventory.GetProductRequest.price_at:type_name -> google.protobuf.Timestamp
+	70,  // 46: inventory.CreateProductRequest.options:type_name -> inventory.CreateProductRequest.OptionsEntry
+	10,  // 47: inventory.CreateProductRequest.components:type_name -> inventory.KitComponent
+	8,   // 48: inventory.CreateProductRequest.units:type_name -> inventory.ProductUnit
+	7,   // 49: inventory.CreateProductRequest.price:type_name -> inventory.Money
+	1,   // 50: inventory.CreateProductRequest.status:type_name -> inventory.ProductStatus
+	74,  // 51: inventory.CreateProductRequest.attributes:type_name -> google.protobuf.Struct
+	71,  // 52: inventory.UpdateProductRequest.options:type_name -> inventory.UpdateProductRequest.OptionsEntry
+	10,  // 53: inventory.UpdateProductRequest.components:type_name -> inventory.KitComponent
+	8,   // 54: inventory.UpdateProductRequest.units:type_name -> inventory.ProductUnit
+	7,   // 55: inventory.UpdateProductRequest.price:type_name -> inventory.Money
+	74,  // 56: inventory.UpdateProductRequest.attributes:type_name -> google.protobuf.Struct
+	76,  // 57: inventory.UpdateProductRequest.update_mask:type_name -> google.protobuf.FieldMask
+	25,  // 58: inventory.BatchCreateProductsRequest.products:type_name -> inventory.CreateProductRequest
+	26,  // 59: inventory.BatchUpdateProductsRequest.products:type_name -> inventory.UpdateProductRequest
+	2,   // 60: inventory.BatchItemResult.status:type_name -> inventory.BatchItemStatus
+	5,   // 61: inventory.BatchItemResult.product:type_name -> inventory.Product
+	72,  // 62: inventory.BatchItemResult.field_errors:type_name -> inventory.BatchItemResult.FieldErrorsEntry
+	29,  // 63: inventory.BatchProductsResponse.results:type_name -> inventory.BatchItemResult
+	1,   // 64: inventory.UpdateProductStatusRequest.status:type_name -> inventory.ProductStatus
+	11,  // 65: inventory.ListCategoriesResponse.categories:type_name -> inventory.Category
+	73,  // 66: inventory.CreateLotRequest.expires_at:type_name -> google.protobuf.Timestamp
+	13,  // 67: inventory.ListLotsResponse.lots:type_name -> inventory.Lot
+	3,   // 68: inventory.ListSerialsRequest.statuses:type_name -> inventory.SerialStatus
+	16,  // 69: inventory.ListSerialsResponse.serials:type_name -> inventory.Serial
+	7,   // 70: inventory.SchedulePriceChangeRequest.price:type_name -> inventory.Money
+	73,  // 71: inventory.SchedulePriceChangeRequest.effective_from:type_name -> google.protobuf.Timestamp
+	15,  // 72: inventory.ListPriceHistoryResponse.price_changes:type_name -> inventory.PriceChange
+	0,   // 73: inventory.ListReservationsRequest.statuses:type_name -> inventory.ReservationStatus
+	12,  // 74: inventory.ListReservationsResponse.reservations:type_name -> inventory.Reservation
+	0,   // 75: inventory.UpdateReservationStatusRequest.status:type_name -> inventory.ReservationStatus
+	73,  // 76: inventory.StockEvent.changed_at:type_name -> google.protobuf.Timestamp
+	17,  // 77: inventory.ListWebhooksResponse.webhooks:type_name -> inventory.Webhook
+	4,   // 78: inventory.ListWebhookDeliveriesRequest.statuses:type_name -> inventory.WebhookDeliveryStatus
+	18,  // 79: inventory.ListWebhookDeliveriesResponse.deliveries:type_name -> inventory.WebhookDelivery
+	73,  // 80: inventory.ListAuditEventsRequest.from:type_name -> google.protobuf.Timestamp
+	73,  // 81: inventory.ListAuditEventsRequest.to:type_name -> google.protobuf.Timestamp
+	19,  // 82: inventory.ListAuditEventsResponse.events:type_name -> inventory.AuditEvent
+	20,  // 83: inventory.InventoryService.ListProducts:input_type -> inventory.ListProductsRequest
+	22,  // 84: inventory.InventoryService.GetProduct:input_type -> inventory.GetProductRequest
+	23,  // 85: inventory.InventoryService.GetProductBySKU:input_type -> inventory.GetProductBySKURequest
+	24,  // 86: inventory.InventoryService.GetProductByBarcode:input_type -> inventory.GetProductByBarcodeRequest
+	25,  // 87: inventory.InventoryService.CreateProduct:input_type -> inventory.CreateProductRequest
+	26,  // 88: inventory.InventoryService.UpdateProduct:input_type -> inventory.UpdateProductRequest
+	33,  // 89: inventory.InventoryService.DeleteProduct:input_type -> inventory.DeleteProductRequest
+	32,  // 90: inventory.InventoryService.AdjustStock:input_type -> inventory.AdjustStockRequest
+	31,  // 91: inventory.InventoryService.UpdateProductStatus:input_type -> inventory.UpdateProductStatusRequest
+	27,  // 92: inventory.InventoryService.BatchCreateProducts:input_type -> inventory.BatchCreateProductsRequest
+	28,  // 93: inventory.InventoryService.BatchUpdateProducts:input_type -> inventory.BatchUpdateProductsRequest
+	20,  // 94: inventory.InventoryService.ExportProducts:input_type -> inventory.ListProductsRequest
+	57,  // 95: inventory.InventoryService.WatchStock:input_type -> inventory.WatchStockRequest
+	34,  // 96: inventory.InventoryService.ListCategories:input_type -> inventory.ListCategoriesRequest
+	36,  // 97: inventory.InventoryService.GetCategory:input_type -> inventory.GetCategoryRequest
+	37,  // 98: inventory.InventoryService.CreateCategory:input_type -> inventory.CreateCategoryRequest
+	38,  // 99: inventory.InventoryService.UpdateCategory:input_type -> inventory.UpdateCategoryRequest
+	39,  // 100: inventory.InventoryService.DeleteCategory:input_type -> inventory.DeleteCategoryRequest
+	40,  // 101: inventory.InventoryService.CreateLot:input_type -> inventory.CreateLotRequest
+	41,  // 102: inventory.InventoryService.ListLots:input_type -> inventory.ListLotsRequest
+	42,  // 103: inventory.InventoryService.ListExpiringLots:input_type -> inventory.ListExpiringLotsRequest
+	44,  // 104: inventory.InventoryService.RegisterSerials:input_type -> inventory.RegisterSerialsRequest
+	45,  // 105: inventory.InventoryService.ListSerials:input_type -> inventory.ListSerialsRequest
+	47,  // 106: inventory.InventoryService.GetSerial:input_type -> inventory.GetSerialRequest
+	48,  // 107: inventory.InventoryService.SchedulePriceChange:input_type -> inventory.SchedulePriceChangeRequest
+	49,  // 108: inventory.InventoryService.CancelPriceChange:input_type -> inventory.CancelPriceChangeRequest
+	50,  // 109: inventory.InventoryService.ListPriceHistory:input_type -> inventory.ListPriceHistoryRequest
+	52,  // 110: inventory.InventoryService.ListReservations:input_type -> inventory.ListReservationsRequest
+	54,  // 111: inventory.InventoryService.GetReservation:input_type -> inventory.GetReservationRequest
+	55,  // 112: inventory.InventoryService.CreateReservation:input_type -> inventory.CreateReservationRequest
+	56,  // 113: inventory.InventoryService.UpdateReservationStatus:input_type -> inventory.UpdateReservationStatusRequest
+	52,  // 114: inventory.InventoryService.ExportReservations:input_type -> inventory.ListReservationsRequest
+	59,  // 115: inventory.InventoryService.ListWebhooks:input_type -> inventory.ListWebhooksRequest
+	61,  // 116: inventory.InventoryService.GetWebhook:input_type -> inventory.GetWebhookRequest
+	62,  // 117: inventory.InventoryService.CreateWebhook:input_type -> inventory.CreateWebhookRequest
+	63,  // 118: inventory.InventoryService.UpdateWebhook:input_type -> inventory.UpdateWebhookRequest
+	64,  // 119: inventory.InventoryService.DeleteWebhook:input_type -> inventory.DeleteWebhookRequest
+	65,  // 120: inventory.InventoryService.ListWebhookDeliveries:input_type -> inventory.ListWebhookDeliveriesRequest
+	67,  // 121: inventory.InventoryService.ListAuditEvents:input_type -> inventory.ListAuditEventsRequest
+	21,  // 122: inventory.InventoryService.ListProducts:output_type -> inventory.ListProductsResponse
+	5,   // 123: inventory.InventoryService.GetProduct:output_type -> inventory.Product
+	5,   // 124: inventory.InventoryService.GetProductBySKU:output_type -> inventory.Product
+	5,   // 125: inventory.InventoryService.GetProductByBarcode:output_type -> inventory.Product
+	5,   // 126: inventory.InventoryService.CreateProduct:output_type -> inventory.Product
+	5,   // 127: inventory.InventoryService.UpdateProduct:output_type -> inventory.Product
+	77,  // 128: inventory.InventoryService.DeleteProduct:output_type -> google.protobuf.Empty
+	9,   // 129: inventory.InventoryService.AdjustStock:output_type -> inventory.StockAdjustment
+	5,   // 130: inventory.InventoryService.UpdateProductStatus:output_type -> inventory.Product
+	30,  // 131: inventory.InventoryService.BatchCreateProducts:output_type -> inventory.BatchProductsResponse
+	30,  // 132: inventory.InventoryService.BatchUpdateProducts:output_type -> inventory.BatchProductsResponse
+	5,   // 133: inventory.InventoryService.ExportProducts:output_type -> inventory.Product
+	58,  // 134: inventory.InventoryService.WatchStock:output_type -> inventory.StockEvent
+	35,  // 135: inventory.InventoryService.ListCategories:output_type -> inventory.ListCategoriesResponse
+	11,  // 136: inventory.InventoryService.GetCategory:output_type -> inventory.Category
+	11,  // 137: inventory.InventoryService.CreateCategory:output_type -> inventory.Category
+	11,  // 138: inventory.InventoryService.UpdateCategory:output_type -> inventory.Category
+	77,  // 139: inventory.InventoryService.DeleteCategory:output_type -> google.protobuf.Empty
+	13,  // 140: inventory.InventoryService.CreateLot:output_type -> inventory.Lot
+	43,  // 141: inventory.InventoryService.ListLots:output_type -> inventory.ListLotsResponse
+	43,  // 142: inventory.InventoryService.ListExpiringLots:output_type -> inventory.ListLotsResponse
+	46,  // 143: inventory.InventoryService.RegisterSerials:output_type -> inventory.ListSerialsResponse
+	46,  // 144: inventory.InventoryService.ListSerials:output_type -> inventory.ListSerialsResponse
+	16,  // 145: inventory.InventoryService.GetSerial:output_type -> inventory.Serial
+	15,  // 146: inventory.InventoryService.SchedulePriceChange:output_type -> inventory.PriceChange
+	77,  // 147: inventory.InventoryService.CancelPriceChange:output_type -> google.protobuf.Empty
+	51,  // 148: inventory.InventoryService.ListPriceHistory:output_type -> inventory.ListPriceHistoryResponse
+	53,  // 149: inventory.InventoryService.ListReservations:output_type -> inventory.ListReservationsResponse
+	12,  // 150: inventory.InventoryService.GetReservation:output_type -> inventory.Reservation
+	12,  // 151: inventory.InventoryService.CreateReservation:output_type -> inventory.Reservation
+	77,  // 152: inventory.InventoryService.UpdateReservationStatus:output_type -> google.protobuf.Empty
+	12,  // 153: inventory.InventoryService.ExportReservations:output_type -> inventory.Reservation
+	60,  // 154: inventory.InventoryService.ListWebhooks:output_type -> inventory.ListWebhooksResponse
+	17,  // 155: inventory.InventoryService.GetWebhook:output_type -> inventory.Webhook
+	17,  // 156: inventory.InventoryService.CreateWebhook:output_type -> inventory.Webhook
+	17,  // 157: inventory.InventoryService.UpdateWebhook:output_type -> inventory.Webhook
+	77,  // 158: inventory.InventoryService.DeleteWebhook:output_type -> google.protobuf.Empty
+	66,  // 159: inventory.InventoryService.ListWebhookDeliveries:output_type -> inventory.ListWebhookDeliveriesResponse
+	68,  // 160: inventory.InventoryService.ListAuditEvents:output_type -> inventory.ListAuditEventsResponse
+	122, // [122:161] is the sub-list for method output_type
+	83,  // [83:122] is the sub-list for method input_type
+	83,  // [83:83] is the sub-list for extension type_name
+	83,  // [83:83] is the sub-list for extension extendee
+	0,   // [0:83] is the sub-list for field type_name
 }
 
 func init() { file_proto_inventory_proto_init() }
@@ -1024,13 +5712,14 @@ func file_proto_inventory_proto_init() {
 	if File_proto_inventory_proto != nil {
 		return
 	}
+	file_proto_inventory_proto_msgTypes[1].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_inventory_proto_rawDesc), len(file_proto_inventory_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   13,
+			NumEnums:      5,
+			NumMessages:   68,
 			NumExtensions: 0,
 			NumServices:   1,
 		},